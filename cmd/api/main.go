@@ -17,12 +17,8 @@ func main() {
 	sigChan := make(chan os.Signal, 1)
 	signal.Notify(sigChan, os.Interrupt, syscall.SIGTERM)
 
-	// Start HTTP server in a goroutine
-	go func() {
-		if err := container.Server.Start(); err != nil {
-			log.Fatalf("Error starting the server: %v", err)
-		}
-	}()
+	// Start background jobs and HTTP server
+	container.Start()
 
 	// Wait for interrupt signal
 	<-sigChan
@@ -0,0 +1,174 @@
+package appointment
+
+import (
+	"citary-backend/pkg/constants"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// BookAppointmentRequest represents a patient's request to book a doctor
+// StartTime is an RFC 3339 timestamp; the appointment lasts the doctor's consultation length
+type BookAppointmentRequest struct {
+	DoctorID  int     `json:"doctorId"`
+	StartTime string  `json:"startTime"`
+	Reason    *string `json:"reason"`
+}
+
+// Validate performs validation on the book appointment request data
+func (dto *BookAppointmentRequest) Validate() error {
+	if dto.DoctorID <= 0 {
+		return ErrDoctorIDMissing
+	}
+
+	if err := validateStartTime(dto.StartTime); err != nil {
+		return err
+	}
+
+	return validateReason(dto.Reason)
+}
+
+// ParsedStartTime returns the validated start time
+func (dto *BookAppointmentRequest) ParsedStartTime() time.Time {
+	startTime, _ := time.Parse(time.RFC3339, dto.StartTime)
+	return startTime
+}
+
+// TrimmedReason returns the reason without surrounding whitespace, or nil when empty
+func (dto *BookAppointmentRequest) TrimmedReason() *string {
+	return trimReason(dto.Reason)
+}
+
+// ChangeStatusRequest represents an optional explanation for moving an appointment to another status
+type ChangeStatusRequest struct {
+	Reason *string `json:"reason"`
+}
+
+// Validate performs validation on the change status request data
+func (dto *ChangeStatusRequest) Validate() error {
+	return validateReason(dto.Reason)
+}
+
+// TrimmedReason returns the reason without surrounding whitespace, or nil when empty
+func (dto *ChangeStatusRequest) TrimmedReason() *string {
+	return trimReason(dto.Reason)
+}
+
+// RescheduleAppointmentRequest represents moving an appointment to a new start time
+// StartTime is an RFC 3339 timestamp; the appointment keeps its duration and location
+type RescheduleAppointmentRequest struct {
+	StartTime string  `json:"startTime"`
+	Reason    *string `json:"reason"`
+}
+
+// Validate performs validation on the reschedule appointment request data
+func (dto *RescheduleAppointmentRequest) Validate() error {
+	if err := validateStartTime(dto.StartTime); err != nil {
+		return err
+	}
+
+	return validateReason(dto.Reason)
+}
+
+// ParsedStartTime returns the validated start time
+func (dto *RescheduleAppointmentRequest) ParsedStartTime() time.Time {
+	startTime, _ := time.Parse(time.RFC3339, dto.StartTime)
+	return startTime
+}
+
+// TrimmedReason returns the reason without surrounding whitespace, or nil when empty
+func (dto *RescheduleAppointmentRequest) TrimmedReason() *string {
+	return trimReason(dto.Reason)
+}
+
+// ListAppointmentsQuery represents the time range an organization's appointments are listed for
+// From and To are RFC 3339 timestamps; appointments starting within [From, To) are returned
+type ListAppointmentsQuery struct {
+	From string
+	To   string
+}
+
+// Validate performs validation on the list appointments query
+func (dto *ListAppointmentsQuery) Validate() error {
+	from, err := time.Parse(time.RFC3339, dto.From)
+	if err != nil {
+		return ErrRangeInvalidFormat
+	}
+
+	to, err := time.Parse(time.RFC3339, dto.To)
+	if err != nil {
+		return ErrRangeInvalidFormat
+	}
+
+	if !to.After(from) {
+		return ErrRangeInvalid
+	}
+
+	if to.Sub(from) > time.Duration(constants.AppointmentConfig.MaxListRangeDays)*24*time.Hour {
+		return ErrRangeTooLong
+	}
+
+	return nil
+}
+
+// ParsedRange returns the validated range bounds
+func (dto *ListAppointmentsQuery) ParsedRange() (time.Time, time.Time) {
+	from, _ := time.Parse(time.RFC3339, dto.From)
+	to, _ := time.Parse(time.RFC3339, dto.To)
+	return from, to
+}
+
+// validateStartTime checks that a start time is an RFC 3339 timestamp on a whole minute
+func validateStartTime(value string) error {
+	startTime, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return ErrStartTimeInvalidFormat
+	}
+
+	if startTime.Second() != 0 || startTime.Nanosecond() != 0 {
+		return ErrStartTimeNotWholeMinute
+	}
+
+	return nil
+}
+
+// validateReason checks the length of an optional reason
+func validateReason(reason *string) error {
+	if reason != nil && len(strings.TrimSpace(*reason)) > constants.AppointmentConfig.MaxReasonLength {
+		return ErrReasonTooLong
+	}
+	return nil
+}
+
+// trimReason returns an optional reason without surrounding whitespace, or nil when empty
+func trimReason(reason *string) *string {
+	if reason == nil {
+		return nil
+	}
+	trimmed := strings.TrimSpace(*reason)
+	if trimmed == "" {
+		return nil
+	}
+	return &trimmed
+}
+
+// ValidationError represents a validation error with a custom message
+type ValidationError struct {
+	Message string
+}
+
+// Error implements the error interface
+func (e *ValidationError) Error() string {
+	return e.Message
+}
+
+// Validation error definitions
+var (
+	ErrDoctorIDMissing         = &ValidationError{Message: "A valid doctor ID is required"}
+	ErrStartTimeInvalidFormat  = &ValidationError{Message: "startTime must be an RFC 3339 timestamp, e.g. 2025-03-01T09:30:00-05:00"}
+	ErrStartTimeNotWholeMinute = &ValidationError{Message: "startTime must fall on a whole minute"}
+	ErrReasonTooLong           = &ValidationError{Message: fmt.Sprintf("Reason cannot exceed %d characters", constants.AppointmentConfig.MaxReasonLength)}
+	ErrRangeInvalidFormat      = &ValidationError{Message: "from and to must be RFC 3339 timestamps, e.g. 2025-03-01T00:00:00Z"}
+	ErrRangeInvalid            = &ValidationError{Message: "to must be after from"}
+	ErrRangeTooLong            = &ValidationError{Message: fmt.Sprintf("The range cannot exceed %d days", constants.AppointmentConfig.MaxListRangeDays)}
+)
@@ -0,0 +1,65 @@
+package auth
+
+// ChangePasswordRequest represents the data required for an authenticated user to change their password
+type ChangePasswordRequest struct {
+	CurrentPassword string `json:"currentPassword"`
+	NewPassword     string `json:"newPassword"`
+}
+
+// Validate performs validation on the change password request data
+// The new password must satisfy the same policy as at signup
+func (dto *ChangePasswordRequest) Validate() error {
+	if dto.CurrentPassword == "" {
+		return ErrCurrentPasswordEmpty
+	}
+
+	if err := ValidatePassword(dto.NewPassword); err != nil {
+		return err
+	}
+
+	if dto.NewPassword == dto.CurrentPassword {
+		return ErrPasswordUnchanged
+	}
+
+	return nil
+}
+
+// ChangeEmailRequest represents the data required to start an email address change
+type ChangeEmailRequest struct {
+	NewEmail        string `json:"newEmail"`
+	CurrentPassword string `json:"currentPassword"`
+}
+
+// Validate performs validation on the change email request data
+func (dto *ChangeEmailRequest) Validate() error {
+	if err := ValidateEmail(dto.NewEmail); err != nil {
+		return err
+	}
+
+	if dto.CurrentPassword == "" {
+		return ErrCurrentPasswordEmpty
+	}
+
+	return nil
+}
+
+// ConfirmEmailChangeRequest represents the token from an email change confirmation link
+type ConfirmEmailChangeRequest struct {
+	Token string `json:"token"`
+}
+
+// Validate performs validation on the confirm email change request data
+func (dto *ConfirmEmailChangeRequest) Validate() error {
+	if dto.Token == "" {
+		return ErrEmailChangeTokenEmpty
+	}
+
+	return nil
+}
+
+// Credential change validation errors
+var (
+	ErrCurrentPasswordEmpty  = &ValidationError{Message: "Current password cannot be empty"}
+	ErrPasswordUnchanged     = &ValidationError{Message: "New password must be different from the current password"}
+	ErrEmailChangeTokenEmpty = &ValidationError{Message: "Email change token cannot be empty"}
+)
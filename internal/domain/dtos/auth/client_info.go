@@ -0,0 +1,9 @@
+package auth
+
+// ClientInfo describes the device and network origin of an authentication request
+// DeviceName is supplied by the client; UserAgent and IPAddress are filled in by the HTTP layer
+type ClientInfo struct {
+	DeviceName string `json:"deviceName"`
+	UserAgent  string `json:"-"`
+	IPAddress  string `json:"-"`
+}
@@ -0,0 +1,22 @@
+package auth
+
+// LoginRequest represents the credentials required to log in
+type LoginRequest struct {
+	Email    string `json:"email"`
+	Password string `json:"password"`
+	ClientInfo
+}
+
+// Validate performs validation on the login request data
+// Only presence is checked: the password policy applies when passwords are set, not when they are used
+func (dto *LoginRequest) Validate() error {
+	if dto.Email == "" {
+		return ErrEmailEmpty
+	}
+
+	if dto.Password == "" {
+		return ErrPasswordEmpty
+	}
+
+	return nil
+}
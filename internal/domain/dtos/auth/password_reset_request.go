@@ -0,0 +1,50 @@
+package auth
+
+// ForgotPasswordRequest represents the data required to request a password reset link
+type ForgotPasswordRequest struct {
+	Email string `json:"email"`
+}
+
+// Validate performs validation on the forgot password request data
+func (dto *ForgotPasswordRequest) Validate() error {
+	return ValidateEmail(dto.Email)
+}
+
+// ResetPasswordRequest represents the data required to set a new password with a reset token
+type ResetPasswordRequest struct {
+	Token    string `json:"token"`
+	Password string `json:"password"`
+}
+
+// Validate performs validation on the reset password request data
+// The new password must satisfy the same policy as at signup
+func (dto *ResetPasswordRequest) Validate() error {
+	if dto.Token == "" {
+		return ErrResetTokenEmpty
+	}
+
+	return ValidatePassword(dto.Password)
+}
+
+// ReactivateAccountRequest represents the data required to reactivate an inactive account
+// A new password is always required; the previous one is discarded
+type ReactivateAccountRequest struct {
+	Token     string `json:"token"`
+	Password  string `json:"password"`
+	IPAddress string `json:"-"`
+}
+
+// Validate performs validation on the reactivate account request data
+func (dto *ReactivateAccountRequest) Validate() error {
+	if dto.Token == "" {
+		return ErrReactivationTokenEmpty
+	}
+
+	return ValidatePassword(dto.Password)
+}
+
+// Password reset and reactivation validation errors
+var (
+	ErrResetTokenEmpty        = &ValidationError{Message: "Reset token cannot be empty"}
+	ErrReactivationTokenEmpty = &ValidationError{Message: "Reactivation token cannot be empty"}
+)
@@ -0,0 +1,53 @@
+package auth
+
+// RefreshTokenRequest represents the data required to exchange a refresh token for a new token pair
+type RefreshTokenRequest struct {
+	RefreshToken string `json:"refreshToken"`
+	ClientInfo
+}
+
+// Validate performs validation on the refresh token request data
+func (dto *RefreshTokenRequest) Validate() error {
+	if dto.RefreshToken == "" {
+		return ErrRefreshTokenEmpty
+	}
+
+	return nil
+}
+
+// SwitchOrganizationRequest represents the data required to move a session into another organization
+// A nil OrganizationID moves the session outside of any organization
+type SwitchOrganizationRequest struct {
+	RefreshToken   string `json:"refreshToken"`
+	OrganizationID *int   `json:"organizationId"`
+	ClientInfo
+}
+
+// Validate performs validation on the switch organization request data
+func (dto *SwitchOrganizationRequest) Validate() error {
+	if dto.RefreshToken == "" {
+		return ErrRefreshTokenEmpty
+	}
+
+	return nil
+}
+
+// LogoutRequest represents the data required to end one or all sessions of a user
+type LogoutRequest struct {
+	RefreshToken string `json:"refreshToken"`
+	AllDevices   bool   `json:"allDevices"`
+}
+
+// Validate performs validation on the logout request data
+func (dto *LogoutRequest) Validate() error {
+	if dto.RefreshToken == "" {
+		return ErrRefreshTokenEmpty
+	}
+
+	return nil
+}
+
+// Refresh token validation errors
+var (
+	ErrRefreshTokenEmpty = &ValidationError{Message: "Refresh token cannot be empty"}
+)
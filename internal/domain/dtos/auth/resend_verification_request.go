@@ -0,0 +1,11 @@
+package auth
+
+// ResendVerificationRequest represents the data required to request a new verification email
+type ResendVerificationRequest struct {
+	Email string `json:"email"`
+}
+
+// Validate performs validation on the resend verification request data
+func (dto *ResendVerificationRequest) Validate() error {
+	return ValidateEmail(dto.Email)
+}
@@ -1,47 +1,88 @@
 package auth
 
 import (
+	"citary-backend/internal/domain/dtos/legal"
+	"citary-backend/pkg/constants"
 	"regexp"
 	"unicode"
 )
 
 // SignupRequest represents the data required to create a new user account
+// TermsVersion and PrivacyVersion are the legal document versions the user explicitly accepted
 type SignupRequest struct {
-	Email    string `json:"email"`
-	Password string `json:"password"`
+	Email          string `json:"email"`
+	Password       string `json:"password"`
+	TermsVersion   string `json:"termsVersion"`
+	PrivacyVersion string `json:"privacyVersion"`
+	IPAddress      string `json:"-"`
+	UserAgent      string `json:"-"`
 }
 
 // Validate performs validation on the signup request data
 func (dto *SignupRequest) Validate() error {
-	// Email validations
-	if dto.Email == "" {
+	if err := ValidateEmail(dto.Email); err != nil {
+		return err
+	}
+
+	if err := ValidatePassword(dto.Password); err != nil {
+		return err
+	}
+
+	if dto.TermsVersion == "" {
+		return ErrTermsNotAccepted
+	}
+
+	if dto.PrivacyVersion == "" {
+		return ErrPrivacyNotAccepted
+	}
+
+	return nil
+}
+
+// AcceptedDocuments returns the legal document versions accepted at signup
+func (dto *SignupRequest) AcceptedDocuments() []legal.AcceptedDocument {
+	return []legal.AcceptedDocument{
+		{Type: constants.LegalDocumentType.Terms, Version: dto.TermsVersion},
+		{Type: constants.LegalDocumentType.Privacy, Version: dto.PrivacyVersion},
+	}
+}
+
+// emailRegex matches the email formats accepted by the platform
+var emailRegex = regexp.MustCompile(`^[a-zA-Z0-9._%+\-]+@[a-zA-Z0-9.\-]+\.[a-zA-Z]{2,}$`)
+
+// ValidateEmail checks an email address against the platform email rules
+func ValidateEmail(email string) error {
+	if email == "" {
 		return ErrEmailEmpty
 	}
 
-	if len(dto.Email) > 100 {
+	if len(email) > 100 {
 		return ErrEmailTooLong
 	}
 
-	emailRegex := regexp.MustCompile(`^[a-zA-Z0-9._%+\-]+@[a-zA-Z0-9.\-]+\.[a-zA-Z]{2,}$`)
-	if !emailRegex.MatchString(dto.Email) {
+	if !emailRegex.MatchString(email) {
 		return ErrEmailInvalidFormat
 	}
 
-	// Password validations
-	if dto.Password == "" {
+	return nil
+}
+
+// ValidatePassword checks a password against the platform password policy
+func ValidatePassword(password string) error {
+	if password == "" {
 		return ErrPasswordEmpty
 	}
 
-	if len(dto.Password) < 8 {
+	if len(password) < 8 {
 		return ErrPasswordTooShort
 	}
 
-	if len(dto.Password) > 100 {
+	if len(password) > 100 {
 		return ErrPasswordTooLong
 	}
 
 	var hasLower, hasUpper, hasDigit, hasSpecial bool
-	for _, char := range dto.Password {
+	for _, char := range password {
 		switch {
 		case unicode.IsLower(char):
 			hasLower = true
@@ -98,4 +139,8 @@ var (
 	ErrPasswordNoUppercase   = &ValidationError{Message: "Password must contain at least one uppercase letter"}
 	ErrPasswordNoDigit       = &ValidationError{Message: "Password must contain at least one digit"}
 	ErrPasswordNoSpecialChar = &ValidationError{Message: "Password must contain at least one special character"}
+
+	// Legal consent validation errors
+	ErrTermsNotAccepted   = &ValidationError{Message: "You must accept the terms of service"}
+	ErrPrivacyNotAccepted = &ValidationError{Message: "You must accept the privacy policy"}
 )
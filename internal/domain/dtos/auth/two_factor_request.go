@@ -0,0 +1,76 @@
+package auth
+
+import "regexp"
+
+// totpCodeRegex matches six-digit authenticator codes
+var totpCodeRegex = regexp.MustCompile(`^[0-9]{6}$`)
+
+// ConfirmTwoFactorRequest represents the first authenticator code confirming a two-factor enrollment
+type ConfirmTwoFactorRequest struct {
+	Code string `json:"code"`
+}
+
+// Validate performs validation on the confirm two-factor request data
+func (dto *ConfirmTwoFactorRequest) Validate() error {
+	return validateTOTPCode(dto.Code)
+}
+
+// DisableTwoFactorRequest represents the data required to turn two-factor authentication off
+type DisableTwoFactorRequest struct {
+	Password string `json:"password"`
+}
+
+// Validate performs validation on the disable two-factor request data
+func (dto *DisableTwoFactorRequest) Validate() error {
+	if dto.Password == "" {
+		return ErrPasswordEmpty
+	}
+
+	return nil
+}
+
+// VerifyTwoFactorLoginRequest represents the second login step: the MFA challenge plus a code
+// Either Code (from the authenticator app) or RecoveryCode must be provided
+type VerifyTwoFactorLoginRequest struct {
+	MFAToken     string `json:"mfaToken"`
+	Code         string `json:"code"`
+	RecoveryCode string `json:"recoveryCode"`
+	ClientInfo
+}
+
+// Validate performs validation on the verify two-factor login request data
+func (dto *VerifyTwoFactorLoginRequest) Validate() error {
+	if dto.MFAToken == "" {
+		return ErrMFATokenEmpty
+	}
+
+	if dto.Code == "" && dto.RecoveryCode == "" {
+		return ErrTwoFactorCodeEmpty
+	}
+
+	if dto.Code != "" {
+		return validateTOTPCode(dto.Code)
+	}
+
+	return nil
+}
+
+// validateTOTPCode checks that an authenticator code has the expected format
+func validateTOTPCode(code string) error {
+	if code == "" {
+		return ErrTwoFactorCodeEmpty
+	}
+
+	if !totpCodeRegex.MatchString(code) {
+		return ErrTwoFactorCodeInvalidFormat
+	}
+
+	return nil
+}
+
+// Two-factor validation errors
+var (
+	ErrMFATokenEmpty              = &ValidationError{Message: "MFA token cannot be empty"}
+	ErrTwoFactorCodeEmpty         = &ValidationError{Message: "Two-factor code cannot be empty"}
+	ErrTwoFactorCodeInvalidFormat = &ValidationError{Message: "Two-factor code must be 6 digits"}
+)
@@ -0,0 +1,30 @@
+package auth
+
+import "regexp"
+
+// VerifyEmailRequest represents the data required to verify a user's email address
+type VerifyEmailRequest struct {
+	Token string `json:"token"`
+}
+
+// verificationTokenRegex matches the 64-character hexadecimal tokens issued at signup
+var verificationTokenRegex = regexp.MustCompile(`^[a-f0-9]{64}$`)
+
+// Validate performs validation on the verify email request data
+func (dto *VerifyEmailRequest) Validate() error {
+	if dto.Token == "" {
+		return ErrVerificationTokenEmpty
+	}
+
+	if !verificationTokenRegex.MatchString(dto.Token) {
+		return ErrVerificationTokenInvalidFormat
+	}
+
+	return nil
+}
+
+// Verification token validation errors
+var (
+	ErrVerificationTokenEmpty         = &ValidationError{Message: "Verification token cannot be empty"}
+	ErrVerificationTokenInvalidFormat = &ValidationError{Message: "Verification token format is invalid"}
+)
@@ -0,0 +1,334 @@
+package availability
+
+import (
+	"citary-backend/pkg/constants"
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+)
+
+// DateLayout is the format accepted for calendar dates
+const DateLayout = "2006-01-02"
+
+// minutesPerDay is the end of a local day expressed in minutes; "24:00" is accepted as an end time
+const minutesPerDay = 24 * 60
+
+// maxExceptionDays bounds how many days a single exception may span
+const maxExceptionDays = 366
+
+// timeOfDayRegex matches wall-clock times in 24-hour "HH:MM" format
+var timeOfDayRegex = regexp.MustCompile(`^([01][0-9]|2[0-4]):([0-5][0-9])$`)
+
+// ParseTimeOfDay converts an "HH:MM" wall-clock time into minutes after midnight; "24:00" yields 1440
+func ParseTimeOfDay(value string) (int, error) {
+	match := timeOfDayRegex.FindStringSubmatch(value)
+	if match == nil {
+		return 0, ErrTimeInvalidFormat
+	}
+
+	hours, _ := strconv.Atoi(match[1])
+	minutes, _ := strconv.Atoi(match[2])
+	total := hours*60 + minutes
+	if total > minutesPerDay {
+		return 0, ErrTimeInvalidFormat
+	}
+
+	return total, nil
+}
+
+// FormatTimeOfDay converts minutes after midnight into an "HH:MM" wall-clock time
+func FormatTimeOfDay(minutes int) string {
+	return fmt.Sprintf("%02d:%02d", minutes/60, minutes%60)
+}
+
+// CreateBlockRequest represents a recurring weekly availability block
+// Weekday counts from Sunday (0) to Saturday (6); times are local to the location
+type CreateBlockRequest struct {
+	LocationID int     `json:"locationId"`
+	Weekday    *int    `json:"weekday"`
+	StartTime  string  `json:"startTime"`
+	EndTime    string  `json:"endTime"`
+	ValidFrom  *string `json:"validFrom"`
+	ValidUntil *string `json:"validUntil"`
+}
+
+// Validate performs validation on the create block request data
+func (dto *CreateBlockRequest) Validate() error {
+	if dto.LocationID <= 0 {
+		return ErrLocationIDMissing
+	}
+
+	if dto.Weekday == nil || *dto.Weekday < int(time.Sunday) || *dto.Weekday > int(time.Saturday) {
+		return ErrWeekdayInvalid
+	}
+
+	start, err := ParseTimeOfDay(dto.StartTime)
+	if err != nil {
+		return err
+	}
+
+	end, err := ParseTimeOfDay(dto.EndTime)
+	if err != nil {
+		return err
+	}
+
+	if start >= minutesPerDay || end <= start {
+		return ErrTimeRangeInvalid
+	}
+
+	validFrom, err := parseOptionalDate(dto.ValidFrom)
+	if err != nil {
+		return err
+	}
+
+	validUntil, err := parseOptionalDate(dto.ValidUntil)
+	if err != nil {
+		return err
+	}
+
+	if validFrom != nil && validUntil != nil && validUntil.Before(*validFrom) {
+		return ErrDateRangeInvalid
+	}
+
+	return nil
+}
+
+// StartMinute returns the start time in minutes after midnight; call after Validate
+func (dto *CreateBlockRequest) StartMinute() int {
+	minutes, _ := ParseTimeOfDay(dto.StartTime)
+	return minutes
+}
+
+// EndMinute returns the end time in minutes after midnight; call after Validate
+func (dto *CreateBlockRequest) EndMinute() int {
+	minutes, _ := ParseTimeOfDay(dto.EndTime)
+	return minutes
+}
+
+// ParsedValidFrom returns the first date the block applies to, or nil when unbounded; call after Validate
+func (dto *CreateBlockRequest) ParsedValidFrom() *time.Time {
+	date, _ := parseOptionalDate(dto.ValidFrom)
+	return date
+}
+
+// ParsedValidUntil returns the last date the block applies to, or nil when unbounded; call after Validate
+func (dto *CreateBlockRequest) ParsedValidUntil() *time.Time {
+	date, _ := parseOptionalDate(dto.ValidUntil)
+	return date
+}
+
+// CreateExceptionRequest represents a date-specific change to a doctor's weekly hours
+// EndDate defaults to StartDate; omitting both times makes the exception cover whole days
+// "available" exceptions (extra shifts) require a location and times
+type CreateExceptionRequest struct {
+	LocationID *int    `json:"locationId"`
+	Kind       string  `json:"kind"`
+	StartDate  string  `json:"startDate"`
+	EndDate    string  `json:"endDate"`
+	StartTime  *string `json:"startTime"`
+	EndTime    *string `json:"endTime"`
+	Reason     *string `json:"reason"`
+}
+
+// Validate performs validation on the create exception request data
+func (dto *CreateExceptionRequest) Validate() error {
+	if dto.Kind != constants.AvailabilityExceptionKind.Unavailable && dto.Kind != constants.AvailabilityExceptionKind.Available {
+		return ErrKindInvalid
+	}
+
+	if dto.LocationID != nil && *dto.LocationID <= 0 {
+		return ErrLocationIDMissing
+	}
+
+	startDate, err := time.Parse(DateLayout, dto.StartDate)
+	if err != nil {
+		return ErrDateInvalidFormat
+	}
+
+	endDate, err := time.Parse(DateLayout, dto.ResolvedEndDate())
+	if err != nil {
+		return ErrDateInvalidFormat
+	}
+
+	if endDate.Before(startDate) {
+		return ErrDateRangeInvalid
+	}
+
+	if endDate.Sub(startDate) > maxExceptionDays*24*time.Hour {
+		return ErrExceptionTooLong
+	}
+
+	if (dto.StartTime == nil) != (dto.EndTime == nil) {
+		return ErrTimesIncomplete
+	}
+
+	if dto.StartTime != nil {
+		start, err := ParseTimeOfDay(*dto.StartTime)
+		if err != nil {
+			return err
+		}
+
+		end, err := ParseTimeOfDay(*dto.EndTime)
+		if err != nil {
+			return err
+		}
+
+		if start >= minutesPerDay || end <= start {
+			return ErrTimeRangeInvalid
+		}
+	}
+
+	if dto.Kind == constants.AvailabilityExceptionKind.Available && (dto.LocationID == nil || dto.StartTime == nil) {
+		return ErrExtraShiftIncomplete
+	}
+
+	if dto.Reason != nil && len(*dto.Reason) > 255 {
+		return ErrReasonTooLong
+	}
+
+	return nil
+}
+
+// ResolvedEndDate returns the requested end date, or the start date for single-day exceptions
+func (dto *CreateExceptionRequest) ResolvedEndDate() string {
+	if dto.EndDate != "" {
+		return dto.EndDate
+	}
+	return dto.StartDate
+}
+
+// ParsedDates returns the first and last dates of the exception; call after Validate
+func (dto *CreateExceptionRequest) ParsedDates() (time.Time, time.Time) {
+	startDate, _ := time.Parse(DateLayout, dto.StartDate)
+	endDate, _ := time.Parse(DateLayout, dto.ResolvedEndDate())
+	return startDate, endDate
+}
+
+// Minutes returns the start and end times in minutes after midnight, or nil for whole-day exceptions; call after Validate
+func (dto *CreateExceptionRequest) Minutes() (*int, *int) {
+	if dto.StartTime == nil || dto.EndTime == nil {
+		return nil, nil
+	}
+
+	start, _ := ParseTimeOfDay(*dto.StartTime)
+	end, _ := ParseTimeOfDay(*dto.EndTime)
+	return &start, &end
+}
+
+// EffectiveAvailabilityQuery represents the time range effective availability is computed for
+// From and To are RFC 3339 timestamps
+type EffectiveAvailabilityQuery struct {
+	From string
+	To   string
+}
+
+// Validate performs validation on the effective availability query
+func (dto *EffectiveAvailabilityQuery) Validate() error {
+	from, err := time.Parse(time.RFC3339, dto.From)
+	if err != nil {
+		return ErrRangeInvalidFormat
+	}
+
+	to, err := time.Parse(time.RFC3339, dto.To)
+	if err != nil {
+		return ErrRangeInvalidFormat
+	}
+
+	if !to.After(from) {
+		return ErrRangeInvalid
+	}
+
+	if to.Sub(from) > time.Duration(constants.AvailabilityConfig.MaxRangeDays)*24*time.Hour {
+		return ErrRangeTooLong
+	}
+
+	return nil
+}
+
+// ParsedRange returns the start and end of the range; call after Validate
+func (dto *EffectiveAvailabilityQuery) ParsedRange() (time.Time, time.Time) {
+	from, _ := time.Parse(time.RFC3339, dto.From)
+	to, _ := time.Parse(time.RFC3339, dto.To)
+	return from, to
+}
+
+// SlotsQuery represents the time range free slots of a doctor are listed for
+// From and To are RFC 3339 timestamps
+type SlotsQuery struct {
+	From string
+	To   string
+}
+
+// Validate performs validation on the slots query
+func (dto *SlotsQuery) Validate() error {
+	from, err := time.Parse(time.RFC3339, dto.From)
+	if err != nil {
+		return ErrRangeInvalidFormat
+	}
+
+	to, err := time.Parse(time.RFC3339, dto.To)
+	if err != nil {
+		return ErrRangeInvalidFormat
+	}
+
+	if !to.After(from) {
+		return ErrRangeInvalid
+	}
+
+	if to.Sub(from) > time.Duration(constants.AvailabilityConfig.MaxSlotRangeDays)*24*time.Hour {
+		return ErrSlotRangeTooLong
+	}
+
+	return nil
+}
+
+// ParsedRange returns the start and end of the range; call after Validate
+func (dto *SlotsQuery) ParsedRange() (time.Time, time.Time) {
+	from, _ := time.Parse(time.RFC3339, dto.From)
+	to, _ := time.Parse(time.RFC3339, dto.To)
+	return from, to
+}
+
+// parseOptionalDate parses an optional "YYYY-MM-DD" date
+func parseOptionalDate(value *string) (*time.Time, error) {
+	if value == nil || *value == "" {
+		return nil, nil
+	}
+
+	date, err := time.Parse(DateLayout, *value)
+	if err != nil {
+		return nil, ErrDateInvalidFormat
+	}
+
+	return &date, nil
+}
+
+// ValidationError represents a validation error with a custom message
+type ValidationError struct {
+	Message string
+}
+
+// Error implements the error interface
+func (e *ValidationError) Error() string {
+	return e.Message
+}
+
+// Validation error definitions
+var (
+	ErrLocationIDMissing    = &ValidationError{Message: "A valid location ID is required"}
+	ErrWeekdayInvalid       = &ValidationError{Message: "Weekday must be a number from 0 (Sunday) to 6 (Saturday)"}
+	ErrTimeInvalidFormat    = &ValidationError{Message: "Times must use the 24-hour HH:MM format, e.g. 09:30"}
+	ErrTimeRangeInvalid     = &ValidationError{Message: "End time must be after start time on the same day"}
+	ErrDateInvalidFormat    = &ValidationError{Message: "Dates must use the YYYY-MM-DD format"}
+	ErrDateRangeInvalid     = &ValidationError{Message: "End date cannot be before start date"}
+	ErrKindInvalid          = &ValidationError{Message: "Kind must be 'unavailable' or 'available'"}
+	ErrExceptionTooLong     = &ValidationError{Message: fmt.Sprintf("An exception cannot span more than %d days", maxExceptionDays)}
+	ErrTimesIncomplete      = &ValidationError{Message: "Provide both start and end times, or neither for whole days"}
+	ErrExtraShiftIncomplete = &ValidationError{Message: "Extra shifts require a location, a start time and an end time"}
+	ErrReasonTooLong        = &ValidationError{Message: "Reason cannot exceed 255 characters"}
+	ErrRangeInvalidFormat   = &ValidationError{Message: "from and to must be RFC 3339 timestamps, e.g. 2025-03-01T00:00:00Z"}
+	ErrRangeInvalid         = &ValidationError{Message: "to must be after from"}
+	ErrRangeTooLong         = &ValidationError{Message: fmt.Sprintf("The range cannot exceed %d days", constants.AvailabilityConfig.MaxRangeDays)}
+	ErrSlotRangeTooLong     = &ValidationError{Message: fmt.Sprintf("Slots can be listed for at most %d days at a time", constants.AvailabilityConfig.MaxSlotRangeDays)}
+)
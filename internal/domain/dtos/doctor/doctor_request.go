@@ -0,0 +1,184 @@
+package doctor
+
+import (
+	"citary-backend/pkg/constants"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// languageRegex matches language tags such as "es" or "es-EC"
+var languageRegex = regexp.MustCompile(`^[a-z]{2}(-[A-Z]{2})?$`)
+
+// currencyRegex matches ISO 4217 currency codes
+var currencyRegex = regexp.MustCompile(`^[A-Z]{3}$`)
+
+// specialtyCodeRegex matches specialty codes: lowercase snake_case
+var specialtyCodeRegex = regexp.MustCompile(`^[a-z][a-z0-9_]{1,49}$`)
+
+// DoctorProfileRequest represents the editable fields of a doctor profile
+// ConsultationMinutes and FeeCurrency are optional and default to the platform values;
+// BufferMinutes is the break kept after each appointment and defaults to none
+type DoctorProfileRequest struct {
+	LicenseNumber        string   `json:"licenseNumber"`
+	Bio                  *string  `json:"bio"`
+	Languages            []string `json:"languages"`
+	ConsultationMinutes  *int     `json:"consultationMinutes"`
+	BufferMinutes        *int     `json:"bufferMinutes"`
+	ConsultationFeeCents *int     `json:"consultationFeeCents"`
+	FeeCurrency          string   `json:"feeCurrency"`
+	Specialties          []string `json:"specialties"`
+}
+
+// Validate performs validation on the doctor profile data
+func (dto *DoctorProfileRequest) Validate() error {
+	license := strings.TrimSpace(dto.LicenseNumber)
+	if license == "" {
+		return ErrLicenseNumberEmpty
+	}
+
+	if len(license) > 50 {
+		return ErrLicenseNumberTooLong
+	}
+
+	if dto.Bio != nil && len(*dto.Bio) > 2000 {
+		return ErrBioTooLong
+	}
+
+	if len(dto.Languages) > constants.DoctorConfig.MaxLanguages {
+		return ErrTooManyLanguages
+	}
+
+	for _, language := range dto.Languages {
+		if !languageRegex.MatchString(language) {
+			return &ValidationError{Message: fmt.Sprintf("Invalid language: %q (use a tag such as \"es\" or \"en-US\")", language)}
+		}
+	}
+
+	minutes := dto.ResolvedConsultationMinutes()
+	if minutes < constants.DoctorConfig.MinConsultationMinutes || minutes > constants.DoctorConfig.MaxConsultationMinutes {
+		return ErrConsultationMinutesOutOfRange
+	}
+
+	if buffer := dto.ResolvedBufferMinutes(); buffer < 0 || buffer > constants.DoctorConfig.MaxBufferMinutes {
+		return ErrBufferMinutesOutOfRange
+	}
+
+	if dto.ConsultationFeeCents != nil && *dto.ConsultationFeeCents < 0 {
+		return ErrFeeNegative
+	}
+
+	if !currencyRegex.MatchString(dto.ResolvedFeeCurrency()) {
+		return ErrFeeCurrencyInvalid
+	}
+
+	if len(dto.Specialties) == 0 {
+		return ErrSpecialtiesEmpty
+	}
+
+	if len(dto.Specialties) > constants.DoctorConfig.MaxSpecialties {
+		return ErrTooManySpecialties
+	}
+
+	for _, code := range dto.Specialties {
+		if !specialtyCodeRegex.MatchString(code) {
+			return &ValidationError{Message: fmt.Sprintf("Invalid specialty code: %q", code)}
+		}
+	}
+
+	return nil
+}
+
+// ResolvedConsultationMinutes returns the requested consultation length, or the platform default when none was sent
+func (dto *DoctorProfileRequest) ResolvedConsultationMinutes() int {
+	if dto.ConsultationMinutes != nil {
+		return *dto.ConsultationMinutes
+	}
+	return constants.DoctorConfig.DefaultConsultationMinutes
+}
+
+// ResolvedBufferMinutes returns the requested buffer between appointments, or zero when none was sent
+func (dto *DoctorProfileRequest) ResolvedBufferMinutes() int {
+	if dto.BufferMinutes != nil {
+		return *dto.BufferMinutes
+	}
+	return 0
+}
+
+// ResolvedFeeCurrency returns the requested currency code, or the platform default when none was sent
+func (dto *DoctorProfileRequest) ResolvedFeeCurrency() string {
+	if dto.FeeCurrency != "" {
+		return dto.FeeCurrency
+	}
+	return constants.DoctorConfig.DefaultFeeCurrency
+}
+
+// UniqueLanguages returns the requested languages without duplicates, in request order
+func (dto *DoctorProfileRequest) UniqueLanguages() []string {
+	return unique(dto.Languages)
+}
+
+// UniqueSpecialties returns the requested specialty codes without duplicates, in request order
+func (dto *DoctorProfileRequest) UniqueSpecialties() []string {
+	return unique(dto.Specialties)
+}
+
+// CreateDoctorRequest represents the data required to create a doctor profile for a member of the organization
+type CreateDoctorRequest struct {
+	UserID int `json:"userId"`
+	DoctorProfileRequest
+}
+
+// Validate performs validation on the create doctor request data
+func (dto *CreateDoctorRequest) Validate() error {
+	if dto.UserID <= 0 {
+		return ErrUserIDMissing
+	}
+
+	return dto.DoctorProfileRequest.Validate()
+}
+
+// UpdateDoctorRequest represents a full replacement of the editable fields of a doctor profile
+// The linked user is immutable
+type UpdateDoctorRequest struct {
+	DoctorProfileRequest
+}
+
+// unique removes duplicate values while keeping their first-seen order
+func unique(values []string) []string {
+	seen := make(map[string]bool, len(values))
+	result := make([]string, 0, len(values))
+	for _, value := range values {
+		if seen[value] {
+			continue
+		}
+		seen[value] = true
+		result = append(result, value)
+	}
+	return result
+}
+
+// ValidationError represents a validation error with a custom message
+type ValidationError struct {
+	Message string
+}
+
+// Error implements the error interface
+func (e *ValidationError) Error() string {
+	return e.Message
+}
+
+// Validation error definitions
+var (
+	ErrUserIDMissing                 = &ValidationError{Message: "User ID is required"}
+	ErrLicenseNumberEmpty            = &ValidationError{Message: "License number cannot be empty"}
+	ErrLicenseNumberTooLong          = &ValidationError{Message: "License number cannot exceed 50 characters"}
+	ErrBioTooLong                    = &ValidationError{Message: "Bio cannot exceed 2000 characters"}
+	ErrTooManyLanguages              = &ValidationError{Message: fmt.Sprintf("A doctor can list at most %d languages", constants.DoctorConfig.MaxLanguages)}
+	ErrConsultationMinutesOutOfRange = &ValidationError{Message: fmt.Sprintf("Consultation duration must be between %d and %d minutes", constants.DoctorConfig.MinConsultationMinutes, constants.DoctorConfig.MaxConsultationMinutes)}
+	ErrBufferMinutesOutOfRange       = &ValidationError{Message: fmt.Sprintf("Buffer between appointments must be between 0 and %d minutes", constants.DoctorConfig.MaxBufferMinutes)}
+	ErrFeeNegative                   = &ValidationError{Message: "Consultation fee cannot be negative"}
+	ErrFeeCurrencyInvalid            = &ValidationError{Message: "Fee currency must be a three-letter ISO 4217 code such as USD"}
+	ErrSpecialtiesEmpty              = &ValidationError{Message: "At least one specialty is required"}
+	ErrTooManySpecialties            = &ValidationError{Message: fmt.Sprintf("A doctor can list at most %d specialties", constants.DoctorConfig.MaxSpecialties)}
+)
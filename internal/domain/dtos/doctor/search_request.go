@@ -0,0 +1,76 @@
+package doctor
+
+import (
+	"citary-backend/pkg/constants"
+	"fmt"
+	"strings"
+)
+
+// SearchDoctorsQuery represents the filters and page of the public doctor search
+// Empty filters are ignored; Page and PageSize default to the first page of the default size
+type SearchDoctorsQuery struct {
+	Specialty    string
+	Organization string
+	Name         string
+	Language     string
+	Page         int
+	PageSize     int
+}
+
+// Validate performs validation on the search query
+func (dto *SearchDoctorsQuery) Validate() error {
+	if dto.Specialty != "" && !specialtyCodeRegex.MatchString(dto.Specialty) {
+		return &ValidationError{Message: fmt.Sprintf("Invalid specialty code: %q", dto.Specialty)}
+	}
+
+	if len(dto.Organization) > 80 {
+		return ErrOrganizationFilterTooLong
+	}
+
+	if len(strings.TrimSpace(dto.Name)) > 100 {
+		return ErrNameFilterTooLong
+	}
+
+	if dto.Language != "" && !languageRegex.MatchString(dto.Language) {
+		return &ValidationError{Message: fmt.Sprintf("Invalid language: %q (use a tag such as \"es\" or \"en-US\")", dto.Language)}
+	}
+
+	if dto.Page < 0 || dto.Page > constants.PaginationConfig.MaxPage {
+		return ErrPageOutOfRange
+	}
+
+	if dto.PageSize < 0 || dto.PageSize > constants.PaginationConfig.MaxPageSize {
+		return ErrPageSizeOutOfRange
+	}
+
+	return nil
+}
+
+// ResolvedPage returns the requested 1-based page, or the first page when none was sent
+func (dto *SearchDoctorsQuery) ResolvedPage() int {
+	if dto.Page > 0 {
+		return dto.Page
+	}
+	return 1
+}
+
+// ResolvedPageSize returns the requested page size, or the default size when none was sent
+func (dto *SearchDoctorsQuery) ResolvedPageSize() int {
+	if dto.PageSize > 0 {
+		return dto.PageSize
+	}
+	return constants.PaginationConfig.DefaultPageSize
+}
+
+// Offset returns the number of results skipped before the requested page
+func (dto *SearchDoctorsQuery) Offset() int {
+	return (dto.ResolvedPage() - 1) * dto.ResolvedPageSize()
+}
+
+// Search validation errors
+var (
+	ErrOrganizationFilterTooLong = &ValidationError{Message: "Organization filter cannot exceed 80 characters"}
+	ErrNameFilterTooLong         = &ValidationError{Message: "Name filter cannot exceed 100 characters"}
+	ErrPageOutOfRange            = &ValidationError{Message: fmt.Sprintf("Page must be between 1 and %d", constants.PaginationConfig.MaxPage)}
+	ErrPageSizeOutOfRange        = &ValidationError{Message: fmt.Sprintf("Page size must be between 1 and %d", constants.PaginationConfig.MaxPageSize)}
+)
@@ -0,0 +1,62 @@
+package legal
+
+// AcceptedDocument identifies a legal document version the user has read and accepts
+type AcceptedDocument struct {
+	Type    string `json:"type"`
+	Version string `json:"version"`
+}
+
+// AcceptLegalDocumentsRequest represents the legal document versions an authenticated user accepts
+type AcceptLegalDocumentsRequest struct {
+	Documents []AcceptedDocument `json:"documents"`
+	IPAddress string             `json:"-"`
+	UserAgent string             `json:"-"`
+}
+
+// Validate performs validation on the accept legal documents request data
+func (dto *AcceptLegalDocumentsRequest) Validate() error {
+	if len(dto.Documents) == 0 {
+		return ErrDocumentsEmpty
+	}
+
+	return ValidateAcceptedDocuments(dto.Documents)
+}
+
+// ValidateAcceptedDocuments checks that every accepted document names a type and version, once per type
+func ValidateAcceptedDocuments(documents []AcceptedDocument) error {
+	seen := make(map[string]bool, len(documents))
+	for _, document := range documents {
+		if document.Type == "" {
+			return ErrDocumentTypeEmpty
+		}
+
+		if document.Version == "" {
+			return ErrDocumentVersionEmpty
+		}
+
+		if seen[document.Type] {
+			return ErrDocumentTypeDuplicated
+		}
+		seen[document.Type] = true
+	}
+
+	return nil
+}
+
+// ValidationError represents a validation error with a custom message
+type ValidationError struct {
+	Message string
+}
+
+// Error implements the error interface
+func (e *ValidationError) Error() string {
+	return e.Message
+}
+
+// Validation error definitions
+var (
+	ErrDocumentsEmpty         = &ValidationError{Message: "At least one legal document must be accepted"}
+	ErrDocumentTypeEmpty      = &ValidationError{Message: "Document type cannot be empty"}
+	ErrDocumentVersionEmpty   = &ValidationError{Message: "Document version cannot be empty"}
+	ErrDocumentTypeDuplicated = &ValidationError{Message: "Each document type can only be accepted once per request"}
+)
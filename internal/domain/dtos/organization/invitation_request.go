@@ -0,0 +1,79 @@
+package organization
+
+import (
+	"citary-backend/internal/domain/dtos/auth"
+	"citary-backend/internal/domain/dtos/legal"
+	"citary-backend/pkg/constants"
+	"slices"
+)
+
+// CreateInvitationRequest represents the data required to invite someone into the caller's organization
+type CreateInvitationRequest struct {
+	Email string `json:"email"`
+	Role  string `json:"role"`
+}
+
+// Validate performs validation on the create invitation request data
+func (dto *CreateInvitationRequest) Validate() error {
+	if err := auth.ValidateEmail(dto.Email); err != nil {
+		return err
+	}
+
+	if !slices.Contains(constants.InvitableRoles, dto.Role) {
+		return ErrInvitationRoleInvalid
+	}
+
+	return nil
+}
+
+// AcceptInvitationRequest represents the data required to accept an invitation
+// Password and the legal versions are only used when the invited email has no account yet
+type AcceptInvitationRequest struct {
+	Token          string `json:"token"`
+	Password       string `json:"password"`
+	TermsVersion   string `json:"termsVersion"`
+	PrivacyVersion string `json:"privacyVersion"`
+	IPAddress      string `json:"-"`
+	UserAgent      string `json:"-"`
+}
+
+// Validate performs validation on the accept invitation request data
+func (dto *AcceptInvitationRequest) Validate() error {
+	if dto.Token == "" {
+		return ErrInvitationTokenEmpty
+	}
+
+	return nil
+}
+
+// ValidateNewAccount checks the fields required to create the invited account
+// The password must satisfy the same policy as at signup
+func (dto *AcceptInvitationRequest) ValidateNewAccount() error {
+	if err := auth.ValidatePassword(dto.Password); err != nil {
+		return err
+	}
+
+	if dto.TermsVersion == "" {
+		return auth.ErrTermsNotAccepted
+	}
+
+	if dto.PrivacyVersion == "" {
+		return auth.ErrPrivacyNotAccepted
+	}
+
+	return nil
+}
+
+// AcceptedDocuments returns the legal document versions accepted with a new account
+func (dto *AcceptInvitationRequest) AcceptedDocuments() []legal.AcceptedDocument {
+	return []legal.AcceptedDocument{
+		{Type: constants.LegalDocumentType.Terms, Version: dto.TermsVersion},
+		{Type: constants.LegalDocumentType.Privacy, Version: dto.PrivacyVersion},
+	}
+}
+
+// Invitation validation errors
+var (
+	ErrInvitationRoleInvalid = &ValidationError{Message: "Role must be one of: doctor, staff"}
+	ErrInvitationTokenEmpty  = &ValidationError{Message: "Invitation token cannot be empty"}
+)
@@ -0,0 +1,44 @@
+package organization
+
+import (
+	"strings"
+	"time"
+)
+
+// CreateLocationRequest represents the data required to add a location to an organization
+// Timezone is optional and defaults to the organization's timezone
+type CreateLocationRequest struct {
+	Name     string  `json:"name"`
+	Address  *string `json:"address"`
+	Timezone string  `json:"timezone"`
+}
+
+// Validate performs validation on the create location request data
+func (dto *CreateLocationRequest) Validate() error {
+	name := strings.TrimSpace(dto.Name)
+	if name == "" {
+		return ErrLocationNameEmpty
+	}
+
+	if len(name) > 100 {
+		return ErrLocationNameTooLong
+	}
+
+	if dto.Address != nil && len(*dto.Address) > 255 {
+		return ErrAddressTooLong
+	}
+
+	if dto.Timezone != "" {
+		if _, err := time.LoadLocation(dto.Timezone); err != nil {
+			return ErrTimezoneInvalid
+		}
+	}
+
+	return nil
+}
+
+// Location validation errors
+var (
+	ErrLocationNameEmpty   = &ValidationError{Message: "Location name cannot be empty"}
+	ErrLocationNameTooLong = &ValidationError{Message: "Location name cannot exceed 100 characters"}
+)
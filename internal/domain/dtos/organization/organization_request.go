@@ -0,0 +1,133 @@
+package organization
+
+import (
+	"citary-backend/internal/domain/dtos/auth"
+	"citary-backend/pkg/constants"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// slugRegex matches organization slugs: lowercase words separated by single hyphens
+var slugRegex = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
+// phoneRegex matches E.164 phone numbers (a plus sign followed by 8 to 15 digits)
+var phoneRegex = regexp.MustCompile(`^\+[1-9][0-9]{7,14}$`)
+
+// accentReplacer folds the accented letters common in clinic names to their ASCII base letter
+var accentReplacer = strings.NewReplacer(
+	"á", "a", "é", "e", "í", "i", "ó", "o", "ú", "u", "ü", "u", "ñ", "n",
+	"Á", "a", "É", "e", "Í", "i", "Ó", "o", "Ú", "u", "Ü", "u", "Ñ", "n",
+)
+
+// CreateOrganizationRequest represents the data required to onboard a new organization
+// Slug and Timezone are optional; they default to a slug derived from the name and the platform timezone
+type CreateOrganizationRequest struct {
+	Name     string  `json:"name"`
+	Slug     string  `json:"slug"`
+	Email    *string `json:"email"`
+	Phone    *string `json:"phone"`
+	Address  *string `json:"address"`
+	Timezone string  `json:"timezone"`
+}
+
+// Validate performs validation on the create organization request data
+func (dto *CreateOrganizationRequest) Validate() error {
+	name := strings.TrimSpace(dto.Name)
+	if name == "" {
+		return ErrNameEmpty
+	}
+
+	if len(name) > 150 {
+		return ErrNameTooLong
+	}
+
+	slug := dto.ResolvedSlug()
+	if slug == "" || !slugRegex.MatchString(slug) {
+		return ErrSlugInvalidFormat
+	}
+
+	if len(slug) > 80 {
+		return ErrSlugTooLong
+	}
+
+	if dto.Email != nil {
+		if err := auth.ValidateEmail(*dto.Email); err != nil {
+			return err
+		}
+	}
+
+	if dto.Phone != nil && !phoneRegex.MatchString(*dto.Phone) {
+		return ErrPhoneInvalidFormat
+	}
+
+	if dto.Address != nil && len(*dto.Address) > 255 {
+		return ErrAddressTooLong
+	}
+
+	if _, err := time.LoadLocation(dto.ResolvedTimezone()); err != nil {
+		return ErrTimezoneInvalid
+	}
+
+	return nil
+}
+
+// ResolvedSlug returns the requested slug, or one derived from the name when none was sent
+func (dto *CreateOrganizationRequest) ResolvedSlug() string {
+	if dto.Slug != "" {
+		return dto.Slug
+	}
+	return Slugify(dto.Name)
+}
+
+// ResolvedTimezone returns the requested IANA timezone, or the platform default when none was sent
+func (dto *CreateOrganizationRequest) ResolvedTimezone() string {
+	if dto.Timezone != "" {
+		return dto.Timezone
+	}
+	return constants.OrganizationConfig.DefaultTimezone
+}
+
+// Slugify converts a display name into a lowercase, hyphen-separated slug
+func Slugify(name string) string {
+	folded := strings.ToLower(accentReplacer.Replace(name))
+
+	var builder strings.Builder
+	pendingHyphen := false
+	for _, char := range folded {
+		isAlphanumeric := (char >= 'a' && char <= 'z') || (char >= '0' && char <= '9')
+		if !isAlphanumeric {
+			pendingHyphen = builder.Len() > 0
+			continue
+		}
+
+		if pendingHyphen {
+			builder.WriteByte('-')
+			pendingHyphen = false
+		}
+		builder.WriteRune(char)
+	}
+
+	return builder.String()
+}
+
+// ValidationError represents a validation error with a custom message
+type ValidationError struct {
+	Message string
+}
+
+// Error implements the error interface
+func (e *ValidationError) Error() string {
+	return e.Message
+}
+
+// Validation error definitions
+var (
+	ErrNameEmpty          = &ValidationError{Message: "Organization name cannot be empty"}
+	ErrNameTooLong        = &ValidationError{Message: "Organization name cannot exceed 150 characters"}
+	ErrSlugInvalidFormat  = &ValidationError{Message: "Slug must contain only lowercase letters, digits and single hyphens"}
+	ErrSlugTooLong        = &ValidationError{Message: "Slug cannot exceed 80 characters"}
+	ErrPhoneInvalidFormat = &ValidationError{Message: "Phone must be in international format, e.g. +593991234567"}
+	ErrAddressTooLong     = &ValidationError{Message: "Address cannot exceed 255 characters"}
+	ErrTimezoneInvalid    = &ValidationError{Message: "Timezone must be a valid IANA timezone such as America/Guayaquil"}
+)
@@ -0,0 +1,100 @@
+package role
+
+import (
+	"citary-backend/internal/domain/security"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// roleCodeRegex matches role codes: lowercase snake_case starting with a letter
+var roleCodeRegex = regexp.MustCompile(`^[a-z][a-z0-9_]{1,49}$`)
+
+// CreateRoleRequest represents the data required to create a role
+type CreateRoleRequest struct {
+	Name        string   `json:"name"`
+	Code        string   `json:"code"`
+	Description *string  `json:"description"`
+	Permissions []string `json:"permissions"`
+}
+
+// Validate performs validation on the create role request data
+func (dto *CreateRoleRequest) Validate() error {
+	if dto.Code == "" {
+		return ErrRoleCodeEmpty
+	}
+
+	if !roleCodeRegex.MatchString(dto.Code) {
+		return ErrRoleCodeInvalidFormat
+	}
+
+	if err := validateName(dto.Name); err != nil {
+		return err
+	}
+
+	return ValidatePermissions(dto.Permissions)
+}
+
+// UpdateRoleRequest represents the editable fields of a role
+// The code is immutable because issued access tokens carry it
+type UpdateRoleRequest struct {
+	Name        string   `json:"name"`
+	Description *string  `json:"description"`
+	Permissions []string `json:"permissions"`
+}
+
+// Validate performs validation on the update role request data
+func (dto *UpdateRoleRequest) Validate() error {
+	if err := validateName(dto.Name); err != nil {
+		return err
+	}
+
+	return ValidatePermissions(dto.Permissions)
+}
+
+// validateName checks the display name of a role
+func validateName(name string) error {
+	if strings.TrimSpace(name) == "" {
+		return ErrRoleNameEmpty
+	}
+
+	if len(name) > 100 {
+		return ErrRoleNameTooLong
+	}
+
+	return nil
+}
+
+// ValidatePermissions checks that every permission pattern belongs to the known permission vocabulary
+func ValidatePermissions(permissions []string) error {
+	if permissions == nil {
+		return ErrPermissionsMissing
+	}
+
+	for _, permission := range permissions {
+		if !security.IsKnownPermission(permission) {
+			return &ValidationError{Message: fmt.Sprintf("Unknown permission: %q", permission)}
+		}
+	}
+
+	return nil
+}
+
+// ValidationError represents a validation error with a custom message
+type ValidationError struct {
+	Message string
+}
+
+// Error implements the error interface
+func (e *ValidationError) Error() string {
+	return e.Message
+}
+
+// Validation error definitions
+var (
+	ErrRoleCodeEmpty         = &ValidationError{Message: "Role code cannot be empty"}
+	ErrRoleCodeInvalidFormat = &ValidationError{Message: "Role code must be lowercase letters, digits or underscores and start with a letter"}
+	ErrRoleNameEmpty         = &ValidationError{Message: "Role name cannot be empty"}
+	ErrRoleNameTooLong       = &ValidationError{Message: "Role name cannot exceed 100 characters"}
+	ErrPermissionsMissing    = &ValidationError{Message: "Permissions must be provided (use an empty list for none)"}
+)
@@ -0,0 +1,21 @@
+package user
+
+// DeleteAccountRequest represents the confirmation required to delete the current user's account
+type DeleteAccountRequest struct {
+	CurrentPassword string `json:"currentPassword"`
+	IPAddress       string `json:"-"`
+}
+
+// Validate performs validation on the delete account request data
+func (dto *DeleteAccountRequest) Validate() error {
+	if dto.CurrentPassword == "" {
+		return ErrCurrentPasswordEmpty
+	}
+
+	return nil
+}
+
+// Account deletion validation errors
+var (
+	ErrCurrentPasswordEmpty = &ValidationError{Message: "Current password is required"}
+)
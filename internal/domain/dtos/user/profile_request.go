@@ -0,0 +1,119 @@
+package user
+
+import (
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// BirthDateLayout is the date format accepted for birth dates
+const BirthDateLayout = "2006-01-02"
+
+// phoneRegex matches E.164 phone numbers (a plus sign followed by 8 to 15 digits)
+var phoneRegex = regexp.MustCompile(`^\+[1-9][0-9]{7,14}$`)
+
+// localeRegex matches language tags such as "es" or "es-EC"
+var localeRegex = regexp.MustCompile(`^[a-z]{2}(-[A-Z]{2})?$`)
+
+// UpdateProfileRequest represents a partial update of the current user's profile
+// Omitted fields are left unchanged; an empty string clears the field
+type UpdateProfileRequest struct {
+	FirstName *string `json:"firstName"`
+	LastName  *string `json:"lastName"`
+	Phone     *string `json:"phone"`
+	BirthDate *string `json:"birthDate"`
+	Locale    *string `json:"locale"`
+	AvatarURL *string `json:"avatarUrl"`
+}
+
+// Validate performs validation on the update profile request data
+func (dto *UpdateProfileRequest) Validate() error {
+	if err := validateName(dto.FirstName, ErrFirstNameTooLong); err != nil {
+		return err
+	}
+
+	if err := validateName(dto.LastName, ErrLastNameTooLong); err != nil {
+		return err
+	}
+
+	if isSet(dto.Phone) && !phoneRegex.MatchString(*dto.Phone) {
+		return ErrPhoneInvalidFormat
+	}
+
+	if isSet(dto.BirthDate) {
+		if _, err := dto.ParsedBirthDate(); err != nil {
+			return err
+		}
+	}
+
+	if isSet(dto.Locale) && !localeRegex.MatchString(*dto.Locale) {
+		return ErrLocaleInvalidFormat
+	}
+
+	if isSet(dto.AvatarURL) {
+		if len(*dto.AvatarURL) > 500 {
+			return ErrAvatarURLTooLong
+		}
+
+		parsed, err := url.Parse(*dto.AvatarURL)
+		if err != nil || parsed.Scheme != "https" || parsed.Host == "" {
+			return ErrAvatarURLInvalid
+		}
+	}
+
+	return nil
+}
+
+// ParsedBirthDate returns the birth date as a time, or nil when it is being cleared
+func (dto *UpdateProfileRequest) ParsedBirthDate() (*time.Time, error) {
+	if !isSet(dto.BirthDate) {
+		return nil, nil
+	}
+
+	birthDate, err := time.Parse(BirthDateLayout, *dto.BirthDate)
+	if err != nil {
+		return nil, ErrBirthDateInvalidFormat
+	}
+
+	if birthDate.After(time.Now()) || birthDate.Year() < 1900 {
+		return nil, ErrBirthDateOutOfRange
+	}
+
+	return &birthDate, nil
+}
+
+// validateName checks an optional name field against the maximum length
+func validateName(name *string, tooLong error) error {
+	if name != nil && len(strings.TrimSpace(*name)) > 100 {
+		return tooLong
+	}
+	return nil
+}
+
+// isSet reports whether an optional field was sent with a non-empty value
+func isSet(value *string) bool {
+	return value != nil && *value != ""
+}
+
+// ValidationError represents a validation error with a custom message
+type ValidationError struct {
+	Message string
+}
+
+// Error implements the error interface
+func (e *ValidationError) Error() string {
+	return e.Message
+}
+
+// Validation error definitions
+var (
+	ErrFirstNameTooLong       = &ValidationError{Message: "First name cannot exceed 100 characters"}
+	ErrLastNameTooLong        = &ValidationError{Message: "Last name cannot exceed 100 characters"}
+	ErrPhoneInvalidFormat     = &ValidationError{Message: "Phone must be in international format, e.g. +593991234567"}
+	ErrBirthDateInvalidFormat = &ValidationError{Message: "Birth date must use the YYYY-MM-DD format"}
+	ErrBirthDateOutOfRange    = &ValidationError{Message: "Birth date must be between 1900-01-01 and today"}
+	ErrLocaleInvalidFormat    = &ValidationError{Message: "Locale must be a language tag such as es or es-EC"}
+	ErrAvatarURLTooLong       = &ValidationError{Message: "Avatar URL cannot exceed 500 characters"}
+	ErrAvatarURLInvalid       = &ValidationError{Message: "Avatar URL must be a valid https URL"}
+)
@@ -0,0 +1,100 @@
+package entities
+
+import (
+	"citary-backend/pkg/constants"
+	"strings"
+	"time"
+)
+
+// Appointment represents a patient's booking with a doctor at one of the organization's locations
+// DoctorUserID, DoctorFirstName, DoctorLastName, OrganizationName, LocationName, LocationAddress and LocationTimezone
+// are read-only, joined from the doctor, organization and location
+type Appointment struct {
+	ID               int
+	OrganizationID   int
+	DoctorID         int
+	LocationID       int
+	PatientID        int
+	StartTime        time.Time
+	EndTime          time.Time
+	Status           string
+	Reason           *string
+	DoctorUserID     int
+	DoctorFirstName  *string
+	DoctorLastName   *string
+	OrganizationName string
+	LocationName     string
+	LocationAddress  *string
+	LocationTimezone string
+	CreatedDate      time.Time
+	UpdatedDate      *time.Time
+	RecordStatus     string
+}
+
+// IsActive checks if the appointment is active
+func (a *Appointment) IsActive() bool {
+	return a.RecordStatus == constants.RecordStatus.Active
+}
+
+// DoctorName returns the doctor's full name, or an empty string if the doctor has no profile yet
+func (a *Appointment) DoctorName() string {
+	var parts []string
+	if a.DoctorFirstName != nil && *a.DoctorFirstName != "" {
+		parts = append(parts, *a.DoctorFirstName)
+	}
+	if a.DoctorLastName != nil && *a.DoctorLastName != "" {
+		parts = append(parts, *a.DoctorLastName)
+	}
+	return strings.Join(parts, " ")
+}
+
+// Duration returns how long the appointment lasts
+func (a *Appointment) Duration() time.Duration {
+	return a.EndTime.Sub(a.StartTime)
+}
+
+// appointmentTransitions lists, for each status, the statuses an appointment may move to next
+// Completed, cancelled and no-show appointments are final
+var appointmentTransitions = map[string][]string{
+	constants.AppointmentStatus.Requested: {
+		constants.AppointmentStatus.Confirmed,
+		constants.AppointmentStatus.CancelledByPatient,
+		constants.AppointmentStatus.CancelledByClinic,
+	},
+	constants.AppointmentStatus.Confirmed: {
+		constants.AppointmentStatus.CheckedIn,
+		constants.AppointmentStatus.CancelledByPatient,
+		constants.AppointmentStatus.CancelledByClinic,
+		constants.AppointmentStatus.NoShow,
+	},
+	constants.AppointmentStatus.CheckedIn: {
+		constants.AppointmentStatus.Completed,
+	},
+}
+
+// CanTransitionTo reports whether the appointment may move from its current status to the given one
+func (a *Appointment) CanTransitionTo(status string) bool {
+	for _, next := range appointmentTransitions[a.Status] {
+		if next == status {
+			return true
+		}
+	}
+	return false
+}
+
+// IsFinal reports whether the appointment has reached a status it can no longer leave
+func (a *Appointment) IsFinal() bool {
+	return len(appointmentTransitions[a.Status]) == 0
+}
+
+// IsCancelled reports whether the appointment was cancelled by either side
+func (a *Appointment) IsCancelled() bool {
+	return a.Status == constants.AppointmentStatus.CancelledByPatient ||
+		a.Status == constants.AppointmentStatus.CancelledByClinic
+}
+
+// CanReschedule reports whether the appointment may still be moved to another time
+// Only appointments that have not been attended, missed or cancelled can be rescheduled
+func (a *Appointment) CanReschedule() bool {
+	return a.Status == constants.AppointmentStatus.Requested || a.Status == constants.AppointmentStatus.Confirmed
+}
@@ -0,0 +1,13 @@
+package entities
+
+import "time"
+
+// AppointmentReminder records a reminder email sent OffsetMinutes before an appointment starting at StartTime
+// A reschedule changes the start time, so the moved appointment can be reminded again
+type AppointmentReminder struct {
+	ID            int
+	AppointmentID int
+	OffsetMinutes int
+	StartTime     time.Time
+	SentDate      time.Time
+}
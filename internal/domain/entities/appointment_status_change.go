@@ -0,0 +1,17 @@
+package entities
+
+import "time"
+
+// AppointmentStatusChange is one entry of an appointment's audit trail
+// FromStatus is nil for the booking itself; a reschedule keeps the status and records the new StartTime and EndTime
+type AppointmentStatusChange struct {
+	ID            int
+	AppointmentID int
+	FromStatus    *string
+	ToStatus      string
+	StartTime     time.Time
+	EndTime       time.Time
+	ChangedBy     int
+	Reason        *string
+	CreatedDate   time.Time
+}
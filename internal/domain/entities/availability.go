@@ -0,0 +1,71 @@
+package entities
+
+import (
+	"citary-backend/pkg/constants"
+	"time"
+)
+
+// AvailabilityBlock represents recurring weekly working hours of a doctor at a location
+// StartMinute and EndMinute count minutes after local midnight at the location; EndMinute may be 1440 (24:00)
+// ValidFrom and ValidUntil optionally bound the dates the block applies to (inclusive)
+type AvailabilityBlock struct {
+	ID           int
+	DoctorID     int
+	LocationID   int
+	Weekday      time.Weekday
+	StartMinute  int
+	EndMinute    int
+	ValidFrom    *time.Time
+	ValidUntil   *time.Time
+	CreatedDate  time.Time
+	UpdatedDate  *time.Time
+	RecordStatus string
+}
+
+// IsActive checks if the availability block is active
+func (b *AvailabilityBlock) IsActive() bool {
+	return b.RecordStatus == constants.RecordStatus.Active
+}
+
+// AvailabilityException represents a date-specific change to a doctor's weekly hours
+// Kind is one of constants.AvailabilityExceptionKind; StartDate and EndDate are inclusive local dates
+// Without StartMinute and EndMinute an exception covers whole days; without LocationID it covers every location
+type AvailabilityException struct {
+	ID           int
+	DoctorID     int
+	LocationID   *int
+	Kind         string
+	StartDate    time.Time
+	EndDate      time.Time
+	StartMinute  *int
+	EndMinute    *int
+	Reason       *string
+	CreatedDate  time.Time
+	RecordStatus string
+}
+
+// IsActive checks if the availability exception is active
+func (e *AvailabilityException) IsActive() bool {
+	return e.RecordStatus == constants.RecordStatus.Active
+}
+
+// IsWholeDay reports whether the exception covers entire days rather than a time range
+func (e *AvailabilityException) IsWholeDay() bool {
+	return e.StartMinute == nil || e.EndMinute == nil
+}
+
+// AvailabilityWindow is a span of time a doctor is available at a location once weekly hours and exceptions are combined
+type AvailabilityWindow struct {
+	LocationID int
+	Start      time.Time
+	End        time.Time
+}
+
+// AvailabilitySlot is a bookable span of a doctor's free time, one consultation long
+// Timezone is the IANA timezone of the location, used to present the slot in local time
+type AvailabilitySlot struct {
+	LocationID int
+	Timezone   string
+	Start      time.Time
+	End        time.Time
+}
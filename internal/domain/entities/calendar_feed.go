@@ -0,0 +1,12 @@
+package entities
+
+import "time"
+
+// CalendarFeed represents a user's secret calendar subscription URL
+// Only the hash of the token in the URL is stored
+type CalendarFeed struct {
+	ID          int
+	UserID      int
+	TokenHash   string
+	CreatedDate time.Time
+}
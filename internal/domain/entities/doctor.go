@@ -0,0 +1,43 @@
+package entities
+
+import (
+	"citary-backend/pkg/constants"
+	"time"
+)
+
+// Doctor represents the profile of an organization member who sees patients there
+// FirstName, LastName, OrganizationName and OrganizationSlug are read-only, joined from the user profile and organization
+type Doctor struct {
+	ID                   int
+	UserID               int
+	OrganizationID       int
+	LicenseNumber        string
+	Bio                  *string
+	Languages            []string
+	ConsultationMinutes  int
+	BufferMinutes        int
+	ConsultationFeeCents *int
+	FeeCurrency          string
+	Specialties          []*Specialty
+	FirstName            *string
+	LastName             *string
+	OrganizationName     string
+	OrganizationSlug     string
+	CreatedDate          time.Time
+	UpdatedDate          *time.Time
+	RecordStatus         string
+}
+
+// IsActive checks if the doctor profile is active
+func (d *Doctor) IsActive() bool {
+	return d.RecordStatus == constants.RecordStatus.Active
+}
+
+// SpecialtyIDs returns the IDs of the doctor's specialties
+func (d *Doctor) SpecialtyIDs() []int {
+	ids := make([]int, 0, len(d.Specialties))
+	for _, specialty := range d.Specialties {
+		ids = append(ids, specialty.ID)
+	}
+	return ids
+}
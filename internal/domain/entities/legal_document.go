@@ -0,0 +1,15 @@
+package entities
+
+import "time"
+
+// LegalDocument represents a published version of a legal text users must accept, such as the terms of service
+type LegalDocument struct {
+	ID           int
+	Type         string
+	Version      string
+	Title        string
+	URL          string
+	PublishedAt  time.Time
+	CreatedDate  time.Time
+	RecordStatus string
+}
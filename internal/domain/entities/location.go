@@ -0,0 +1,24 @@
+package entities
+
+import (
+	"citary-backend/pkg/constants"
+	"time"
+)
+
+// Location represents a place an organization sees patients at
+// Availability at a location is expressed in the wall-clock time of its timezone
+type Location struct {
+	ID             int
+	OrganizationID int
+	Name           string
+	Address        *string
+	Timezone       string
+	CreatedDate    time.Time
+	UpdatedDate    *time.Time
+	RecordStatus   string
+}
+
+// IsActive checks if the location is active
+func (l *Location) IsActive() bool {
+	return l.RecordStatus == constants.RecordStatus.Active
+}
@@ -0,0 +1,26 @@
+package entities
+
+import (
+	"citary-backend/pkg/constants"
+	"time"
+)
+
+// Organization represents a clinic using the platform; organization-bound records carry its ID
+type Organization struct {
+	ID           int
+	OwnerUserID  int
+	Name         string
+	Slug         string
+	Email        *string
+	Phone        *string
+	Address      *string
+	Timezone     string
+	CreatedDate  time.Time
+	UpdatedDate  *time.Time
+	RecordStatus string
+}
+
+// IsActive checks if the organization is active
+func (o *Organization) IsActive() bool {
+	return o.RecordStatus == constants.RecordStatus.Active
+}
@@ -0,0 +1,48 @@
+package entities
+
+import (
+	"citary-backend/pkg/constants"
+	"time"
+)
+
+// OrganizationInvitation represents an emailed, single-use invitation to join an organization with a given role
+// RoleCode is a read-only copy of the invited role's code
+type OrganizationInvitation struct {
+	ID               int
+	OrganizationID   int
+	RoleID           int
+	RoleCode         string
+	InvitedByUserID  int
+	AcceptedByUserID *int
+	Email            string
+	TokenHash        string
+	ExpiresAt        time.Time
+	AcceptedAt       *time.Time
+	RevokedAt        *time.Time
+	CreatedDate      time.Time
+	RecordStatus     string
+}
+
+// IsExpired checks if the invitation is past its expiration
+func (i *OrganizationInvitation) IsExpired() bool {
+	return !i.ExpiresAt.After(time.Now())
+}
+
+// IsPending checks if the invitation can still be accepted or revoked
+func (i *OrganizationInvitation) IsPending() bool {
+	return i.AcceptedAt == nil && i.RevokedAt == nil
+}
+
+// Status reports the invitation as pending, accepted, revoked or expired
+func (i *OrganizationInvitation) Status() string {
+	switch {
+	case i.AcceptedAt != nil:
+		return constants.InvitationStatus.Accepted
+	case i.RevokedAt != nil:
+		return constants.InvitationStatus.Revoked
+	case i.IsExpired():
+		return constants.InvitationStatus.Expired
+	default:
+		return constants.InvitationStatus.Pending
+	}
+}
@@ -0,0 +1,25 @@
+package entities
+
+import (
+	"citary-backend/pkg/constants"
+	"time"
+)
+
+// OrganizationMembership represents a user working in an organization with a role specific to it
+// RoleCode and OrganizationName are read-only copies joined from the role and the organization
+type OrganizationMembership struct {
+	ID               int
+	OrganizationID   int
+	OrganizationName string
+	UserID           int
+	RoleID           int
+	RoleCode         string
+	CreatedDate      time.Time
+	UpdatedDate      *time.Time
+	RecordStatus     string
+}
+
+// IsActive checks if the membership is active
+func (m *OrganizationMembership) IsActive() bool {
+	return m.RecordStatus == constants.RecordStatus.Active
+}
@@ -0,0 +1,39 @@
+package entities
+
+import "time"
+
+// RefreshToken represents a hashed, opaque refresh token bound to a user device session
+//
+// Every login starts a new token family; each rotation revokes the presented token and
+// links it to its replacement so replays of rotated tokens can be detected.
+// OrganizationID is the organization the session acts within; nil outside of any organization.
+type RefreshToken struct {
+	ID             int
+	UserID         int
+	OrganizationID *int
+	FamilyID       string
+	TokenHash      string
+	DeviceName     *string
+	UserAgent      *string
+	IPAddress      *string
+	ExpiresAt      time.Time
+	RevokedAt      *time.Time
+	ReplacedByID   *int
+	CreatedDate    time.Time
+	RecordStatus   string
+}
+
+// IsExpired checks if the refresh token is past its expiration
+func (t *RefreshToken) IsExpired() bool {
+	return !t.ExpiresAt.After(time.Now())
+}
+
+// IsRevoked checks if the refresh token has been revoked or rotated
+func (t *RefreshToken) IsRevoked() bool {
+	return t.RevokedAt != nil
+}
+
+// WasRotated checks if the refresh token has already been exchanged for a newer one
+func (t *RefreshToken) WasRotated() bool {
+	return t.ReplacedByID != nil
+}
@@ -1,6 +1,7 @@
 package entities
 
 import (
+	"citary-backend/pkg/constants"
 	"encoding/json"
 	"time"
 )
@@ -15,3 +16,8 @@ type Role struct {
 	CreatedDate  time.Time
 	RecordStatus string
 }
+
+// IsActive checks if the role is active
+func (r *Role) IsActive() bool {
+	return r.RecordStatus == constants.RecordStatus.Active
+}
@@ -0,0 +1,20 @@
+package entities
+
+import (
+	"citary-backend/pkg/constants"
+	"time"
+)
+
+// Specialty represents a medical specialty doctors are listed under
+type Specialty struct {
+	ID           int
+	Code         string
+	Name         string
+	CreatedDate  time.Time
+	RecordStatus string
+}
+
+// IsActive checks if the specialty is active
+func (s *Specialty) IsActive() bool {
+	return s.RecordStatus == constants.RecordStatus.Active
+}
@@ -6,14 +6,19 @@ import (
 )
 
 // User represents the user entity in the domain layer
+// RoleID is the user's own role outside of any organization; organization roles live on memberships
+// OrganizationID is the organization new sessions act within by default
 type User struct {
 	ID                         int
 	RoleID                     int
+	OrganizationID             *int
 	Email                      string
 	PasswordHash               string
 	EmailVerified              bool
 	VerificationToken          *string
 	VerificationTokenExpiresAt *time.Time
+	VerificationSentAt         *time.Time
+	VerificationSendCount      int
 	PhoneVerified              bool
 	TwoFactorEnabled           bool
 	TwoFactorSecret            *string
@@ -22,6 +27,8 @@ type User struct {
 	LockedUntil                *time.Time
 	TermsAcceptedAt            *time.Time
 	PrivacyAcceptedAt          *time.Time
+	DeletedAt                  *time.Time
+	AnonymizeAfter             *time.Time
 	CreatedDate                time.Time
 	RecordStatus               string
 }
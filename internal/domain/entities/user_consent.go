@@ -0,0 +1,18 @@
+package entities
+
+import "time"
+
+// UserConsent represents a user's acceptance of a specific legal document version
+// DocumentType and DocumentVersion are read-only copies of the accepted document
+type UserConsent struct {
+	ID              int
+	UserID          int
+	LegalDocumentID int
+	DocumentType    string
+	DocumentVersion string
+	AcceptedAt      time.Time
+	IPAddress       *string
+	UserAgent       *string
+	CreatedDate     time.Time
+	RecordStatus    string
+}
@@ -0,0 +1,14 @@
+package entities
+
+import "time"
+
+// UserEvent represents an entry in the audit trail of a user account
+type UserEvent struct {
+	ID           int
+	UserID       int
+	Type         string
+	Details      *string
+	IPAddress    *string
+	CreatedDate  time.Time
+	RecordStatus string
+}
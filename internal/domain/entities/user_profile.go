@@ -0,0 +1,18 @@
+package entities
+
+import "time"
+
+// UserProfile represents the personal data of a user, kept apart from credentials and security state
+type UserProfile struct {
+	ID           int
+	UserID       int
+	FirstName    *string
+	LastName     *string
+	Phone        *string
+	BirthDate    *time.Time
+	Locale       *string
+	AvatarURL    *string
+	CreatedDate  time.Time
+	UpdatedDate  *time.Time
+	RecordStatus string
+}
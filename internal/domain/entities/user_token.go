@@ -0,0 +1,27 @@
+package entities
+
+import "time"
+
+// UserToken represents a single-use token emailed to a user for a specific purpose
+// Only the hash of the token is stored; Payload carries purpose-specific data
+type UserToken struct {
+	ID           int
+	UserID       int
+	Purpose      string
+	TokenHash    string
+	Payload      *string
+	ExpiresAt    time.Time
+	UsedAt       *time.Time
+	CreatedDate  time.Time
+	RecordStatus string
+}
+
+// IsExpired checks if the token is past its expiration
+func (t *UserToken) IsExpired() bool {
+	return !t.ExpiresAt.After(time.Now())
+}
+
+// IsUsed checks if the token has already been consumed
+func (t *UserToken) IsUsed() bool {
+	return t.UsedAt != nil
+}
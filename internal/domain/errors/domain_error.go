@@ -64,6 +64,14 @@ func ErrUnauthorized(message string) *DomainError {
 	return NewDomainError(message, constants.StatusCode.Unauthorized, nil)
 }
 
+// ErrForbidden creates a forbidden error (403)
+func ErrForbidden(message string) *DomainError {
+	if message == "" {
+		message = constants.ErrorMessages.Forbidden
+	}
+	return NewDomainError(message, constants.StatusCode.Forbidden, nil)
+}
+
 // ErrConflict creates a conflict error (409)
 func ErrConflict(message string) *DomainError {
 	if message == "" {
@@ -71,3 +79,27 @@ func ErrConflict(message string) *DomainError {
 	}
 	return NewDomainError(message, constants.StatusCode.Conflict, nil)
 }
+
+// ErrGone creates a gone error (410)
+func ErrGone(message string) *DomainError {
+	if message == "" {
+		message = constants.ErrorMessages.Gone
+	}
+	return NewDomainError(message, constants.StatusCode.Gone, nil)
+}
+
+// ErrLocked creates a locked error (423)
+func ErrLocked(message string) *DomainError {
+	if message == "" {
+		message = constants.ErrorMessages.Locked
+	}
+	return NewDomainError(message, constants.StatusCode.Locked, nil)
+}
+
+// ErrPreconditionRequired creates a precondition required error (428)
+func ErrPreconditionRequired(message string) *DomainError {
+	if message == "" {
+		message = constants.ErrorMessages.PreconditionRequired
+	}
+	return NewDomainError(message, constants.StatusCode.PreconditionRequired, nil)
+}
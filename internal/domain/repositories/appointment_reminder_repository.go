@@ -0,0 +1,16 @@
+package repositories
+
+import (
+	"citary-backend/internal/domain/entities"
+	"context"
+)
+
+// AppointmentReminderRepository defines the contract for tracking sent appointment reminders
+type AppointmentReminderRepository interface {
+	// Claim records a reminder before it is sent and sets its ID
+	// Returns false if the same reminder was already claimed, by this or another process
+	Claim(ctx context.Context, reminder *entities.AppointmentReminder) (bool, error)
+
+	// Release deletes a claimed reminder whose email could not be sent, so a later run retries it
+	Release(ctx context.Context, id int) error
+}
@@ -0,0 +1,43 @@
+package repositories
+
+import (
+	"citary-backend/internal/domain/entities"
+	"context"
+	"time"
+)
+
+// AppointmentRepository defines the contract for appointment data operations
+type AppointmentRepository interface {
+	// FindByID retrieves an appointment by its ID
+	FindByID(ctx context.Context, id int) (*entities.Appointment, error)
+
+	// FindByPatient retrieves the active appointments of a patient that end after the given time, soonest first
+	FindByPatient(ctx context.Context, patientID int, endingAfter time.Time) ([]*entities.Appointment, error)
+
+	// FindAllByPatient retrieves every appointment of a patient, whatever its status or time, oldest first
+	FindAllByPatient(ctx context.Context, patientID int) ([]*entities.Appointment, error)
+
+	// FindByOrganization retrieves the active appointments of an organization starting within [from, to), soonest first
+	FindByOrganization(ctx context.Context, organizationID int, from, to time.Time) ([]*entities.Appointment, error)
+
+	// FindForCalendar retrieves the active appointments a user attends as patient or as doctor, in any organization,
+	// that end after the given time, soonest first; cancelled appointments are included
+	FindForCalendar(ctx context.Context, userID int, endingAfter time.Time) ([]*entities.Appointment, error)
+
+	// FindDueForReminder retrieves requested and confirmed appointments starting within (from, to], soonest first,
+	// that have no reminder recorded for offsetMinutes at their current start time
+	// Appointments booked less than offsetMinutes before they start are left out
+	FindDueForReminder(ctx context.Context, offsetMinutes int, from, to time.Time, limit int) ([]*entities.Appointment, error)
+
+	// Create persists a new appointment, sets its ID and records the booking in its history
+	// Returns a conflict error if it overlaps another appointment holding the same doctor or that appointment's buffer
+	Create(ctx context.Context, appointment *entities.Appointment) error
+
+	// ApplyChange moves an appointment to the status and times of the change and records it in the history
+	// The change only applies while the appointment is still in change.FromStatus; returns false otherwise
+	// Returns a conflict error if new times overlap another appointment holding the same doctor or that appointment's buffer
+	ApplyChange(ctx context.Context, change *entities.AppointmentStatusChange) (bool, error)
+
+	// FindHistory retrieves the recorded changes of an appointment, oldest first
+	FindHistory(ctx context.Context, appointmentID int) ([]*entities.AppointmentStatusChange, error)
+}
@@ -0,0 +1,53 @@
+package repositories
+
+import (
+	"citary-backend/internal/domain/entities"
+	"context"
+	"time"
+)
+
+// FreeTimeFilter selects the free time of a doctor between From and To
+// Bookings are padded by BufferMinutes on both sides; IgnoreAppointmentID excludes one booking,
+// so an appointment being rescheduled does not block its own neighbourhood (0 ignores none)
+type FreeTimeFilter struct {
+	DoctorID            int
+	From                time.Time
+	To                  time.Time
+	BufferMinutes       int
+	IgnoreAppointmentID int
+}
+
+// AvailabilityRepository defines the contract for doctor availability data operations
+type AvailabilityRepository interface {
+	// FindBlocksByDoctor retrieves the active weekly availability blocks of a doctor ordered by weekday and start time
+	FindBlocksByDoctor(ctx context.Context, doctorID int) ([]*entities.AvailabilityBlock, error)
+
+	// CreateBlock persists a new availability block and sets its ID
+	// Returns a conflict error if it overlaps another active block of the doctor on the same weekday and dates
+	CreateBlock(ctx context.Context, block *entities.AvailabilityBlock) error
+
+	// DeactivateBlock deactivates an availability block of a doctor
+	// Returns false if no such active block exists
+	DeactivateBlock(ctx context.Context, doctorID, id int) (bool, error)
+
+	// FindExceptionsByDoctor retrieves the active exceptions of a doctor that end on or after the given date, ordered by start date
+	FindExceptionsByDoctor(ctx context.Context, doctorID int, endingFrom time.Time) ([]*entities.AvailabilityException, error)
+
+	// CreateException persists a new availability exception and sets its ID
+	CreateException(ctx context.Context, exception *entities.AvailabilityException) error
+
+	// DeactivateException deactivates an availability exception of a doctor
+	// Returns false if no such active exception exists
+	DeactivateException(ctx context.Context, doctorID, id int) (bool, error)
+
+	// FindEffectiveAvailability combines the weekly blocks and exceptions of a doctor into the windows
+	// the doctor is available in between from and to, clipped to that range and ordered by start
+	FindEffectiveAvailability(ctx context.Context, doctorID int, from, to time.Time) ([]*entities.AvailabilityWindow, error)
+
+	// FindFreeWindows retrieves the effective availability of a doctor with active bookings and their buffers removed
+	FindFreeWindows(ctx context.Context, filter FreeTimeFilter) ([]*entities.AvailabilityWindow, error)
+
+	// FindFreeSlots splits the free windows of a doctor into consecutive slots of slotMinutes, separated by the buffer
+	// Slots start at the beginning of each free window, start no earlier than From and end no later than To
+	FindFreeSlots(ctx context.Context, filter FreeTimeFilter, slotMinutes int) ([]*entities.AvailabilitySlot, error)
+}
@@ -0,0 +1,23 @@
+package repositories
+
+import (
+	"citary-backend/internal/domain/entities"
+	"context"
+)
+
+// CalendarFeedRepository defines the contract for calendar subscription feed data operations
+type CalendarFeedRepository interface {
+	// FindByTokenHash retrieves a feed by the hash of its token
+	FindByTokenHash(ctx context.Context, tokenHash string) (*entities.CalendarFeed, error)
+
+	// FindByUser retrieves the user's feed
+	// Returns (nil, nil) if the user has none
+	FindByUser(ctx context.Context, userID int) (*entities.CalendarFeed, error)
+
+	// Replace stores the user's feed, replacing any previous one, and sets its ID
+	Replace(ctx context.Context, feed *entities.CalendarFeed) error
+
+	// DeleteForUser removes the user's feed
+	// Returns false if the user had none
+	DeleteForUser(ctx context.Context, userID int) (bool, error)
+}
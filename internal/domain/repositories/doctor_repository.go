@@ -0,0 +1,47 @@
+package repositories
+
+import (
+	"citary-backend/internal/domain/entities"
+	"context"
+)
+
+// DoctorSearchFilter narrows the public doctor search; empty fields are not filtered on
+// Only active doctors of active organizations are ever returned
+type DoctorSearchFilter struct {
+	SpecialtyCode    string
+	OrganizationSlug string
+	Name             string
+	Language         string
+	Limit            int
+	Offset           int
+}
+
+// DoctorRepository defines the contract for doctor profile data operations
+type DoctorRepository interface {
+	// FindByID retrieves a doctor profile of an organization by its ID
+	FindByID(ctx context.Context, organizationID, id int) (*entities.Doctor, error)
+
+	// FindPublicByID retrieves an active doctor profile of an active organization by its ID
+	FindPublicByID(ctx context.Context, id int) (*entities.Doctor, error)
+
+	// FindByOrganization retrieves every doctor profile of an organization, active or not
+	FindByOrganization(ctx context.Context, organizationID int) ([]*entities.Doctor, error)
+
+	// FindByUser retrieves every doctor profile of a user across organizations, active or not
+	FindByUser(ctx context.Context, userID int) ([]*entities.Doctor, error)
+
+	// Search retrieves one page of doctors matching the filter and the total number of matches
+	Search(ctx context.Context, filter DoctorSearchFilter) ([]*entities.Doctor, int, error)
+
+	// Create persists a new doctor profile with its specialties and sets its ID
+	// Returns a conflict error if the user already has a profile or the license is taken in the organization
+	Create(ctx context.Context, doctor *entities.Doctor) error
+
+	// Update persists the editable fields of a doctor profile and replaces its specialties
+	// Returns a conflict error if the license is taken in the organization
+	Update(ctx context.Context, doctor *entities.Doctor) error
+
+	// UpdateStatus sets the record status of a doctor profile of an organization
+	// Returns false if no such profile exists
+	UpdateStatus(ctx context.Context, organizationID, id int, status string) (bool, error)
+}
@@ -0,0 +1,15 @@
+package repositories
+
+import (
+	"citary-backend/internal/domain/entities"
+	"context"
+)
+
+// LegalDocumentRepository defines the contract for legal document data operations
+type LegalDocumentRepository interface {
+	// FindCurrent retrieves the latest published active version of each document type
+	FindCurrent(ctx context.Context) ([]*entities.LegalDocument, error)
+
+	// FindPendingForUser retrieves the current documents the user has not accepted yet
+	FindPendingForUser(ctx context.Context, userID int) ([]*entities.LegalDocument, error)
+}
@@ -0,0 +1,22 @@
+package repositories
+
+import (
+	"citary-backend/internal/domain/entities"
+	"context"
+)
+
+// LocationRepository defines the contract for organization location data operations
+type LocationRepository interface {
+	// FindByID retrieves a location of an organization by its ID
+	FindByID(ctx context.Context, organizationID, id int) (*entities.Location, error)
+
+	// FindByOrganization retrieves every location of an organization, active or not
+	FindByOrganization(ctx context.Context, organizationID int) ([]*entities.Location, error)
+
+	// Create persists a new location and sets its ID
+	Create(ctx context.Context, location *entities.Location) error
+
+	// UpdateStatus sets the record status of a location of an organization
+	// Returns false if no such location exists
+	UpdateStatus(ctx context.Context, organizationID, id int, status string) (bool, error)
+}
@@ -0,0 +1,39 @@
+package repositories
+
+import (
+	"citary-backend/internal/domain/entities"
+	"context"
+)
+
+// OrganizationInvitationRepository defines the contract for organization invitation data operations
+type OrganizationInvitationRepository interface {
+	// Create persists a new invitation
+	Create(ctx context.Context, invitation *entities.OrganizationInvitation) error
+
+	// FindByID retrieves an invitation by its ID
+	FindByID(ctx context.Context, id int) (*entities.OrganizationInvitation, error)
+
+	// FindByTokenHash retrieves an invitation by the hash of its emailed token
+	FindByTokenHash(ctx context.Context, tokenHash string) (*entities.OrganizationInvitation, error)
+
+	// FindByOrganization retrieves every invitation of an organization, newest first
+	FindByOrganization(ctx context.Context, organizationID int) ([]*entities.OrganizationInvitation, error)
+
+	// FindByInvitee retrieves every invitation sent to the email address or accepted by the user, newest first
+	FindByInvitee(ctx context.Context, userID int, email string) ([]*entities.OrganizationInvitation, error)
+
+	// RevokePendingForEmail revokes the organization's open invitations for an email address
+	RevokePendingForEmail(ctx context.Context, organizationID int, email string) error
+
+	// Revoke revokes a single invitation
+	// Returns false without changes if the invitation is no longer pending
+	Revoke(ctx context.Context, id int) (bool, error)
+
+	// AcceptForUser atomically consumes the invitation and makes the user a member of its organization with its role
+	// Returns a conflict error if the invitation is no longer pending or the user is already a member
+	AcceptForUser(ctx context.Context, invitation *entities.OrganizationInvitation, userID int) error
+
+	// AcceptForNewUser atomically creates the invited account with its consents and accepts the invitation for it
+	// Sets the user's ID and each consent's UserID; fails like AcceptForUser without creating the account
+	AcceptForNewUser(ctx context.Context, invitation *entities.OrganizationInvitation, user *entities.User, consents []*entities.UserConsent) error
+}
@@ -0,0 +1,16 @@
+package repositories
+
+import (
+	"citary-backend/internal/domain/entities"
+	"context"
+)
+
+// OrganizationMembershipRepository defines the contract for organization membership data operations
+// Memberships are created together with the organization or the accepted invitation that grants them
+type OrganizationMembershipRepository interface {
+	// FindByUserAndOrganization retrieves the membership of a user in an organization
+	FindByUserAndOrganization(ctx context.Context, userID, organizationID int) (*entities.OrganizationMembership, error)
+
+	// FindByUser retrieves every membership of a user, oldest first
+	FindByUser(ctx context.Context, userID int) ([]*entities.OrganizationMembership, error)
+}
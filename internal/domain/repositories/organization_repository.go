@@ -0,0 +1,20 @@
+package repositories
+
+import (
+	"citary-backend/internal/domain/entities"
+	"context"
+)
+
+// OrganizationRepository defines the contract for organization data operations
+type OrganizationRepository interface {
+	// FindByID retrieves an organization by its ID
+	FindByID(ctx context.Context, id int) (*entities.Organization, error)
+
+	// FindBySlug retrieves an organization by its unique slug
+	FindBySlug(ctx context.Context, slug string) (*entities.Organization, error)
+
+	// CreateWithOwner atomically persists a new organization, makes its owner a member with the given role
+	// and selects it as the owner's default organization
+	// Returns a conflict error if the slug is taken
+	CreateWithOwner(ctx context.Context, organization *entities.Organization, ownerRoleID int) error
+}
@@ -0,0 +1,17 @@
+package repositories
+
+import "context"
+
+// RecoveryCodeRepository defines the contract for two-factor recovery code data operations
+// Codes are only ever handled as hashes at this layer
+type RecoveryCodeRepository interface {
+	// ReplaceForUser atomically discards the user's existing codes and stores the new set
+	ReplaceForUser(ctx context.Context, userID int, codeHashes []string) error
+
+	// Consume atomically marks an unused code as used
+	// Returns false if no unused code with that hash exists for the user
+	Consume(ctx context.Context, userID int, codeHash string) (bool, error)
+
+	// DeleteForUser discards every code belonging to the user
+	DeleteForUser(ctx context.Context, userID int) error
+}
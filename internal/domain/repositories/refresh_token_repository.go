@@ -0,0 +1,28 @@
+package repositories
+
+import (
+	"citary-backend/internal/domain/entities"
+	"context"
+)
+
+// RefreshTokenRepository defines the contract for refresh token data operations
+type RefreshTokenRepository interface {
+	// Create persists a new refresh token
+	Create(ctx context.Context, token *entities.RefreshToken) error
+
+	// FindByTokenHash retrieves a refresh token by the hash of its opaque value
+	FindByTokenHash(ctx context.Context, tokenHash string) (*entities.RefreshToken, error)
+
+	// Rotate atomically revokes the current token and persists its replacement
+	// Returns false without changes if the current token was already revoked
+	Rotate(ctx context.Context, current *entities.RefreshToken, next *entities.RefreshToken) (bool, error)
+
+	// RevokeFamily revokes every active token in a token family
+	RevokeFamily(ctx context.Context, familyID string) error
+
+	// RevokeAllForUser revokes every active token belonging to a user
+	RevokeAllForUser(ctx context.Context, userID int) error
+
+	// FindByUser retrieves every refresh token issued to a user, newest first
+	FindByUser(ctx context.Context, userID int) ([]*entities.RefreshToken, error)
+}
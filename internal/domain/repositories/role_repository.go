@@ -9,4 +9,19 @@ import (
 type RoleRepository interface {
 	// FindByCode retrieves a role by its code
 	FindByCode(ctx context.Context, code string) (*entities.Role, error)
+
+	// FindByID retrieves a role by its ID
+	FindByID(ctx context.Context, id int) (*entities.Role, error)
+
+	// FindAll retrieves every role, active or not, ordered by name
+	FindAll(ctx context.Context) ([]*entities.Role, error)
+
+	// Create persists a new role and sets its ID
+	Create(ctx context.Context, role *entities.Role) error
+
+	// Update persists the name, description and permissions of an existing role
+	Update(ctx context.Context, role *entities.Role) error
+
+	// UpdateStatus sets the record status of a role
+	UpdateStatus(ctx context.Context, id int, status string) error
 }
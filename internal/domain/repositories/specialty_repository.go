@@ -0,0 +1,16 @@
+package repositories
+
+import (
+	"citary-backend/internal/domain/entities"
+	"context"
+)
+
+// SpecialtyRepository defines the contract for medical specialty data operations
+type SpecialtyRepository interface {
+	// FindActive retrieves every active specialty ordered by name
+	FindActive(ctx context.Context) ([]*entities.Specialty, error)
+
+	// FindActiveByCodes retrieves the active specialties matching the given codes
+	// Unknown or inactive codes are simply absent from the result
+	FindActiveByCodes(ctx context.Context, codes []string) ([]*entities.Specialty, error)
+}
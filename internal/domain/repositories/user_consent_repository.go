@@ -0,0 +1,16 @@
+package repositories
+
+import (
+	"citary-backend/internal/domain/entities"
+	"context"
+)
+
+// UserConsentRepository defines the contract for the legal consent history of users
+type UserConsentRepository interface {
+	// Create records a user's acceptance of a document version
+	// Accepting an already accepted version keeps the original record
+	Create(ctx context.Context, consent *entities.UserConsent) error
+
+	// FindByUser retrieves the consent history of a user, oldest first
+	FindByUser(ctx context.Context, userID int) ([]*entities.UserConsent, error)
+}
@@ -0,0 +1,15 @@
+package repositories
+
+import (
+	"citary-backend/internal/domain/entities"
+	"context"
+)
+
+// UserEventRepository defines the contract for the user account audit trail
+type UserEventRepository interface {
+	// Create appends an event to the audit trail
+	Create(ctx context.Context, event *entities.UserEvent) error
+
+	// FindByUser retrieves the audit trail of a user, oldest first
+	FindByUser(ctx context.Context, userID int) ([]*entities.UserEvent, error)
+}
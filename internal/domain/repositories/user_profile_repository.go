@@ -0,0 +1,16 @@
+package repositories
+
+import (
+	"citary-backend/internal/domain/entities"
+	"context"
+)
+
+// UserProfileRepository defines the contract for user profile data operations
+type UserProfileRepository interface {
+	// FindByUserID retrieves the profile of a user
+	// Returns (nil, nil) if the user has never saved a profile
+	FindByUserID(ctx context.Context, userID int) (*entities.UserProfile, error)
+
+	// Upsert creates the user's profile or replaces its fields, and sets the profile ID
+	Upsert(ctx context.Context, profile *entities.UserProfile) error
+}
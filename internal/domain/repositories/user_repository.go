@@ -3,6 +3,7 @@ package repositories
 import (
 	"citary-backend/internal/domain/entities"
 	"context"
+	"time"
 )
 
 // UserRepository defines the contract for user data operations
@@ -10,6 +11,72 @@ type UserRepository interface {
 	// FindByEmail retrieves a user by their email address
 	FindByEmail(ctx context.Context, email string) (*entities.User, error)
 
+	// FindByID retrieves a user by their ID
+	FindByID(ctx context.Context, id int) (*entities.User, error)
+
+	// FindByVerificationToken retrieves a user by their current, unused email verification token
+	FindByVerificationToken(ctx context.Context, token string) (*entities.User, error)
+
 	// Create persists a new user to the database
 	Create(ctx context.Context, user *entities.User) error
+
+	// CreateWithConsents persists a new user and their legal consents atomically, setting each consent's UserID
+	CreateWithConsents(ctx context.Context, user *entities.User, consents []*entities.UserConsent) error
+
+	// MarkEmailVerified flags the user's email as verified if token is still their current, unexpired verification token,
+	// clears the token and stores consumed, the used token's record, atomically
+	// Returns false without changes if the token was rotated, expired or already used
+	MarkEmailVerified(ctx context.Context, userID int, token string, consumed *entities.UserToken) (bool, error)
+
+	// RotateVerificationToken stores a new verification token for an unverified user unless the resend cooldown
+	// or the daily delivery cap forbids it, updating the user's send tracking on success
+	// Returns false if the rotation was rate limited
+	RotateVerificationToken(ctx context.Context, user *entities.User, cooldown time.Duration, maxSendsPerDay int) (bool, error)
+
+	// RecordSuccessfulLogin stores the latest successful login and resets the failed attempt counter and lock
+	RecordSuccessfulLogin(ctx context.Context, userID int, loggedInAt time.Time) error
+
+	// UpdatePassword replaces the user's password hash and clears any failed login lock
+	UpdatePassword(ctx context.Context, userID int, passwordHash string) error
+
+	// Reactivate restores a deleted account awaiting anonymization with a new password and a pending email
+	// verification, clearing any failed login lock
+	// Returns false without changes if the account is no longer awaiting anonymization, e.g. because it was anonymized
+	Reactivate(ctx context.Context, user *entities.User) (bool, error)
+
+	// UpdateEmail replaces the user's email address
+	// Returns a conflict error if another account already uses the address
+	UpdateEmail(ctx context.Context, userID int, email string) error
+
+	// UpdateTwoFactor stores the user's two-factor state and TOTP secret
+	UpdateTwoFactor(ctx context.Context, userID int, enabled bool, secret *string) error
+
+	// UpdateLegalAcceptance stores when the user last accepted the terms and the privacy policy
+	// Nil timestamps keep the stored value
+	UpdateLegalAcceptance(ctx context.Context, userID int, termsAcceptedAt, privacyAcceptedAt *time.Time) error
+
+	// UpdateDefaultOrganization stores the organization new sessions of the user act within
+	// A nil organizationID makes new sessions act outside of any organization
+	UpdateDefaultOrganization(ctx context.Context, userID int, organizationID *int) error
+
+	// SoftDelete deactivates the account and schedules its personal data for anonymization
+	SoftDelete(ctx context.Context, userID int, deletedAt, anonymizeAfter time.Time) error
+
+	// FindDueForAnonymization returns the IDs of deleted accounts whose grace period ended before now
+	FindDueForAnonymization(ctx context.Context, now time.Time, limit int) ([]int, error)
+
+	// Anonymize erases the personal data of a deleted account whose grace period ended before now:
+	// its profile, credentials, sessions, tokens and calendar feed, the client details of its consents and events,
+	// and the reasons given for its appointments;
+	// its organization memberships and doctor profiles are deactivated, the profiles without license or bio
+	// The email is replaced with tombstoneEmail, also on the invitations sent to it or accepted by the account,
+	// whose pending ones are revoked; everything happens atomically
+	// Returns false without changes if the account is no longer due, e.g. because it was reactivated
+	Anonymize(ctx context.Context, userID int, tombstoneEmail string, now time.Time) (bool, error)
+
+	// RegisterFailedLogin increments the failed attempt counter and, when lockSchedule has a lock for the new count,
+	// locks the account from now, all in one atomic statement; an existing lock that ends later is kept
+	// lockSchedule[i] is the lock after i+1 consecutive failures and its last entry applies to every later failure
+	// Returns the updated count and the end of the lock, nil if the account is not locked
+	RegisterFailedLogin(ctx context.Context, userID int, lockSchedule []time.Duration, now time.Time) (int, *time.Time, error)
 }
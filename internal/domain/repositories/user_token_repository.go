@@ -0,0 +1,28 @@
+package repositories
+
+import (
+	"citary-backend/internal/domain/entities"
+	"context"
+	"time"
+)
+
+// UserTokenRepository defines the contract for single-use user token data operations
+type UserTokenRepository interface {
+	// Create persists a new user token
+	Create(ctx context.Context, token *entities.UserToken) error
+
+	// FindByTokenHash retrieves a token of the given purpose by the hash of its value
+	FindByTokenHash(ctx context.Context, purpose, tokenHash string) (*entities.UserToken, error)
+
+	// MarkUsed atomically consumes a token
+	// Returns false if the token had already been consumed
+	MarkUsed(ctx context.Context, id int) (bool, error)
+
+	// InvalidateForUser consumes every outstanding token of the given purpose for a user
+	InvalidateForUser(ctx context.Context, userID int, purpose string) error
+
+	// Rotate consumes the user's outstanding tokens of the token's purpose and stores the new one
+	// Returns false without storing anything if a token of that purpose was issued within the cooldown
+	// or maxPerDay of them were already issued during the token's UTC creation day
+	Rotate(ctx context.Context, token *entities.UserToken, cooldown time.Duration, maxPerDay int) (bool, error)
+}
@@ -0,0 +1,145 @@
+// Package scheduling turns a doctor's weekly hours, exceptions and bookings into concrete availability
+// windows and bookable slots
+//
+// Weekly blocks and exceptions are wall-clock times at a location; they are converted to absolute
+// time per local date with the location's timezone rules, so hours stay put across DST changes.
+// Everything here is pure computation; the caller loads the schedule and bookings.
+package scheduling
+
+import (
+	"citary-backend/internal/domain/entities"
+	"citary-backend/pkg/constants"
+	"sort"
+	"time"
+)
+
+// Schedule is the weekly availability of one doctor with the exceptions to it
+// Locations maps each location that may open time to its timezone; blocks and exceptions at any other
+// location are ignored. Timezone reads exceptions that apply to every location, normally the organization's
+type Schedule struct {
+	Blocks     []*entities.AvailabilityBlock
+	Exceptions []*entities.AvailabilityException
+	Locations  map[int]*time.Location
+	Timezone   *time.Location
+}
+
+// Windows combines the weekly blocks and exceptions into the windows the doctor is available in
+// between from and to, clipped to that range and ordered by start and location
+// Unavailable exceptions close time at their location, or at every location when they have none;
+// available exceptions open extra time at their location
+func (s *Schedule) Windows(from, to time.Time) []*entities.AvailabilityWindow {
+	// Local dates one day either side of the range cover every timezone offset
+	first := dateOf(from.UTC()).AddDate(0, 0, -1)
+	last := dateOf(to.UTC()).AddDate(0, 0, 1)
+
+	opening := map[int][]Span{}
+	closing := map[int][]Span{}
+	var closingEverywhere []Span
+
+	for day := first; !day.After(last); day = day.AddDate(0, 0, 1) {
+		for _, block := range s.Blocks {
+			loc, ok := s.Locations[block.LocationID]
+			if !ok || !block.IsActive() || block.Weekday != day.Weekday() || !blockAppliesOn(block, day) {
+				continue
+			}
+			opening[block.LocationID] = append(opening[block.LocationID], Span{
+				Start: wallClock(day, block.StartMinute, loc),
+				End:   wallClock(day, block.EndMinute, loc),
+			})
+		}
+
+		for _, exception := range s.Exceptions {
+			if !exception.IsActive() || day.Before(dateOf(exception.StartDate)) || day.After(dateOf(exception.EndDate)) {
+				continue
+			}
+
+			switch exception.Kind {
+			case constants.AvailabilityExceptionKind.Available:
+				if exception.LocationID == nil || exception.IsWholeDay() {
+					continue
+				}
+				loc, ok := s.Locations[*exception.LocationID]
+				if !ok {
+					continue
+				}
+				opening[*exception.LocationID] = append(opening[*exception.LocationID], exceptionSpan(exception, day, loc))
+
+			case constants.AvailabilityExceptionKind.Unavailable:
+				if exception.LocationID == nil {
+					closingEverywhere = append(closingEverywhere, exceptionSpan(exception, day, s.Timezone))
+					continue
+				}
+				loc, ok := s.Locations[*exception.LocationID]
+				if !ok {
+					continue
+				}
+				closing[*exception.LocationID] = append(closing[*exception.LocationID], exceptionSpan(exception, day, loc))
+			}
+		}
+	}
+
+	windows := []*entities.AvailabilityWindow{}
+	for locationID, spans := range opening {
+		closed := union(append(closing[locationID], closingEverywhere...))
+		for _, span := range clip(subtract(union(spans), closed), from, to) {
+			windows = append(windows, &entities.AvailabilityWindow{LocationID: locationID, Start: span.Start, End: span.End})
+		}
+	}
+
+	sortWindows(windows)
+	return windows
+}
+
+// blockAppliesOn reports whether the local date falls within the block's optional validity dates
+func blockAppliesOn(block *entities.AvailabilityBlock, day time.Time) bool {
+	if block.ValidFrom != nil && day.Before(dateOf(*block.ValidFrom)) {
+		return false
+	}
+	if block.ValidUntil != nil && day.After(dateOf(*block.ValidUntil)) {
+		return false
+	}
+	return true
+}
+
+// exceptionSpan returns the time an exception covers on one local date: its time range, or the whole day
+func exceptionSpan(exception *entities.AvailabilityException, day time.Time, loc *time.Location) Span {
+	if exception.IsWholeDay() {
+		return Span{Start: wallClock(day, 0, loc), End: wallClock(day, 24*60, loc)}
+	}
+	return Span{Start: wallClock(day, *exception.StartMinute, loc), End: wallClock(day, *exception.EndMinute, loc)}
+}
+
+// dateOf returns the calendar date of t, as midnight UTC so dates compare and step without offsets
+func dateOf(t time.Time) time.Time {
+	year, month, day := t.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+// wallClock returns the instant that a local date and minute of the day refer to in loc, in UTC
+// Minute 1440 is midnight at the end of the day. A time skipped by a DST change resolves with the offset
+// before the change, so 02:30 on a spring-forward day is read as 03:30; a time repeated by a DST change
+// resolves to its second occurrence, in the offset after the change. PostgreSQL reads local times the same way
+func wallClock(day time.Time, minute int, loc *time.Location) time.Time {
+	local := day.Add(time.Duration(minute) * time.Minute)
+
+	// Zones change offset at most once within a day either side of the local time
+	_, before := local.Add(-24 * time.Hour).In(loc).Zone()
+	_, after := local.Add(24 * time.Hour).In(loc).Zone()
+
+	late := local.Add(-time.Duration(after) * time.Second)
+	if _, offset := late.In(loc).Zone(); offset == after {
+		return late
+	}
+
+	return local.Add(-time.Duration(before) * time.Second)
+}
+
+// sortWindows orders windows by start, then by location
+func sortWindows(windows []*entities.AvailabilityWindow) {
+	sort.Slice(windows, func(i, j int) bool {
+		if !windows[i].Start.Equal(windows[j].Start) {
+			return windows[i].Start.Before(windows[j].Start)
+		}
+		return windows[i].LocationID < windows[j].LocationID
+	})
+}
@@ -0,0 +1,67 @@
+package scheduling
+
+import (
+	"citary-backend/internal/domain/entities"
+	"sort"
+	"time"
+)
+
+// FreeWindows removes the bookings from the availability windows, each booking padded by the buffer on
+// both sides, so free time never starts or ends closer than the buffer to another appointment
+// Windows must be ordered by start and not overlap within a location, as Schedule.Windows returns them
+func FreeWindows(windows []*entities.AvailabilityWindow, bookings []Span, buffer time.Duration) []*entities.AvailabilityWindow {
+	padded := make([]Span, 0, len(bookings))
+	for _, booking := range bookings {
+		padded = append(padded, Span{Start: booking.Start.Add(-buffer), End: booking.End.Add(buffer)})
+	}
+	busy := union(padded)
+
+	free := []*entities.AvailabilityWindow{}
+	for _, window := range windows {
+		for _, span := range subtract([]Span{{Start: window.Start, End: window.End}}, busy) {
+			free = append(free, &entities.AvailabilityWindow{LocationID: window.LocationID, Start: span.Start, End: span.End})
+		}
+	}
+
+	sortWindows(free)
+	return free
+}
+
+// Slots splits free windows into consecutive slots of the given length separated by the buffer
+// Slots start at the beginning of each window and must end within it; only slots starting at or after from
+// are returned, so free time resolved from earlier keeps slots aligned to the start of its window
+// Slots are cut in absolute time, so a slot spanning a DST change still lasts exactly length
+// timezones maps each location to its timezone, reported with the slot
+func Slots(free []*entities.AvailabilityWindow, timezones map[int]*time.Location, from time.Time, length, buffer time.Duration) []*entities.AvailabilitySlot {
+	slots := []*entities.AvailabilitySlot{}
+	if length <= 0 {
+		return slots
+	}
+
+	for _, window := range free {
+		timezone := ""
+		if loc, ok := timezones[window.LocationID]; ok {
+			timezone = loc.String()
+		}
+
+		for start := window.Start; !start.Add(length).After(window.End); start = start.Add(length + buffer) {
+			if start.Before(from) {
+				continue
+			}
+			slots = append(slots, &entities.AvailabilitySlot{
+				LocationID: window.LocationID,
+				Timezone:   timezone,
+				Start:      start,
+				End:        start.Add(length),
+			})
+		}
+	}
+
+	sort.Slice(slots, func(i, j int) bool {
+		if !slots[i].Start.Equal(slots[j].Start) {
+			return slots[i].Start.Before(slots[j].Start)
+		}
+		return slots[i].LocationID < slots[j].LocationID
+	})
+	return slots
+}
@@ -0,0 +1,89 @@
+package scheduling
+
+import (
+	"sort"
+	"time"
+)
+
+// Span is the half-open interval of absolute time [Start, End)
+type Span struct {
+	Start time.Time
+	End   time.Time
+}
+
+// isEmpty reports whether the span contains no time
+func (s Span) isEmpty() bool {
+	return !s.End.After(s.Start)
+}
+
+// union sorts the spans and merges the ones that overlap or touch, dropping empty spans
+// The result is the normalized form every other span operation expects
+func union(spans []Span) []Span {
+	sorted := make([]Span, 0, len(spans))
+	for _, span := range spans {
+		if !span.isEmpty() {
+			sorted = append(sorted, span)
+		}
+	}
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Start.Before(sorted[j].Start) })
+
+	merged := make([]Span, 0, len(sorted))
+	for _, span := range sorted {
+		last := len(merged) - 1
+		if last >= 0 && !span.Start.After(merged[last].End) {
+			if span.End.After(merged[last].End) {
+				merged[last].End = span.End
+			}
+			continue
+		}
+		merged = append(merged, span)
+	}
+	return merged
+}
+
+// subtract removes the time covered by cut from spans; both must be normalized
+func subtract(spans, cut []Span) []Span {
+	result := make([]Span, 0, len(spans))
+	j := 0
+	for _, span := range spans {
+		// Cuts ending before this span cannot reach any later span either
+		for j < len(cut) && !cut[j].End.After(span.Start) {
+			j++
+		}
+
+		current := span
+		for k := j; k < len(cut) && cut[k].Start.Before(current.End); k++ {
+			if cut[k].Start.After(current.Start) {
+				result = append(result, Span{Start: current.Start, End: cut[k].Start})
+			}
+			if cut[k].End.After(current.Start) {
+				current.Start = cut[k].End
+			}
+			if current.isEmpty() {
+				break
+			}
+		}
+
+		if !current.isEmpty() {
+			result = append(result, current)
+		}
+	}
+	return result
+}
+
+// clip keeps the part of each normalized span that lies within [from, to)
+func clip(spans []Span, from, to time.Time) []Span {
+	result := make([]Span, 0, len(spans))
+	for _, span := range spans {
+		if span.Start.Before(from) {
+			span.Start = from
+		}
+		if span.End.After(to) {
+			span.End = to
+		}
+		if !span.isEmpty() {
+			result = append(result, span)
+		}
+	}
+	return result
+}
@@ -0,0 +1,39 @@
+package security
+
+import (
+	"citary-backend/internal/domain/repositories"
+	"context"
+	"log"
+)
+
+// AccountGuard checks that the account behind an access token may still use it
+// Access tokens are only verified by signature and expiry, so without this check a deleted
+// or deactivated account would keep access until its token expired
+type AccountGuard struct {
+	userRepository repositories.UserRepository
+}
+
+// NewAccountGuard creates a new instance of AccountGuard
+func NewAccountGuard(userRepository repositories.UserRepository) *AccountGuard {
+	return &AccountGuard{
+		userRepository: userRepository,
+	}
+}
+
+// Allows reports whether the user exists and is active
+// It is the same rule refreshing a session applies, checked on every authenticated request
+// A temporary login lockout only guards the login paths, so it does not end existing sessions
+func (g *AccountGuard) Allows(ctx context.Context, userID int) (bool, error) {
+	user, err := g.userRepository.FindByID(ctx, userID)
+	if err != nil {
+		log.Printf("[AccountGuard] Error finding user: userID=%d, error=%v", userID, err)
+		return false, err
+	}
+
+	if user == nil || !user.IsActive() {
+		log.Printf("[AccountGuard] Account can no longer use its sessions: userID=%d", userID)
+		return false, nil
+	}
+
+	return true, nil
+}
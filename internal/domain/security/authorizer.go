@@ -0,0 +1,90 @@
+package security
+
+import (
+	"citary-backend/internal/domain/errors"
+	"citary-backend/internal/domain/repositories"
+	"citary-backend/pkg/constants"
+	"context"
+	"log"
+)
+
+// Authorizer resolves what the caller of a request is allowed to do from the permissions of their role
+// It is used by the HTTP layer to guard routes
+type Authorizer struct {
+	roleRepository       repositories.RoleRepository
+	membershipRepository repositories.OrganizationMembershipRepository
+}
+
+// NewAuthorizer creates a new instance of Authorizer
+func NewAuthorizer(
+	roleRepository repositories.RoleRepository,
+	membershipRepository repositories.OrganizationMembershipRepository,
+) *Authorizer {
+	return &Authorizer{
+		roleRepository:       roleRepository,
+		membershipRepository: membershipRepository,
+	}
+}
+
+// PermissionsFor loads the permission set of the role the principal acts with
+// Within an organization that is the role of the caller's current membership, so removed members lose access
+// immediately; outside of any organization it is the role carried in the token
+// Unknown or inactive roles and memberships grant nothing
+func (a *Authorizer) PermissionsFor(ctx context.Context, principal *Principal) (PermissionSet, error) {
+	roleCode := principal.RoleCode
+
+	if principal.HasOrganization() {
+		membership, err := a.membershipRepository.FindByUserAndOrganization(ctx, principal.UserID, *principal.OrganizationID)
+		if err != nil {
+			log.Printf("[Authorizer] Error fetching membership: userID=%d, organizationID=%d, error=%v", principal.UserID, *principal.OrganizationID, err)
+			return PermissionSet{}, err
+		}
+
+		if membership == nil || !membership.IsActive() {
+			log.Printf("[Authorizer] Membership missing or inactive: userID=%d, organizationID=%d", principal.UserID, *principal.OrganizationID)
+			return PermissionSet{}, nil
+		}
+
+		roleCode = membership.RoleCode
+	}
+
+	role, err := a.roleRepository.FindByCode(ctx, roleCode)
+	if err != nil {
+		log.Printf("[Authorizer] Error fetching role: role=%s, error=%v", roleCode, err)
+		return PermissionSet{}, err
+	}
+
+	if role == nil || !role.IsActive() {
+		log.Printf("[Authorizer] Role missing or inactive: role=%s, userID=%d", roleCode, principal.UserID)
+		return PermissionSet{}, nil
+	}
+
+	permissions, err := ParsePermissions(role.Permissions)
+	if err != nil {
+		log.Printf("[Authorizer] Error parsing permissions: role=%s, error=%v", role.Code, err)
+		return PermissionSet{}, errors.ErrInternal(err)
+	}
+
+	return permissions, nil
+}
+
+// Require checks that the caller stored in ctx holds the given permission
+// Returns an unauthorized error for anonymous callers and a forbidden error when the permission is missing
+func (a *Authorizer) Require(ctx context.Context, permission string) error {
+	principal, ok := PrincipalFromContext(ctx)
+	if !ok {
+		return errors.ErrUnauthorized(constants.ErrorMessages.Unauthorized)
+	}
+
+	permissions, err := a.PermissionsFor(ctx, principal)
+	if err != nil {
+		return err
+	}
+
+	if !permissions.Allows(permission) {
+		log.Printf("[Authorizer] Permission denied: userID=%d, role=%s, permission=%s", principal.UserID, principal.RoleCode, permission)
+		return errors.ErrForbidden(constants.ErrorMessages.Forbidden)
+	}
+
+	return nil
+}
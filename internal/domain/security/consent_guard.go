@@ -0,0 +1,44 @@
+package security
+
+import (
+	"citary-backend/internal/domain/errors"
+	"citary-backend/internal/domain/repositories"
+	"citary-backend/pkg/constants"
+	"context"
+	"log"
+)
+
+// ConsentGuard blocks authenticated callers who have not accepted the current legal documents
+// Publishing a new document version makes every user accept it again before continuing
+type ConsentGuard struct {
+	legalDocumentRepository repositories.LegalDocumentRepository
+}
+
+// NewConsentGuard creates a new instance of ConsentGuard
+func NewConsentGuard(legalDocumentRepository repositories.LegalDocumentRepository) *ConsentGuard {
+	return &ConsentGuard{
+		legalDocumentRepository: legalDocumentRepository,
+	}
+}
+
+// Require returns nil when the caller is anonymous or has accepted every current document
+// Callers with pending documents receive 428 Precondition Required
+func (g *ConsentGuard) Require(ctx context.Context) error {
+	principal, ok := PrincipalFromContext(ctx)
+	if !ok {
+		return nil
+	}
+
+	pending, err := g.legalDocumentRepository.FindPendingForUser(ctx, principal.UserID)
+	if err != nil {
+		log.Printf("[ConsentGuard] Error finding pending documents: userID=%d, error=%v", principal.UserID, err)
+		return err
+	}
+
+	if len(pending) > 0 {
+		log.Printf("[ConsentGuard] Consent required: userID=%d, pending=%d", principal.UserID, len(pending))
+		return errors.ErrPreconditionRequired(constants.ErrorMessages.ConsentRequired)
+	}
+
+	return nil
+}
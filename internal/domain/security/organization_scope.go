@@ -0,0 +1,24 @@
+package security
+
+import (
+	"citary-backend/internal/domain/errors"
+	"citary-backend/pkg/constants"
+	"context"
+	"log"
+)
+
+// OrganizationScope returns the organization the caller stored in ctx acts within
+// Organization-bound records are read and written under this ID, never under one taken from the request
+func OrganizationScope(ctx context.Context) (int, error) {
+	principal, ok := PrincipalFromContext(ctx)
+	if !ok {
+		return 0, errors.ErrUnauthorized(constants.ErrorMessages.Unauthorized)
+	}
+
+	if !principal.HasOrganization() {
+		log.Printf("[OrganizationScope] Caller has no organization: userID=%d", principal.UserID)
+		return 0, errors.ErrForbidden(constants.ErrorMessages.OrganizationRequired)
+	}
+
+	return *principal.OrganizationID, nil
+}
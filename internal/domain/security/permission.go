@@ -0,0 +1,92 @@
+package security
+
+import (
+	"citary-backend/pkg/constants"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// permissionSeparator splits a permission code into its resource, action and scope segments
+const permissionSeparator = ":"
+
+// permissionWildcard matches any segment; as the last segment it also matches every deeper segment
+const permissionWildcard = "*"
+
+// PermissionSet is the parsed set of permission patterns granted to a role
+type PermissionSet struct {
+	patterns [][]string
+}
+
+// ParsePermissions parses the JSONB permissions column of a role
+// The column holds a JSON array of permission codes, e.g. ["appointments:*", "appointments:read:any"]
+// A nil or null column yields an empty set
+func ParsePermissions(raw *json.RawMessage) (PermissionSet, error) {
+	if raw == nil || len(*raw) == 0 || string(*raw) == "null" {
+		return PermissionSet{}, nil
+	}
+
+	var codes []string
+	if err := json.Unmarshal(*raw, &codes); err != nil {
+		return PermissionSet{}, fmt.Errorf("invalid role permissions, expected an array of strings: %w", err)
+	}
+
+	return NewPermissionSet(codes...), nil
+}
+
+// NewPermissionSet builds a permission set from permission codes, ignoring blank entries
+func NewPermissionSet(codes ...string) PermissionSet {
+	set := PermissionSet{patterns: make([][]string, 0, len(codes))}
+	for _, code := range codes {
+		code = strings.TrimSpace(code)
+		if code == "" {
+			continue
+		}
+		set.patterns = append(set.patterns, strings.Split(code, permissionSeparator))
+	}
+	return set
+}
+
+// Allows reports whether any pattern in the set grants the required permission
+func (s PermissionSet) Allows(permission string) bool {
+	required := strings.Split(permission, permissionSeparator)
+	for _, pattern := range s.patterns {
+		if patternMatches(pattern, required) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsEmpty reports whether the set grants nothing
+func (s PermissionSet) IsEmpty() bool {
+	return len(s.patterns) == 0
+}
+
+// patternMatches compares a granted pattern with a required permission segment by segment
+func patternMatches(pattern, required []string) bool {
+	for i, segment := range pattern {
+		if segment == permissionWildcard && i == len(pattern)-1 {
+			return true
+		}
+		if i >= len(required) {
+			return false
+		}
+		if segment != permissionWildcard && segment != required[i] {
+			return false
+		}
+	}
+	return len(pattern) == len(required)
+}
+
+// IsKnownPermission reports whether a permission pattern grants at least one permission of the vocabulary
+// Patterns that could never match anything (typos, unknown resources) are rejected by role management
+func IsKnownPermission(pattern string) bool {
+	patternSegments := strings.Split(strings.TrimSpace(pattern), permissionSeparator)
+	for _, permission := range constants.PermissionVocabulary {
+		if patternMatches(patternSegments, strings.Split(permission, permissionSeparator)) {
+			return true
+		}
+	}
+	return false
+}
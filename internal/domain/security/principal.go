@@ -0,0 +1,30 @@
+package security
+
+import "context"
+
+// Principal represents the authenticated caller of a request
+type Principal struct {
+	UserID         int
+	RoleCode       string
+	OrganizationID *int
+}
+
+// HasOrganization reports whether the caller is acting within an organization
+func (p *Principal) HasOrganization() bool {
+	return p.OrganizationID != nil
+}
+
+// principalContextKey is the unexported key under which the principal is stored in a context
+type principalContextKey struct{}
+
+// WithPrincipal returns a copy of ctx carrying the given principal
+func WithPrincipal(ctx context.Context, principal *Principal) context.Context {
+	return context.WithValue(ctx, principalContextKey{}, principal)
+}
+
+// PrincipalFromContext returns the principal stored in ctx
+// The boolean is false when the request is anonymous
+func PrincipalFromContext(ctx context.Context) (*Principal, bool) {
+	principal, ok := ctx.Value(principalContextKey{}).(*Principal)
+	return principal, ok && principal != nil
+}
@@ -0,0 +1,10 @@
+package services
+
+import "citary-backend/internal/domain/entities"
+
+// CalendarService defines the interface for exporting appointments to calendar applications
+type CalendarService interface {
+	// RenderAppointments renders appointments as an iCalendar document, one event per appointment
+	// viewerID is the user the calendar is for, which decides whether events are described for the patient or the doctor
+	RenderAppointments(viewerID int, calendarName string, appointments []*entities.Appointment) []byte
+}
@@ -1,9 +1,33 @@
 package services
 
-import "context"
+import (
+	"citary-backend/internal/domain/entities"
+	"context"
+)
 
 // EmailService defines the interface for sending emails
 type EmailService interface {
 	// SendVerificationEmail sends an email verification link to the user
 	SendVerificationEmail(ctx context.Context, email, token string) error
+
+	// SendPasswordResetEmail sends a password reset link to the user
+	SendPasswordResetEmail(ctx context.Context, email, token string) error
+
+	// SendReactivationEmail sends a link to reactivate an inactive account
+	SendReactivationEmail(ctx context.Context, email, token string) error
+
+	// SendEmailChangeConfirmation sends a link confirming the new address to that address
+	SendEmailChangeConfirmation(ctx context.Context, newEmail, token string) error
+
+	// SendEmailChangedNotice tells the previous address that the account email was changed
+	SendEmailChangedNotice(ctx context.Context, oldEmail, newEmail string) error
+
+	// SendOrganizationInvitation sends a link to join an organization with the given role
+	SendOrganizationInvitation(ctx context.Context, email, organizationName, roleName, token string) error
+
+	// SendAppointmentBooked confirms a new booking to the patient with the appointment attached as an iCalendar invite
+	SendAppointmentBooked(ctx context.Context, email string, appointment *entities.Appointment, invite []byte) error
+
+	// SendAppointmentReminder reminds a patient of an upcoming appointment
+	SendAppointmentReminder(ctx context.Context, email string, appointment *entities.Appointment) error
 }
@@ -0,0 +1,41 @@
+package services
+
+import "time"
+
+// AccessTokenClaims represents the identity carried by a signed access token
+// OrganizationID is nil for users acting outside of any organization
+type AccessTokenClaims struct {
+	UserID         int
+	RoleCode       string
+	OrganizationID *int
+	IssuedAt       time.Time
+	ExpiresAt      time.Time
+}
+
+// IssuedToken represents a freshly signed token and its expiration
+type IssuedToken struct {
+	Token     string
+	ExpiresAt time.Time
+}
+
+// TokenService defines the interface for issuing and validating authentication tokens
+type TokenService interface {
+	// GenerateAccessToken signs an access token for the given claims
+	// IssuedAt and ExpiresAt are set by the implementation
+	GenerateAccessToken(claims AccessTokenClaims) (*IssuedToken, error)
+
+	// ParseAccessToken validates an access token and returns its claims
+	ParseAccessToken(token string) (*AccessTokenClaims, error)
+
+	// GenerateMFAChallengeToken signs a short-lived token proving the password step succeeded for a user
+	GenerateMFAChallengeToken(userID int) (*IssuedToken, error)
+
+	// ParseMFAChallengeToken validates an MFA challenge token and returns the user ID it was issued for
+	ParseMFAChallengeToken(token string) (int, error)
+
+	// GenerateRefreshToken creates a new random opaque refresh token
+	GenerateRefreshToken() (*IssuedToken, error)
+
+	// HashToken returns the deterministic hash under which opaque tokens are stored
+	HashToken(token string) string
+}
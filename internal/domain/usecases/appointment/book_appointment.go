@@ -0,0 +1,212 @@
+package appointment
+
+import (
+	"citary-backend/internal/domain/dtos/appointment"
+	"citary-backend/internal/domain/entities"
+	"citary-backend/internal/domain/errors"
+	"citary-backend/internal/domain/repositories"
+	"citary-backend/internal/domain/services"
+	"citary-backend/pkg/constants"
+	"context"
+	"log"
+	"time"
+)
+
+// BookAppointmentUseCase handles the business logic for a patient booking a doctor
+type BookAppointmentUseCase struct {
+	doctorRepository       repositories.DoctorRepository
+	membershipRepository   repositories.OrganizationMembershipRepository
+	availabilityRepository repositories.AvailabilityRepository
+	appointmentRepository  repositories.AppointmentRepository
+	userRepository         repositories.UserRepository
+	emailService           services.EmailService
+	calendarService        services.CalendarService
+}
+
+// NewBookAppointmentUseCase creates a new instance of BookAppointmentUseCase
+func NewBookAppointmentUseCase(
+	doctorRepository repositories.DoctorRepository,
+	membershipRepository repositories.OrganizationMembershipRepository,
+	availabilityRepository repositories.AvailabilityRepository,
+	appointmentRepository repositories.AppointmentRepository,
+	userRepository repositories.UserRepository,
+	emailService services.EmailService,
+	calendarService services.CalendarService,
+) *BookAppointmentUseCase {
+	return &BookAppointmentUseCase{
+		doctorRepository:       doctorRepository,
+		membershipRepository:   membershipRepository,
+		availabilityRepository: availabilityRepository,
+		appointmentRepository:  appointmentRepository,
+		userRepository:         userRepository,
+		emailService:           emailService,
+		calendarService:        calendarService,
+	}
+}
+
+// Execute books the doctor for the patient starting at the requested time
+// The slot must lie within the doctor's effective availability and keep the doctor's buffer from other
+// bookings; the repository repeats the buffer check under a per-doctor lock, so if another patient takes an
+// overlapping or adjacent slot first the booking is rejected with a conflict error
+func (uc *BookAppointmentUseCase) Execute(ctx context.Context, patientID int, dto appointment.BookAppointmentRequest) (*entities.Appointment, error) {
+	log.Printf("[BookAppointmentUseCase] Execute: patientID=%d, doctorID=%d, startTime=%s", patientID, dto.DoctorID, dto.StartTime)
+
+	// 1. Validate input data
+	if err := dto.Validate(); err != nil {
+		log.Printf("[BookAppointmentUseCase] Validation failed: %v", err)
+		return nil, errors.ErrBadRequest(err.Error())
+	}
+
+	// 2. The doctor must be publicly listed
+	doctor, err := uc.doctorRepository.FindPublicByID(ctx, dto.DoctorID)
+	if err != nil {
+		log.Printf("[BookAppointmentUseCase] Error finding doctor: doctorID=%d, error=%v", dto.DoctorID, err)
+		return nil, err
+	}
+
+	if doctor == nil {
+		log.Printf("[BookAppointmentUseCase] Doctor not found: doctorID=%d", dto.DoctorID)
+		return nil, errors.ErrNotFound(constants.ErrorMessages.DoctorNotFound)
+	}
+
+	// 3. The provider must still hold the doctor role in the organization
+	membership, err := uc.membershipRepository.FindByUserAndOrganization(ctx, doctor.UserID, doctor.OrganizationID)
+	if err != nil {
+		log.Printf("[BookAppointmentUseCase] Error checking membership: userID=%d, error=%v", doctor.UserID, err)
+		return nil, err
+	}
+
+	if membership == nil || !membership.IsActive() || membership.RoleCode != constants.RoleCodes.Doctor {
+		log.Printf("[BookAppointmentUseCase] Provider is not an active doctor: doctorID=%d, userID=%d", doctor.ID, doctor.UserID)
+		return nil, errors.ErrBadRequest(constants.ErrorMessages.DoctorNotBookable)
+	}
+
+	// 4. Check the booking window
+	startTime := dto.ParsedStartTime()
+	endTime := startTime.Add(time.Duration(doctor.ConsultationMinutes) * time.Minute)
+	now := time.Now()
+
+	if err := checkBookingWindow(startTime, now); err != nil {
+		log.Printf("[BookAppointmentUseCase] Start outside the booking window: doctorID=%d, startTime=%s", doctor.ID, startTime.Format(time.RFC3339))
+		return nil, err
+	}
+
+	// 5. The whole slot must fall within one availability window, which also decides the location
+	windows, err := uc.availabilityRepository.FindEffectiveAvailability(ctx, doctor.ID, startTime, endTime)
+	if err != nil {
+		log.Printf("[BookAppointmentUseCase] Error resolving availability: doctorID=%d, error=%v", doctor.ID, err)
+		return nil, err
+	}
+
+	window := findCoveringWindow(windows, startTime, endTime)
+	if window == nil {
+		log.Printf("[BookAppointmentUseCase] Outside availability: doctorID=%d, startTime=%s", doctor.ID, startTime.Format(time.RFC3339))
+		return nil, errors.ErrBadRequest(constants.ErrorMessages.AppointmentOutsideAvailability)
+	}
+
+	// 6. The slot must not overlap another booking or its buffer
+	if err := checkSlotFree(ctx, uc.availabilityRepository, doctor, 0, startTime, endTime); err != nil {
+		return nil, err
+	}
+
+	// 7. Reserve the slot (the repository rejects bookings that reach another one or its buffer)
+	newAppointment := &entities.Appointment{
+		OrganizationID: doctor.OrganizationID,
+		DoctorID:       doctor.ID,
+		LocationID:     window.LocationID,
+		PatientID:      patientID,
+		StartTime:      startTime,
+		EndTime:        endTime,
+		Status:         constants.AppointmentStatus.Requested,
+		Reason:         dto.TrimmedReason(),
+		CreatedDate:    now,
+		RecordStatus:   constants.RecordStatus.Active,
+	}
+
+	if err := uc.appointmentRepository.Create(ctx, newAppointment); err != nil {
+		log.Printf("[BookAppointmentUseCase] Error booking appointment: doctorID=%d, error=%v", doctor.ID, err)
+		return nil, err
+	}
+
+	log.Printf("[BookAppointmentUseCase] Appointment booked: appointmentID=%d, doctorID=%d, patientID=%d", newAppointment.ID, doctor.ID, patientID)
+
+	// 8. Reload to include the joined doctor, organization and location fields
+	booked, err := findAppointment(ctx, uc.appointmentRepository, newAppointment.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 9. Send the confirmation with a calendar invite (failures are logged, the booking stands)
+	uc.sendConfirmation(ctx, booked)
+
+	return booked, nil
+}
+
+// sendConfirmation emails the patient a booking confirmation with the appointment attached as an iCalendar file
+func (uc *BookAppointmentUseCase) sendConfirmation(ctx context.Context, booked *entities.Appointment) {
+	patient, err := uc.userRepository.FindByID(ctx, booked.PatientID)
+	if err != nil || patient == nil {
+		log.Printf("[BookAppointmentUseCase] WARNING: Could not load patient for confirmation: appointmentID=%d, error=%v", booked.ID, err)
+		return
+	}
+
+	invite := uc.calendarService.RenderAppointments(patient.ID, constants.CalendarConfig.FeedName, []*entities.Appointment{booked})
+
+	if err := uc.emailService.SendAppointmentBooked(ctx, patient.Email, booked, invite); err != nil {
+		log.Printf("[BookAppointmentUseCase] WARNING: Failed to send booking confirmation to %s: %v", patient.Email, err)
+		return
+	}
+
+	log.Printf("[BookAppointmentUseCase] Booking confirmation sent: appointmentID=%d", booked.ID)
+}
+
+// checkBookingWindow rejects start times too close to now or too far ahead to be booked
+func checkBookingWindow(startTime, now time.Time) error {
+	if startTime.Before(now.Add(constants.AppointmentConfig.MinBookingNotice)) {
+		return errors.ErrBadRequest(constants.ErrorMessages.AppointmentTooSoon)
+	}
+
+	if startTime.After(now.Add(constants.AppointmentConfig.MaxBookingAdvance)) {
+		return errors.ErrBadRequest(constants.ErrorMessages.AppointmentTooFar)
+	}
+
+	return nil
+}
+
+// checkSlotFree rejects a slot that overlaps another booking of the doctor, buffers included
+// ignoreAppointmentID excludes the appointment being moved from the check (0 excludes none)
+func checkSlotFree(ctx context.Context, availabilityRepo repositories.AvailabilityRepository, doctor *entities.Doctor, ignoreAppointmentID int, start, end time.Time) error {
+	windows, err := availabilityRepo.FindFreeWindows(ctx, repositories.FreeTimeFilter{
+		DoctorID:            doctor.ID,
+		From:                start,
+		To:                  end,
+		BufferMinutes:       doctor.BufferMinutes,
+		IgnoreAppointmentID: ignoreAppointmentID,
+	})
+	if err != nil {
+		log.Printf("[Appointment] Error resolving free time: doctorID=%d, error=%v", doctor.ID, err)
+		return err
+	}
+
+	if findCoveringWindow(windows, start, end) == nil {
+		log.Printf("[Appointment] Slot taken: doctorID=%d, startTime=%s", doctor.ID, start.Format(time.RFC3339))
+		return errors.ErrConflict(constants.ErrorMessages.AppointmentSlotTaken)
+	}
+
+	return nil
+}
+
+// findCoveringWindow returns the availability window containing the whole [start, end) span, if any
+func findCoveringWindow(windows []*entities.AvailabilityWindow, start, end time.Time) *entities.AvailabilityWindow {
+	for _, window := range windows {
+		if windowCovers(window, start, end) {
+			return window
+		}
+	}
+	return nil
+}
+
+// windowCovers reports whether an availability window contains the whole [start, end) span
+func windowCovers(window *entities.AvailabilityWindow, start, end time.Time) bool {
+	return !window.Start.After(start) && !window.End.Before(end)
+}
@@ -0,0 +1,62 @@
+package appointment
+
+import (
+	"citary-backend/internal/domain/dtos/appointment"
+	"citary-backend/internal/domain/entities"
+	"citary-backend/internal/domain/errors"
+	"citary-backend/internal/domain/repositories"
+	"citary-backend/pkg/constants"
+	"context"
+	"log"
+	"time"
+)
+
+// CancelAppointmentUseCase handles the business logic for cancelling an appointment
+type CancelAppointmentUseCase struct {
+	appointmentRepository repositories.AppointmentRepository
+}
+
+// NewCancelAppointmentUseCase creates a new instance of CancelAppointmentUseCase
+func NewCancelAppointmentUseCase(appointmentRepository repositories.AppointmentRepository) *CancelAppointmentUseCase {
+	return &CancelAppointmentUseCase{
+		appointmentRepository: appointmentRepository,
+	}
+}
+
+// Execute cancels an appointment on behalf of its patient or its clinic
+// Patients must cancel at least AppointmentConfig.MinCancellationNotice ahead; clinics can cancel until the start
+func (uc *CancelAppointmentUseCase) Execute(ctx context.Context, actor Actor, id int, dto appointment.ChangeStatusRequest) (*entities.Appointment, error) {
+	log.Printf("[CancelAppointmentUseCase] Execute: userID=%d, appointmentID=%d, clinic=%t", actor.UserID, id, actor.IsClinic())
+
+	// 1. Validate input data
+	if err := dto.Validate(); err != nil {
+		log.Printf("[CancelAppointmentUseCase] Validation failed: %v", err)
+		return nil, errors.ErrBadRequest(err.Error())
+	}
+
+	// 2. The actor must be the patient or belong to the clinic
+	current, err := findAppointmentFor(ctx, uc.appointmentRepository, actor, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. Enforce the notice required from each side
+	now := time.Now()
+	if !now.Before(current.StartTime) {
+		log.Printf("[CancelAppointmentUseCase] Appointment already started: appointmentID=%d", id)
+		return nil, errors.ErrConflict(constants.ErrorMessages.AppointmentAlreadyStarted)
+	}
+
+	toStatus := constants.AppointmentStatus.CancelledByClinic
+	if !actor.IsClinic() {
+		toStatus = constants.AppointmentStatus.CancelledByPatient
+
+		if now.Add(constants.AppointmentConfig.MinCancellationNotice).After(current.StartTime) {
+			log.Printf("[CancelAppointmentUseCase] Cancellation too late: appointmentID=%d, startTime=%s", id, current.StartTime.Format(time.RFC3339))
+			return nil, errors.ErrConflict(constants.ErrorMessages.AppointmentCancellationTooLate)
+		}
+	}
+
+	// 4. Move the appointment, which frees its slot
+	return transition(ctx, uc.appointmentRepository, current, toStatus, actor.UserID, dto.TrimmedReason())
+}
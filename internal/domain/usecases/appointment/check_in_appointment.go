@@ -0,0 +1,52 @@
+package appointment
+
+import (
+	"citary-backend/internal/domain/dtos/appointment"
+	"citary-backend/internal/domain/entities"
+	"citary-backend/internal/domain/errors"
+	"citary-backend/internal/domain/repositories"
+	"citary-backend/pkg/constants"
+	"context"
+	"log"
+	"time"
+)
+
+// CheckInAppointmentUseCase handles the business logic for checking a patient in for a confirmed appointment
+type CheckInAppointmentUseCase struct {
+	appointmentRepository repositories.AppointmentRepository
+}
+
+// NewCheckInAppointmentUseCase creates a new instance of CheckInAppointmentUseCase
+func NewCheckInAppointmentUseCase(appointmentRepository repositories.AppointmentRepository) *CheckInAppointmentUseCase {
+	return &CheckInAppointmentUseCase{
+		appointmentRepository: appointmentRepository,
+	}
+}
+
+// Execute checks the patient of a confirmed appointment in
+// Check-in opens AppointmentConfig.CheckInOpensBefore the start and closes when the appointment ends
+func (uc *CheckInAppointmentUseCase) Execute(ctx context.Context, organizationID, userID, id int, dto appointment.ChangeStatusRequest) (*entities.Appointment, error) {
+	log.Printf("[CheckInAppointmentUseCase] Execute: organizationID=%d, userID=%d, appointmentID=%d", organizationID, userID, id)
+
+	// 1. Validate input data
+	if err := dto.Validate(); err != nil {
+		log.Printf("[CheckInAppointmentUseCase] Validation failed: %v", err)
+		return nil, errors.ErrBadRequest(err.Error())
+	}
+
+	// 2. The appointment must belong to the organization
+	current, err := findAppointmentFor(ctx, uc.appointmentRepository, ClinicActor(userID, organizationID), id)
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. Check-in must be open
+	now := time.Now()
+	if now.Before(current.StartTime.Add(-constants.AppointmentConfig.CheckInOpensBefore)) || !now.Before(current.EndTime) {
+		log.Printf("[CheckInAppointmentUseCase] Check-in not open: appointmentID=%d, startTime=%s", id, current.StartTime.Format(time.RFC3339))
+		return nil, errors.ErrConflict(constants.ErrorMessages.AppointmentCheckInNotOpen)
+	}
+
+	// 4. Move the appointment
+	return transition(ctx, uc.appointmentRepository, current, constants.AppointmentStatus.CheckedIn, userID, dto.TrimmedReason())
+}
@@ -0,0 +1,43 @@
+package appointment
+
+import (
+	"citary-backend/internal/domain/dtos/appointment"
+	"citary-backend/internal/domain/entities"
+	"citary-backend/internal/domain/errors"
+	"citary-backend/internal/domain/repositories"
+	"citary-backend/pkg/constants"
+	"context"
+	"log"
+)
+
+// CompleteAppointmentUseCase handles the business logic for closing an attended appointment
+type CompleteAppointmentUseCase struct {
+	appointmentRepository repositories.AppointmentRepository
+}
+
+// NewCompleteAppointmentUseCase creates a new instance of CompleteAppointmentUseCase
+func NewCompleteAppointmentUseCase(appointmentRepository repositories.AppointmentRepository) *CompleteAppointmentUseCase {
+	return &CompleteAppointmentUseCase{
+		appointmentRepository: appointmentRepository,
+	}
+}
+
+// Execute completes an appointment whose patient has checked in
+func (uc *CompleteAppointmentUseCase) Execute(ctx context.Context, organizationID, userID, id int, dto appointment.ChangeStatusRequest) (*entities.Appointment, error) {
+	log.Printf("[CompleteAppointmentUseCase] Execute: organizationID=%d, userID=%d, appointmentID=%d", organizationID, userID, id)
+
+	// 1. Validate input data
+	if err := dto.Validate(); err != nil {
+		log.Printf("[CompleteAppointmentUseCase] Validation failed: %v", err)
+		return nil, errors.ErrBadRequest(err.Error())
+	}
+
+	// 2. The appointment must belong to the organization
+	current, err := findAppointmentFor(ctx, uc.appointmentRepository, ClinicActor(userID, organizationID), id)
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. Move the appointment
+	return transition(ctx, uc.appointmentRepository, current, constants.AppointmentStatus.Completed, userID, dto.TrimmedReason())
+}
@@ -0,0 +1,50 @@
+package appointment
+
+import (
+	"citary-backend/internal/domain/dtos/appointment"
+	"citary-backend/internal/domain/entities"
+	"citary-backend/internal/domain/errors"
+	"citary-backend/internal/domain/repositories"
+	"citary-backend/pkg/constants"
+	"context"
+	"log"
+	"time"
+)
+
+// ConfirmAppointmentUseCase handles the business logic for a clinic confirming a requested appointment
+type ConfirmAppointmentUseCase struct {
+	appointmentRepository repositories.AppointmentRepository
+}
+
+// NewConfirmAppointmentUseCase creates a new instance of ConfirmAppointmentUseCase
+func NewConfirmAppointmentUseCase(appointmentRepository repositories.AppointmentRepository) *ConfirmAppointmentUseCase {
+	return &ConfirmAppointmentUseCase{
+		appointmentRepository: appointmentRepository,
+	}
+}
+
+// Execute confirms a requested appointment of the organization before it starts
+func (uc *ConfirmAppointmentUseCase) Execute(ctx context.Context, organizationID, userID, id int, dto appointment.ChangeStatusRequest) (*entities.Appointment, error) {
+	log.Printf("[ConfirmAppointmentUseCase] Execute: organizationID=%d, userID=%d, appointmentID=%d", organizationID, userID, id)
+
+	// 1. Validate input data
+	if err := dto.Validate(); err != nil {
+		log.Printf("[ConfirmAppointmentUseCase] Validation failed: %v", err)
+		return nil, errors.ErrBadRequest(err.Error())
+	}
+
+	// 2. The appointment must belong to the organization
+	current, err := findAppointmentFor(ctx, uc.appointmentRepository, ClinicActor(userID, organizationID), id)
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. Appointments can only be confirmed before they start
+	if !time.Now().Before(current.StartTime) {
+		log.Printf("[ConfirmAppointmentUseCase] Appointment already started: appointmentID=%d", id)
+		return nil, errors.ErrConflict(constants.ErrorMessages.AppointmentAlreadyStarted)
+	}
+
+	// 4. Move the appointment
+	return transition(ctx, uc.appointmentRepository, current, constants.AppointmentStatus.Confirmed, userID, dto.TrimmedReason())
+}
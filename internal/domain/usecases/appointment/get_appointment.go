@@ -0,0 +1,27 @@
+package appointment
+
+import (
+	"citary-backend/internal/domain/entities"
+	"citary-backend/internal/domain/repositories"
+	"context"
+	"log"
+)
+
+// GetAppointmentUseCase handles the business logic for reading one appointment
+type GetAppointmentUseCase struct {
+	appointmentRepository repositories.AppointmentRepository
+}
+
+// NewGetAppointmentUseCase creates a new instance of GetAppointmentUseCase
+func NewGetAppointmentUseCase(appointmentRepository repositories.AppointmentRepository) *GetAppointmentUseCase {
+	return &GetAppointmentUseCase{
+		appointmentRepository: appointmentRepository,
+	}
+}
+
+// Execute returns an appointment of the patient or of the clinic the actor belongs to
+func (uc *GetAppointmentUseCase) Execute(ctx context.Context, actor Actor, id int) (*entities.Appointment, error) {
+	log.Printf("[GetAppointmentUseCase] Execute: userID=%d, appointmentID=%d, clinic=%t", actor.UserID, id, actor.IsClinic())
+
+	return findAppointmentFor(ctx, uc.appointmentRepository, actor, id)
+}
@@ -0,0 +1,39 @@
+package appointment
+
+import (
+	"citary-backend/internal/domain/entities"
+	"citary-backend/internal/domain/repositories"
+	"context"
+	"log"
+)
+
+// GetAppointmentHistoryUseCase handles the business logic for reading the audit trail of an appointment
+type GetAppointmentHistoryUseCase struct {
+	appointmentRepository repositories.AppointmentRepository
+}
+
+// NewGetAppointmentHistoryUseCase creates a new instance of GetAppointmentHistoryUseCase
+func NewGetAppointmentHistoryUseCase(appointmentRepository repositories.AppointmentRepository) *GetAppointmentHistoryUseCase {
+	return &GetAppointmentHistoryUseCase{
+		appointmentRepository: appointmentRepository,
+	}
+}
+
+// Execute returns every recorded change of an appointment the actor may see, oldest first
+func (uc *GetAppointmentHistoryUseCase) Execute(ctx context.Context, actor Actor, id int) ([]*entities.AppointmentStatusChange, error) {
+	log.Printf("[GetAppointmentHistoryUseCase] Execute: userID=%d, appointmentID=%d, clinic=%t", actor.UserID, id, actor.IsClinic())
+
+	// 1. The actor must be the patient or belong to the clinic
+	if _, err := findAppointmentFor(ctx, uc.appointmentRepository, actor, id); err != nil {
+		return nil, err
+	}
+
+	// 2. Load the history
+	changes, err := uc.appointmentRepository.FindHistory(ctx, id)
+	if err != nil {
+		log.Printf("[GetAppointmentHistoryUseCase] Error loading history: appointmentID=%d, error=%v", id, err)
+		return nil, err
+	}
+
+	return changes, nil
+}
@@ -0,0 +1,52 @@
+package appointment
+
+import (
+	"citary-backend/internal/domain/entities"
+	"citary-backend/internal/domain/errors"
+	"citary-backend/internal/domain/repositories"
+	"citary-backend/pkg/constants"
+	"context"
+	"log"
+	"time"
+)
+
+// ListMyAppointmentsUseCase handles the business logic for listing the caller's upcoming appointments
+type ListMyAppointmentsUseCase struct {
+	appointmentRepository repositories.AppointmentRepository
+}
+
+// NewListMyAppointmentsUseCase creates a new instance of ListMyAppointmentsUseCase
+func NewListMyAppointmentsUseCase(appointmentRepository repositories.AppointmentRepository) *ListMyAppointmentsUseCase {
+	return &ListMyAppointmentsUseCase{
+		appointmentRepository: appointmentRepository,
+	}
+}
+
+// Execute returns the patient's appointments that have not ended yet, soonest first
+func (uc *ListMyAppointmentsUseCase) Execute(ctx context.Context, patientID int) ([]*entities.Appointment, error) {
+	log.Printf("[ListMyAppointmentsUseCase] Execute: patientID=%d", patientID)
+
+	appointments, err := uc.appointmentRepository.FindByPatient(ctx, patientID, time.Now())
+	if err != nil {
+		log.Printf("[ListMyAppointmentsUseCase] Error listing appointments: patientID=%d, error=%v", patientID, err)
+		return nil, err
+	}
+
+	return appointments, nil
+}
+
+// findAppointment loads an appointment, reporting a missing one as not found
+func findAppointment(ctx context.Context, appointmentRepository repositories.AppointmentRepository, id int) (*entities.Appointment, error) {
+	appointment, err := appointmentRepository.FindByID(ctx, id)
+	if err != nil {
+		log.Printf("[Appointment] Error finding appointment: appointmentID=%d, error=%v", id, err)
+		return nil, err
+	}
+
+	if appointment == nil {
+		log.Printf("[Appointment] Appointment not found: appointmentID=%d", id)
+		return nil, errors.ErrNotFound(constants.ErrorMessages.AppointmentNotFound)
+	}
+
+	return appointment, nil
+}
@@ -0,0 +1,43 @@
+package appointment
+
+import (
+	"citary-backend/internal/domain/dtos/appointment"
+	"citary-backend/internal/domain/entities"
+	"citary-backend/internal/domain/errors"
+	"citary-backend/internal/domain/repositories"
+	"context"
+	"log"
+)
+
+// ListOrganizationAppointmentsUseCase handles the business logic for listing a clinic's appointments
+type ListOrganizationAppointmentsUseCase struct {
+	appointmentRepository repositories.AppointmentRepository
+}
+
+// NewListOrganizationAppointmentsUseCase creates a new instance of ListOrganizationAppointmentsUseCase
+func NewListOrganizationAppointmentsUseCase(appointmentRepository repositories.AppointmentRepository) *ListOrganizationAppointmentsUseCase {
+	return &ListOrganizationAppointmentsUseCase{
+		appointmentRepository: appointmentRepository,
+	}
+}
+
+// Execute returns the organization's appointments starting within the query range, in every status
+func (uc *ListOrganizationAppointmentsUseCase) Execute(ctx context.Context, organizationID int, query appointment.ListAppointmentsQuery) ([]*entities.Appointment, error) {
+	log.Printf("[ListOrganizationAppointmentsUseCase] Execute: organizationID=%d, from=%s, to=%s", organizationID, query.From, query.To)
+
+	// 1. Validate input data
+	if err := query.Validate(); err != nil {
+		log.Printf("[ListOrganizationAppointmentsUseCase] Validation failed: %v", err)
+		return nil, errors.ErrBadRequest(err.Error())
+	}
+
+	// 2. Load the appointments
+	from, to := query.ParsedRange()
+	appointments, err := uc.appointmentRepository.FindByOrganization(ctx, organizationID, from, to)
+	if err != nil {
+		log.Printf("[ListOrganizationAppointmentsUseCase] Error listing appointments: organizationID=%d, error=%v", organizationID, err)
+		return nil, err
+	}
+
+	return appointments, nil
+}
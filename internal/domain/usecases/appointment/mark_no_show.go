@@ -0,0 +1,51 @@
+package appointment
+
+import (
+	"citary-backend/internal/domain/dtos/appointment"
+	"citary-backend/internal/domain/entities"
+	"citary-backend/internal/domain/errors"
+	"citary-backend/internal/domain/repositories"
+	"citary-backend/pkg/constants"
+	"context"
+	"log"
+	"time"
+)
+
+// MarkNoShowUseCase handles the business logic for recording that a patient missed a confirmed appointment
+type MarkNoShowUseCase struct {
+	appointmentRepository repositories.AppointmentRepository
+}
+
+// NewMarkNoShowUseCase creates a new instance of MarkNoShowUseCase
+func NewMarkNoShowUseCase(appointmentRepository repositories.AppointmentRepository) *MarkNoShowUseCase {
+	return &MarkNoShowUseCase{
+		appointmentRepository: appointmentRepository,
+	}
+}
+
+// Execute marks a confirmed appointment as a no-show
+// This is only possible once AppointmentConfig.NoShowGracePeriod has passed since the start
+func (uc *MarkNoShowUseCase) Execute(ctx context.Context, organizationID, userID, id int, dto appointment.ChangeStatusRequest) (*entities.Appointment, error) {
+	log.Printf("[MarkNoShowUseCase] Execute: organizationID=%d, userID=%d, appointmentID=%d", organizationID, userID, id)
+
+	// 1. Validate input data
+	if err := dto.Validate(); err != nil {
+		log.Printf("[MarkNoShowUseCase] Validation failed: %v", err)
+		return nil, errors.ErrBadRequest(err.Error())
+	}
+
+	// 2. The appointment must belong to the organization
+	current, err := findAppointmentFor(ctx, uc.appointmentRepository, ClinicActor(userID, organizationID), id)
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. The grace period after the start must have passed
+	if time.Now().Before(current.StartTime.Add(constants.AppointmentConfig.NoShowGracePeriod)) {
+		log.Printf("[MarkNoShowUseCase] Too early for a no-show: appointmentID=%d, startTime=%s", id, current.StartTime.Format(time.RFC3339))
+		return nil, errors.ErrConflict(constants.ErrorMessages.AppointmentNoShowTooEarly)
+	}
+
+	// 4. Move the appointment
+	return transition(ctx, uc.appointmentRepository, current, constants.AppointmentStatus.NoShow, userID, dto.TrimmedReason())
+}
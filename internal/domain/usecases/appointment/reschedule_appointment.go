@@ -0,0 +1,119 @@
+package appointment
+
+import (
+	"citary-backend/internal/domain/dtos/appointment"
+	"citary-backend/internal/domain/entities"
+	"citary-backend/internal/domain/errors"
+	"citary-backend/internal/domain/repositories"
+	"citary-backend/pkg/constants"
+	"context"
+	"log"
+	"time"
+)
+
+// RescheduleAppointmentUseCase handles the business logic for moving an appointment to another time
+type RescheduleAppointmentUseCase struct {
+	doctorRepository       repositories.DoctorRepository
+	availabilityRepository repositories.AvailabilityRepository
+	appointmentRepository  repositories.AppointmentRepository
+}
+
+// NewRescheduleAppointmentUseCase creates a new instance of RescheduleAppointmentUseCase
+func NewRescheduleAppointmentUseCase(
+	doctorRepository repositories.DoctorRepository,
+	availabilityRepository repositories.AvailabilityRepository,
+	appointmentRepository repositories.AppointmentRepository,
+) *RescheduleAppointmentUseCase {
+	return &RescheduleAppointmentUseCase{
+		doctorRepository:       doctorRepository,
+		availabilityRepository: availabilityRepository,
+		appointmentRepository:  appointmentRepository,
+	}
+}
+
+// Execute moves a requested or confirmed appointment to a new start time, keeping its duration, location and status
+// Patients are held to the cancellation notice for the current time; the new time follows the booking rules
+func (uc *RescheduleAppointmentUseCase) Execute(ctx context.Context, actor Actor, id int, dto appointment.RescheduleAppointmentRequest) (*entities.Appointment, error) {
+	log.Printf("[RescheduleAppointmentUseCase] Execute: userID=%d, appointmentID=%d, startTime=%s, clinic=%t", actor.UserID, id, dto.StartTime, actor.IsClinic())
+
+	// 1. Validate input data
+	if err := dto.Validate(); err != nil {
+		log.Printf("[RescheduleAppointmentUseCase] Validation failed: %v", err)
+		return nil, errors.ErrBadRequest(err.Error())
+	}
+
+	// 2. The actor must be the patient or belong to the clinic
+	current, err := findAppointmentFor(ctx, uc.appointmentRepository, actor, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !current.CanReschedule() {
+		log.Printf("[RescheduleAppointmentUseCase] Not reschedulable: appointmentID=%d, status=%s", id, current.Status)
+		return nil, errors.ErrConflict(constants.ErrorMessages.AppointmentNotReschedulable)
+	}
+
+	// 3. The current time must still be far enough away
+	now := time.Now()
+	if !now.Before(current.StartTime) {
+		log.Printf("[RescheduleAppointmentUseCase] Appointment already started: appointmentID=%d", id)
+		return nil, errors.ErrConflict(constants.ErrorMessages.AppointmentAlreadyStarted)
+	}
+
+	if !actor.IsClinic() && now.Add(constants.AppointmentConfig.MinCancellationNotice).After(current.StartTime) {
+		log.Printf("[RescheduleAppointmentUseCase] Reschedule too late: appointmentID=%d, startTime=%s", id, current.StartTime.Format(time.RFC3339))
+		return nil, errors.ErrConflict(constants.ErrorMessages.AppointmentCancellationTooLate)
+	}
+
+	// 4. The new time must be bookable at the same location
+	startTime := dto.ParsedStartTime()
+	endTime := startTime.Add(current.Duration())
+
+	if err := checkBookingWindow(startTime, now); err != nil {
+		log.Printf("[RescheduleAppointmentUseCase] Start outside the booking window: appointmentID=%d, startTime=%s", id, startTime.Format(time.RFC3339))
+		return nil, err
+	}
+
+	windows, err := uc.availabilityRepository.FindEffectiveAvailability(ctx, current.DoctorID, startTime, endTime)
+	if err != nil {
+		log.Printf("[RescheduleAppointmentUseCase] Error resolving availability: doctorID=%d, error=%v", current.DoctorID, err)
+		return nil, err
+	}
+
+	window := findCoveringWindow(windows, startTime, endTime)
+	if window == nil || window.LocationID != current.LocationID {
+		log.Printf("[RescheduleAppointmentUseCase] Outside availability: appointmentID=%d, startTime=%s", id, startTime.Format(time.RFC3339))
+		return nil, errors.ErrBadRequest(constants.ErrorMessages.AppointmentOutsideAvailability)
+	}
+
+	// 5. The new time must keep the doctor's buffer from other bookings
+	doctor, err := uc.doctorRepository.FindByID(ctx, current.OrganizationID, current.DoctorID)
+	if err != nil {
+		log.Printf("[RescheduleAppointmentUseCase] Error finding doctor: doctorID=%d, error=%v", current.DoctorID, err)
+		return nil, err
+	}
+
+	if doctor == nil {
+		log.Printf("[RescheduleAppointmentUseCase] Doctor not found: doctorID=%d", current.DoctorID)
+		return nil, errors.ErrNotFound(constants.ErrorMessages.DoctorNotFound)
+	}
+
+	if err := checkSlotFree(ctx, uc.availabilityRepository, doctor, current.ID, startTime, endTime); err != nil {
+		return nil, err
+	}
+
+	// 6. Move the appointment (the repository rejects times that reach another booking or its buffer)
+	status := current.Status
+	change := &entities.AppointmentStatusChange{
+		AppointmentID: current.ID,
+		FromStatus:    &status,
+		ToStatus:      status,
+		StartTime:     startTime,
+		EndTime:       endTime,
+		ChangedBy:     actor.UserID,
+		Reason:        dto.TrimmedReason(),
+		CreatedDate:   now,
+	}
+
+	return applyChange(ctx, uc.appointmentRepository, change)
+}
@@ -0,0 +1,136 @@
+package appointment
+
+import (
+	"citary-backend/internal/domain/entities"
+	"citary-backend/internal/domain/repositories"
+	"citary-backend/internal/domain/services"
+	"citary-backend/pkg/constants"
+	"context"
+	"log"
+	"sort"
+	"time"
+)
+
+// SendAppointmentRemindersUseCase emails patients ahead of their upcoming appointments
+//
+// Each reminder is claimed in the database before its email is sent, so a restart or another
+// replica running the same job never sends it twice; a failed email releases the claim for the next run.
+type SendAppointmentRemindersUseCase struct {
+	appointmentRepository repositories.AppointmentRepository
+	reminderRepository    repositories.AppointmentReminderRepository
+	userRepository        repositories.UserRepository
+	emailService          services.EmailService
+	offsets               []time.Duration
+}
+
+// NewSendAppointmentRemindersUseCase creates a new instance of SendAppointmentRemindersUseCase
+// offsets are how long before an appointment each reminder is sent
+func NewSendAppointmentRemindersUseCase(
+	appointmentRepository repositories.AppointmentRepository,
+	reminderRepository repositories.AppointmentReminderRepository,
+	userRepository repositories.UserRepository,
+	emailService services.EmailService,
+	offsets []time.Duration,
+) *SendAppointmentRemindersUseCase {
+	sorted := append([]time.Duration(nil), offsets...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] > sorted[j] })
+
+	return &SendAppointmentRemindersUseCase{
+		appointmentRepository: appointmentRepository,
+		reminderRepository:    reminderRepository,
+		userRepository:        userRepository,
+		emailService:          emailService,
+		offsets:               sorted,
+	}
+}
+
+// Execute sends one batch of due reminders per offset
+// A reminder is due once its offset is reached and only until the next shorter offset is, so after
+// downtime a patient gets the most relevant reminder rather than every one that was missed
+// A failure on one appointment is logged and does not stop the rest of the batch
+func (uc *SendAppointmentRemindersUseCase) Execute(ctx context.Context) error {
+	now := time.Now()
+
+	for i, offset := range uc.offsets {
+		// 1. Find the appointments whose reminder for this offset is due
+		from := now
+		if i+1 < len(uc.offsets) {
+			from = now.Add(uc.offsets[i+1])
+		}
+
+		offsetMinutes := int(offset / time.Minute)
+		appointments, err := uc.appointmentRepository.FindDueForReminder(ctx, offsetMinutes, from, now.Add(offset), constants.AppointmentConfig.ReminderBatchSize)
+		if err != nil {
+			log.Printf("[SendAppointmentRemindersUseCase] Error finding due reminders: offset=%v, error=%v", offset, err)
+			return err
+		}
+
+		if len(appointments) == 0 {
+			continue
+		}
+
+		// 2. Send each reminder
+		sent := 0
+		for _, appt := range appointments {
+			ok, err := uc.remind(ctx, appt, offsetMinutes, now)
+			if err != nil {
+				log.Printf("[SendAppointmentRemindersUseCase] Error sending reminder: appointmentID=%d, offset=%v, error=%v", appt.ID, offset, err)
+				continue
+			}
+			if ok {
+				sent++
+			}
+		}
+
+		log.Printf("[SendAppointmentRemindersUseCase] Batch finished: offset=%v, due=%d, sent=%d", offset, len(appointments), sent)
+	}
+
+	return nil
+}
+
+// remind claims and sends the reminder for one appointment
+// Returns false if the reminder was already claimed or the patient can no longer be emailed
+func (uc *SendAppointmentRemindersUseCase) remind(ctx context.Context, appt *entities.Appointment, offsetMinutes int, now time.Time) (bool, error) {
+	reminder := &entities.AppointmentReminder{
+		AppointmentID: appt.ID,
+		OffsetMinutes: offsetMinutes,
+		StartTime:     appt.StartTime,
+		SentDate:      now,
+	}
+
+	claimed, err := uc.reminderRepository.Claim(ctx, reminder)
+	if err != nil {
+		return false, err
+	}
+
+	if !claimed {
+		return false, nil
+	}
+
+	// Inactive and deleted accounts keep their claim so they are not picked up again
+	patient, err := uc.userRepository.FindByID(ctx, appt.PatientID)
+	if err != nil {
+		uc.release(ctx, reminder)
+		return false, err
+	}
+
+	if patient == nil || !patient.IsActive() {
+		log.Printf("[SendAppointmentRemindersUseCase] Patient cannot be reminded: appointmentID=%d, patientID=%d", appt.ID, appt.PatientID)
+		return false, nil
+	}
+
+	if err := uc.emailService.SendAppointmentReminder(ctx, patient.Email, appt); err != nil {
+		uc.release(ctx, reminder)
+		return false, err
+	}
+
+	return true, nil
+}
+
+// release gives up a claimed reminder so a later run retries it
+// It runs even if the job is being cancelled, otherwise the reminder would never be sent
+func (uc *SendAppointmentRemindersUseCase) release(ctx context.Context, reminder *entities.AppointmentReminder) {
+	if err := uc.reminderRepository.Release(context.WithoutCancel(ctx), reminder.ID); err != nil {
+		log.Printf("[SendAppointmentRemindersUseCase] Error releasing reminder: reminderID=%d, error=%v", reminder.ID, err)
+	}
+}
@@ -0,0 +1,103 @@
+package appointment
+
+import (
+	"citary-backend/internal/domain/entities"
+	"citary-backend/internal/domain/errors"
+	"citary-backend/internal/domain/repositories"
+	"citary-backend/pkg/constants"
+	"context"
+	"log"
+	"time"
+)
+
+// Actor identifies who acts on an appointment
+// A patient acts on their own appointments; clinic staff act on the appointments of the organization they are scoped to
+type Actor struct {
+	UserID         int
+	OrganizationID *int
+}
+
+// PatientActor returns the actor for a user acting on their own appointments
+func PatientActor(userID int) Actor {
+	return Actor{UserID: userID}
+}
+
+// ClinicActor returns the actor for a staff member acting on behalf of an organization
+func ClinicActor(userID, organizationID int) Actor {
+	return Actor{UserID: userID, OrganizationID: &organizationID}
+}
+
+// IsClinic reports whether the actor acts on behalf of an organization
+func (a Actor) IsClinic() bool {
+	return a.OrganizationID != nil
+}
+
+// findAppointmentFor loads an appointment the actor may act on
+// Appointments of other patients or other organizations are reported as not found
+func findAppointmentFor(ctx context.Context, appointmentRepository repositories.AppointmentRepository, actor Actor, id int) (*entities.Appointment, error) {
+	appointment, err := findAppointment(ctx, appointmentRepository, id)
+	if err != nil {
+		return nil, err
+	}
+
+	visible := appointment.IsActive() && appointment.PatientID == actor.UserID
+	if actor.IsClinic() {
+		visible = appointment.IsActive() && appointment.OrganizationID == *actor.OrganizationID
+	}
+
+	if !visible {
+		log.Printf("[Appointment] Appointment not visible to actor: appointmentID=%d, userID=%d", id, actor.UserID)
+		return nil, errors.ErrNotFound(constants.ErrorMessages.AppointmentNotFound)
+	}
+
+	return appointment, nil
+}
+
+// transition moves an appointment to a new status if the state machine allows it and records who did it
+// Returns a conflict error if the transition is not allowed or someone else changed the appointment first
+func transition(
+	ctx context.Context,
+	appointmentRepository repositories.AppointmentRepository,
+	appointment *entities.Appointment,
+	toStatus string,
+	changedBy int,
+	reason *string,
+) (*entities.Appointment, error) {
+	if !appointment.CanTransitionTo(toStatus) {
+		log.Printf("[Appointment] Transition not allowed: appointmentID=%d, from=%s, to=%s", appointment.ID, appointment.Status, toStatus)
+		return nil, errors.ErrConflict(constants.ErrorMessages.AppointmentTransitionNotAllowed)
+	}
+
+	fromStatus := appointment.Status
+	change := &entities.AppointmentStatusChange{
+		AppointmentID: appointment.ID,
+		FromStatus:    &fromStatus,
+		ToStatus:      toStatus,
+		StartTime:     appointment.StartTime,
+		EndTime:       appointment.EndTime,
+		ChangedBy:     changedBy,
+		Reason:        reason,
+		CreatedDate:   time.Now(),
+	}
+
+	return applyChange(ctx, appointmentRepository, change)
+}
+
+// applyChange persists an appointment change and reloads the appointment
+// A change that no longer applies because the status moved meanwhile is reported as a conflict
+func applyChange(ctx context.Context, appointmentRepository repositories.AppointmentRepository, change *entities.AppointmentStatusChange) (*entities.Appointment, error) {
+	applied, err := appointmentRepository.ApplyChange(ctx, change)
+	if err != nil {
+		log.Printf("[Appointment] Error applying change: appointmentID=%d, error=%v", change.AppointmentID, err)
+		return nil, err
+	}
+
+	if !applied {
+		log.Printf("[Appointment] Appointment changed concurrently: appointmentID=%d", change.AppointmentID)
+		return nil, errors.ErrConflict(constants.ErrorMessages.AppointmentModified)
+	}
+
+	log.Printf("[Appointment] Change applied: appointmentID=%d, to=%s, changedBy=%d", change.AppointmentID, change.ToStatus, change.ChangedBy)
+
+	return findAppointment(ctx, appointmentRepository, change.AppointmentID)
+}
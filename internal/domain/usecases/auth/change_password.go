@@ -0,0 +1,79 @@
+package auth
+
+import (
+	"citary-backend/internal/domain/dtos/auth"
+	"citary-backend/internal/domain/errors"
+	"citary-backend/internal/domain/repositories"
+	"citary-backend/pkg/constants"
+	"context"
+	"log"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// ChangePasswordUseCase handles the business logic for an authenticated password change
+type ChangePasswordUseCase struct {
+	userRepository         repositories.UserRepository
+	refreshTokenRepository repositories.RefreshTokenRepository
+}
+
+// NewChangePasswordUseCase creates a new instance of ChangePasswordUseCase
+func NewChangePasswordUseCase(
+	userRepository repositories.UserRepository,
+	refreshTokenRepository repositories.RefreshTokenRepository,
+) *ChangePasswordUseCase {
+	return &ChangePasswordUseCase{
+		userRepository:         userRepository,
+		refreshTokenRepository: refreshTokenRepository,
+	}
+}
+
+// Execute verifies the current password, stores the new one and signs the user out everywhere
+func (uc *ChangePasswordUseCase) Execute(ctx context.Context, userID int, dto auth.ChangePasswordRequest) error {
+	log.Printf("[ChangePasswordUseCase] Execute: userID=%d", userID)
+
+	// 1. Validate input data (including the signup password policy)
+	if err := dto.Validate(); err != nil {
+		log.Printf("[ChangePasswordUseCase] Validation failed: %v", err)
+		return errors.ErrBadRequest(err.Error())
+	}
+
+	// 2. Find the user
+	user, err := uc.userRepository.FindByID(ctx, userID)
+	if err != nil {
+		log.Printf("[ChangePasswordUseCase] Error finding user: %v", err)
+		return err
+	}
+
+	if user == nil || !user.IsActive() {
+		log.Printf("[ChangePasswordUseCase] User not found or inactive: userID=%d", userID)
+		return errors.ErrNotFound(constants.ErrorMessages.UserNotFound)
+	}
+
+	// 3. Check the current password
+	if err := bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(dto.CurrentPassword)); err != nil {
+		log.Printf("[ChangePasswordUseCase] Incorrect current password: userID=%d", userID)
+		return errors.ErrUnauthorized(constants.ErrorMessages.PasswordIncorrect)
+	}
+
+	// 4. Store the new password
+	hashedPassword, err := hashPassword(dto.NewPassword)
+	if err != nil {
+		log.Printf("[ChangePasswordUseCase] Error hashing password: %v", err)
+		return errors.ErrInternal(err)
+	}
+
+	if err := uc.userRepository.UpdatePassword(ctx, userID, hashedPassword); err != nil {
+		log.Printf("[ChangePasswordUseCase] Error updating password: userID=%d, error=%v", userID, err)
+		return err
+	}
+
+	// 5. Invalidate every existing session
+	if err := uc.refreshTokenRepository.RevokeAllForUser(ctx, userID); err != nil {
+		log.Printf("[ChangePasswordUseCase] Error revoking sessions: userID=%d, error=%v", userID, err)
+		return err
+	}
+
+	log.Printf("[ChangePasswordUseCase] Password changed successfully: userID=%d", userID)
+	return nil
+}
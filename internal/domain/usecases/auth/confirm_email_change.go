@@ -0,0 +1,105 @@
+package auth
+
+import (
+	"citary-backend/internal/domain/dtos/auth"
+	"citary-backend/internal/domain/entities"
+	"citary-backend/internal/domain/errors"
+	"citary-backend/internal/domain/repositories"
+	"citary-backend/internal/domain/services"
+	"citary-backend/pkg/constants"
+	"context"
+	"log"
+)
+
+// ConfirmEmailChangeUseCase handles the business logic for completing an email address change
+type ConfirmEmailChangeUseCase struct {
+	userRepository      repositories.UserRepository
+	userTokenRepository repositories.UserTokenRepository
+	tokenService        services.TokenService
+	emailService        services.EmailService
+}
+
+// NewConfirmEmailChangeUseCase creates a new instance of ConfirmEmailChangeUseCase
+func NewConfirmEmailChangeUseCase(
+	userRepository repositories.UserRepository,
+	userTokenRepository repositories.UserTokenRepository,
+	tokenService services.TokenService,
+	emailService services.EmailService,
+) *ConfirmEmailChangeUseCase {
+	return &ConfirmEmailChangeUseCase{
+		userRepository:      userRepository,
+		userTokenRepository: userTokenRepository,
+		tokenService:        tokenService,
+		emailService:        emailService,
+	}
+}
+
+// Execute consumes an email change token, swaps the address and notifies the previous one
+func (uc *ConfirmEmailChangeUseCase) Execute(ctx context.Context, dto auth.ConfirmEmailChangeRequest) (*entities.User, error) {
+	log.Printf("[ConfirmEmailChangeUseCase] Execute")
+
+	// 1. Validate input data
+	if err := dto.Validate(); err != nil {
+		log.Printf("[ConfirmEmailChangeUseCase] Validation failed: %v", err)
+		return nil, errors.ErrBadRequest(err.Error())
+	}
+
+	// 2. Find the token
+	changeToken, err := uc.userTokenRepository.FindByTokenHash(ctx, constants.UserTokenPurpose.EmailChange, uc.tokenService.HashToken(dto.Token))
+	if err != nil {
+		log.Printf("[ConfirmEmailChangeUseCase] Error finding token: %v", err)
+		return nil, err
+	}
+
+	if changeToken == nil || changeToken.IsUsed() || changeToken.Payload == nil {
+		log.Printf("[ConfirmEmailChangeUseCase] Token not found or already used")
+		return nil, errors.ErrBadRequest(constants.ErrorMessages.EmailChangeTokenInvalid)
+	}
+
+	if changeToken.IsExpired() {
+		log.Printf("[ConfirmEmailChangeUseCase] Token expired: tokenID=%d", changeToken.ID)
+		return nil, errors.ErrGone(constants.ErrorMessages.EmailChangeTokenExpired)
+	}
+
+	// 3. Business validation: the owner must still be active
+	user, err := uc.userRepository.FindByID(ctx, changeToken.UserID)
+	if err != nil {
+		log.Printf("[ConfirmEmailChangeUseCase] Error finding user: userID=%d, error=%v", changeToken.UserID, err)
+		return nil, err
+	}
+
+	if user == nil || !user.IsActive() {
+		log.Printf("[ConfirmEmailChangeUseCase] User not found or inactive: userID=%d", changeToken.UserID)
+		return nil, errors.ErrBadRequest(constants.ErrorMessages.EmailChangeTokenInvalid)
+	}
+
+	// 4. Consume the token (guards against concurrent use of the same link)
+	consumed, err := uc.userTokenRepository.MarkUsed(ctx, changeToken.ID)
+	if err != nil {
+		log.Printf("[ConfirmEmailChangeUseCase] Error consuming token: tokenID=%d, error=%v", changeToken.ID, err)
+		return nil, err
+	}
+
+	if !consumed {
+		log.Printf("[ConfirmEmailChangeUseCase] Token consumed concurrently: tokenID=%d", changeToken.ID)
+		return nil, errors.ErrBadRequest(constants.ErrorMessages.EmailChangeTokenInvalid)
+	}
+
+	// 5. Swap the address (a conflict here means the address was taken after the request)
+	oldEmail := user.Email
+	newEmail := *changeToken.Payload
+
+	if err := uc.userRepository.UpdateEmail(ctx, user.ID, newEmail); err != nil {
+		log.Printf("[ConfirmEmailChangeUseCase] Error updating email: userID=%d, error=%v", user.ID, err)
+		return nil, err
+	}
+	user.Email = newEmail
+
+	// 6. Notify the previous address (failures are logged, the change already happened)
+	if err := uc.emailService.SendEmailChangedNotice(ctx, oldEmail, newEmail); err != nil {
+		log.Printf("[ConfirmEmailChangeUseCase] WARNING: Failed to notify previous address %s: %v", oldEmail, err)
+	}
+
+	log.Printf("[ConfirmEmailChangeUseCase] Email changed: userID=%d", user.ID)
+	return user, nil
+}
@@ -0,0 +1,145 @@
+package auth
+
+import (
+	"citary-backend/internal/domain/dtos/auth"
+	"citary-backend/internal/domain/errors"
+	"citary-backend/internal/domain/repositories"
+	"citary-backend/internal/domain/services"
+	"citary-backend/pkg/constants"
+	"citary-backend/pkg/totp"
+	"context"
+	"crypto/rand"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+)
+
+// recoveryCodeAlphabet avoids characters that are easily confused when copied by hand (0/O, 1/I/L)
+const recoveryCodeAlphabet = "ABCDEFGHJKMNPQRSTUVWXYZ23456789"
+
+// ConfirmTwoFactorUseCase handles the business logic for completing a two-factor enrollment
+type ConfirmTwoFactorUseCase struct {
+	userRepository         repositories.UserRepository
+	recoveryCodeRepository repositories.RecoveryCodeRepository
+	tokenService           services.TokenService
+}
+
+// NewConfirmTwoFactorUseCase creates a new instance of ConfirmTwoFactorUseCase
+func NewConfirmTwoFactorUseCase(
+	userRepository repositories.UserRepository,
+	recoveryCodeRepository repositories.RecoveryCodeRepository,
+	tokenService services.TokenService,
+) *ConfirmTwoFactorUseCase {
+	return &ConfirmTwoFactorUseCase{
+		userRepository:         userRepository,
+		recoveryCodeRepository: recoveryCodeRepository,
+		tokenService:           tokenService,
+	}
+}
+
+// Execute checks the first authenticator code against the pending secret, enables two-factor
+// authentication and returns the one-time recovery codes (the only time they are shown in plain text)
+func (uc *ConfirmTwoFactorUseCase) Execute(ctx context.Context, userID int, dto auth.ConfirmTwoFactorRequest) ([]string, error) {
+	log.Printf("[ConfirmTwoFactorUseCase] Execute: userID=%d", userID)
+
+	// 1. Validate input data
+	if err := dto.Validate(); err != nil {
+		log.Printf("[ConfirmTwoFactorUseCase] Validation failed: %v", err)
+		return nil, errors.ErrBadRequest(err.Error())
+	}
+
+	// 2. Find the user
+	user, err := uc.userRepository.FindByID(ctx, userID)
+	if err != nil {
+		log.Printf("[ConfirmTwoFactorUseCase] Error finding user: %v", err)
+		return nil, err
+	}
+
+	if user == nil {
+		log.Printf("[ConfirmTwoFactorUseCase] User not found: userID=%d", userID)
+		return nil, errors.ErrNotFound(constants.ErrorMessages.UserNotFound)
+	}
+
+	// 3. Business validation: there must be a pending enrollment
+	if user.TwoFactorEnabled {
+		log.Printf("[ConfirmTwoFactorUseCase] Two-factor already enabled: userID=%d", userID)
+		return nil, errors.ErrConflict(constants.ErrorMessages.TwoFactorAlreadyEnabled)
+	}
+
+	if user.TwoFactorSecret == nil {
+		log.Printf("[ConfirmTwoFactorUseCase] No pending secret: userID=%d", userID)
+		return nil, errors.ErrBadRequest(constants.ErrorMessages.TwoFactorSetupRequired)
+	}
+
+	// 4. Check the code
+	if !totp.Validate(*user.TwoFactorSecret, dto.Code, time.Now(), constants.TwoFactorConfig.ValidationSkew) {
+		log.Printf("[ConfirmTwoFactorUseCase] Invalid code: userID=%d", userID)
+		return nil, errors.ErrBadRequest(constants.ErrorMessages.TwoFactorCodeInvalid)
+	}
+
+	// 5. Generate and store the recovery codes
+	codes, hashes, err := uc.generateRecoveryCodes()
+	if err != nil {
+		log.Printf("[ConfirmTwoFactorUseCase] Error generating recovery codes: %v", err)
+		return nil, errors.ErrInternal(err)
+	}
+
+	if err := uc.recoveryCodeRepository.ReplaceForUser(ctx, userID, hashes); err != nil {
+		log.Printf("[ConfirmTwoFactorUseCase] Error storing recovery codes: userID=%d, error=%v", userID, err)
+		return nil, err
+	}
+
+	// 6. Enable two-factor authentication
+	if err := uc.userRepository.UpdateTwoFactor(ctx, userID, true, user.TwoFactorSecret); err != nil {
+		log.Printf("[ConfirmTwoFactorUseCase] Error enabling two-factor: userID=%d, error=%v", userID, err)
+		return nil, err
+	}
+
+	log.Printf("[ConfirmTwoFactorUseCase] Two-factor enabled: userID=%d", userID)
+
+	return codes, nil
+}
+
+// generateRecoveryCodes returns the plain codes shown to the user and the hashes that are stored
+func (uc *ConfirmTwoFactorUseCase) generateRecoveryCodes() ([]string, []string, error) {
+	count := constants.TwoFactorConfig.RecoveryCodeCount
+	codes := make([]string, 0, count)
+	hashes := make([]string, 0, count)
+
+	for i := 0; i < count; i++ {
+		code, err := generateRecoveryCode()
+		if err != nil {
+			return nil, nil, err
+		}
+		codes = append(codes, code)
+		hashes = append(hashes, uc.tokenService.HashToken(normalizeRecoveryCode(code)))
+	}
+
+	return codes, hashes, nil
+}
+
+// generateRecoveryCode creates a random code formatted as XXXXX-XXXXX
+func generateRecoveryCode() (string, error) {
+	randomBytes := make([]byte, 10)
+	if _, err := rand.Read(randomBytes); err != nil {
+		return "", fmt.Errorf("failed to generate recovery code: %w", err)
+	}
+
+	var code strings.Builder
+	for i, b := range randomBytes {
+		if i == 5 {
+			code.WriteByte('-')
+		}
+		code.WriteByte(recoveryCodeAlphabet[int(b)%len(recoveryCodeAlphabet)])
+	}
+
+	return code.String(), nil
+}
+
+// normalizeRecoveryCode makes recovery code matching insensitive to case, spaces and dashes
+func normalizeRecoveryCode(code string) string {
+	code = strings.ToUpper(code)
+	code = strings.ReplaceAll(code, "-", "")
+	return strings.ReplaceAll(code, " ", "")
+}
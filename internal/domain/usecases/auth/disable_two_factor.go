@@ -0,0 +1,80 @@
+package auth
+
+import (
+	"citary-backend/internal/domain/dtos/auth"
+	"citary-backend/internal/domain/errors"
+	"citary-backend/internal/domain/repositories"
+	"citary-backend/pkg/constants"
+	"context"
+	"log"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// DisableTwoFactorUseCase handles the business logic for turning two-factor authentication off
+type DisableTwoFactorUseCase struct {
+	userRepository         repositories.UserRepository
+	recoveryCodeRepository repositories.RecoveryCodeRepository
+}
+
+// NewDisableTwoFactorUseCase creates a new instance of DisableTwoFactorUseCase
+func NewDisableTwoFactorUseCase(
+	userRepository repositories.UserRepository,
+	recoveryCodeRepository repositories.RecoveryCodeRepository,
+) *DisableTwoFactorUseCase {
+	return &DisableTwoFactorUseCase{
+		userRepository:         userRepository,
+		recoveryCodeRepository: recoveryCodeRepository,
+	}
+}
+
+// Execute disables two-factor authentication after re-checking the current password
+// The secret and every recovery code are discarded
+func (uc *DisableTwoFactorUseCase) Execute(ctx context.Context, userID int, dto auth.DisableTwoFactorRequest) error {
+	log.Printf("[DisableTwoFactorUseCase] Execute: userID=%d", userID)
+
+	// 1. Validate input data
+	if err := dto.Validate(); err != nil {
+		log.Printf("[DisableTwoFactorUseCase] Validation failed: %v", err)
+		return errors.ErrBadRequest(err.Error())
+	}
+
+	// 2. Find the user
+	user, err := uc.userRepository.FindByID(ctx, userID)
+	if err != nil {
+		log.Printf("[DisableTwoFactorUseCase] Error finding user: %v", err)
+		return err
+	}
+
+	if user == nil {
+		log.Printf("[DisableTwoFactorUseCase] User not found: userID=%d", userID)
+		return errors.ErrNotFound(constants.ErrorMessages.UserNotFound)
+	}
+
+	// 3. Business validation: two-factor must be enabled
+	if !user.TwoFactorEnabled {
+		log.Printf("[DisableTwoFactorUseCase] Two-factor not enabled: userID=%d", userID)
+		return errors.ErrConflict(constants.ErrorMessages.TwoFactorNotEnabled)
+	}
+
+	// 4. Check the current password
+	if err := bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(dto.Password)); err != nil {
+		log.Printf("[DisableTwoFactorUseCase] Incorrect password: userID=%d", userID)
+		return errors.ErrUnauthorized(constants.ErrorMessages.PasswordIncorrect)
+	}
+
+	// 5. Disable two-factor and discard the recovery codes
+	if err := uc.userRepository.UpdateTwoFactor(ctx, userID, false, nil); err != nil {
+		log.Printf("[DisableTwoFactorUseCase] Error disabling two-factor: userID=%d, error=%v", userID, err)
+		return err
+	}
+
+	if err := uc.recoveryCodeRepository.DeleteForUser(ctx, userID); err != nil {
+		log.Printf("[DisableTwoFactorUseCase] Error deleting recovery codes: userID=%d, error=%v", userID, err)
+		return err
+	}
+
+	log.Printf("[DisableTwoFactorUseCase] Two-factor disabled: userID=%d", userID)
+
+	return nil
+}
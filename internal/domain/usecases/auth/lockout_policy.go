@@ -0,0 +1,50 @@
+package auth
+
+import "time"
+
+// LockoutPolicy configures brute-force protection for credential checks
+//
+// Once LoginAttempts reaches MaxAttempts the account is locked for LockDuration,
+// doubling with every further failure up to MaxLockDuration.
+type LockoutPolicy struct {
+	MaxAttempts     int
+	LockDuration    time.Duration
+	MaxLockDuration time.Duration
+}
+
+// LockFor returns how long the account is locked after its attempts-th consecutive failure
+// Zero means the failure does not lock the account
+func (p LockoutPolicy) LockFor(attempts int) time.Duration {
+	if attempts < p.MaxAttempts || p.LockDuration <= 0 {
+		return 0
+	}
+
+	// Doubling stops at the cap, so long failure streaks cannot overflow the duration
+	lock := p.LockDuration
+	for extra := attempts - p.MaxAttempts; extra > 0 && lock < p.MaxLockDuration; extra-- {
+		lock *= 2
+	}
+
+	if lock > p.MaxLockDuration {
+		return p.MaxLockDuration
+	}
+	return lock
+}
+
+// Schedule lists the lock after each consecutive failure: entry i is LockFor(i+1)
+// It ends once the lock stops growing, so the last entry applies to every later failure
+// The repository applies it in the same statement that counts the failure; nil means failures never lock
+func (p LockoutPolicy) Schedule() []time.Duration {
+	if p.LockFor(p.MaxAttempts) <= 0 {
+		return nil
+	}
+
+	schedule := []time.Duration{}
+	for attempts := 1; ; attempts++ {
+		lock := p.LockFor(attempts)
+		schedule = append(schedule, lock)
+		if attempts >= p.MaxAttempts && p.LockFor(attempts+1) == lock {
+			return schedule
+		}
+	}
+}
@@ -0,0 +1,187 @@
+package auth
+
+import (
+	"citary-backend/internal/domain/dtos/auth"
+	"citary-backend/internal/domain/entities"
+	"citary-backend/internal/domain/errors"
+	"citary-backend/internal/domain/repositories"
+	"citary-backend/internal/domain/services"
+	"citary-backend/pkg/constants"
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// timingEqualizerHash is compared against when the email is unknown so both paths cost one bcrypt check
+const timingEqualizerHash = "$2a$10$UwaDeIoLWKS9RpsMnzeTEe0gaOPVMw8jz1hUvXonpx8eExSRXjRMK"
+
+// LoginResult represents the outcome of a successful login
+// When MFARequired is set no session is issued yet; MFAChallenge must be exchanged with a second factor
+type LoginResult struct {
+	User           *entities.User
+	RoleCode       string
+	OrganizationID *int
+	Session        *Session
+	MFARequired    bool
+	MFAChallenge   *services.IssuedToken
+}
+
+// LoginUseCase handles the business logic for password authentication
+type LoginUseCase struct {
+	userRepository repositories.UserRepository
+	tokenService   services.TokenService
+	sessionIssuer  *SessionIssuer
+	lockoutPolicy  LockoutPolicy
+}
+
+// NewLoginUseCase creates a new instance of LoginUseCase
+func NewLoginUseCase(
+	userRepository repositories.UserRepository,
+	tokenService services.TokenService,
+	sessionIssuer *SessionIssuer,
+	lockoutPolicy LockoutPolicy,
+) *LoginUseCase {
+	return &LoginUseCase{
+		userRepository: userRepository,
+		tokenService:   tokenService,
+		sessionIssuer:  sessionIssuer,
+		lockoutPolicy:  lockoutPolicy,
+	}
+}
+
+// Execute authenticates a user by email and password and starts a new session,
+// or issues an MFA challenge when the user has two-factor authentication enabled
+func (uc *LoginUseCase) Execute(ctx context.Context, dto auth.LoginRequest) (*LoginResult, error) {
+	log.Printf("[LoginUseCase] Execute: email=%s", dto.Email)
+
+	// 1. Validate input data
+	if err := dto.Validate(); err != nil {
+		log.Printf("[LoginUseCase] Validation failed: %v", err)
+		return nil, errors.ErrBadRequest(err.Error())
+	}
+
+	// 2. Find the user
+	user, err := uc.userRepository.FindByEmail(ctx, dto.Email)
+	if err != nil {
+		log.Printf("[LoginUseCase] Error finding user: %v", err)
+		return nil, err
+	}
+
+	// 3. Check the password (unknown emails still pay for a bcrypt comparison)
+	if user == nil {
+		bcrypt.CompareHashAndPassword([]byte(timingEqualizerHash), []byte(dto.Password))
+		log.Printf("[LoginUseCase] User not found: email=%s", dto.Email)
+		return nil, errors.ErrUnauthorized(constants.ErrorMessages.InvalidCredentials)
+	}
+
+	// Business validation: locked accounts are rejected without checking their password
+	if user.IsLocked() {
+		log.Printf("[LoginUseCase] User is locked: userID=%d, lockedUntil=%v", user.ID, *user.LockedUntil)
+		return nil, errors.ErrLocked(constants.ErrorMessages.AccountLocked)
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(dto.Password)); err != nil {
+		log.Printf("[LoginUseCase] Invalid password: userID=%d", user.ID)
+		return nil, registerFailedLogin(ctx, uc.userRepository, uc.lockoutPolicy, user, constants.ErrorMessages.InvalidCredentials)
+	}
+
+	// 4. Business validation: account must be active and verified
+	if !user.IsActive() {
+		log.Printf("[LoginUseCase] User is inactive: userID=%d, status=%s", user.ID, user.RecordStatus)
+		return nil, errors.ErrForbidden(constants.ErrorMessages.AccountInactive)
+	}
+
+	if !user.EmailVerified {
+		log.Printf("[LoginUseCase] Email not verified: userID=%d", user.ID)
+		return nil, errors.ErrForbidden(constants.ErrorMessages.EmailNotVerified)
+	}
+
+	// 5. Two-factor users must complete a second step before getting a session
+	if user.TwoFactorEnabled {
+		challenge, err := uc.tokenService.GenerateMFAChallengeToken(user.ID)
+		if err != nil {
+			log.Printf("[LoginUseCase] Error generating MFA challenge: userID=%d, error=%v", user.ID, err)
+			return nil, err
+		}
+
+		log.Printf("[LoginUseCase] Two-factor challenge issued: userID=%d", user.ID)
+		return &LoginResult{User: user, MFARequired: true, MFAChallenge: challenge}, nil
+	}
+
+	// 6. Issue the session and record the login
+	return completeLogin(ctx, uc.userRepository, uc.sessionIssuer, user, dto.ClientInfo)
+}
+
+// completeLogin resolves the session scope, starts a session and records the successful login
+// It is the final step of every login path (password only, or password plus second factor)
+// New sessions act within the user's default organization when they are still a member of it
+func completeLogin(
+	ctx context.Context,
+	userRepository repositories.UserRepository,
+	sessionIssuer *SessionIssuer,
+	user *entities.User,
+	client auth.ClientInfo,
+) (*LoginResult, error) {
+	// Resolve the organization and role carried in the token
+	scope, err := sessionIssuer.resolveScope(ctx, user, user.OrganizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	if scope == nil {
+		return nil, errors.ErrInternal(fmt.Errorf("role %d assigned to user %d not found", user.RoleID, user.ID))
+	}
+
+	// Issue the access and refresh tokens
+	session, err := sessionIssuer.Start(ctx, user, scope, client)
+	if err != nil {
+		log.Printf("[Login] Error starting session: userID=%d, error=%v", user.ID, err)
+		return nil, err
+	}
+
+	// Record the login (also resets the failed attempt counter)
+	now := time.Now()
+	if err := userRepository.RecordSuccessfulLogin(ctx, user.ID, now); err != nil {
+		log.Printf("[Login] Error recording login: userID=%d, error=%v", user.ID, err)
+		return nil, err
+	}
+	user.LastLogin = &now
+	user.LoginAttempts = 0
+	user.LockedUntil = nil
+
+	log.Printf("[Login] Login successful: userID=%d, role=%s", user.ID, scope.RoleCode)
+
+	return &LoginResult{
+		User:           user,
+		RoleCode:       scope.RoleCode,
+		OrganizationID: scope.OrganizationID,
+		Session:        session,
+	}, nil
+}
+
+// registerFailedLogin records a failed credential check and locks the account once the policy says so,
+// both in one atomic update
+// Returns an unauthorized error with failureMessage, or a locked error when this failure locked the account
+func registerFailedLogin(
+	ctx context.Context,
+	userRepository repositories.UserRepository,
+	policy LockoutPolicy,
+	user *entities.User,
+	failureMessage string,
+) error {
+	attempts, lockedUntil, err := userRepository.RegisterFailedLogin(ctx, user.ID, policy.Schedule(), time.Now())
+	if err != nil {
+		log.Printf("[Login] Error registering failed login: userID=%d, error=%v", user.ID, err)
+		return err
+	}
+
+	if lockedUntil == nil {
+		return errors.ErrUnauthorized(failureMessage)
+	}
+
+	log.Printf("[Login] User locked after failed attempts: userID=%d, attempts=%d, lockedUntil=%v", user.ID, attempts, *lockedUntil)
+	return errors.ErrLocked(constants.ErrorMessages.AccountLocked)
+}
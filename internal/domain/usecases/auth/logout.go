@@ -0,0 +1,79 @@
+package auth
+
+import (
+	"citary-backend/internal/domain/dtos/auth"
+	"citary-backend/internal/domain/errors"
+	"citary-backend/internal/domain/repositories"
+	"citary-backend/internal/domain/services"
+	"citary-backend/pkg/constants"
+	"context"
+	"log"
+)
+
+// LogoutUseCase handles the business logic for ending sessions
+type LogoutUseCase struct {
+	refreshTokenRepository repositories.RefreshTokenRepository
+	tokenService           services.TokenService
+}
+
+// NewLogoutUseCase creates a new instance of LogoutUseCase
+func NewLogoutUseCase(
+	refreshTokenRepository repositories.RefreshTokenRepository,
+	tokenService services.TokenService,
+) *LogoutUseCase {
+	return &LogoutUseCase{
+		refreshTokenRepository: refreshTokenRepository,
+		tokenService:           tokenService,
+	}
+}
+
+// Execute revokes the session of the presented refresh token, or every session of its owner
+//
+// Logging out an unknown or already revoked session succeeds silently; logging out all
+// devices requires a currently valid token since it affects other sessions.
+func (uc *LogoutUseCase) Execute(ctx context.Context, dto auth.LogoutRequest) error {
+	log.Printf("[LogoutUseCase] Execute: allDevices=%v", dto.AllDevices)
+
+	// 1. Validate input data
+	if err := dto.Validate(); err != nil {
+		log.Printf("[LogoutUseCase] Validation failed: %v", err)
+		return errors.ErrBadRequest(err.Error())
+	}
+
+	// 2. Find the stored token
+	token, err := uc.refreshTokenRepository.FindByTokenHash(ctx, uc.tokenService.HashToken(dto.RefreshToken))
+	if err != nil {
+		log.Printf("[LogoutUseCase] Error finding refresh token: %v", err)
+		return err
+	}
+
+	// 3. Revoke every device
+	if dto.AllDevices {
+		if token == nil || token.IsRevoked() || token.IsExpired() {
+			log.Printf("[LogoutUseCase] Cannot log out all devices with an invalid token")
+			return errors.ErrUnauthorized(constants.ErrorMessages.RefreshTokenInvalid)
+		}
+
+		if err := uc.refreshTokenRepository.RevokeAllForUser(ctx, token.UserID); err != nil {
+			log.Printf("[LogoutUseCase] Error revoking all sessions: userID=%d, error=%v", token.UserID, err)
+			return err
+		}
+
+		log.Printf("[LogoutUseCase] All sessions revoked: userID=%d", token.UserID)
+		return nil
+	}
+
+	// 4. Revoke this device only (the whole family, so no earlier token of it stays usable)
+	if token == nil {
+		log.Printf("[LogoutUseCase] Refresh token not found, nothing to revoke")
+		return nil
+	}
+
+	if err := uc.refreshTokenRepository.RevokeFamily(ctx, token.FamilyID); err != nil {
+		log.Printf("[LogoutUseCase] Error revoking session: familyID=%s, error=%v", token.FamilyID, err)
+		return err
+	}
+
+	log.Printf("[LogoutUseCase] Session revoked: userID=%d, familyID=%s", token.UserID, token.FamilyID)
+	return nil
+}
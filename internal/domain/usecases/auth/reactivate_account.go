@@ -0,0 +1,151 @@
+package auth
+
+import (
+	"citary-backend/internal/domain/dtos/auth"
+	"citary-backend/internal/domain/entities"
+	"citary-backend/internal/domain/errors"
+	"citary-backend/internal/domain/repositories"
+	"citary-backend/internal/domain/services"
+	"citary-backend/pkg/constants"
+	"context"
+	"log"
+	"time"
+)
+
+// ReactivateAccountUseCase handles the business logic for restoring an inactive account from an emailed link
+type ReactivateAccountUseCase struct {
+	userRepository         repositories.UserRepository
+	userTokenRepository    repositories.UserTokenRepository
+	refreshTokenRepository repositories.RefreshTokenRepository
+	userEventRepository    repositories.UserEventRepository
+	tokenService           services.TokenService
+	emailService           services.EmailService
+}
+
+// NewReactivateAccountUseCase creates a new instance of ReactivateAccountUseCase
+func NewReactivateAccountUseCase(
+	userRepository repositories.UserRepository,
+	userTokenRepository repositories.UserTokenRepository,
+	refreshTokenRepository repositories.RefreshTokenRepository,
+	userEventRepository repositories.UserEventRepository,
+	tokenService services.TokenService,
+	emailService services.EmailService,
+) *ReactivateAccountUseCase {
+	return &ReactivateAccountUseCase{
+		userRepository:         userRepository,
+		userTokenRepository:    userTokenRepository,
+		refreshTokenRepository: refreshTokenRepository,
+		userEventRepository:    userEventRepository,
+		tokenService:           tokenService,
+		emailService:           emailService,
+	}
+}
+
+// Execute consumes a reactivation token, restores the account with a new password
+// and requires the email address to be verified again
+func (uc *ReactivateAccountUseCase) Execute(ctx context.Context, dto auth.ReactivateAccountRequest) (*entities.User, error) {
+	log.Printf("[ReactivateAccountUseCase] Execute")
+
+	// 1. Validate input data (including the signup password policy)
+	if err := dto.Validate(); err != nil {
+		log.Printf("[ReactivateAccountUseCase] Validation failed: %v", err)
+		return nil, errors.ErrBadRequest(err.Error())
+	}
+
+	// 2. Find the token
+	reactivationToken, err := uc.userTokenRepository.FindByTokenHash(ctx, constants.UserTokenPurpose.Reactivation, uc.tokenService.HashToken(dto.Token))
+	if err != nil {
+		log.Printf("[ReactivateAccountUseCase] Error finding token: %v", err)
+		return nil, err
+	}
+
+	if reactivationToken == nil || reactivationToken.IsUsed() {
+		log.Printf("[ReactivateAccountUseCase] Token not found or already used")
+		return nil, errors.ErrBadRequest(constants.ErrorMessages.ReactivationTokenInvalid)
+	}
+
+	if reactivationToken.IsExpired() {
+		log.Printf("[ReactivateAccountUseCase] Token expired: tokenID=%d", reactivationToken.ID)
+		return nil, errors.ErrGone(constants.ErrorMessages.ReactivationTokenExpired)
+	}
+
+	// 3. Business validation: the account must still be deleted and awaiting anonymization
+	user, err := uc.userRepository.FindByID(ctx, reactivationToken.UserID)
+	if err != nil {
+		log.Printf("[ReactivateAccountUseCase] Error finding user: userID=%d, error=%v", reactivationToken.UserID, err)
+		return nil, err
+	}
+
+	if user == nil || user.IsActive() || user.AnonymizeAfter == nil {
+		log.Printf("[ReactivateAccountUseCase] User not found, already active or anonymized: userID=%d", reactivationToken.UserID)
+		return nil, errors.ErrBadRequest(constants.ErrorMessages.ReactivationTokenInvalid)
+	}
+
+	// 4. Consume the token (guards against concurrent use of the same link)
+	consumed, err := uc.userTokenRepository.MarkUsed(ctx, reactivationToken.ID)
+	if err != nil {
+		log.Printf("[ReactivateAccountUseCase] Error consuming token: tokenID=%d, error=%v", reactivationToken.ID, err)
+		return nil, err
+	}
+
+	if !consumed {
+		log.Printf("[ReactivateAccountUseCase] Token consumed concurrently: tokenID=%d", reactivationToken.ID)
+		return nil, errors.ErrBadRequest(constants.ErrorMessages.ReactivationTokenInvalid)
+	}
+
+	// 5. Restore the account with a new password and a fresh verification token
+	hashedPassword, err := hashPassword(dto.Password)
+	if err != nil {
+		log.Printf("[ReactivateAccountUseCase] Error hashing password: %v", err)
+		return nil, errors.ErrInternal(err)
+	}
+
+	verificationToken, err := generateSecureToken()
+	if err != nil {
+		log.Printf("[ReactivateAccountUseCase] Error generating verification token: %v", err)
+		return nil, errors.ErrInternal(err)
+	}
+
+	now := time.Now()
+	tokenExpiresAt := now.Add(constants.VerificationConfig.TokenTTL)
+
+	user.PasswordHash = hashedPassword
+	user.EmailVerified = false
+	user.VerificationToken = &verificationToken
+	user.VerificationTokenExpiresAt = &tokenExpiresAt
+	user.VerificationSentAt = &now
+	user.VerificationSendCount = 1
+	user.LoginAttempts = 0
+	user.LockedUntil = nil
+	user.RecordStatus = constants.RecordStatus.Active
+
+	reactivated, err := uc.userRepository.Reactivate(ctx, user)
+	if err != nil {
+		log.Printf("[ReactivateAccountUseCase] Error reactivating user: userID=%d, error=%v", user.ID, err)
+		return nil, err
+	}
+
+	if !reactivated {
+		log.Printf("[ReactivateAccountUseCase] Account no longer awaiting anonymization: userID=%d", user.ID)
+		return nil, errors.ErrConflict(constants.ErrorMessages.ReactivationTokenInvalid)
+	}
+
+	// 6. Drop any session that survived the deactivation
+	if err := uc.refreshTokenRepository.RevokeAllForUser(ctx, user.ID); err != nil {
+		log.Printf("[ReactivateAccountUseCase] Error revoking sessions: userID=%d, error=%v", user.ID, err)
+		return nil, err
+	}
+
+	RecordUserEvent(ctx, uc.userEventRepository, user.ID, constants.UserEventType.Reactivated, dto.IPAddress)
+
+	log.Printf("[ReactivateAccountUseCase] Account reactivated: userID=%d", user.ID)
+
+	// 7. Send verification email (non-blocking - the account is already reactivated)
+	if err := uc.emailService.SendVerificationEmail(ctx, user.Email, verificationToken); err != nil {
+		log.Printf("[ReactivateAccountUseCase] WARNING: Failed to send verification email to %s: %v", user.Email, err)
+	} else {
+		log.Printf("[ReactivateAccountUseCase] Verification email sent successfully to: %s", user.Email)
+	}
+
+	return user, nil
+}
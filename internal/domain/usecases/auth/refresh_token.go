@@ -0,0 +1,131 @@
+package auth
+
+import (
+	"citary-backend/internal/domain/dtos/auth"
+	"citary-backend/internal/domain/errors"
+	"citary-backend/internal/domain/repositories"
+	"citary-backend/internal/domain/services"
+	"citary-backend/pkg/constants"
+	"context"
+	"log"
+)
+
+// RefreshTokenUseCase handles the business logic for rotating refresh tokens
+//
+// Presenting a token that was already rotated means it leaked: the whole family is revoked
+// so both the attacker and the legitimate client have to log in again.
+type RefreshTokenUseCase struct {
+	userRepository         repositories.UserRepository
+	refreshTokenRepository repositories.RefreshTokenRepository
+	tokenService           services.TokenService
+	sessionIssuer          *SessionIssuer
+}
+
+// NewRefreshTokenUseCase creates a new instance of RefreshTokenUseCase
+func NewRefreshTokenUseCase(
+	userRepository repositories.UserRepository,
+	refreshTokenRepository repositories.RefreshTokenRepository,
+	tokenService services.TokenService,
+	sessionIssuer *SessionIssuer,
+) *RefreshTokenUseCase {
+	return &RefreshTokenUseCase{
+		userRepository:         userRepository,
+		refreshTokenRepository: refreshTokenRepository,
+		tokenService:           tokenService,
+		sessionIssuer:          sessionIssuer,
+	}
+}
+
+// Execute exchanges a valid refresh token for a new access and refresh token pair
+func (uc *RefreshTokenUseCase) Execute(ctx context.Context, dto auth.RefreshTokenRequest) (*LoginResult, error) {
+	log.Printf("[RefreshTokenUseCase] Execute")
+
+	// 1. Validate input data
+	if err := dto.Validate(); err != nil {
+		log.Printf("[RefreshTokenUseCase] Validation failed: %v", err)
+		return nil, errors.ErrBadRequest(err.Error())
+	}
+
+	// 2. Find the stored token
+	current, err := uc.refreshTokenRepository.FindByTokenHash(ctx, uc.tokenService.HashToken(dto.RefreshToken))
+	if err != nil {
+		log.Printf("[RefreshTokenUseCase] Error finding refresh token: %v", err)
+		return nil, err
+	}
+
+	if current == nil {
+		log.Printf("[RefreshTokenUseCase] Refresh token not found")
+		return nil, errors.ErrUnauthorized(constants.ErrorMessages.RefreshTokenInvalid)
+	}
+
+	// 3. Reuse detection: a rotated token must never be presented again
+	if current.WasRotated() {
+		log.Printf("[RefreshTokenUseCase] SECURITY: rotated refresh token replayed, revoking family: tokenID=%d, familyID=%s, userID=%d",
+			current.ID, current.FamilyID, current.UserID)
+		if err := uc.refreshTokenRepository.RevokeFamily(ctx, current.FamilyID); err != nil {
+			return nil, err
+		}
+		return nil, errors.ErrUnauthorized(constants.ErrorMessages.RefreshTokenReused)
+	}
+
+	if current.IsRevoked() || current.IsExpired() {
+		log.Printf("[RefreshTokenUseCase] Refresh token revoked or expired: tokenID=%d", current.ID)
+		return nil, errors.ErrUnauthorized(constants.ErrorMessages.RefreshTokenInvalid)
+	}
+
+	// 4. Business validation: the owner must still exist and be active
+	// A temporary login lockout does not end existing sessions, or failed logins could sign anyone out
+	user, err := uc.userRepository.FindByID(ctx, current.UserID)
+	if err != nil {
+		log.Printf("[RefreshTokenUseCase] Error finding user: userID=%d, error=%v", current.UserID, err)
+		return nil, err
+	}
+
+	if user == nil || !user.IsActive() {
+		log.Printf("[RefreshTokenUseCase] User no longer active, revoking family: userID=%d", current.UserID)
+		if err := uc.refreshTokenRepository.RevokeFamily(ctx, current.FamilyID); err != nil {
+			return nil, err
+		}
+		return nil, errors.ErrUnauthorized(constants.ErrorMessages.RefreshTokenInvalid)
+	}
+
+	// 5. Resolve the current scope (role and membership changes apply on the next refresh)
+	// Sessions outside of any organization move into the user's default one, e.g. right after onboarding
+	organizationID := current.OrganizationID
+	if organizationID == nil {
+		organizationID = user.OrganizationID
+	}
+
+	scope, err := uc.sessionIssuer.resolveScope(ctx, user, organizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	if scope == nil {
+		return nil, errors.ErrUnauthorized(constants.ErrorMessages.RefreshTokenInvalid)
+	}
+
+	// 6. Rotate the token
+	session, rotated, err := uc.sessionIssuer.Rotate(ctx, current, user, scope, dto.ClientInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	// Lost a race against another request presenting the same token: treat it as reuse
+	if !rotated {
+		log.Printf("[RefreshTokenUseCase] SECURITY: concurrent rotation detected, revoking family: familyID=%s", current.FamilyID)
+		if err := uc.refreshTokenRepository.RevokeFamily(ctx, current.FamilyID); err != nil {
+			return nil, err
+		}
+		return nil, errors.ErrUnauthorized(constants.ErrorMessages.RefreshTokenReused)
+	}
+
+	log.Printf("[RefreshTokenUseCase] Token refreshed: userID=%d, familyID=%s", user.ID, current.FamilyID)
+
+	return &LoginResult{
+		User:           user,
+		RoleCode:       scope.RoleCode,
+		OrganizationID: scope.OrganizationID,
+		Session:        session,
+	}, nil
+}
@@ -0,0 +1,125 @@
+package auth
+
+import (
+	"citary-backend/internal/domain/dtos/auth"
+	"citary-backend/internal/domain/entities"
+	"citary-backend/internal/domain/errors"
+	"citary-backend/internal/domain/repositories"
+	"citary-backend/internal/domain/services"
+	"citary-backend/pkg/constants"
+	"context"
+	"log"
+	"strings"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// RequestEmailChangeUseCase handles the business logic for starting an email address change
+// The account keeps its current address until the link sent to the new one is confirmed
+type RequestEmailChangeUseCase struct {
+	userRepository      repositories.UserRepository
+	userTokenRepository repositories.UserTokenRepository
+	tokenService        services.TokenService
+	emailService        services.EmailService
+}
+
+// NewRequestEmailChangeUseCase creates a new instance of RequestEmailChangeUseCase
+func NewRequestEmailChangeUseCase(
+	userRepository repositories.UserRepository,
+	userTokenRepository repositories.UserTokenRepository,
+	tokenService services.TokenService,
+	emailService services.EmailService,
+) *RequestEmailChangeUseCase {
+	return &RequestEmailChangeUseCase{
+		userRepository:      userRepository,
+		userTokenRepository: userTokenRepository,
+		tokenService:        tokenService,
+		emailService:        emailService,
+	}
+}
+
+// Execute verifies the current password and emails a confirmation link to the new address
+func (uc *RequestEmailChangeUseCase) Execute(ctx context.Context, userID int, dto auth.ChangeEmailRequest) error {
+	log.Printf("[RequestEmailChangeUseCase] Execute: userID=%d, newEmail=%s", userID, dto.NewEmail)
+
+	// 1. Validate input data
+	if err := dto.Validate(); err != nil {
+		log.Printf("[RequestEmailChangeUseCase] Validation failed: %v", err)
+		return errors.ErrBadRequest(err.Error())
+	}
+
+	// 2. Find the user
+	user, err := uc.userRepository.FindByID(ctx, userID)
+	if err != nil {
+		log.Printf("[RequestEmailChangeUseCase] Error finding user: %v", err)
+		return err
+	}
+
+	if user == nil || !user.IsActive() {
+		log.Printf("[RequestEmailChangeUseCase] User not found or inactive: userID=%d", userID)
+		return errors.ErrNotFound(constants.ErrorMessages.UserNotFound)
+	}
+
+	// 3. Check the current password
+	if err := bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(dto.CurrentPassword)); err != nil {
+		log.Printf("[RequestEmailChangeUseCase] Incorrect current password: userID=%d", userID)
+		return errors.ErrUnauthorized(constants.ErrorMessages.PasswordIncorrect)
+	}
+
+	// 4. Business validation: the new address must differ and be free
+	if strings.EqualFold(user.Email, dto.NewEmail) {
+		log.Printf("[RequestEmailChangeUseCase] New email equals current: userID=%d", userID)
+		return errors.ErrBadRequest(constants.ErrorMessages.EmailUnchanged)
+	}
+
+	existingUser, err := uc.userRepository.FindByEmail(ctx, dto.NewEmail)
+	if err != nil {
+		log.Printf("[RequestEmailChangeUseCase] Error checking new email: %v", err)
+		return err
+	}
+
+	if existingUser != nil {
+		log.Printf("[RequestEmailChangeUseCase] New email already in use: userID=%d, newEmail=%s", userID, dto.NewEmail)
+		return errors.ErrConflict(constants.ErrorMessages.UserAlreadyExists)
+	}
+
+	// 5. Only the most recent link stays valid
+	if err := uc.userTokenRepository.InvalidateForUser(ctx, userID, constants.UserTokenPurpose.EmailChange); err != nil {
+		log.Printf("[RequestEmailChangeUseCase] Error invalidating previous tokens: userID=%d, error=%v", userID, err)
+		return err
+	}
+
+	// 6. Issue a new token carrying the requested address
+	changeToken, err := generateSecureToken()
+	if err != nil {
+		log.Printf("[RequestEmailChangeUseCase] Error generating token: %v", err)
+		return errors.ErrInternal(err)
+	}
+
+	now := time.Now()
+	newEmail := dto.NewEmail
+	userToken := &entities.UserToken{
+		UserID:       userID,
+		Purpose:      constants.UserTokenPurpose.EmailChange,
+		TokenHash:    uc.tokenService.HashToken(changeToken),
+		Payload:      &newEmail,
+		ExpiresAt:    now.Add(constants.EmailChangeConfig.TokenTTL),
+		CreatedDate:  now,
+		RecordStatus: constants.RecordStatus.Active,
+	}
+
+	if err := uc.userTokenRepository.Create(ctx, userToken); err != nil {
+		log.Printf("[RequestEmailChangeUseCase] Error storing token: userID=%d, error=%v", userID, err)
+		return err
+	}
+
+	// 7. Send the confirmation to the new address (the change cannot complete without it)
+	if err := uc.emailService.SendEmailChangeConfirmation(ctx, newEmail, changeToken); err != nil {
+		log.Printf("[RequestEmailChangeUseCase] Failed to send confirmation to %s: %v", newEmail, err)
+		return errors.ErrInternal(err)
+	}
+
+	log.Printf("[RequestEmailChangeUseCase] Email change confirmation sent: userID=%d, newEmail=%s", userID, newEmail)
+	return nil
+}
@@ -0,0 +1,98 @@
+package auth
+
+import (
+	"citary-backend/internal/domain/dtos/auth"
+	"citary-backend/internal/domain/entities"
+	"citary-backend/internal/domain/errors"
+	"citary-backend/internal/domain/repositories"
+	"citary-backend/internal/domain/services"
+	"citary-backend/pkg/constants"
+	"context"
+	"log"
+	"time"
+)
+
+// RequestPasswordResetUseCase handles the business logic for issuing password reset links
+// Like ResendVerificationUseCase, it answers identically whether or not the account exists
+type RequestPasswordResetUseCase struct {
+	userRepository      repositories.UserRepository
+	userTokenRepository repositories.UserTokenRepository
+	tokenService        services.TokenService
+	emailService        services.EmailService
+}
+
+// NewRequestPasswordResetUseCase creates a new instance of RequestPasswordResetUseCase
+func NewRequestPasswordResetUseCase(
+	userRepository repositories.UserRepository,
+	userTokenRepository repositories.UserTokenRepository,
+	tokenService services.TokenService,
+	emailService services.EmailService,
+) *RequestPasswordResetUseCase {
+	return &RequestPasswordResetUseCase{
+		userRepository:      userRepository,
+		userTokenRepository: userTokenRepository,
+		tokenService:        tokenService,
+		emailService:        emailService,
+	}
+}
+
+// Execute issues a single-use password reset token and emails the reset link
+func (uc *RequestPasswordResetUseCase) Execute(ctx context.Context, dto auth.ForgotPasswordRequest) error {
+	log.Printf("[RequestPasswordResetUseCase] Execute: email=%s", dto.Email)
+
+	// 1. Validate input data
+	if err := dto.Validate(); err != nil {
+		log.Printf("[RequestPasswordResetUseCase] Validation failed: %v", err)
+		return errors.ErrBadRequest(err.Error())
+	}
+
+	// 2. Find the user
+	user, err := uc.userRepository.FindByEmail(ctx, dto.Email)
+	if err != nil {
+		log.Printf("[RequestPasswordResetUseCase] Error finding user: %v", err)
+		return err
+	}
+
+	// Business validation: only active accounts can reset their password
+	if user == nil || !user.IsActive() {
+		log.Printf("[RequestPasswordResetUseCase] User not found or inactive, skipping: email=%s", dto.Email)
+		return nil
+	}
+
+	// 3. Only the most recent link stays valid
+	if err := uc.userTokenRepository.InvalidateForUser(ctx, user.ID, constants.UserTokenPurpose.PasswordReset); err != nil {
+		log.Printf("[RequestPasswordResetUseCase] Error invalidating previous tokens: userID=%d, error=%v", user.ID, err)
+		return err
+	}
+
+	// 4. Issue a new token (only its hash is stored)
+	resetToken, err := generateSecureToken()
+	if err != nil {
+		log.Printf("[RequestPasswordResetUseCase] Error generating reset token: %v", err)
+		return errors.ErrInternal(err)
+	}
+
+	now := time.Now()
+	userToken := &entities.UserToken{
+		UserID:       user.ID,
+		Purpose:      constants.UserTokenPurpose.PasswordReset,
+		TokenHash:    uc.tokenService.HashToken(resetToken),
+		ExpiresAt:    now.Add(constants.PasswordResetConfig.TokenTTL),
+		CreatedDate:  now,
+		RecordStatus: constants.RecordStatus.Active,
+	}
+
+	if err := uc.userTokenRepository.Create(ctx, userToken); err != nil {
+		log.Printf("[RequestPasswordResetUseCase] Error storing reset token: userID=%d, error=%v", user.ID, err)
+		return err
+	}
+
+	// 5. Send the reset email (failures are logged, the caller always gets the same answer)
+	if err := uc.emailService.SendPasswordResetEmail(ctx, user.Email, resetToken); err != nil {
+		log.Printf("[RequestPasswordResetUseCase] WARNING: Failed to send password reset email to %s: %v", user.Email, err)
+	} else {
+		log.Printf("[RequestPasswordResetUseCase] Password reset email sent successfully to: %s", user.Email)
+	}
+
+	return nil
+}
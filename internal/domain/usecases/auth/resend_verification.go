@@ -0,0 +1,101 @@
+package auth
+
+import (
+	"citary-backend/internal/domain/dtos/auth"
+	"citary-backend/internal/domain/errors"
+	"citary-backend/internal/domain/repositories"
+	"citary-backend/internal/domain/services"
+	"citary-backend/pkg/constants"
+	"context"
+	"log"
+	"time"
+)
+
+// ResendVerificationUseCase handles the business logic for re-issuing email verification links
+//
+// The use case never reveals whether an account exists: unknown, verified, inactive and
+// rate-limited emails all complete silently so the endpoint answers identically.
+type ResendVerificationUseCase struct {
+	userRepository repositories.UserRepository
+	emailService   services.EmailService
+}
+
+// NewResendVerificationUseCase creates a new instance of ResendVerificationUseCase
+func NewResendVerificationUseCase(
+	userRepository repositories.UserRepository,
+	emailService services.EmailService,
+) *ResendVerificationUseCase {
+	return &ResendVerificationUseCase{
+		userRepository: userRepository,
+		emailService:   emailService,
+	}
+}
+
+// Execute rotates the verification token of an unverified account and emails the new link
+func (uc *ResendVerificationUseCase) Execute(ctx context.Context, dto auth.ResendVerificationRequest) error {
+	log.Printf("[ResendVerificationUseCase] Execute: email=%s", dto.Email)
+
+	// 1. Validate input data
+	if err := dto.Validate(); err != nil {
+		log.Printf("[ResendVerificationUseCase] Validation failed: %v", err)
+		return errors.ErrBadRequest(err.Error())
+	}
+
+	// 2. Find the user
+	user, err := uc.userRepository.FindByEmail(ctx, dto.Email)
+	if err != nil {
+		log.Printf("[ResendVerificationUseCase] Error finding user: %v", err)
+		return err
+	}
+
+	// Business validation: only active, unverified accounts receive a new link
+	if user == nil {
+		log.Printf("[ResendVerificationUseCase] User not found, skipping: email=%s", dto.Email)
+		return nil
+	}
+
+	if !user.IsActive() || user.EmailVerified {
+		log.Printf("[ResendVerificationUseCase] User not eligible, skipping: userID=%d, status=%s, emailVerified=%v",
+			user.ID, user.RecordStatus, user.EmailVerified)
+		return nil
+	}
+
+	// 3. Rotate the token (the previous link stops working), enforcing the cooldown and daily cap
+	verificationToken, err := generateSecureToken()
+	if err != nil {
+		log.Printf("[ResendVerificationUseCase] Error generating verification token: %v", err)
+		return errors.ErrInternal(err)
+	}
+
+	now := time.Now()
+	tokenExpiresAt := now.Add(constants.VerificationConfig.TokenTTL)
+
+	user.VerificationToken = &verificationToken
+	user.VerificationTokenExpiresAt = &tokenExpiresAt
+	user.VerificationSentAt = &now
+
+	rotated, err := uc.userRepository.RotateVerificationToken(
+		ctx,
+		user,
+		constants.VerificationConfig.ResendCooldown,
+		constants.VerificationConfig.MaxSendsPerDay,
+	)
+	if err != nil {
+		log.Printf("[ResendVerificationUseCase] Error rotating verification token: userID=%d, error=%v", user.ID, err)
+		return err
+	}
+
+	if !rotated {
+		log.Printf("[ResendVerificationUseCase] Cooldown active or daily cap reached, skipping: userID=%d", user.ID)
+		return nil
+	}
+
+	// 4. Send verification email (failures are logged, the caller always gets the same answer)
+	if err := uc.emailService.SendVerificationEmail(ctx, user.Email, verificationToken); err != nil {
+		log.Printf("[ResendVerificationUseCase] WARNING: Failed to send verification email to %s: %v", user.Email, err)
+	} else {
+		log.Printf("[ResendVerificationUseCase] Verification email sent successfully to: %s", user.Email)
+	}
+
+	return nil
+}
@@ -0,0 +1,107 @@
+package auth
+
+import (
+	"citary-backend/internal/domain/dtos/auth"
+	"citary-backend/internal/domain/errors"
+	"citary-backend/internal/domain/repositories"
+	"citary-backend/internal/domain/services"
+	"citary-backend/pkg/constants"
+	"context"
+	"log"
+)
+
+// ResetPasswordUseCase handles the business logic for setting a new password with a reset token
+type ResetPasswordUseCase struct {
+	userRepository         repositories.UserRepository
+	userTokenRepository    repositories.UserTokenRepository
+	refreshTokenRepository repositories.RefreshTokenRepository
+	tokenService           services.TokenService
+}
+
+// NewResetPasswordUseCase creates a new instance of ResetPasswordUseCase
+func NewResetPasswordUseCase(
+	userRepository repositories.UserRepository,
+	userTokenRepository repositories.UserTokenRepository,
+	refreshTokenRepository repositories.RefreshTokenRepository,
+	tokenService services.TokenService,
+) *ResetPasswordUseCase {
+	return &ResetPasswordUseCase{
+		userRepository:         userRepository,
+		userTokenRepository:    userTokenRepository,
+		refreshTokenRepository: refreshTokenRepository,
+		tokenService:           tokenService,
+	}
+}
+
+// Execute consumes a reset token, stores the new password and signs the user out everywhere
+func (uc *ResetPasswordUseCase) Execute(ctx context.Context, dto auth.ResetPasswordRequest) error {
+	log.Printf("[ResetPasswordUseCase] Execute")
+
+	// 1. Validate input data (including the signup password policy)
+	if err := dto.Validate(); err != nil {
+		log.Printf("[ResetPasswordUseCase] Validation failed: %v", err)
+		return errors.ErrBadRequest(err.Error())
+	}
+
+	// 2. Find the token
+	resetToken, err := uc.userTokenRepository.FindByTokenHash(ctx, constants.UserTokenPurpose.PasswordReset, uc.tokenService.HashToken(dto.Token))
+	if err != nil {
+		log.Printf("[ResetPasswordUseCase] Error finding reset token: %v", err)
+		return err
+	}
+
+	if resetToken == nil || resetToken.IsUsed() {
+		log.Printf("[ResetPasswordUseCase] Reset token not found or already used")
+		return errors.ErrBadRequest(constants.ErrorMessages.PasswordResetTokenInvalid)
+	}
+
+	if resetToken.IsExpired() {
+		log.Printf("[ResetPasswordUseCase] Reset token expired: tokenID=%d", resetToken.ID)
+		return errors.ErrGone(constants.ErrorMessages.PasswordResetTokenExpired)
+	}
+
+	// 3. Business validation: the owner must still be active
+	user, err := uc.userRepository.FindByID(ctx, resetToken.UserID)
+	if err != nil {
+		log.Printf("[ResetPasswordUseCase] Error finding user: userID=%d, error=%v", resetToken.UserID, err)
+		return err
+	}
+
+	if user == nil || !user.IsActive() {
+		log.Printf("[ResetPasswordUseCase] User not found or inactive: userID=%d", resetToken.UserID)
+		return errors.ErrBadRequest(constants.ErrorMessages.PasswordResetTokenInvalid)
+	}
+
+	// 4. Consume the token (guards against concurrent use of the same link)
+	consumed, err := uc.userTokenRepository.MarkUsed(ctx, resetToken.ID)
+	if err != nil {
+		log.Printf("[ResetPasswordUseCase] Error consuming reset token: tokenID=%d, error=%v", resetToken.ID, err)
+		return err
+	}
+
+	if !consumed {
+		log.Printf("[ResetPasswordUseCase] Reset token consumed concurrently: tokenID=%d", resetToken.ID)
+		return errors.ErrBadRequest(constants.ErrorMessages.PasswordResetTokenInvalid)
+	}
+
+	// 5. Store the new password
+	hashedPassword, err := hashPassword(dto.Password)
+	if err != nil {
+		log.Printf("[ResetPasswordUseCase] Error hashing password: %v", err)
+		return errors.ErrInternal(err)
+	}
+
+	if err := uc.userRepository.UpdatePassword(ctx, user.ID, hashedPassword); err != nil {
+		log.Printf("[ResetPasswordUseCase] Error updating password: userID=%d, error=%v", user.ID, err)
+		return err
+	}
+
+	// 6. Invalidate every existing session
+	if err := uc.refreshTokenRepository.RevokeAllForUser(ctx, user.ID); err != nil {
+		log.Printf("[ResetPasswordUseCase] Error revoking sessions: userID=%d, error=%v", user.ID, err)
+		return err
+	}
+
+	log.Printf("[ResetPasswordUseCase] Password reset successfully: userID=%d", user.ID)
+	return nil
+}
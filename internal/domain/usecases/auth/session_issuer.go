@@ -0,0 +1,180 @@
+package auth
+
+import (
+	"citary-backend/internal/domain/dtos/auth"
+	"citary-backend/internal/domain/entities"
+	"citary-backend/internal/domain/repositories"
+	"citary-backend/internal/domain/services"
+	"citary-backend/pkg/constants"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"time"
+	"unicode/utf8"
+)
+
+// Session represents an issued access token and refresh token pair
+type Session struct {
+	AccessToken  *services.IssuedToken
+	RefreshToken *services.IssuedToken
+}
+
+// SessionIssuer issues and rotates token pairs for authenticated users
+// It is shared by every use case that ends in the user being logged in
+type SessionIssuer struct {
+	roleRepository         repositories.RoleRepository
+	membershipRepository   repositories.OrganizationMembershipRepository
+	refreshTokenRepository repositories.RefreshTokenRepository
+	tokenService           services.TokenService
+}
+
+// NewSessionIssuer creates a new instance of SessionIssuer
+func NewSessionIssuer(
+	roleRepository repositories.RoleRepository,
+	membershipRepository repositories.OrganizationMembershipRepository,
+	refreshTokenRepository repositories.RefreshTokenRepository,
+	tokenService services.TokenService,
+) *SessionIssuer {
+	return &SessionIssuer{
+		roleRepository:         roleRepository,
+		membershipRepository:   membershipRepository,
+		refreshTokenRepository: refreshTokenRepository,
+		tokenService:           tokenService,
+	}
+}
+
+// Start issues a token pair opening a new refresh token family (one per device login)
+func (s *SessionIssuer) Start(ctx context.Context, user *entities.User, scope *SessionScope, client auth.ClientInfo) (*Session, error) {
+	familyID, err := generateTokenFamilyID()
+	if err != nil {
+		log.Printf("[SessionIssuer] Error generating token family: userID=%d, error=%v", user.ID, err)
+		return nil, err
+	}
+
+	refreshToken, issuedRefresh, err := s.newRefreshToken(user.ID, familyID, scope, client)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.refreshTokenRepository.Create(ctx, refreshToken); err != nil {
+		log.Printf("[SessionIssuer] Error persisting refresh token: userID=%d, error=%v", user.ID, err)
+		return nil, err
+	}
+
+	accessToken, err := s.issueAccessToken(user, scope)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("[SessionIssuer] Session started: userID=%d, familyID=%s", user.ID, familyID)
+	return &Session{AccessToken: accessToken, RefreshToken: issuedRefresh}, nil
+}
+
+// Rotate exchanges the current refresh token for a new pair within the same family
+// The new pair acts within the given scope, which is how a session switches organization
+// Returns (nil, false, nil) if the current token was rotated concurrently by another request
+func (s *SessionIssuer) Rotate(
+	ctx context.Context,
+	current *entities.RefreshToken,
+	user *entities.User,
+	scope *SessionScope,
+	client auth.ClientInfo,
+) (*Session, bool, error) {
+	// Keep the device name chosen at login unless the client sends a new one
+	if client.DeviceName == "" && current.DeviceName != nil {
+		client.DeviceName = *current.DeviceName
+	}
+
+	next, issuedRefresh, err := s.newRefreshToken(user.ID, current.FamilyID, scope, client)
+	if err != nil {
+		return nil, false, err
+	}
+
+	rotated, err := s.refreshTokenRepository.Rotate(ctx, current, next)
+	if err != nil {
+		log.Printf("[SessionIssuer] Error rotating refresh token: tokenID=%d, error=%v", current.ID, err)
+		return nil, false, err
+	}
+
+	if !rotated {
+		return nil, false, nil
+	}
+
+	accessToken, err := s.issueAccessToken(user, scope)
+	if err != nil {
+		return nil, false, err
+	}
+
+	log.Printf("[SessionIssuer] Session rotated: userID=%d, familyID=%s", user.ID, current.FamilyID)
+	return &Session{AccessToken: accessToken, RefreshToken: issuedRefresh}, true, nil
+}
+
+// issueAccessToken signs an access token carrying the user's identity and session scope
+func (s *SessionIssuer) issueAccessToken(user *entities.User, scope *SessionScope) (*services.IssuedToken, error) {
+	accessToken, err := s.tokenService.GenerateAccessToken(services.AccessTokenClaims{
+		UserID:         user.ID,
+		RoleCode:       scope.RoleCode,
+		OrganizationID: scope.OrganizationID,
+	})
+	if err != nil {
+		log.Printf("[SessionIssuer] Error generating access token: userID=%d, error=%v", user.ID, err)
+		return nil, err
+	}
+
+	return accessToken, nil
+}
+
+// newRefreshToken generates an opaque refresh token and the entity storing its hash
+func (s *SessionIssuer) newRefreshToken(userID int, familyID string, scope *SessionScope, client auth.ClientInfo) (*entities.RefreshToken, *services.IssuedToken, error) {
+	issued, err := s.tokenService.GenerateRefreshToken()
+	if err != nil {
+		log.Printf("[SessionIssuer] Error generating refresh token: userID=%d, error=%v", userID, err)
+		return nil, nil, err
+	}
+
+	refreshToken := &entities.RefreshToken{
+		UserID:         userID,
+		OrganizationID: scope.OrganizationID,
+		FamilyID:       familyID,
+		TokenHash:      s.tokenService.HashToken(issued.Token),
+		DeviceName:     optionalString(client.DeviceName, 100),
+		UserAgent:      optionalString(client.UserAgent, 255),
+		IPAddress:      optionalString(client.IPAddress, 45),
+		ExpiresAt:      issued.ExpiresAt,
+		CreatedDate:    time.Now(),
+		RecordStatus:   constants.RecordStatus.Active,
+	}
+
+	return refreshToken, issued, nil
+}
+
+// generateTokenFamilyID generates a random identifier for a refresh token family (32 hex characters)
+func generateTokenFamilyID() (string, error) {
+	familyBytes := make([]byte, 16)
+	if _, err := rand.Read(familyBytes); err != nil {
+		return "", fmt.Errorf("failed to generate token family id: %w", err)
+	}
+	return hex.EncodeToString(familyBytes), nil
+}
+
+// optionalString returns nil for empty values and truncates the rest to the column size
+// Truncation counts bytes but only cuts on rune boundaries, so multi-byte characters are never split
+func optionalString(value string, maxLength int) *string {
+	if value == "" {
+		return nil
+	}
+	if len(value) > maxLength {
+		cut := 0
+		for cut < len(value) {
+			_, size := utf8.DecodeRuneInString(value[cut:])
+			if cut+size > maxLength {
+				break
+			}
+			cut += size
+		}
+		value = value[:cut]
+	}
+	return &value
+}
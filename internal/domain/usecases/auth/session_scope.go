@@ -0,0 +1,46 @@
+package auth
+
+import (
+	"citary-backend/internal/domain/entities"
+	"context"
+	"log"
+)
+
+// SessionScope is the organization a session acts within and the role the caller holds there
+// OrganizationID is nil when the session acts outside of any organization, with the user's own role
+type SessionScope struct {
+	RoleCode       string
+	OrganizationID *int
+}
+
+// resolveScope determines the scope of a session for the requested organization
+// Without an active membership in that organization the session falls back to the user's own role
+// Returns (nil, nil) if the user's own role no longer exists - callers decide if that's an error
+func (s *SessionIssuer) resolveScope(ctx context.Context, user *entities.User, organizationID *int) (*SessionScope, error) {
+	if organizationID != nil {
+		membership, err := s.membershipRepository.FindByUserAndOrganization(ctx, user.ID, *organizationID)
+		if err != nil {
+			log.Printf("[SessionScope] Error finding membership: userID=%d, organizationID=%d, error=%v", user.ID, *organizationID, err)
+			return nil, err
+		}
+
+		if membership != nil && membership.IsActive() {
+			return &SessionScope{RoleCode: membership.RoleCode, OrganizationID: &membership.OrganizationID}, nil
+		}
+
+		log.Printf("[SessionScope] No active membership, using the user's own role: userID=%d, organizationID=%d", user.ID, *organizationID)
+	}
+
+	role, err := s.roleRepository.FindByID(ctx, user.RoleID)
+	if err != nil {
+		log.Printf("[SessionScope] Error fetching role: roleID=%d, error=%v", user.RoleID, err)
+		return nil, err
+	}
+
+	if role == nil {
+		log.Printf("[SessionScope] Role not found: roleID=%d", user.RoleID)
+		return nil, nil
+	}
+
+	return &SessionScope{RoleCode: role.Code}, nil
+}
@@ -0,0 +1,72 @@
+package auth
+
+import (
+	"citary-backend/internal/domain/errors"
+	"citary-backend/internal/domain/repositories"
+	"citary-backend/pkg/constants"
+	"citary-backend/pkg/totp"
+	"context"
+	"log"
+)
+
+// TwoFactorSetup represents a pending two-factor enrollment shown to the user
+type TwoFactorSetup struct {
+	Secret string
+	URI    string
+}
+
+// SetupTwoFactorUseCase handles the business logic for starting a two-factor enrollment
+type SetupTwoFactorUseCase struct {
+	userRepository repositories.UserRepository
+}
+
+// NewSetupTwoFactorUseCase creates a new instance of SetupTwoFactorUseCase
+func NewSetupTwoFactorUseCase(userRepository repositories.UserRepository) *SetupTwoFactorUseCase {
+	return &SetupTwoFactorUseCase{
+		userRepository: userRepository,
+	}
+}
+
+// Execute generates a new TOTP secret for the user and stores it pending confirmation
+// Calling it again before confirming replaces the pending secret
+func (uc *SetupTwoFactorUseCase) Execute(ctx context.Context, userID int) (*TwoFactorSetup, error) {
+	log.Printf("[SetupTwoFactorUseCase] Execute: userID=%d", userID)
+
+	// 1. Find the user
+	user, err := uc.userRepository.FindByID(ctx, userID)
+	if err != nil {
+		log.Printf("[SetupTwoFactorUseCase] Error finding user: %v", err)
+		return nil, err
+	}
+
+	if user == nil {
+		log.Printf("[SetupTwoFactorUseCase] User not found: userID=%d", userID)
+		return nil, errors.ErrNotFound(constants.ErrorMessages.UserNotFound)
+	}
+
+	// 2. Business validation: two-factor must not be enabled already
+	if user.TwoFactorEnabled {
+		log.Printf("[SetupTwoFactorUseCase] Two-factor already enabled: userID=%d", userID)
+		return nil, errors.ErrConflict(constants.ErrorMessages.TwoFactorAlreadyEnabled)
+	}
+
+	// 3. Generate the secret
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		log.Printf("[SetupTwoFactorUseCase] Error generating secret: %v", err)
+		return nil, errors.ErrInternal(err)
+	}
+
+	// 4. Store it as pending (enabled stays false until a code is confirmed)
+	if err := uc.userRepository.UpdateTwoFactor(ctx, userID, false, &secret); err != nil {
+		log.Printf("[SetupTwoFactorUseCase] Error storing secret: userID=%d, error=%v", userID, err)
+		return nil, err
+	}
+
+	log.Printf("[SetupTwoFactorUseCase] Two-factor setup started: userID=%d", userID)
+
+	return &TwoFactorSetup{
+		Secret: secret,
+		URI:    totp.KeyURI(constants.TwoFactorConfig.Issuer, user.Email, secret),
+	}, nil
+}
@@ -6,6 +6,7 @@ import (
 	"citary-backend/internal/domain/errors"
 	"citary-backend/internal/domain/repositories"
 	"citary-backend/internal/domain/services"
+	"citary-backend/internal/domain/usecases/legal"
 	"citary-backend/pkg/constants"
 	"context"
 	"crypto/rand"
@@ -19,9 +20,13 @@ import (
 
 // SignupUserUseCase handles the business logic for user registration
 type SignupUserUseCase struct {
-	userRepository repositories.UserRepository
-	roleRepository repositories.RoleRepository
-	emailService   services.EmailService
+	userRepository      repositories.UserRepository
+	roleRepository      repositories.RoleRepository
+	emailService        services.EmailService
+	userTokenRepository repositories.UserTokenRepository
+	userEventRepository repositories.UserEventRepository
+	tokenService        services.TokenService
+	consentRecorder     *legal.ConsentRecorder
 }
 
 // NewSignupUserUseCase creates a new instance of SignupUserUseCase
@@ -29,11 +34,19 @@ func NewSignupUserUseCase(
 	userRepository repositories.UserRepository,
 	roleRepository repositories.RoleRepository,
 	emailService services.EmailService,
+	userTokenRepository repositories.UserTokenRepository,
+	userEventRepository repositories.UserEventRepository,
+	tokenService services.TokenService,
+	consentRecorder *legal.ConsentRecorder,
 ) *SignupUserUseCase {
 	return &SignupUserUseCase{
-		userRepository: userRepository,
-		roleRepository: roleRepository,
-		emailService:   emailService,
+		userRepository:      userRepository,
+		roleRepository:      roleRepository,
+		emailService:        emailService,
+		userTokenRepository: userTokenRepository,
+		userEventRepository: userEventRepository,
+		tokenService:        tokenService,
+		consentRecorder:     consentRecorder,
 	}
 }
 
@@ -62,12 +75,26 @@ func (uc *SignupUserUseCase) Execute(ctx context.Context, dto auth.SignupRequest
 			log.Printf("[SignupUserUseCase] User already exists and is active: email=%s", dto.Email)
 			return nil, errors.ErrConflict(constants.ErrorMessages.UserAlreadyExists)
 		}
-		// User exists but is inactive - could reactivate or return error based on business rules
+		// User exists but is inactive - email a reactivation link instead of creating a duplicate account
 		log.Printf("[SignupUserUseCase] User exists but is inactive: email=%s, status=%s", dto.Email, existingUser.RecordStatus)
-		return nil, errors.ErrConflict("User account exists but is inactive")
+		if err := uc.sendReactivationLink(ctx, existingUser); err != nil {
+			return nil, err
+		}
+		return nil, errors.ErrConflict(constants.ErrorMessages.AccountInactiveReactivationSent)
+	}
+
+	// 3. Check the accepted legal documents are the current versions
+	legalDocuments, missingDocuments, err := uc.consentRecorder.Resolve(ctx, dto.AcceptedDocuments())
+	if err != nil {
+		return nil, err
+	}
+
+	if len(missingDocuments) > 0 {
+		log.Printf("[SignupUserUseCase] Not every current legal document was accepted: email=%s, missing=%d", dto.Email, len(missingDocuments))
+		return nil, errors.ErrBadRequest(constants.ErrorMessages.LegalAcceptanceRequired)
 	}
 
-	// 3. Get default role (BUSINESS LOGIC - validate both physical and logical existence)
+	// 4. Get default role (BUSINESS LOGIC - validate both physical and logical existence)
 	defaultRole, err := uc.roleRepository.FindByCode(ctx, constants.DefaultUserRole)
 	if err != nil {
 		// Technical error from repository
@@ -89,24 +116,26 @@ func (uc *SignupUserUseCase) Execute(ctx context.Context, dto auth.SignupRequest
 
 	log.Printf("[SignupUserUseCase] Using role: code=%s, id=%d, name=%s", defaultRole.Code, defaultRole.ID, defaultRole.Name)
 
-	// 4. Hash password
+	// 5. Hash password
 	hashedPassword, err := hashPassword(dto.Password)
 	if err != nil {
 		log.Printf("[SignupUserUseCase] Error hashing password: %v", err)
 		return nil, errors.ErrInternal(err)
 	}
 
-	// 5. Generate verification token (32 bytes = 64 hex characters)
-	verificationToken, err := generateVerificationToken()
+	// 6. Generate verification token (32 bytes = 64 hex characters)
+	verificationToken, err := generateSecureToken()
 	if err != nil {
 		log.Printf("[SignupUserUseCase] Error generating verification token: %v", err)
 		return nil, errors.ErrInternal(err)
 	}
 
-	// 6. Set token expiration to 24 hours from now
-	tokenExpiresAt := time.Now().Add(24 * time.Hour)
+	// 7. Set token expiration to 24 hours from now
+	now := time.Now()
+	tokenExpiresAt := now.Add(constants.VerificationConfig.TokenTTL)
+	termsAcceptedAt, privacyAcceptedAt := legal.AcceptanceTimestamps(legalDocuments, now)
 
-	// 7. Create user entity
+	// 8. Create user entity
 	user := &entities.User{
 		RoleID:                     defaultRole.ID,
 		Email:                      dto.Email,
@@ -114,22 +143,27 @@ func (uc *SignupUserUseCase) Execute(ctx context.Context, dto auth.SignupRequest
 		EmailVerified:              false,
 		VerificationToken:          &verificationToken,
 		VerificationTokenExpiresAt: &tokenExpiresAt,
+		VerificationSentAt:         &now,
+		VerificationSendCount:      1,
 		PhoneVerified:              false,
 		TwoFactorEnabled:           false,
 		LoginAttempts:              0,
-		CreatedDate:                time.Now(),
+		TermsAcceptedAt:            termsAcceptedAt,
+		PrivacyAcceptedAt:          privacyAcceptedAt,
+		CreatedDate:                now,
 		RecordStatus:               constants.RecordStatus.Active,
 	}
 
-	// 8. Persist the user
-	if err := uc.userRepository.Create(ctx, user); err != nil {
+	// 9. Persist the user together with the consent history, so no account exists without its consents
+	consents := legal.Consents(0, legalDocuments, now, dto.IPAddress, dto.UserAgent)
+	if err := uc.userRepository.CreateWithConsents(ctx, user, consents); err != nil {
 		log.Printf("[SignupUserUseCase] Error creating user: %v", err)
 		return nil, err
 	}
 
-	log.Printf("[SignupUserUseCase] User created successfully: email=%s, userID=%d, roleID=%d", user.Email, user.ID, user.RoleID)
+	log.Printf("[SignupUserUseCase] User created successfully: email=%s, userID=%d, roleID=%d, consents=%d", user.Email, user.ID, user.RoleID, len(consents))
 
-	// 9. Send verification email (non-blocking - if it fails, user is still created)
+	// 10. Send verification email (non-blocking - if it fails, user is still created)
 	if err := uc.emailService.SendVerificationEmail(ctx, user.Email, verificationToken); err != nil {
 		// Log the error but don't fail the signup - user is already created
 		log.Printf("[SignupUserUseCase] WARNING: Failed to send verification email to %s: %v", user.Email, err)
@@ -141,22 +175,71 @@ func (uc *SignupUserUseCase) Execute(ctx context.Context, dto auth.SignupRequest
 	return user, nil
 }
 
+// sendReactivationLink issues a single-use reactivation token for an inactive user and emails the link
+// Links are subject to the same resend cooldown and daily cap as verification emails; a rate limited
+// request sends nothing and the caller gets the same answer
+func (uc *SignupUserUseCase) sendReactivationLink(ctx context.Context, user *entities.User) error {
+	reactivationToken, err := generateSecureToken()
+	if err != nil {
+		log.Printf("[SignupUserUseCase] Error generating reactivation token: %v", err)
+		return errors.ErrInternal(err)
+	}
+
+	now := time.Now()
+	userToken := &entities.UserToken{
+		UserID:       user.ID,
+		Purpose:      constants.UserTokenPurpose.Reactivation,
+		TokenHash:    uc.tokenService.HashToken(reactivationToken),
+		ExpiresAt:    now.Add(constants.ReactivationConfig.TokenTTL),
+		CreatedDate:  now,
+		RecordStatus: constants.RecordStatus.Active,
+	}
+
+	// Only the most recent link stays valid
+	rotated, err := uc.userTokenRepository.Rotate(
+		ctx,
+		userToken,
+		constants.ReactivationConfig.ResendCooldown,
+		constants.ReactivationConfig.MaxSendsPerDay,
+	)
+	if err != nil {
+		log.Printf("[SignupUserUseCase] Error storing reactivation token: userID=%d, error=%v", user.ID, err)
+		return err
+	}
+
+	if !rotated {
+		log.Printf("[SignupUserUseCase] Cooldown active or daily cap reached, skipping reactivation link: userID=%d", user.ID)
+		return nil
+	}
+
+	RecordUserEvent(ctx, uc.userEventRepository, user.ID, constants.UserEventType.ReactivationRequested, "")
+
+	// Failures are logged; the caller gets the same answer and can sign up again to retry
+	if err := uc.emailService.SendReactivationEmail(ctx, user.Email, reactivationToken); err != nil {
+		log.Printf("[SignupUserUseCase] WARNING: Failed to send reactivation email to %s: %v", user.Email, err)
+	} else {
+		log.Printf("[SignupUserUseCase] Reactivation email sent successfully to: %s", user.Email)
+	}
+
+	return nil
+}
+
 // hashPassword generates a bcrypt hash of the given password
 func hashPassword(password string) (string, error) {
 	bytes, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
 	return string(bytes), err
 }
 
-// generateVerificationToken generates a secure random token for email verification
+// generateSecureToken generates a secure random token for emailed links
 // Returns a 64-character hexadecimal string (32 bytes of random data)
-func generateVerificationToken() (string, error) {
+func generateSecureToken() (string, error) {
 	// Create a byte slice of 32 bytes
 	tokenBytes := make([]byte, 32)
 
 	// Fill it with cryptographically secure random bytes
 	_, err := rand.Read(tokenBytes)
 	if err != nil {
-		return "", fmt.Errorf("failed to generate verification token: %w", err)
+		return "", fmt.Errorf("failed to generate secure token: %w", err)
 	}
 
 	// Convert to hexadecimal string (64 characters)
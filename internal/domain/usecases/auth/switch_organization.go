@@ -0,0 +1,129 @@
+package auth
+
+import (
+	"citary-backend/internal/domain/dtos/auth"
+	"citary-backend/internal/domain/errors"
+	"citary-backend/internal/domain/repositories"
+	"citary-backend/internal/domain/services"
+	"citary-backend/pkg/constants"
+	"context"
+	"log"
+)
+
+// SwitchOrganizationUseCase handles the business logic for moving a session into another organization
+// The session's refresh token is rotated into a pair carrying the new organization and the membership role there
+type SwitchOrganizationUseCase struct {
+	userRepository         repositories.UserRepository
+	membershipRepository   repositories.OrganizationMembershipRepository
+	refreshTokenRepository repositories.RefreshTokenRepository
+	tokenService           services.TokenService
+	sessionIssuer          *SessionIssuer
+}
+
+// NewSwitchOrganizationUseCase creates a new instance of SwitchOrganizationUseCase
+func NewSwitchOrganizationUseCase(
+	userRepository repositories.UserRepository,
+	membershipRepository repositories.OrganizationMembershipRepository,
+	refreshTokenRepository repositories.RefreshTokenRepository,
+	tokenService services.TokenService,
+	sessionIssuer *SessionIssuer,
+) *SwitchOrganizationUseCase {
+	return &SwitchOrganizationUseCase{
+		userRepository:         userRepository,
+		membershipRepository:   membershipRepository,
+		refreshTokenRepository: refreshTokenRepository,
+		tokenService:           tokenService,
+		sessionIssuer:          sessionIssuer,
+	}
+}
+
+// Execute rotates the caller's session into the requested organization
+// The organization also becomes the user's default one for future logins
+func (uc *SwitchOrganizationUseCase) Execute(ctx context.Context, userID int, dto auth.SwitchOrganizationRequest) (*LoginResult, error) {
+	log.Printf("[SwitchOrganizationUseCase] Execute: userID=%d", userID)
+
+	// 1. Validate input data
+	if err := dto.Validate(); err != nil {
+		log.Printf("[SwitchOrganizationUseCase] Validation failed: %v", err)
+		return nil, errors.ErrBadRequest(err.Error())
+	}
+
+	// 2. Find the caller's current refresh token
+	current, err := uc.refreshTokenRepository.FindByTokenHash(ctx, uc.tokenService.HashToken(dto.RefreshToken))
+	if err != nil {
+		log.Printf("[SwitchOrganizationUseCase] Error finding refresh token: %v", err)
+		return nil, err
+	}
+
+	if current == nil || current.UserID != userID || current.IsRevoked() || current.IsExpired() {
+		log.Printf("[SwitchOrganizationUseCase] Refresh token not found, foreign, revoked or expired: userID=%d", userID)
+		return nil, errors.ErrUnauthorized(constants.ErrorMessages.RefreshTokenInvalid)
+	}
+
+	// 3. Find the user
+	user, err := uc.userRepository.FindByID(ctx, userID)
+	if err != nil {
+		log.Printf("[SwitchOrganizationUseCase] Error finding user: %v", err)
+		return nil, err
+	}
+
+	if user == nil || !user.IsActive() {
+		log.Printf("[SwitchOrganizationUseCase] User not found or inactive: userID=%d", userID)
+		return nil, errors.ErrUnauthorized(constants.ErrorMessages.RefreshTokenInvalid)
+	}
+
+	// 4. Business validation: only organizations the user is an active member of can be entered
+	if dto.OrganizationID != nil {
+		membership, err := uc.membershipRepository.FindByUserAndOrganization(ctx, userID, *dto.OrganizationID)
+		if err != nil {
+			log.Printf("[SwitchOrganizationUseCase] Error finding membership: %v", err)
+			return nil, err
+		}
+
+		if membership == nil || !membership.IsActive() {
+			log.Printf("[SwitchOrganizationUseCase] Not a member: userID=%d, organizationID=%d", userID, *dto.OrganizationID)
+			return nil, errors.ErrForbidden(constants.ErrorMessages.NotOrganizationMember)
+		}
+	}
+
+	// 5. Resolve the new scope
+	scope, err := uc.sessionIssuer.resolveScope(ctx, user, dto.OrganizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	if scope == nil {
+		return nil, errors.ErrUnauthorized(constants.ErrorMessages.RefreshTokenInvalid)
+	}
+
+	// 6. Rotate the session into the new scope
+	session, rotated, err := uc.sessionIssuer.Rotate(ctx, current, user, scope, dto.ClientInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	// Lost a race against another request presenting the same token: treat it as reuse
+	if !rotated {
+		log.Printf("[SwitchOrganizationUseCase] SECURITY: concurrent rotation detected, revoking family: familyID=%s", current.FamilyID)
+		if err := uc.refreshTokenRepository.RevokeFamily(ctx, current.FamilyID); err != nil {
+			return nil, err
+		}
+		return nil, errors.ErrUnauthorized(constants.ErrorMessages.RefreshTokenReused)
+	}
+
+	// 7. Remember the choice for future logins
+	if err := uc.userRepository.UpdateDefaultOrganization(ctx, userID, scope.OrganizationID); err != nil {
+		log.Printf("[SwitchOrganizationUseCase] Error storing default organization: userID=%d, error=%v", userID, err)
+		return nil, err
+	}
+	user.OrganizationID = scope.OrganizationID
+
+	log.Printf("[SwitchOrganizationUseCase] Organization switched: userID=%d, role=%s", userID, scope.RoleCode)
+
+	return &LoginResult{
+		User:           user,
+		RoleCode:       scope.RoleCode,
+		OrganizationID: scope.OrganizationID,
+		Session:        session,
+	}, nil
+}
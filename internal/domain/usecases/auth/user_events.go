@@ -0,0 +1,26 @@
+package auth
+
+import (
+	"citary-backend/internal/domain/entities"
+	"citary-backend/internal/domain/repositories"
+	"citary-backend/pkg/constants"
+	"context"
+	"log"
+	"time"
+)
+
+// RecordUserEvent appends an entry to the user's audit trail
+// It is shared by every use case that audits account changes; failures are logged and do not undo the action being audited
+func RecordUserEvent(ctx context.Context, userEventRepository repositories.UserEventRepository, userID int, eventType, ipAddress string) {
+	event := &entities.UserEvent{
+		UserID:       userID,
+		Type:         eventType,
+		IPAddress:    optionalString(ipAddress, 45),
+		CreatedDate:  time.Now(),
+		RecordStatus: constants.RecordStatus.Active,
+	}
+
+	if err := userEventRepository.Create(ctx, event); err != nil {
+		log.Printf("[UserEvent] WARNING: Failed to record event: userID=%d, type=%s, error=%v", userID, eventType, err)
+	}
+}
@@ -0,0 +1,135 @@
+package auth
+
+import (
+	"citary-backend/internal/domain/dtos/auth"
+	"citary-backend/internal/domain/entities"
+	"citary-backend/internal/domain/errors"
+	"citary-backend/internal/domain/repositories"
+	"citary-backend/internal/domain/services"
+	"citary-backend/pkg/constants"
+	"context"
+	"log"
+	"time"
+)
+
+// VerifyEmailUseCase handles the business logic for confirming a user's email address
+type VerifyEmailUseCase struct {
+	userRepository      repositories.UserRepository
+	userTokenRepository repositories.UserTokenRepository
+	tokenService        services.TokenService
+}
+
+// NewVerifyEmailUseCase creates a new instance of VerifyEmailUseCase
+func NewVerifyEmailUseCase(
+	userRepository repositories.UserRepository,
+	userTokenRepository repositories.UserTokenRepository,
+	tokenService services.TokenService,
+) *VerifyEmailUseCase {
+	return &VerifyEmailUseCase{
+		userRepository:      userRepository,
+		userTokenRepository: userTokenRepository,
+		tokenService:        tokenService,
+	}
+}
+
+// Execute consumes a verification token issued at signup and marks the owner's email as verified
+// Consuming clears the token; presenting it again succeeds if the email is still verified, and is
+// rejected as used otherwise
+func (uc *VerifyEmailUseCase) Execute(ctx context.Context, dto auth.VerifyEmailRequest) (*entities.User, error) {
+	log.Printf("[VerifyEmailUseCase] Execute")
+
+	// 1. Validate input data
+	if err := dto.Validate(); err != nil {
+		log.Printf("[VerifyEmailUseCase] Validation failed: %v", err)
+		return nil, errors.ErrBadRequest(err.Error())
+	}
+
+	// 2. Find the user holding the token
+	user, err := uc.userRepository.FindByVerificationToken(ctx, dto.Token)
+	if err != nil {
+		log.Printf("[VerifyEmailUseCase] Error finding user by token: %v", err)
+		return nil, err
+	}
+
+	// Business validation: a token no user holds is unknown, superseded or already used
+	if user == nil {
+		return uc.answerUsedToken(ctx, dto.Token)
+	}
+
+	// Business validation: inactive accounts cannot be verified
+	if !user.IsActive() {
+		log.Printf("[VerifyEmailUseCase] User is inactive: userID=%d, status=%s", user.ID, user.RecordStatus)
+		return nil, errors.ErrBadRequest(constants.ErrorMessages.VerificationTokenInvalid)
+	}
+
+	// 3. Idempotency: an already verified account is a successful outcome
+	if user.EmailVerified {
+		log.Printf("[VerifyEmailUseCase] Email already verified: userID=%d", user.ID)
+		return user, nil
+	}
+
+	// 4. Reject expired tokens
+	if user.VerificationTokenExpiresAt == nil || user.VerificationTokenExpiresAt.Before(time.Now()) {
+		log.Printf("[VerifyEmailUseCase] Token expired: userID=%d", user.ID)
+		return nil, errors.ErrGone(constants.ErrorMessages.VerificationTokenExpired)
+	}
+
+	// 5. Mark the email as verified and consume the token, unless it was rotated, expired or used since it was read
+	now := time.Now()
+	consumed := &entities.UserToken{
+		Purpose:      constants.UserTokenPurpose.EmailVerification,
+		TokenHash:    uc.tokenService.HashToken(dto.Token),
+		ExpiresAt:    *user.VerificationTokenExpiresAt,
+		UsedAt:       &now,
+		CreatedDate:  now,
+		RecordStatus: constants.RecordStatus.Active,
+	}
+
+	verified, err := uc.userRepository.MarkEmailVerified(ctx, user.ID, dto.Token, consumed)
+	if err != nil {
+		log.Printf("[VerifyEmailUseCase] Error marking email verified: userID=%d, error=%v", user.ID, err)
+		return nil, err
+	}
+
+	if !verified {
+		log.Printf("[VerifyEmailUseCase] Token no longer current while verifying: userID=%d", user.ID)
+		return uc.answerUsedToken(ctx, dto.Token)
+	}
+
+	user.EmailVerified = true
+	user.VerificationToken = nil
+	user.VerificationTokenExpiresAt = nil
+
+	log.Printf("[VerifyEmailUseCase] Email verified successfully: userID=%d, email=%s", user.ID, user.Email)
+	return user, nil
+}
+
+// answerUsedToken answers a token that is no longer any user's current verification token
+// A token that verified an email which is still verified is answered idempotently with its user;
+// one that verified an email since changed or deactivated is rejected as used, and any other token as invalid
+func (uc *VerifyEmailUseCase) answerUsedToken(ctx context.Context, token string) (*entities.User, error) {
+	used, err := uc.userTokenRepository.FindByTokenHash(ctx, constants.UserTokenPurpose.EmailVerification, uc.tokenService.HashToken(token))
+	if err != nil {
+		log.Printf("[VerifyEmailUseCase] Error finding used token: %v", err)
+		return nil, err
+	}
+
+	if used == nil {
+		log.Printf("[VerifyEmailUseCase] Token not found or superseded")
+		return nil, errors.ErrBadRequest(constants.ErrorMessages.VerificationTokenInvalid)
+	}
+
+	user, err := uc.userRepository.FindByID(ctx, used.UserID)
+	if err != nil {
+		log.Printf("[VerifyEmailUseCase] Error finding user: userID=%d, error=%v", used.UserID, err)
+		return nil, err
+	}
+
+	if user == nil || !user.IsActive() || !user.EmailVerified {
+		log.Printf("[VerifyEmailUseCase] Token already used: userID=%d", used.UserID)
+		return nil, errors.ErrConflict(constants.ErrorMessages.VerificationTokenUsed)
+	}
+
+	log.Printf("[VerifyEmailUseCase] Token already used, email still verified: userID=%d", user.ID)
+	return user, nil
+}
@@ -0,0 +1,113 @@
+package auth
+
+import (
+	"citary-backend/internal/domain/dtos/auth"
+	"citary-backend/internal/domain/errors"
+	"citary-backend/internal/domain/repositories"
+	"citary-backend/internal/domain/services"
+	"citary-backend/pkg/constants"
+	"citary-backend/pkg/totp"
+	"context"
+	"log"
+	"time"
+)
+
+// VerifyTwoFactorLoginUseCase handles the second login step for users with two-factor enabled
+type VerifyTwoFactorLoginUseCase struct {
+	userRepository         repositories.UserRepository
+	recoveryCodeRepository repositories.RecoveryCodeRepository
+	tokenService           services.TokenService
+	sessionIssuer          *SessionIssuer
+	lockoutPolicy          LockoutPolicy
+}
+
+// NewVerifyTwoFactorLoginUseCase creates a new instance of VerifyTwoFactorLoginUseCase
+func NewVerifyTwoFactorLoginUseCase(
+	userRepository repositories.UserRepository,
+	recoveryCodeRepository repositories.RecoveryCodeRepository,
+	tokenService services.TokenService,
+	sessionIssuer *SessionIssuer,
+	lockoutPolicy LockoutPolicy,
+) *VerifyTwoFactorLoginUseCase {
+	return &VerifyTwoFactorLoginUseCase{
+		userRepository:         userRepository,
+		recoveryCodeRepository: recoveryCodeRepository,
+		tokenService:           tokenService,
+		sessionIssuer:          sessionIssuer,
+		lockoutPolicy:          lockoutPolicy,
+	}
+}
+
+// Execute exchanges an MFA challenge token plus an authenticator or recovery code for a session
+// Wrong codes count towards the same lockout as wrong passwords
+func (uc *VerifyTwoFactorLoginUseCase) Execute(ctx context.Context, dto auth.VerifyTwoFactorLoginRequest) (*LoginResult, error) {
+	log.Printf("[VerifyTwoFactorLoginUseCase] Execute")
+
+	// 1. Validate input data
+	if err := dto.Validate(); err != nil {
+		log.Printf("[VerifyTwoFactorLoginUseCase] Validation failed: %v", err)
+		return nil, errors.ErrBadRequest(err.Error())
+	}
+
+	// 2. Resolve the challenge
+	userID, err := uc.tokenService.ParseMFAChallengeToken(dto.MFAToken)
+	if err != nil {
+		log.Printf("[VerifyTwoFactorLoginUseCase] Invalid challenge token: %v", err)
+		return nil, errors.ErrUnauthorized(constants.ErrorMessages.Unauthorized)
+	}
+
+	user, err := uc.userRepository.FindByID(ctx, userID)
+	if err != nil {
+		log.Printf("[VerifyTwoFactorLoginUseCase] Error finding user: %v", err)
+		return nil, err
+	}
+
+	if user == nil || !user.TwoFactorEnabled || user.TwoFactorSecret == nil {
+		log.Printf("[VerifyTwoFactorLoginUseCase] Challenge no longer applicable: userID=%d", userID)
+		return nil, errors.ErrUnauthorized(constants.ErrorMessages.Unauthorized)
+	}
+
+	// 3. Business validation: the account state may have changed since the password step
+	if user.IsLocked() {
+		log.Printf("[VerifyTwoFactorLoginUseCase] User is locked: userID=%d, lockedUntil=%v", user.ID, *user.LockedUntil)
+		return nil, errors.ErrLocked(constants.ErrorMessages.AccountLocked)
+	}
+
+	if !user.IsActive() {
+		log.Printf("[VerifyTwoFactorLoginUseCase] User is inactive: userID=%d, status=%s", user.ID, user.RecordStatus)
+		return nil, errors.ErrForbidden(constants.ErrorMessages.AccountInactive)
+	}
+
+	// 4. Check the second factor
+	valid, err := uc.checkSecondFactor(ctx, user.ID, *user.TwoFactorSecret, dto)
+	if err != nil {
+		return nil, err
+	}
+
+	if !valid {
+		log.Printf("[VerifyTwoFactorLoginUseCase] Invalid second factor: userID=%d", user.ID)
+		return nil, registerFailedLogin(ctx, uc.userRepository, uc.lockoutPolicy, user, constants.ErrorMessages.TwoFactorCodeInvalid)
+	}
+
+	// 5. Issue the session and record the login
+	return completeLogin(ctx, uc.userRepository, uc.sessionIssuer, user, dto.ClientInfo)
+}
+
+// checkSecondFactor validates the authenticator code, or consumes the recovery code when one was sent instead
+func (uc *VerifyTwoFactorLoginUseCase) checkSecondFactor(ctx context.Context, userID int, secret string, dto auth.VerifyTwoFactorLoginRequest) (bool, error) {
+	if dto.Code != "" {
+		return totp.Validate(secret, dto.Code, time.Now(), constants.TwoFactorConfig.ValidationSkew), nil
+	}
+
+	consumed, err := uc.recoveryCodeRepository.Consume(ctx, userID, uc.tokenService.HashToken(normalizeRecoveryCode(dto.RecoveryCode)))
+	if err != nil {
+		log.Printf("[VerifyTwoFactorLoginUseCase] Error consuming recovery code: userID=%d, error=%v", userID, err)
+		return false, err
+	}
+
+	if consumed {
+		log.Printf("[VerifyTwoFactorLoginUseCase] Recovery code used: userID=%d", userID)
+	}
+
+	return consumed, nil
+}
@@ -0,0 +1,82 @@
+package availability
+
+import (
+	"citary-backend/internal/domain/dtos/availability"
+	"citary-backend/internal/domain/entities"
+	"citary-backend/internal/domain/errors"
+	"citary-backend/internal/domain/repositories"
+	"citary-backend/pkg/constants"
+	"context"
+	"log"
+	"time"
+)
+
+// CreateBlockUseCase handles the business logic for adding weekly working hours to a doctor
+type CreateBlockUseCase struct {
+	doctorRepository       repositories.DoctorRepository
+	locationRepository     repositories.LocationRepository
+	availabilityRepository repositories.AvailabilityRepository
+}
+
+// NewCreateBlockUseCase creates a new instance of CreateBlockUseCase
+func NewCreateBlockUseCase(
+	doctorRepository repositories.DoctorRepository,
+	locationRepository repositories.LocationRepository,
+	availabilityRepository repositories.AvailabilityRepository,
+) *CreateBlockUseCase {
+	return &CreateBlockUseCase{
+		doctorRepository:       doctorRepository,
+		locationRepository:     locationRepository,
+		availabilityRepository: availabilityRepository,
+	}
+}
+
+// Execute adds a recurring weekly block to a doctor of the organization
+// Blocks of the same doctor may not overlap on the same weekday within overlapping validity dates
+func (uc *CreateBlockUseCase) Execute(ctx context.Context, organizationID, doctorID int, dto availability.CreateBlockRequest) (*entities.AvailabilityBlock, error) {
+	log.Printf("[CreateBlockUseCase] Execute: organizationID=%d, doctorID=%d, locationID=%d", organizationID, doctorID, dto.LocationID)
+
+	// 1. Validate input data
+	if err := dto.Validate(); err != nil {
+		log.Printf("[CreateBlockUseCase] Validation failed: %v", err)
+		return nil, errors.ErrBadRequest(err.Error())
+	}
+
+	// 2. The doctor must be an active doctor of the organization
+	doctor, err := findDoctor(ctx, uc.doctorRepository, organizationID, doctorID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !doctor.IsActive() {
+		log.Printf("[CreateBlockUseCase] Doctor inactive: doctorID=%d", doctorID)
+		return nil, errors.ErrNotFound(constants.ErrorMessages.DoctorNotFound)
+	}
+
+	// 3. The location must be an active location of the organization
+	if _, err := findActiveLocation(ctx, uc.locationRepository, organizationID, dto.LocationID); err != nil {
+		return nil, err
+	}
+
+	// 4. Persist the block (the repository rejects overlaps)
+	block := &entities.AvailabilityBlock{
+		DoctorID:     doctorID,
+		LocationID:   dto.LocationID,
+		Weekday:      time.Weekday(*dto.Weekday),
+		StartMinute:  dto.StartMinute(),
+		EndMinute:    dto.EndMinute(),
+		ValidFrom:    dto.ParsedValidFrom(),
+		ValidUntil:   dto.ParsedValidUntil(),
+		CreatedDate:  time.Now(),
+		RecordStatus: constants.RecordStatus.Active,
+	}
+
+	if err := uc.availabilityRepository.CreateBlock(ctx, block); err != nil {
+		log.Printf("[CreateBlockUseCase] Error creating block: doctorID=%d, error=%v", doctorID, err)
+		return nil, err
+	}
+
+	log.Printf("[CreateBlockUseCase] Block created: blockID=%d, doctorID=%d", block.ID, doctorID)
+
+	return block, nil
+}
@@ -0,0 +1,86 @@
+package availability
+
+import (
+	"citary-backend/internal/domain/dtos/availability"
+	"citary-backend/internal/domain/entities"
+	"citary-backend/internal/domain/errors"
+	"citary-backend/internal/domain/repositories"
+	"citary-backend/pkg/constants"
+	"context"
+	"log"
+	"time"
+)
+
+// CreateExceptionUseCase handles the business logic for recording time off or an extra shift for a doctor
+type CreateExceptionUseCase struct {
+	doctorRepository       repositories.DoctorRepository
+	locationRepository     repositories.LocationRepository
+	availabilityRepository repositories.AvailabilityRepository
+}
+
+// NewCreateExceptionUseCase creates a new instance of CreateExceptionUseCase
+func NewCreateExceptionUseCase(
+	doctorRepository repositories.DoctorRepository,
+	locationRepository repositories.LocationRepository,
+	availabilityRepository repositories.AvailabilityRepository,
+) *CreateExceptionUseCase {
+	return &CreateExceptionUseCase{
+		doctorRepository:       doctorRepository,
+		locationRepository:     locationRepository,
+		availabilityRepository: availabilityRepository,
+	}
+}
+
+// Execute adds a date-specific exception to a doctor of the organization
+func (uc *CreateExceptionUseCase) Execute(ctx context.Context, organizationID, doctorID int, dto availability.CreateExceptionRequest) (*entities.AvailabilityException, error) {
+	log.Printf("[CreateExceptionUseCase] Execute: organizationID=%d, doctorID=%d, kind=%s", organizationID, doctorID, dto.Kind)
+
+	// 1. Validate input data
+	if err := dto.Validate(); err != nil {
+		log.Printf("[CreateExceptionUseCase] Validation failed: %v", err)
+		return nil, errors.ErrBadRequest(err.Error())
+	}
+
+	// 2. The doctor must be an active doctor of the organization
+	doctor, err := findDoctor(ctx, uc.doctorRepository, organizationID, doctorID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !doctor.IsActive() {
+		log.Printf("[CreateExceptionUseCase] Doctor inactive: doctorID=%d", doctorID)
+		return nil, errors.ErrNotFound(constants.ErrorMessages.DoctorNotFound)
+	}
+
+	// 3. A location, when given, must be an active location of the organization
+	if dto.LocationID != nil {
+		if _, err := findActiveLocation(ctx, uc.locationRepository, organizationID, *dto.LocationID); err != nil {
+			return nil, err
+		}
+	}
+
+	// 4. Persist the exception
+	startDate, endDate := dto.ParsedDates()
+	startMinute, endMinute := dto.Minutes()
+	exception := &entities.AvailabilityException{
+		DoctorID:     doctorID,
+		LocationID:   dto.LocationID,
+		Kind:         dto.Kind,
+		StartDate:    startDate,
+		EndDate:      endDate,
+		StartMinute:  startMinute,
+		EndMinute:    endMinute,
+		Reason:       dto.Reason,
+		CreatedDate:  time.Now(),
+		RecordStatus: constants.RecordStatus.Active,
+	}
+
+	if err := uc.availabilityRepository.CreateException(ctx, exception); err != nil {
+		log.Printf("[CreateExceptionUseCase] Error creating exception: doctorID=%d, error=%v", doctorID, err)
+		return nil, err
+	}
+
+	log.Printf("[CreateExceptionUseCase] Exception created: exceptionID=%d, doctorID=%d, kind=%s", exception.ID, doctorID, exception.Kind)
+
+	return exception, nil
+}
@@ -0,0 +1,52 @@
+package availability
+
+import (
+	"citary-backend/internal/domain/errors"
+	"citary-backend/internal/domain/repositories"
+	"citary-backend/pkg/constants"
+	"context"
+	"log"
+)
+
+// DeleteBlockUseCase handles the business logic for removing weekly working hours from a doctor
+type DeleteBlockUseCase struct {
+	doctorRepository       repositories.DoctorRepository
+	availabilityRepository repositories.AvailabilityRepository
+}
+
+// NewDeleteBlockUseCase creates a new instance of DeleteBlockUseCase
+func NewDeleteBlockUseCase(
+	doctorRepository repositories.DoctorRepository,
+	availabilityRepository repositories.AvailabilityRepository,
+) *DeleteBlockUseCase {
+	return &DeleteBlockUseCase{
+		doctorRepository:       doctorRepository,
+		availabilityRepository: availabilityRepository,
+	}
+}
+
+// Execute deactivates a weekly availability block of a doctor of the organization
+func (uc *DeleteBlockUseCase) Execute(ctx context.Context, organizationID, doctorID, blockID int) error {
+	log.Printf("[DeleteBlockUseCase] Execute: organizationID=%d, doctorID=%d, blockID=%d", organizationID, doctorID, blockID)
+
+	// 1. The doctor must belong to the organization
+	if _, err := findDoctor(ctx, uc.doctorRepository, organizationID, doctorID); err != nil {
+		return err
+	}
+
+	// 2. Deactivate the block
+	deactivated, err := uc.availabilityRepository.DeactivateBlock(ctx, doctorID, blockID)
+	if err != nil {
+		log.Printf("[DeleteBlockUseCase] Error deactivating block: blockID=%d, error=%v", blockID, err)
+		return err
+	}
+
+	if !deactivated {
+		log.Printf("[DeleteBlockUseCase] Block not found: doctorID=%d, blockID=%d", doctorID, blockID)
+		return errors.ErrNotFound(constants.ErrorMessages.AvailabilityBlockNotFound)
+	}
+
+	log.Printf("[DeleteBlockUseCase] Block removed: blockID=%d, doctorID=%d", blockID, doctorID)
+
+	return nil
+}
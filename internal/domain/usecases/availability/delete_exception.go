@@ -0,0 +1,52 @@
+package availability
+
+import (
+	"citary-backend/internal/domain/errors"
+	"citary-backend/internal/domain/repositories"
+	"citary-backend/pkg/constants"
+	"context"
+	"log"
+)
+
+// DeleteExceptionUseCase handles the business logic for removing an availability exception from a doctor
+type DeleteExceptionUseCase struct {
+	doctorRepository       repositories.DoctorRepository
+	availabilityRepository repositories.AvailabilityRepository
+}
+
+// NewDeleteExceptionUseCase creates a new instance of DeleteExceptionUseCase
+func NewDeleteExceptionUseCase(
+	doctorRepository repositories.DoctorRepository,
+	availabilityRepository repositories.AvailabilityRepository,
+) *DeleteExceptionUseCase {
+	return &DeleteExceptionUseCase{
+		doctorRepository:       doctorRepository,
+		availabilityRepository: availabilityRepository,
+	}
+}
+
+// Execute deactivates an availability exception of a doctor of the organization
+func (uc *DeleteExceptionUseCase) Execute(ctx context.Context, organizationID, doctorID, exceptionID int) error {
+	log.Printf("[DeleteExceptionUseCase] Execute: organizationID=%d, doctorID=%d, exceptionID=%d", organizationID, doctorID, exceptionID)
+
+	// 1. The doctor must belong to the organization
+	if _, err := findDoctor(ctx, uc.doctorRepository, organizationID, doctorID); err != nil {
+		return err
+	}
+
+	// 2. Deactivate the exception
+	deactivated, err := uc.availabilityRepository.DeactivateException(ctx, doctorID, exceptionID)
+	if err != nil {
+		log.Printf("[DeleteExceptionUseCase] Error deactivating exception: exceptionID=%d, error=%v", exceptionID, err)
+		return err
+	}
+
+	if !deactivated {
+		log.Printf("[DeleteExceptionUseCase] Exception not found: doctorID=%d, exceptionID=%d", doctorID, exceptionID)
+		return errors.ErrNotFound(constants.ErrorMessages.AvailabilityExceptionNotFound)
+	}
+
+	log.Printf("[DeleteExceptionUseCase] Exception removed: exceptionID=%d, doctorID=%d", exceptionID, doctorID)
+
+	return nil
+}
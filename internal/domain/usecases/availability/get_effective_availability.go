@@ -0,0 +1,53 @@
+package availability
+
+import (
+	"citary-backend/internal/domain/dtos/availability"
+	"citary-backend/internal/domain/entities"
+	"citary-backend/internal/domain/errors"
+	"citary-backend/internal/domain/repositories"
+	"context"
+	"log"
+)
+
+// GetEffectiveAvailabilityUseCase handles the business logic for resolving when a doctor actually works in a time range
+type GetEffectiveAvailabilityUseCase struct {
+	doctorRepository       repositories.DoctorRepository
+	availabilityRepository repositories.AvailabilityRepository
+}
+
+// NewGetEffectiveAvailabilityUseCase creates a new instance of GetEffectiveAvailabilityUseCase
+func NewGetEffectiveAvailabilityUseCase(
+	doctorRepository repositories.DoctorRepository,
+	availabilityRepository repositories.AvailabilityRepository,
+) *GetEffectiveAvailabilityUseCase {
+	return &GetEffectiveAvailabilityUseCase{
+		doctorRepository:       doctorRepository,
+		availabilityRepository: availabilityRepository,
+	}
+}
+
+// Execute returns the windows a doctor of the organization is available in, weekly hours combined with exceptions
+func (uc *GetEffectiveAvailabilityUseCase) Execute(ctx context.Context, organizationID, doctorID int, query availability.EffectiveAvailabilityQuery) ([]*entities.AvailabilityWindow, error) {
+	log.Printf("[GetEffectiveAvailabilityUseCase] Execute: organizationID=%d, doctorID=%d, from=%s, to=%s", organizationID, doctorID, query.From, query.To)
+
+	// 1. Validate input data
+	if err := query.Validate(); err != nil {
+		log.Printf("[GetEffectiveAvailabilityUseCase] Validation failed: %v", err)
+		return nil, errors.ErrBadRequest(err.Error())
+	}
+
+	// 2. The doctor must belong to the organization
+	if _, err := findDoctor(ctx, uc.doctorRepository, organizationID, doctorID); err != nil {
+		return nil, err
+	}
+
+	// 3. Resolve the availability
+	from, to := query.ParsedRange()
+	windows, err := uc.availabilityRepository.FindEffectiveAvailability(ctx, doctorID, from, to)
+	if err != nil {
+		log.Printf("[GetEffectiveAvailabilityUseCase] Error resolving availability: doctorID=%d, error=%v", doctorID, err)
+		return nil, err
+	}
+
+	return windows, nil
+}
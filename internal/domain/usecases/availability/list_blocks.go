@@ -0,0 +1,83 @@
+package availability
+
+import (
+	"citary-backend/internal/domain/entities"
+	"citary-backend/internal/domain/errors"
+	"citary-backend/internal/domain/repositories"
+	"citary-backend/pkg/constants"
+	"context"
+	"log"
+)
+
+// ListBlocksUseCase handles the business logic for listing a doctor's weekly availability
+type ListBlocksUseCase struct {
+	doctorRepository       repositories.DoctorRepository
+	availabilityRepository repositories.AvailabilityRepository
+}
+
+// NewListBlocksUseCase creates a new instance of ListBlocksUseCase
+func NewListBlocksUseCase(
+	doctorRepository repositories.DoctorRepository,
+	availabilityRepository repositories.AvailabilityRepository,
+) *ListBlocksUseCase {
+	return &ListBlocksUseCase{
+		doctorRepository:       doctorRepository,
+		availabilityRepository: availabilityRepository,
+	}
+}
+
+// Execute returns the active weekly availability blocks of a doctor of the organization
+func (uc *ListBlocksUseCase) Execute(ctx context.Context, organizationID, doctorID int) ([]*entities.AvailabilityBlock, error) {
+	log.Printf("[ListBlocksUseCase] Execute: organizationID=%d, doctorID=%d", organizationID, doctorID)
+
+	// 1. The doctor must belong to the organization
+	if _, err := findDoctor(ctx, uc.doctorRepository, organizationID, doctorID); err != nil {
+		return nil, err
+	}
+
+	// 2. List the blocks
+	blocks, err := uc.availabilityRepository.FindBlocksByDoctor(ctx, doctorID)
+	if err != nil {
+		log.Printf("[ListBlocksUseCase] Error listing blocks: doctorID=%d, error=%v", doctorID, err)
+		return nil, err
+	}
+
+	return blocks, nil
+}
+
+// findDoctor loads a doctor profile of an organization or returns a not-found error
+func findDoctor(ctx context.Context, doctorRepository repositories.DoctorRepository, organizationID, doctorID int) (*entities.Doctor, error) {
+	doctor, err := doctorRepository.FindByID(ctx, organizationID, doctorID)
+	if err != nil {
+		log.Printf("[Availability] Error finding doctor: organizationID=%d, doctorID=%d, error=%v", organizationID, doctorID, err)
+		return nil, err
+	}
+
+	if doctor == nil {
+		log.Printf("[Availability] Doctor not found: organizationID=%d, doctorID=%d", organizationID, doctorID)
+		return nil, errors.ErrNotFound(constants.ErrorMessages.DoctorNotFound)
+	}
+
+	return doctor, nil
+}
+
+// findActiveLocation loads an active location of an organization or returns an error
+func findActiveLocation(ctx context.Context, locationRepository repositories.LocationRepository, organizationID, locationID int) (*entities.Location, error) {
+	location, err := locationRepository.FindByID(ctx, organizationID, locationID)
+	if err != nil {
+		log.Printf("[Availability] Error finding location: organizationID=%d, locationID=%d, error=%v", organizationID, locationID, err)
+		return nil, err
+	}
+
+	if location == nil {
+		log.Printf("[Availability] Location not found: organizationID=%d, locationID=%d", organizationID, locationID)
+		return nil, errors.ErrNotFound(constants.ErrorMessages.LocationNotFound)
+	}
+
+	if !location.IsActive() {
+		log.Printf("[Availability] Location inactive: locationID=%d", locationID)
+		return nil, errors.ErrBadRequest(constants.ErrorMessages.LocationInactive)
+	}
+
+	return location, nil
+}
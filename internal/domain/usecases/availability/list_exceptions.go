@@ -0,0 +1,46 @@
+package availability
+
+import (
+	"citary-backend/internal/domain/entities"
+	"citary-backend/internal/domain/repositories"
+	"context"
+	"log"
+	"time"
+)
+
+// ListExceptionsUseCase handles the business logic for listing a doctor's upcoming availability exceptions
+type ListExceptionsUseCase struct {
+	doctorRepository       repositories.DoctorRepository
+	availabilityRepository repositories.AvailabilityRepository
+}
+
+// NewListExceptionsUseCase creates a new instance of ListExceptionsUseCase
+func NewListExceptionsUseCase(
+	doctorRepository repositories.DoctorRepository,
+	availabilityRepository repositories.AvailabilityRepository,
+) *ListExceptionsUseCase {
+	return &ListExceptionsUseCase{
+		doctorRepository:       doctorRepository,
+		availabilityRepository: availabilityRepository,
+	}
+}
+
+// Execute returns the active exceptions of a doctor of the organization that have not ended yet
+// Exceptions ending yesterday (UTC) are still included so no timezone loses today's entries
+func (uc *ListExceptionsUseCase) Execute(ctx context.Context, organizationID, doctorID int) ([]*entities.AvailabilityException, error) {
+	log.Printf("[ListExceptionsUseCase] Execute: organizationID=%d, doctorID=%d", organizationID, doctorID)
+
+	// 1. The doctor must belong to the organization
+	if _, err := findDoctor(ctx, uc.doctorRepository, organizationID, doctorID); err != nil {
+		return nil, err
+	}
+
+	// 2. List the exceptions
+	exceptions, err := uc.availabilityRepository.FindExceptionsByDoctor(ctx, doctorID, time.Now().UTC().AddDate(0, 0, -1))
+	if err != nil {
+		log.Printf("[ListExceptionsUseCase] Error listing exceptions: doctorID=%d, error=%v", doctorID, err)
+		return nil, err
+	}
+
+	return exceptions, nil
+}
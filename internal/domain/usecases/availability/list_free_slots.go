@@ -0,0 +1,100 @@
+package availability
+
+import (
+	"citary-backend/internal/domain/dtos/availability"
+	"citary-backend/internal/domain/entities"
+	"citary-backend/internal/domain/errors"
+	"citary-backend/internal/domain/repositories"
+	"citary-backend/pkg/constants"
+	"context"
+	"log"
+	"time"
+)
+
+// ListFreeSlotsUseCase handles the business logic for listing the times a doctor can be booked at
+type ListFreeSlotsUseCase struct {
+	doctorRepository       repositories.DoctorRepository
+	membershipRepository   repositories.OrganizationMembershipRepository
+	availabilityRepository repositories.AvailabilityRepository
+}
+
+// NewListFreeSlotsUseCase creates a new instance of ListFreeSlotsUseCase
+func NewListFreeSlotsUseCase(
+	doctorRepository repositories.DoctorRepository,
+	membershipRepository repositories.OrganizationMembershipRepository,
+	availabilityRepository repositories.AvailabilityRepository,
+) *ListFreeSlotsUseCase {
+	return &ListFreeSlotsUseCase{
+		doctorRepository:       doctorRepository,
+		membershipRepository:   membershipRepository,
+		availabilityRepository: availabilityRepository,
+	}
+}
+
+// Execute returns the bookable slots of a publicly listed doctor within the requested range
+// Slots last one consultation and are separated by the doctor's buffer; the range is narrowed
+// to the booking window, so every returned slot can be booked as is
+func (uc *ListFreeSlotsUseCase) Execute(ctx context.Context, doctorID int, query availability.SlotsQuery) ([]*entities.AvailabilitySlot, error) {
+	log.Printf("[ListFreeSlotsUseCase] Execute: doctorID=%d, from=%s, to=%s", doctorID, query.From, query.To)
+
+	// 1. Validate input data
+	if err := query.Validate(); err != nil {
+		log.Printf("[ListFreeSlotsUseCase] Validation failed: %v", err)
+		return nil, errors.ErrBadRequest(err.Error())
+	}
+
+	// 2. The doctor must be publicly listed
+	doctor, err := uc.doctorRepository.FindPublicByID(ctx, doctorID)
+	if err != nil {
+		log.Printf("[ListFreeSlotsUseCase] Error finding doctor: doctorID=%d, error=%v", doctorID, err)
+		return nil, err
+	}
+
+	if doctor == nil {
+		log.Printf("[ListFreeSlotsUseCase] Doctor not found: doctorID=%d", doctorID)
+		return nil, errors.ErrNotFound(constants.ErrorMessages.DoctorNotFound)
+	}
+
+	// 3. A provider who no longer holds the doctor role cannot be booked
+	membership, err := uc.membershipRepository.FindByUserAndOrganization(ctx, doctor.UserID, doctor.OrganizationID)
+	if err != nil {
+		log.Printf("[ListFreeSlotsUseCase] Error checking membership: userID=%d, error=%v", doctor.UserID, err)
+		return nil, err
+	}
+
+	if membership == nil || !membership.IsActive() || membership.RoleCode != constants.RoleCodes.Doctor {
+		log.Printf("[ListFreeSlotsUseCase] Provider is not an active doctor: doctorID=%d, userID=%d", doctor.ID, doctor.UserID)
+		return []*entities.AvailabilitySlot{}, nil
+	}
+
+	// 4. Narrow the range to the booking window
+	from, to := query.ParsedRange()
+	now := time.Now()
+
+	if earliest := now.Add(constants.AppointmentConfig.MinBookingNotice).Truncate(time.Minute).Add(time.Minute); from.Before(earliest) {
+		from = earliest
+	}
+
+	if latest := now.Add(constants.AppointmentConfig.MaxBookingAdvance); to.After(latest) {
+		to = latest
+	}
+
+	if !to.After(from) {
+		return []*entities.AvailabilitySlot{}, nil
+	}
+
+	// 5. Split the free time into slots
+	slots, err := uc.availabilityRepository.FindFreeSlots(ctx, repositories.FreeTimeFilter{
+		DoctorID:      doctor.ID,
+		From:          from,
+		To:            to,
+		BufferMinutes: doctor.BufferMinutes,
+	}, doctor.ConsultationMinutes)
+	if err != nil {
+		log.Printf("[ListFreeSlotsUseCase] Error listing slots: doctorID=%d, error=%v", doctor.ID, err)
+		return nil, err
+	}
+
+	log.Printf("[ListFreeSlotsUseCase] Slots listed: doctorID=%d, count=%d", doctor.ID, len(slots))
+	return slots, nil
+}
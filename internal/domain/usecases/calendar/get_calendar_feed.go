@@ -0,0 +1,75 @@
+package calendar
+
+import (
+	"citary-backend/internal/domain/errors"
+	"citary-backend/internal/domain/repositories"
+	"citary-backend/internal/domain/services"
+	"citary-backend/pkg/constants"
+	"context"
+	"log"
+	"time"
+)
+
+// GetCalendarFeedUseCase handles the business logic for serving a calendar subscription feed
+type GetCalendarFeedUseCase struct {
+	calendarFeedRepository repositories.CalendarFeedRepository
+	userRepository         repositories.UserRepository
+	appointmentRepository  repositories.AppointmentRepository
+	tokenService           services.TokenService
+	calendarService        services.CalendarService
+}
+
+// NewGetCalendarFeedUseCase creates a new instance of GetCalendarFeedUseCase
+func NewGetCalendarFeedUseCase(
+	calendarFeedRepository repositories.CalendarFeedRepository,
+	userRepository repositories.UserRepository,
+	appointmentRepository repositories.AppointmentRepository,
+	tokenService services.TokenService,
+	calendarService services.CalendarService,
+) *GetCalendarFeedUseCase {
+	return &GetCalendarFeedUseCase{
+		calendarFeedRepository: calendarFeedRepository,
+		userRepository:         userRepository,
+		appointmentRepository:  appointmentRepository,
+		tokenService:           tokenService,
+		calendarService:        calendarService,
+	}
+}
+
+// Execute renders the iCalendar document behind a feed token
+// The token is the only credential, so unknown tokens and inactive accounts look the same to the caller
+func (uc *GetCalendarFeedUseCase) Execute(ctx context.Context, token string) ([]byte, error) {
+	// 1. Resolve the feed from its token
+	feed, err := uc.calendarFeedRepository.FindByTokenHash(ctx, uc.tokenService.HashToken(token))
+	if err != nil {
+		log.Printf("[GetCalendarFeedUseCase] Error finding feed: %v", err)
+		return nil, err
+	}
+
+	if feed == nil {
+		log.Printf("[GetCalendarFeedUseCase] Feed not found")
+		return nil, errors.ErrNotFound(constants.ErrorMessages.CalendarFeedNotFound)
+	}
+
+	// 2. The owner must still have an active account
+	user, err := uc.userRepository.FindByID(ctx, feed.UserID)
+	if err != nil {
+		log.Printf("[GetCalendarFeedUseCase] Error finding user: userID=%d, error=%v", feed.UserID, err)
+		return nil, err
+	}
+
+	if user == nil || !user.IsActive() {
+		log.Printf("[GetCalendarFeedUseCase] Feed owner inactive: userID=%d", feed.UserID)
+		return nil, errors.ErrNotFound(constants.ErrorMessages.CalendarFeedNotFound)
+	}
+
+	// 3. Render recent and upcoming appointments, as patient and as doctor
+	appointments, err := uc.appointmentRepository.FindForCalendar(ctx, user.ID, time.Now().Add(-constants.CalendarConfig.FeedLookback))
+	if err != nil {
+		log.Printf("[GetCalendarFeedUseCase] Error listing appointments: userID=%d, error=%v", user.ID, err)
+		return nil, err
+	}
+
+	log.Printf("[GetCalendarFeedUseCase] Feed rendered: userID=%d, events=%d", user.ID, len(appointments))
+	return uc.calendarService.RenderAppointments(user.ID, constants.CalendarConfig.FeedName, appointments), nil
+}
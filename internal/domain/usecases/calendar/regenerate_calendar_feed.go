@@ -0,0 +1,74 @@
+package calendar
+
+import (
+	"citary-backend/internal/domain/entities"
+	"citary-backend/internal/domain/errors"
+	"citary-backend/internal/domain/repositories"
+	"citary-backend/internal/domain/services"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"time"
+)
+
+// RegenerateCalendarFeedUseCase handles the business logic for issuing a user's calendar subscription URL
+type RegenerateCalendarFeedUseCase struct {
+	calendarFeedRepository repositories.CalendarFeedRepository
+	tokenService           services.TokenService
+	apiURL                 string
+}
+
+// NewRegenerateCalendarFeedUseCase creates a new instance of RegenerateCalendarFeedUseCase
+// apiURL is the public base URL feed links are built on
+func NewRegenerateCalendarFeedUseCase(
+	calendarFeedRepository repositories.CalendarFeedRepository,
+	tokenService services.TokenService,
+	apiURL string,
+) *RegenerateCalendarFeedUseCase {
+	return &RegenerateCalendarFeedUseCase{
+		calendarFeedRepository: calendarFeedRepository,
+		tokenService:           tokenService,
+		apiURL:                 apiURL,
+	}
+}
+
+// Execute issues a new secret feed URL for the user and returns it
+// The URL is only shown once; any previous URL of the user stops working
+func (uc *RegenerateCalendarFeedUseCase) Execute(ctx context.Context, userID int) (string, error) {
+	log.Printf("[RegenerateCalendarFeedUseCase] Execute: userID=%d", userID)
+
+	// 1. Issue a new token (only its hash is stored)
+	token, err := generateSecureToken()
+	if err != nil {
+		log.Printf("[RegenerateCalendarFeedUseCase] Error generating feed token: %v", err)
+		return "", errors.ErrInternal(err)
+	}
+
+	// 2. Replace the previous feed, revoking its URL
+	feed := &entities.CalendarFeed{
+		UserID:      userID,
+		TokenHash:   uc.tokenService.HashToken(token),
+		CreatedDate: time.Now(),
+	}
+
+	if err := uc.calendarFeedRepository.Replace(ctx, feed); err != nil {
+		log.Printf("[RegenerateCalendarFeedUseCase] Error storing feed: userID=%d, error=%v", userID, err)
+		return "", err
+	}
+
+	log.Printf("[RegenerateCalendarFeedUseCase] Feed issued: userID=%d, feedID=%d", userID, feed.ID)
+	return fmt.Sprintf("%s/calendar/%s.ics", uc.apiURL, token), nil
+}
+
+// generateSecureToken generates a cryptographically secure random token
+func generateSecureToken() (string, error) {
+	tokenBytes := make([]byte, 32)
+
+	if _, err := rand.Read(tokenBytes); err != nil {
+		return "", fmt.Errorf("failed to generate secure token: %w", err)
+	}
+
+	return hex.EncodeToString(tokenBytes), nil
+}
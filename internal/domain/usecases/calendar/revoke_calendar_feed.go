@@ -0,0 +1,40 @@
+package calendar
+
+import (
+	"citary-backend/internal/domain/errors"
+	"citary-backend/internal/domain/repositories"
+	"citary-backend/pkg/constants"
+	"context"
+	"log"
+)
+
+// RevokeCalendarFeedUseCase handles the business logic for disabling a user's calendar subscription URL
+type RevokeCalendarFeedUseCase struct {
+	calendarFeedRepository repositories.CalendarFeedRepository
+}
+
+// NewRevokeCalendarFeedUseCase creates a new instance of RevokeCalendarFeedUseCase
+func NewRevokeCalendarFeedUseCase(calendarFeedRepository repositories.CalendarFeedRepository) *RevokeCalendarFeedUseCase {
+	return &RevokeCalendarFeedUseCase{
+		calendarFeedRepository: calendarFeedRepository,
+	}
+}
+
+// Execute deletes the user's feed so its URL stops working
+func (uc *RevokeCalendarFeedUseCase) Execute(ctx context.Context, userID int) error {
+	log.Printf("[RevokeCalendarFeedUseCase] Execute: userID=%d", userID)
+
+	deleted, err := uc.calendarFeedRepository.DeleteForUser(ctx, userID)
+	if err != nil {
+		log.Printf("[RevokeCalendarFeedUseCase] Error deleting feed: userID=%d, error=%v", userID, err)
+		return err
+	}
+
+	if !deleted {
+		log.Printf("[RevokeCalendarFeedUseCase] Feed not found: userID=%d", userID)
+		return errors.ErrNotFound(constants.ErrorMessages.CalendarFeedNotFound)
+	}
+
+	log.Printf("[RevokeCalendarFeedUseCase] Feed revoked: userID=%d", userID)
+	return nil
+}
@@ -0,0 +1,104 @@
+package doctor
+
+import (
+	"citary-backend/internal/domain/dtos/doctor"
+	"citary-backend/internal/domain/entities"
+	"citary-backend/internal/domain/errors"
+	"citary-backend/internal/domain/repositories"
+	"citary-backend/pkg/constants"
+	"context"
+	"log"
+	"strings"
+	"time"
+)
+
+// CreateDoctorUseCase handles the business logic for creating the doctor profile of an organization member
+type CreateDoctorUseCase struct {
+	membershipRepository repositories.OrganizationMembershipRepository
+	specialtyRepository  repositories.SpecialtyRepository
+	doctorRepository     repositories.DoctorRepository
+}
+
+// NewCreateDoctorUseCase creates a new instance of CreateDoctorUseCase
+func NewCreateDoctorUseCase(
+	membershipRepository repositories.OrganizationMembershipRepository,
+	specialtyRepository repositories.SpecialtyRepository,
+	doctorRepository repositories.DoctorRepository,
+) *CreateDoctorUseCase {
+	return &CreateDoctorUseCase{
+		membershipRepository: membershipRepository,
+		specialtyRepository:  specialtyRepository,
+		doctorRepository:     doctorRepository,
+	}
+}
+
+// Execute creates a doctor profile in the organization for one of its members
+func (uc *CreateDoctorUseCase) Execute(ctx context.Context, organizationID int, dto doctor.CreateDoctorRequest) (*entities.Doctor, error) {
+	log.Printf("[CreateDoctorUseCase] Execute: organizationID=%d, userID=%d", organizationID, dto.UserID)
+
+	// 1. Validate input data
+	if err := dto.Validate(); err != nil {
+		log.Printf("[CreateDoctorUseCase] Validation failed: %v", err)
+		return nil, errors.ErrBadRequest(err.Error())
+	}
+
+	// 2. Only members of the organization can practice there
+	membership, err := uc.membershipRepository.FindByUserAndOrganization(ctx, dto.UserID, organizationID)
+	if err != nil {
+		log.Printf("[CreateDoctorUseCase] Error checking membership: userID=%d, error=%v", dto.UserID, err)
+		return nil, err
+	}
+
+	if membership == nil || !membership.IsActive() {
+		log.Printf("[CreateDoctorUseCase] User is not an active member: userID=%d, organizationID=%d", dto.UserID, organizationID)
+		return nil, errors.ErrBadRequest(constants.ErrorMessages.DoctorUserNotMember)
+	}
+
+	// 3. Resolve the specialties
+	specialties, err := resolveSpecialties(ctx, uc.specialtyRepository, dto.UniqueSpecialties())
+	if err != nil {
+		return nil, err
+	}
+
+	// 4. Persist the profile
+	newDoctor := &entities.Doctor{
+		UserID:               dto.UserID,
+		OrganizationID:       organizationID,
+		LicenseNumber:        strings.TrimSpace(dto.LicenseNumber),
+		Bio:                  dto.Bio,
+		Languages:            dto.UniqueLanguages(),
+		ConsultationMinutes:  dto.ResolvedConsultationMinutes(),
+		BufferMinutes:        dto.ResolvedBufferMinutes(),
+		ConsultationFeeCents: dto.ConsultationFeeCents,
+		FeeCurrency:          dto.ResolvedFeeCurrency(),
+		Specialties:          specialties,
+		CreatedDate:          time.Now(),
+		RecordStatus:         constants.RecordStatus.Active,
+	}
+
+	if err := uc.doctorRepository.Create(ctx, newDoctor); err != nil {
+		log.Printf("[CreateDoctorUseCase] Error creating doctor: userID=%d, error=%v", dto.UserID, err)
+		return nil, err
+	}
+
+	log.Printf("[CreateDoctorUseCase] Doctor created: doctorID=%d, organizationID=%d, userID=%d", newDoctor.ID, organizationID, dto.UserID)
+
+	// 5. Reload to include the joined name and organization fields
+	return findDoctor(ctx, uc.doctorRepository, organizationID, newDoctor.ID)
+}
+
+// resolveSpecialties loads the active specialties for the given codes, rejecting unknown codes
+func resolveSpecialties(ctx context.Context, specialtyRepository repositories.SpecialtyRepository, codes []string) ([]*entities.Specialty, error) {
+	specialties, err := specialtyRepository.FindActiveByCodes(ctx, codes)
+	if err != nil {
+		log.Printf("[Doctor] Error finding specialties: codes=%v, error=%v", codes, err)
+		return nil, err
+	}
+
+	if len(specialties) != len(codes) {
+		log.Printf("[Doctor] Unknown specialties: requested=%v, found=%d", codes, len(specialties))
+		return nil, errors.ErrBadRequest(constants.ErrorMessages.SpecialtyUnknown)
+	}
+
+	return specialties, nil
+}
@@ -0,0 +1,42 @@
+package doctor
+
+import (
+	"citary-backend/internal/domain/errors"
+	"citary-backend/internal/domain/repositories"
+	"citary-backend/pkg/constants"
+	"context"
+	"log"
+)
+
+// DeactivateDoctorUseCase handles the business logic for removing a doctor profile from the public listing
+type DeactivateDoctorUseCase struct {
+	doctorRepository repositories.DoctorRepository
+}
+
+// NewDeactivateDoctorUseCase creates a new instance of DeactivateDoctorUseCase
+func NewDeactivateDoctorUseCase(doctorRepository repositories.DoctorRepository) *DeactivateDoctorUseCase {
+	return &DeactivateDoctorUseCase{
+		doctorRepository: doctorRepository,
+	}
+}
+
+// Execute marks a doctor profile of the organization as inactive
+// The profile is kept so past records still reference it
+func (uc *DeactivateDoctorUseCase) Execute(ctx context.Context, organizationID, id int) error {
+	log.Printf("[DeactivateDoctorUseCase] Execute: organizationID=%d, doctorID=%d", organizationID, id)
+
+	updated, err := uc.doctorRepository.UpdateStatus(ctx, organizationID, id, constants.RecordStatus.Inactive)
+	if err != nil {
+		log.Printf("[DeactivateDoctorUseCase] Error deactivating doctor: doctorID=%d, error=%v", id, err)
+		return err
+	}
+
+	if !updated {
+		log.Printf("[DeactivateDoctorUseCase] Doctor not found: organizationID=%d, doctorID=%d", organizationID, id)
+		return errors.ErrNotFound(constants.ErrorMessages.DoctorNotFound)
+	}
+
+	log.Printf("[DeactivateDoctorUseCase] Doctor deactivated: doctorID=%d, organizationID=%d", id, organizationID)
+
+	return nil
+}
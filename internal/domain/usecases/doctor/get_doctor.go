@@ -0,0 +1,44 @@
+package doctor
+
+import (
+	"citary-backend/internal/domain/entities"
+	"citary-backend/internal/domain/errors"
+	"citary-backend/internal/domain/repositories"
+	"citary-backend/pkg/constants"
+	"context"
+	"log"
+)
+
+// GetDoctorUseCase handles the business logic for reading a doctor profile of an organization
+type GetDoctorUseCase struct {
+	doctorRepository repositories.DoctorRepository
+}
+
+// NewGetDoctorUseCase creates a new instance of GetDoctorUseCase
+func NewGetDoctorUseCase(doctorRepository repositories.DoctorRepository) *GetDoctorUseCase {
+	return &GetDoctorUseCase{
+		doctorRepository: doctorRepository,
+	}
+}
+
+// Execute returns a doctor profile of the organization, active or not
+func (uc *GetDoctorUseCase) Execute(ctx context.Context, organizationID, id int) (*entities.Doctor, error) {
+	log.Printf("[GetDoctorUseCase] Execute: organizationID=%d, doctorID=%d", organizationID, id)
+	return findDoctor(ctx, uc.doctorRepository, organizationID, id)
+}
+
+// findDoctor loads a doctor profile of an organization or returns a not-found error
+func findDoctor(ctx context.Context, doctorRepository repositories.DoctorRepository, organizationID, id int) (*entities.Doctor, error) {
+	doctor, err := doctorRepository.FindByID(ctx, organizationID, id)
+	if err != nil {
+		log.Printf("[Doctor] Error finding doctor: organizationID=%d, doctorID=%d, error=%v", organizationID, id, err)
+		return nil, err
+	}
+
+	if doctor == nil {
+		log.Printf("[Doctor] Doctor not found: organizationID=%d, doctorID=%d", organizationID, id)
+		return nil, errors.ErrNotFound(constants.ErrorMessages.DoctorNotFound)
+	}
+
+	return doctor, nil
+}
@@ -0,0 +1,41 @@
+package doctor
+
+import (
+	"citary-backend/internal/domain/entities"
+	"citary-backend/internal/domain/errors"
+	"citary-backend/internal/domain/repositories"
+	"citary-backend/pkg/constants"
+	"context"
+	"log"
+)
+
+// GetPublicDoctorUseCase handles the business logic for reading a doctor from the public listing
+type GetPublicDoctorUseCase struct {
+	doctorRepository repositories.DoctorRepository
+}
+
+// NewGetPublicDoctorUseCase creates a new instance of GetPublicDoctorUseCase
+func NewGetPublicDoctorUseCase(doctorRepository repositories.DoctorRepository) *GetPublicDoctorUseCase {
+	return &GetPublicDoctorUseCase{
+		doctorRepository: doctorRepository,
+	}
+}
+
+// Execute returns an active doctor of an active organization
+// Deactivated profiles are reported as not found
+func (uc *GetPublicDoctorUseCase) Execute(ctx context.Context, id int) (*entities.Doctor, error) {
+	log.Printf("[GetPublicDoctorUseCase] Execute: doctorID=%d", id)
+
+	doctor, err := uc.doctorRepository.FindPublicByID(ctx, id)
+	if err != nil {
+		log.Printf("[GetPublicDoctorUseCase] Error finding doctor: doctorID=%d, error=%v", id, err)
+		return nil, err
+	}
+
+	if doctor == nil {
+		log.Printf("[GetPublicDoctorUseCase] Doctor not found: doctorID=%d", id)
+		return nil, errors.ErrNotFound(constants.ErrorMessages.DoctorNotFound)
+	}
+
+	return doctor, nil
+}
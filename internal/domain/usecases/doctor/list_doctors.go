@@ -0,0 +1,33 @@
+package doctor
+
+import (
+	"citary-backend/internal/domain/entities"
+	"citary-backend/internal/domain/repositories"
+	"context"
+	"log"
+)
+
+// ListDoctorsUseCase handles the business logic for listing the doctor profiles of an organization
+type ListDoctorsUseCase struct {
+	doctorRepository repositories.DoctorRepository
+}
+
+// NewListDoctorsUseCase creates a new instance of ListDoctorsUseCase
+func NewListDoctorsUseCase(doctorRepository repositories.DoctorRepository) *ListDoctorsUseCase {
+	return &ListDoctorsUseCase{
+		doctorRepository: doctorRepository,
+	}
+}
+
+// Execute returns every doctor profile of the organization, including deactivated ones
+func (uc *ListDoctorsUseCase) Execute(ctx context.Context, organizationID int) ([]*entities.Doctor, error) {
+	log.Printf("[ListDoctorsUseCase] Execute: organizationID=%d", organizationID)
+
+	doctors, err := uc.doctorRepository.FindByOrganization(ctx, organizationID)
+	if err != nil {
+		log.Printf("[ListDoctorsUseCase] Error listing doctors: organizationID=%d, error=%v", organizationID, err)
+		return nil, err
+	}
+
+	return doctors, nil
+}
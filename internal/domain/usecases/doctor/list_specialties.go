@@ -0,0 +1,33 @@
+package doctor
+
+import (
+	"citary-backend/internal/domain/entities"
+	"citary-backend/internal/domain/repositories"
+	"context"
+	"log"
+)
+
+// ListSpecialtiesUseCase handles the business logic for listing the specialties doctors can be searched by
+type ListSpecialtiesUseCase struct {
+	specialtyRepository repositories.SpecialtyRepository
+}
+
+// NewListSpecialtiesUseCase creates a new instance of ListSpecialtiesUseCase
+func NewListSpecialtiesUseCase(specialtyRepository repositories.SpecialtyRepository) *ListSpecialtiesUseCase {
+	return &ListSpecialtiesUseCase{
+		specialtyRepository: specialtyRepository,
+	}
+}
+
+// Execute returns every active specialty
+func (uc *ListSpecialtiesUseCase) Execute(ctx context.Context) ([]*entities.Specialty, error) {
+	log.Printf("[ListSpecialtiesUseCase] Execute")
+
+	specialties, err := uc.specialtyRepository.FindActive(ctx)
+	if err != nil {
+		log.Printf("[ListSpecialtiesUseCase] Error listing specialties: %v", err)
+		return nil, err
+	}
+
+	return specialties, nil
+}
@@ -0,0 +1,51 @@
+package doctor
+
+import (
+	"citary-backend/internal/domain/dtos/doctor"
+	"citary-backend/internal/domain/entities"
+	"citary-backend/internal/domain/errors"
+	"citary-backend/internal/domain/repositories"
+	"context"
+	"log"
+	"strings"
+)
+
+// SearchDoctorsUseCase handles the business logic for the public doctor search
+type SearchDoctorsUseCase struct {
+	doctorRepository repositories.DoctorRepository
+}
+
+// NewSearchDoctorsUseCase creates a new instance of SearchDoctorsUseCase
+func NewSearchDoctorsUseCase(doctorRepository repositories.DoctorRepository) *SearchDoctorsUseCase {
+	return &SearchDoctorsUseCase{
+		doctorRepository: doctorRepository,
+	}
+}
+
+// Execute returns one page of active doctors matching the query and the total number of matches
+func (uc *SearchDoctorsUseCase) Execute(ctx context.Context, query doctor.SearchDoctorsQuery) ([]*entities.Doctor, int, error) {
+	log.Printf("[SearchDoctorsUseCase] Execute: specialty=%q, organization=%q, language=%q, page=%d, pageSize=%d",
+		query.Specialty, query.Organization, query.Language, query.ResolvedPage(), query.ResolvedPageSize())
+
+	// 1. Validate input data
+	if err := query.Validate(); err != nil {
+		log.Printf("[SearchDoctorsUseCase] Validation failed: %v", err)
+		return nil, 0, errors.ErrBadRequest(err.Error())
+	}
+
+	// 2. Search
+	doctors, total, err := uc.doctorRepository.Search(ctx, repositories.DoctorSearchFilter{
+		SpecialtyCode:    query.Specialty,
+		OrganizationSlug: query.Organization,
+		Name:             strings.TrimSpace(query.Name),
+		Language:         query.Language,
+		Limit:            query.ResolvedPageSize(),
+		Offset:           query.Offset(),
+	})
+	if err != nil {
+		log.Printf("[SearchDoctorsUseCase] Error searching doctors: %v", err)
+		return nil, 0, err
+	}
+
+	return doctors, total, nil
+}
@@ -0,0 +1,74 @@
+package doctor
+
+import (
+	"citary-backend/internal/domain/dtos/doctor"
+	"citary-backend/internal/domain/entities"
+	"citary-backend/internal/domain/errors"
+	"citary-backend/internal/domain/repositories"
+	"context"
+	"log"
+	"strings"
+	"time"
+)
+
+// UpdateDoctorUseCase handles the business logic for editing a doctor profile
+type UpdateDoctorUseCase struct {
+	specialtyRepository repositories.SpecialtyRepository
+	doctorRepository    repositories.DoctorRepository
+}
+
+// NewUpdateDoctorUseCase creates a new instance of UpdateDoctorUseCase
+func NewUpdateDoctorUseCase(
+	specialtyRepository repositories.SpecialtyRepository,
+	doctorRepository repositories.DoctorRepository,
+) *UpdateDoctorUseCase {
+	return &UpdateDoctorUseCase{
+		specialtyRepository: specialtyRepository,
+		doctorRepository:    doctorRepository,
+	}
+}
+
+// Execute replaces the editable fields and specialties of a doctor profile of the organization
+func (uc *UpdateDoctorUseCase) Execute(ctx context.Context, organizationID, id int, dto doctor.UpdateDoctorRequest) (*entities.Doctor, error) {
+	log.Printf("[UpdateDoctorUseCase] Execute: organizationID=%d, doctorID=%d", organizationID, id)
+
+	// 1. Validate input data
+	if err := dto.Validate(); err != nil {
+		log.Printf("[UpdateDoctorUseCase] Validation failed: %v", err)
+		return nil, errors.ErrBadRequest(err.Error())
+	}
+
+	// 2. Find the doctor
+	existing, err := findDoctor(ctx, uc.doctorRepository, organizationID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. Resolve the specialties
+	specialties, err := resolveSpecialties(ctx, uc.specialtyRepository, dto.UniqueSpecialties())
+	if err != nil {
+		return nil, err
+	}
+
+	// 4. Apply the changes
+	now := time.Now()
+	existing.LicenseNumber = strings.TrimSpace(dto.LicenseNumber)
+	existing.Bio = dto.Bio
+	existing.Languages = dto.UniqueLanguages()
+	existing.ConsultationMinutes = dto.ResolvedConsultationMinutes()
+	existing.BufferMinutes = dto.ResolvedBufferMinutes()
+	existing.ConsultationFeeCents = dto.ConsultationFeeCents
+	existing.FeeCurrency = dto.ResolvedFeeCurrency()
+	existing.Specialties = specialties
+	existing.UpdatedDate = &now
+
+	// 5. Persist the doctor
+	if err := uc.doctorRepository.Update(ctx, existing); err != nil {
+		log.Printf("[UpdateDoctorUseCase] Error updating doctor: doctorID=%d, error=%v", id, err)
+		return nil, err
+	}
+
+	log.Printf("[UpdateDoctorUseCase] Doctor updated: doctorID=%d, organizationID=%d", id, organizationID)
+
+	return existing, nil
+}
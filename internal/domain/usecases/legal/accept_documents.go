@@ -0,0 +1,72 @@
+package legal
+
+import (
+	"citary-backend/internal/domain/dtos/legal"
+	"citary-backend/internal/domain/entities"
+	"citary-backend/internal/domain/errors"
+	"citary-backend/internal/domain/repositories"
+	"context"
+	"log"
+	"time"
+)
+
+// AcceptDocumentsUseCase handles the business logic for an authenticated user accepting legal documents
+type AcceptDocumentsUseCase struct {
+	userRepository          repositories.UserRepository
+	legalDocumentRepository repositories.LegalDocumentRepository
+	consentRecorder         *ConsentRecorder
+}
+
+// NewAcceptDocumentsUseCase creates a new instance of AcceptDocumentsUseCase
+func NewAcceptDocumentsUseCase(
+	userRepository repositories.UserRepository,
+	legalDocumentRepository repositories.LegalDocumentRepository,
+	consentRecorder *ConsentRecorder,
+) *AcceptDocumentsUseCase {
+	return &AcceptDocumentsUseCase{
+		userRepository:          userRepository,
+		legalDocumentRepository: legalDocumentRepository,
+		consentRecorder:         consentRecorder,
+	}
+}
+
+// Execute records the user's consent to the given current document versions
+// Returns the current documents that are still pending afterwards
+func (uc *AcceptDocumentsUseCase) Execute(ctx context.Context, userID int, dto legal.AcceptLegalDocumentsRequest) ([]*entities.LegalDocument, error) {
+	log.Printf("[AcceptDocumentsUseCase] Execute: userID=%d, documents=%d", userID, len(dto.Documents))
+
+	// 1. Validate input data
+	if err := dto.Validate(); err != nil {
+		log.Printf("[AcceptDocumentsUseCase] Validation failed: %v", err)
+		return nil, errors.ErrBadRequest(err.Error())
+	}
+
+	// 2. Match the accepted versions against the current documents
+	documents, _, err := uc.consentRecorder.Resolve(ctx, dto.Documents)
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. Record the consents
+	acceptedAt := time.Now()
+	if err := uc.consentRecorder.Record(ctx, userID, documents, acceptedAt, dto.IPAddress, dto.UserAgent); err != nil {
+		return nil, err
+	}
+
+	// 4. Keep the user's latest acceptance timestamps in sync
+	termsAcceptedAt, privacyAcceptedAt := AcceptanceTimestamps(documents, acceptedAt)
+	if err := uc.userRepository.UpdateLegalAcceptance(ctx, userID, termsAcceptedAt, privacyAcceptedAt); err != nil {
+		log.Printf("[AcceptDocumentsUseCase] Error updating acceptance timestamps: userID=%d, error=%v", userID, err)
+		return nil, err
+	}
+
+	// 5. Report what is still pending
+	pending, err := uc.legalDocumentRepository.FindPendingForUser(ctx, userID)
+	if err != nil {
+		log.Printf("[AcceptDocumentsUseCase] Error finding pending documents: userID=%d, error=%v", userID, err)
+		return nil, err
+	}
+
+	log.Printf("[AcceptDocumentsUseCase] Documents accepted: userID=%d, accepted=%d, pending=%d", userID, len(documents), len(pending))
+	return pending, nil
+}
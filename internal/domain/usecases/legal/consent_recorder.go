@@ -0,0 +1,132 @@
+package legal
+
+import (
+	"citary-backend/internal/domain/dtos/legal"
+	"citary-backend/internal/domain/entities"
+	"citary-backend/internal/domain/errors"
+	"citary-backend/internal/domain/repositories"
+	"citary-backend/pkg/constants"
+	"context"
+	"log"
+	"time"
+)
+
+// ConsentRecorder matches accepted legal document versions against the current ones and records the consents
+// It is shared by signup and the explicit acceptance of newly published versions
+type ConsentRecorder struct {
+	legalDocumentRepository repositories.LegalDocumentRepository
+	userConsentRepository   repositories.UserConsentRepository
+}
+
+// NewConsentRecorder creates a new instance of ConsentRecorder
+func NewConsentRecorder(
+	legalDocumentRepository repositories.LegalDocumentRepository,
+	userConsentRepository repositories.UserConsentRepository,
+) *ConsentRecorder {
+	return &ConsentRecorder{
+		legalDocumentRepository: legalDocumentRepository,
+		userConsentRepository:   userConsentRepository,
+	}
+}
+
+// Resolve maps the accepted versions to the current documents
+// Returns the accepted documents and the current documents that were not accepted;
+// accepting an unknown type or an outdated version is an error
+func (c *ConsentRecorder) Resolve(ctx context.Context, accepted []legal.AcceptedDocument) ([]*entities.LegalDocument, []*entities.LegalDocument, error) {
+	current, err := c.legalDocumentRepository.FindCurrent(ctx)
+	if err != nil {
+		log.Printf("[ConsentRecorder] Error finding current documents: %v", err)
+		return nil, nil, err
+	}
+
+	currentByType := make(map[string]*entities.LegalDocument, len(current))
+	for _, document := range current {
+		currentByType[document.Type] = document
+	}
+
+	acceptedDocuments := make([]*entities.LegalDocument, 0, len(accepted))
+	acceptedTypes := make(map[string]bool, len(accepted))
+	for _, acceptance := range accepted {
+		document, ok := currentByType[acceptance.Type]
+		if !ok {
+			log.Printf("[ConsentRecorder] Unknown document type: type=%s", acceptance.Type)
+			return nil, nil, errors.ErrBadRequest(constants.ErrorMessages.LegalDocumentUnknown)
+		}
+
+		if document.Version != acceptance.Version {
+			log.Printf("[ConsentRecorder] Outdated version accepted: type=%s, accepted=%s, current=%s", acceptance.Type, acceptance.Version, document.Version)
+			return nil, nil, errors.ErrConflict(constants.ErrorMessages.LegalDocumentOutdated)
+		}
+
+		acceptedDocuments = append(acceptedDocuments, document)
+		acceptedTypes[document.Type] = true
+	}
+
+	missing := []*entities.LegalDocument{}
+	for _, document := range current {
+		if !acceptedTypes[document.Type] {
+			missing = append(missing, document)
+		}
+	}
+
+	return acceptedDocuments, missing, nil
+}
+
+// Record stores the user's consent to each document together with the client it was given from
+func (c *ConsentRecorder) Record(ctx context.Context, userID int, documents []*entities.LegalDocument, acceptedAt time.Time, ipAddress, userAgent string) error {
+	for _, consent := range Consents(userID, documents, acceptedAt, ipAddress, userAgent) {
+		if err := c.userConsentRepository.Create(ctx, consent); err != nil {
+			log.Printf("[ConsentRecorder] Error recording consent: userID=%d, documentID=%d, error=%v", userID, consent.LegalDocumentID, err)
+			return err
+		}
+	}
+
+	log.Printf("[ConsentRecorder] Consents recorded: userID=%d, documents=%d", userID, len(documents))
+	return nil
+}
+
+// Consents builds the consent records for the documents without storing them
+// Account creation passes them to the repository so the user and their consents are written together
+func Consents(userID int, documents []*entities.LegalDocument, acceptedAt time.Time, ipAddress, userAgent string) []*entities.UserConsent {
+	consents := make([]*entities.UserConsent, 0, len(documents))
+	for _, document := range documents {
+		consents = append(consents, &entities.UserConsent{
+			UserID:          userID,
+			LegalDocumentID: document.ID,
+			DocumentType:    document.Type,
+			DocumentVersion: document.Version,
+			AcceptedAt:      acceptedAt,
+			IPAddress:       optionalString(ipAddress, 45),
+			UserAgent:       optionalString(userAgent, 255),
+			CreatedDate:     acceptedAt,
+			RecordStatus:    constants.RecordStatus.Active,
+		})
+	}
+	return consents
+}
+
+// AcceptanceTimestamps returns the user's terms and privacy acceptance times implied by the documents
+// A nil timestamp means that document type was not among them
+func AcceptanceTimestamps(documents []*entities.LegalDocument, acceptedAt time.Time) (*time.Time, *time.Time) {
+	var termsAcceptedAt, privacyAcceptedAt *time.Time
+	for _, document := range documents {
+		switch document.Type {
+		case constants.LegalDocumentType.Terms:
+			termsAcceptedAt = &acceptedAt
+		case constants.LegalDocumentType.Privacy:
+			privacyAcceptedAt = &acceptedAt
+		}
+	}
+	return termsAcceptedAt, privacyAcceptedAt
+}
+
+// optionalString converts a possibly empty value into an optional column value truncated to maxLength
+func optionalString(value string, maxLength int) *string {
+	if value == "" {
+		return nil
+	}
+	if len(value) > maxLength {
+		value = value[:maxLength]
+	}
+	return &value
+}
@@ -0,0 +1,33 @@
+package legal
+
+import (
+	"citary-backend/internal/domain/entities"
+	"citary-backend/internal/domain/repositories"
+	"context"
+	"log"
+)
+
+// GetCurrentDocumentsUseCase handles the business logic for listing the legal documents in force
+type GetCurrentDocumentsUseCase struct {
+	legalDocumentRepository repositories.LegalDocumentRepository
+}
+
+// NewGetCurrentDocumentsUseCase creates a new instance of GetCurrentDocumentsUseCase
+func NewGetCurrentDocumentsUseCase(legalDocumentRepository repositories.LegalDocumentRepository) *GetCurrentDocumentsUseCase {
+	return &GetCurrentDocumentsUseCase{
+		legalDocumentRepository: legalDocumentRepository,
+	}
+}
+
+// Execute returns the current version of each legal document
+func (uc *GetCurrentDocumentsUseCase) Execute(ctx context.Context) ([]*entities.LegalDocument, error) {
+	log.Printf("[GetCurrentDocumentsUseCase] Execute")
+
+	documents, err := uc.legalDocumentRepository.FindCurrent(ctx)
+	if err != nil {
+		log.Printf("[GetCurrentDocumentsUseCase] Error finding current documents: %v", err)
+		return nil, err
+	}
+
+	return documents, nil
+}
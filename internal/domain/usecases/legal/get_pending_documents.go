@@ -0,0 +1,33 @@
+package legal
+
+import (
+	"citary-backend/internal/domain/entities"
+	"citary-backend/internal/domain/repositories"
+	"context"
+	"log"
+)
+
+// GetPendingDocumentsUseCase handles the business logic for listing the legal documents a user still has to accept
+type GetPendingDocumentsUseCase struct {
+	legalDocumentRepository repositories.LegalDocumentRepository
+}
+
+// NewGetPendingDocumentsUseCase creates a new instance of GetPendingDocumentsUseCase
+func NewGetPendingDocumentsUseCase(legalDocumentRepository repositories.LegalDocumentRepository) *GetPendingDocumentsUseCase {
+	return &GetPendingDocumentsUseCase{
+		legalDocumentRepository: legalDocumentRepository,
+	}
+}
+
+// Execute returns the current documents the user has not accepted yet
+func (uc *GetPendingDocumentsUseCase) Execute(ctx context.Context, userID int) ([]*entities.LegalDocument, error) {
+	log.Printf("[GetPendingDocumentsUseCase] Execute: userID=%d", userID)
+
+	documents, err := uc.legalDocumentRepository.FindPendingForUser(ctx, userID)
+	if err != nil {
+		log.Printf("[GetPendingDocumentsUseCase] Error finding pending documents: userID=%d, error=%v", userID, err)
+		return nil, err
+	}
+
+	return documents, nil
+}
@@ -0,0 +1,204 @@
+package organization
+
+import (
+	"citary-backend/internal/domain/dtos/organization"
+	"citary-backend/internal/domain/entities"
+	"citary-backend/internal/domain/errors"
+	"citary-backend/internal/domain/repositories"
+	"citary-backend/internal/domain/services"
+	"citary-backend/internal/domain/usecases/legal"
+	"citary-backend/pkg/constants"
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// AcceptedInvitation describes the outcome of accepting an invitation
+type AcceptedInvitation struct {
+	UserID         int
+	OrganizationID int
+	RoleCode       string
+	AccountCreated bool
+}
+
+// AcceptInvitationUseCase handles the business logic for redeeming an invitation token
+// The invited email either gets a new, already verified account, or its existing account becomes a member as well
+type AcceptInvitationUseCase struct {
+	userRepository       repositories.UserRepository
+	roleRepository       repositories.RoleRepository
+	invitationRepository repositories.OrganizationInvitationRepository
+	tokenService         services.TokenService
+	consentRecorder      *legal.ConsentRecorder
+}
+
+// NewAcceptInvitationUseCase creates a new instance of AcceptInvitationUseCase
+func NewAcceptInvitationUseCase(
+	userRepository repositories.UserRepository,
+	roleRepository repositories.RoleRepository,
+	invitationRepository repositories.OrganizationInvitationRepository,
+	tokenService services.TokenService,
+	consentRecorder *legal.ConsentRecorder,
+) *AcceptInvitationUseCase {
+	return &AcceptInvitationUseCase{
+		userRepository:       userRepository,
+		roleRepository:       roleRepository,
+		invitationRepository: invitationRepository,
+		tokenService:         tokenService,
+		consentRecorder:      consentRecorder,
+	}
+}
+
+// Execute consumes the invitation token and attaches the invited account to the organization
+// callerUserID is the authenticated caller, or nil; an existing account can only accept while logged in as itself
+func (uc *AcceptInvitationUseCase) Execute(ctx context.Context, callerUserID *int, dto organization.AcceptInvitationRequest) (*AcceptedInvitation, error) {
+	log.Printf("[AcceptInvitationUseCase] Execute")
+
+	// 1. Validate input data
+	if err := dto.Validate(); err != nil {
+		log.Printf("[AcceptInvitationUseCase] Validation failed: %v", err)
+		return nil, errors.ErrBadRequest(err.Error())
+	}
+
+	// 2. Find the invitation
+	invitation, err := uc.invitationRepository.FindByTokenHash(ctx, uc.tokenService.HashToken(dto.Token))
+	if err != nil {
+		log.Printf("[AcceptInvitationUseCase] Error finding invitation: %v", err)
+		return nil, err
+	}
+
+	if invitation == nil {
+		log.Printf("[AcceptInvitationUseCase] Invitation not found")
+		return nil, errors.ErrBadRequest(constants.ErrorMessages.InvitationInvalid)
+	}
+
+	if !invitation.IsPending() {
+		log.Printf("[AcceptInvitationUseCase] Invitation no longer pending: invitationID=%d", invitation.ID)
+		return nil, errors.ErrConflict(constants.ErrorMessages.InvitationNotPending)
+	}
+
+	if invitation.IsExpired() {
+		log.Printf("[AcceptInvitationUseCase] Invitation expired: invitationID=%d", invitation.ID)
+		return nil, errors.ErrGone(constants.ErrorMessages.InvitationExpired)
+	}
+
+	// 3. Find the account of the invited email
+	existingUser, err := uc.userRepository.FindByEmail(ctx, invitation.Email)
+	if err != nil {
+		log.Printf("[AcceptInvitationUseCase] Error checking existing user: %v", err)
+		return nil, err
+	}
+
+	// 4. Attach the existing account, or prepare a new one
+	accepted := &AcceptedInvitation{
+		OrganizationID: invitation.OrganizationID,
+		RoleCode:       invitation.RoleCode,
+	}
+
+	// 5. Consume the invitation and make the account a member of the organization
+	// A new account is created in the same transaction, so a failed acceptance leaves no account behind
+	if existingUser != nil {
+		if err := uc.checkExistingAccount(existingUser, callerUserID); err != nil {
+			return nil, err
+		}
+
+		if err := uc.invitationRepository.AcceptForUser(ctx, invitation, existingUser.ID); err != nil {
+			log.Printf("[AcceptInvitationUseCase] Error accepting invitation: invitationID=%d, userID=%d, error=%v", invitation.ID, existingUser.ID, err)
+			return nil, err
+		}
+		accepted.UserID = existingUser.ID
+	} else {
+		newUser, consents, err := uc.newInvitedAccount(ctx, invitation, dto)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := uc.invitationRepository.AcceptForNewUser(ctx, invitation, newUser, consents); err != nil {
+			log.Printf("[AcceptInvitationUseCase] Error accepting invitation with a new account: invitationID=%d, error=%v", invitation.ID, err)
+			return nil, err
+		}
+		accepted.UserID = newUser.ID
+		accepted.AccountCreated = true
+	}
+
+	log.Printf("[AcceptInvitationUseCase] Invitation accepted: invitationID=%d, userID=%d, organizationID=%d, accountCreated=%t", invitation.ID, accepted.UserID, invitation.OrganizationID, accepted.AccountCreated)
+	return accepted, nil
+}
+
+// checkExistingAccount verifies the invited email's existing account may join the organization
+// Membership of other organizations is no obstacle; membership of this one is reported when accepting
+func (uc *AcceptInvitationUseCase) checkExistingAccount(user *entities.User, callerUserID *int) error {
+	if !user.IsActive() {
+		log.Printf("[AcceptInvitationUseCase] Invited account is inactive: userID=%d", user.ID)
+		return errors.ErrForbidden(constants.ErrorMessages.AccountInactive)
+	}
+
+	if callerUserID == nil {
+		log.Printf("[AcceptInvitationUseCase] Invited account exists, login required: userID=%d", user.ID)
+		return errors.ErrUnauthorized(constants.ErrorMessages.InvitationLoginRequired)
+	}
+
+	if *callerUserID != user.ID {
+		log.Printf("[AcceptInvitationUseCase] Caller is not the invited account: callerUserID=%d, userID=%d", *callerUserID, user.ID)
+		return errors.ErrForbidden(constants.ErrorMessages.InvitationEmailMismatch)
+	}
+
+	return nil
+}
+
+// newInvitedAccount builds the account of an invited email that has none yet, with its consents
+// Nothing is stored: the account is created when the invitation is accepted
+// The account starts with the default role and a verified email (the emailed token proves ownership of the address);
+// the invited role is granted by the membership created when accepting
+func (uc *AcceptInvitationUseCase) newInvitedAccount(ctx context.Context, invitation *entities.OrganizationInvitation, dto organization.AcceptInvitationRequest) (*entities.User, []*entities.UserConsent, error) {
+	if err := dto.ValidateNewAccount(); err != nil {
+		log.Printf("[AcceptInvitationUseCase] New account validation failed: %v", err)
+		return nil, nil, errors.ErrBadRequest(err.Error())
+	}
+
+	legalDocuments, missingDocuments, err := uc.consentRecorder.Resolve(ctx, dto.AcceptedDocuments())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(missingDocuments) > 0 {
+		log.Printf("[AcceptInvitationUseCase] Not every current legal document was accepted: invitationID=%d, missing=%d", invitation.ID, len(missingDocuments))
+		return nil, nil, errors.ErrBadRequest(constants.ErrorMessages.LegalAcceptanceRequired)
+	}
+
+	defaultRole, err := uc.roleRepository.FindByCode(ctx, constants.DefaultUserRole)
+	if err != nil {
+		log.Printf("[AcceptInvitationUseCase] Error fetching default role: %v", err)
+		return nil, nil, err
+	}
+
+	if defaultRole == nil || !defaultRole.IsActive() {
+		log.Printf("[AcceptInvitationUseCase] Default role missing or inactive: code=%s", constants.DefaultUserRole)
+		return nil, nil, errors.ErrInternal(fmt.Errorf("default role '%s' not configured in system", constants.DefaultUserRole))
+	}
+
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(dto.Password), bcrypt.DefaultCost)
+	if err != nil {
+		log.Printf("[AcceptInvitationUseCase] Error hashing password: %v", err)
+		return nil, nil, errors.ErrInternal(err)
+	}
+
+	now := time.Now()
+	termsAcceptedAt, privacyAcceptedAt := legal.AcceptanceTimestamps(legalDocuments, now)
+
+	user := &entities.User{
+		RoleID:            defaultRole.ID,
+		Email:             invitation.Email,
+		PasswordHash:      string(hashedPassword),
+		EmailVerified:     true,
+		TermsAcceptedAt:   termsAcceptedAt,
+		PrivacyAcceptedAt: privacyAcceptedAt,
+		CreatedDate:       now,
+		RecordStatus:      constants.RecordStatus.Active,
+	}
+
+	consents := legal.Consents(0, legalDocuments, now, dto.IPAddress, dto.UserAgent)
+	return user, consents, nil
+}
@@ -0,0 +1,156 @@
+package organization
+
+import (
+	"citary-backend/internal/domain/dtos/organization"
+	"citary-backend/internal/domain/entities"
+	"citary-backend/internal/domain/errors"
+	"citary-backend/internal/domain/repositories"
+	"citary-backend/internal/domain/services"
+	"citary-backend/pkg/constants"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"time"
+)
+
+// CreateInvitationUseCase handles the business logic for inviting a doctor or staff member into an organization
+type CreateInvitationUseCase struct {
+	userRepository         repositories.UserRepository
+	roleRepository         repositories.RoleRepository
+	organizationRepository repositories.OrganizationRepository
+	membershipRepository   repositories.OrganizationMembershipRepository
+	invitationRepository   repositories.OrganizationInvitationRepository
+	tokenService           services.TokenService
+	emailService           services.EmailService
+}
+
+// NewCreateInvitationUseCase creates a new instance of CreateInvitationUseCase
+func NewCreateInvitationUseCase(
+	userRepository repositories.UserRepository,
+	roleRepository repositories.RoleRepository,
+	organizationRepository repositories.OrganizationRepository,
+	membershipRepository repositories.OrganizationMembershipRepository,
+	invitationRepository repositories.OrganizationInvitationRepository,
+	tokenService services.TokenService,
+	emailService services.EmailService,
+) *CreateInvitationUseCase {
+	return &CreateInvitationUseCase{
+		userRepository:         userRepository,
+		roleRepository:         roleRepository,
+		organizationRepository: organizationRepository,
+		membershipRepository:   membershipRepository,
+		invitationRepository:   invitationRepository,
+		tokenService:           tokenService,
+		emailService:           emailService,
+	}
+}
+
+// Execute issues a single-use invitation token for the organization and emails the acceptance link
+// Inviting the same email again revokes the previous open invitation
+func (uc *CreateInvitationUseCase) Execute(ctx context.Context, organizationID, invitedByUserID int, dto organization.CreateInvitationRequest) (*entities.OrganizationInvitation, error) {
+	log.Printf("[CreateInvitationUseCase] Execute: organizationID=%d, email=%s, role=%s", organizationID, dto.Email, dto.Role)
+
+	// 1. Validate input data (only the invitable roles are accepted)
+	if err := dto.Validate(); err != nil {
+		log.Printf("[CreateInvitationUseCase] Validation failed: %v", err)
+		return nil, errors.ErrBadRequest(err.Error())
+	}
+
+	// 2. Load the organization
+	organizationEntity, err := uc.organizationRepository.FindByID(ctx, organizationID)
+	if err != nil {
+		log.Printf("[CreateInvitationUseCase] Error finding organization: organizationID=%d, error=%v", organizationID, err)
+		return nil, err
+	}
+
+	if organizationEntity == nil || !organizationEntity.IsActive() {
+		log.Printf("[CreateInvitationUseCase] Organization not found or inactive: organizationID=%d", organizationID)
+		return nil, errors.ErrNotFound(constants.ErrorMessages.OrganizationNotFound)
+	}
+
+	// 3. Get the invited role
+	role, err := uc.roleRepository.FindByCode(ctx, dto.Role)
+	if err != nil {
+		log.Printf("[CreateInvitationUseCase] Error fetching role: %v", err)
+		return nil, err
+	}
+
+	if role == nil || !role.IsActive() {
+		log.Printf("[CreateInvitationUseCase] Role missing or inactive: code=%s", dto.Role)
+		return nil, errors.ErrInternal(fmt.Errorf("role '%s' not configured in system", dto.Role))
+	}
+
+	// 4. Existing members don't need an invitation
+	existingUser, err := uc.userRepository.FindByEmail(ctx, dto.Email)
+	if err != nil {
+		log.Printf("[CreateInvitationUseCase] Error checking existing user: %v", err)
+		return nil, err
+	}
+
+	if existingUser != nil {
+		membership, err := uc.membershipRepository.FindByUserAndOrganization(ctx, existingUser.ID, organizationID)
+		if err != nil {
+			log.Printf("[CreateInvitationUseCase] Error checking membership: %v", err)
+			return nil, err
+		}
+
+		if membership != nil && membership.IsActive() {
+			log.Printf("[CreateInvitationUseCase] User already belongs to the organization: userID=%d, organizationID=%d", existingUser.ID, organizationID)
+			return nil, errors.ErrConflict(constants.ErrorMessages.AlreadyOrganizationMember)
+		}
+	}
+
+	// 5. Only the most recent invitation for the email stays valid
+	if err := uc.invitationRepository.RevokePendingForEmail(ctx, organizationID, dto.Email); err != nil {
+		log.Printf("[CreateInvitationUseCase] Error revoking previous invitations: organizationID=%d, error=%v", organizationID, err)
+		return nil, err
+	}
+
+	// 6. Issue a new token (only its hash is stored)
+	invitationToken, err := generateSecureToken()
+	if err != nil {
+		log.Printf("[CreateInvitationUseCase] Error generating invitation token: %v", err)
+		return nil, errors.ErrInternal(err)
+	}
+
+	now := time.Now()
+	invitation := &entities.OrganizationInvitation{
+		OrganizationID:  organizationID,
+		RoleID:          role.ID,
+		RoleCode:        role.Code,
+		InvitedByUserID: invitedByUserID,
+		Email:           dto.Email,
+		TokenHash:       uc.tokenService.HashToken(invitationToken),
+		ExpiresAt:       now.Add(constants.InvitationConfig.TokenTTL),
+		CreatedDate:     now,
+		RecordStatus:    constants.RecordStatus.Active,
+	}
+
+	if err := uc.invitationRepository.Create(ctx, invitation); err != nil {
+		log.Printf("[CreateInvitationUseCase] Error storing invitation: organizationID=%d, error=%v", organizationID, err)
+		return nil, err
+	}
+
+	// 7. Send the invitation email (failures are logged; inviting the email again issues a new link)
+	if err := uc.emailService.SendOrganizationInvitation(ctx, invitation.Email, organizationEntity.Name, role.Name, invitationToken); err != nil {
+		log.Printf("[CreateInvitationUseCase] WARNING: Failed to send invitation email to %s: %v", invitation.Email, err)
+	} else {
+		log.Printf("[CreateInvitationUseCase] Invitation email sent successfully to: %s", invitation.Email)
+	}
+
+	log.Printf("[CreateInvitationUseCase] Invitation created: invitationID=%d, organizationID=%d, role=%s", invitation.ID, organizationID, role.Code)
+	return invitation, nil
+}
+
+// generateSecureToken generates a secure random token for emailed links
+func generateSecureToken() (string, error) {
+	tokenBytes := make([]byte, 32)
+
+	if _, err := rand.Read(tokenBytes); err != nil {
+		return "", fmt.Errorf("failed to generate secure token: %w", err)
+	}
+
+	return hex.EncodeToString(tokenBytes), nil
+}
@@ -0,0 +1,77 @@
+package organization
+
+import (
+	"citary-backend/internal/domain/dtos/organization"
+	"citary-backend/internal/domain/entities"
+	"citary-backend/internal/domain/errors"
+	"citary-backend/internal/domain/repositories"
+	"citary-backend/pkg/constants"
+	"context"
+	"log"
+	"strings"
+	"time"
+)
+
+// CreateLocationUseCase handles the business logic for adding a location to an organization
+type CreateLocationUseCase struct {
+	organizationRepository repositories.OrganizationRepository
+	locationRepository     repositories.LocationRepository
+}
+
+// NewCreateLocationUseCase creates a new instance of CreateLocationUseCase
+func NewCreateLocationUseCase(
+	organizationRepository repositories.OrganizationRepository,
+	locationRepository repositories.LocationRepository,
+) *CreateLocationUseCase {
+	return &CreateLocationUseCase{
+		organizationRepository: organizationRepository,
+		locationRepository:     locationRepository,
+	}
+}
+
+// Execute creates a location in the organization; without a timezone it inherits the organization's
+func (uc *CreateLocationUseCase) Execute(ctx context.Context, organizationID int, dto organization.CreateLocationRequest) (*entities.Location, error) {
+	log.Printf("[CreateLocationUseCase] Execute: organizationID=%d, name=%s", organizationID, dto.Name)
+
+	// 1. Validate input data
+	if err := dto.Validate(); err != nil {
+		log.Printf("[CreateLocationUseCase] Validation failed: %v", err)
+		return nil, errors.ErrBadRequest(err.Error())
+	}
+
+	// 2. Load the organization
+	organizationEntity, err := uc.organizationRepository.FindByID(ctx, organizationID)
+	if err != nil {
+		log.Printf("[CreateLocationUseCase] Error finding organization: organizationID=%d, error=%v", organizationID, err)
+		return nil, err
+	}
+
+	if organizationEntity == nil || !organizationEntity.IsActive() {
+		log.Printf("[CreateLocationUseCase] Organization not found or inactive: organizationID=%d", organizationID)
+		return nil, errors.ErrNotFound(constants.ErrorMessages.OrganizationNotFound)
+	}
+
+	// 3. Persist the location
+	timezone := dto.Timezone
+	if timezone == "" {
+		timezone = organizationEntity.Timezone
+	}
+
+	location := &entities.Location{
+		OrganizationID: organizationID,
+		Name:           strings.TrimSpace(dto.Name),
+		Address:        dto.Address,
+		Timezone:       timezone,
+		CreatedDate:    time.Now(),
+		RecordStatus:   constants.RecordStatus.Active,
+	}
+
+	if err := uc.locationRepository.Create(ctx, location); err != nil {
+		log.Printf("[CreateLocationUseCase] Error creating location: organizationID=%d, error=%v", organizationID, err)
+		return nil, err
+	}
+
+	log.Printf("[CreateLocationUseCase] Location created: locationID=%d, organizationID=%d", location.ID, organizationID)
+
+	return location, nil
+}
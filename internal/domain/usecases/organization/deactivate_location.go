@@ -0,0 +1,42 @@
+package organization
+
+import (
+	"citary-backend/internal/domain/errors"
+	"citary-backend/internal/domain/repositories"
+	"citary-backend/pkg/constants"
+	"context"
+	"log"
+)
+
+// DeactivateLocationUseCase handles the business logic for closing a location of an organization
+type DeactivateLocationUseCase struct {
+	locationRepository repositories.LocationRepository
+}
+
+// NewDeactivateLocationUseCase creates a new instance of DeactivateLocationUseCase
+func NewDeactivateLocationUseCase(locationRepository repositories.LocationRepository) *DeactivateLocationUseCase {
+	return &DeactivateLocationUseCase{
+		locationRepository: locationRepository,
+	}
+}
+
+// Execute marks a location of the organization as inactive
+// Availability at an inactive location no longer counts towards a doctor's effective availability
+func (uc *DeactivateLocationUseCase) Execute(ctx context.Context, organizationID, id int) error {
+	log.Printf("[DeactivateLocationUseCase] Execute: organizationID=%d, locationID=%d", organizationID, id)
+
+	updated, err := uc.locationRepository.UpdateStatus(ctx, organizationID, id, constants.RecordStatus.Inactive)
+	if err != nil {
+		log.Printf("[DeactivateLocationUseCase] Error deactivating location: locationID=%d, error=%v", id, err)
+		return err
+	}
+
+	if !updated {
+		log.Printf("[DeactivateLocationUseCase] Location not found: organizationID=%d, locationID=%d", organizationID, id)
+		return errors.ErrNotFound(constants.ErrorMessages.LocationNotFound)
+	}
+
+	log.Printf("[DeactivateLocationUseCase] Location deactivated: locationID=%d, organizationID=%d", id, organizationID)
+
+	return nil
+}
@@ -0,0 +1,84 @@
+package organization
+
+import (
+	"citary-backend/internal/domain/entities"
+	"citary-backend/internal/domain/errors"
+	"citary-backend/internal/domain/repositories"
+	"citary-backend/pkg/constants"
+	"context"
+	"log"
+)
+
+// GetMyOrganizationUseCase handles the business logic for reading the organization the current user works in
+type GetMyOrganizationUseCase struct {
+	userRepository         repositories.UserRepository
+	organizationRepository repositories.OrganizationRepository
+	membershipRepository   repositories.OrganizationMembershipRepository
+}
+
+// NewGetMyOrganizationUseCase creates a new instance of GetMyOrganizationUseCase
+func NewGetMyOrganizationUseCase(
+	userRepository repositories.UserRepository,
+	organizationRepository repositories.OrganizationRepository,
+	membershipRepository repositories.OrganizationMembershipRepository,
+) *GetMyOrganizationUseCase {
+	return &GetMyOrganizationUseCase{
+		userRepository:         userRepository,
+		organizationRepository: organizationRepository,
+		membershipRepository:   membershipRepository,
+	}
+}
+
+// Execute returns the organization the session acts within, or the user's default organization
+// The user is read from the database so a freshly onboarded owner sees the clinic before refreshing the session
+func (uc *GetMyOrganizationUseCase) Execute(ctx context.Context, userID int, activeOrganizationID *int) (*entities.Organization, error) {
+	log.Printf("[GetMyOrganizationUseCase] Execute: userID=%d", userID)
+
+	// 1. Find the user
+	user, err := uc.userRepository.FindByID(ctx, userID)
+	if err != nil {
+		log.Printf("[GetMyOrganizationUseCase] Error finding user: %v", err)
+		return nil, err
+	}
+
+	if user == nil || !user.IsActive() {
+		log.Printf("[GetMyOrganizationUseCase] User not found or inactive: userID=%d", userID)
+		return nil, errors.ErrNotFound(constants.ErrorMessages.UserNotFound)
+	}
+
+	organizationID := activeOrganizationID
+	if organizationID == nil {
+		organizationID = user.OrganizationID
+	}
+
+	if organizationID == nil {
+		log.Printf("[GetMyOrganizationUseCase] User has no organization: userID=%d", userID)
+		return nil, errors.ErrNotFound(constants.ErrorMessages.OrganizationNotFound)
+	}
+
+	// 2. The user must still be a member
+	membership, err := uc.membershipRepository.FindByUserAndOrganization(ctx, userID, *organizationID)
+	if err != nil {
+		log.Printf("[GetMyOrganizationUseCase] Error finding membership: %v", err)
+		return nil, err
+	}
+
+	if membership == nil || !membership.IsActive() {
+		log.Printf("[GetMyOrganizationUseCase] No active membership: userID=%d, organizationID=%d", userID, *organizationID)
+		return nil, errors.ErrNotFound(constants.ErrorMessages.OrganizationNotFound)
+	}
+
+	// 3. Load the organization
+	organization, err := uc.organizationRepository.FindByID(ctx, *organizationID)
+	if err != nil {
+		log.Printf("[GetMyOrganizationUseCase] Error finding organization: organizationID=%d, error=%v", *organizationID, err)
+		return nil, err
+	}
+
+	if organization == nil || !organization.IsActive() {
+		log.Printf("[GetMyOrganizationUseCase] Organization not found or inactive: organizationID=%d", *organizationID)
+		return nil, errors.ErrNotFound(constants.ErrorMessages.OrganizationNotFound)
+	}
+
+	return organization, nil
+}
@@ -0,0 +1,33 @@
+package organization
+
+import (
+	"citary-backend/internal/domain/entities"
+	"citary-backend/internal/domain/repositories"
+	"context"
+	"log"
+)
+
+// ListInvitationsUseCase handles the business logic for listing an organization's invitations
+type ListInvitationsUseCase struct {
+	invitationRepository repositories.OrganizationInvitationRepository
+}
+
+// NewListInvitationsUseCase creates a new instance of ListInvitationsUseCase
+func NewListInvitationsUseCase(invitationRepository repositories.OrganizationInvitationRepository) *ListInvitationsUseCase {
+	return &ListInvitationsUseCase{
+		invitationRepository: invitationRepository,
+	}
+}
+
+// Execute returns every invitation of the organization, newest first
+func (uc *ListInvitationsUseCase) Execute(ctx context.Context, organizationID int) ([]*entities.OrganizationInvitation, error) {
+	log.Printf("[ListInvitationsUseCase] Execute: organizationID=%d", organizationID)
+
+	invitations, err := uc.invitationRepository.FindByOrganization(ctx, organizationID)
+	if err != nil {
+		log.Printf("[ListInvitationsUseCase] Error finding invitations: organizationID=%d, error=%v", organizationID, err)
+		return nil, err
+	}
+
+	return invitations, nil
+}
@@ -0,0 +1,33 @@
+package organization
+
+import (
+	"citary-backend/internal/domain/entities"
+	"citary-backend/internal/domain/repositories"
+	"context"
+	"log"
+)
+
+// ListLocationsUseCase handles the business logic for listing the locations of an organization
+type ListLocationsUseCase struct {
+	locationRepository repositories.LocationRepository
+}
+
+// NewListLocationsUseCase creates a new instance of ListLocationsUseCase
+func NewListLocationsUseCase(locationRepository repositories.LocationRepository) *ListLocationsUseCase {
+	return &ListLocationsUseCase{
+		locationRepository: locationRepository,
+	}
+}
+
+// Execute returns every location of the organization, including deactivated ones
+func (uc *ListLocationsUseCase) Execute(ctx context.Context, organizationID int) ([]*entities.Location, error) {
+	log.Printf("[ListLocationsUseCase] Execute: organizationID=%d", organizationID)
+
+	locations, err := uc.locationRepository.FindByOrganization(ctx, organizationID)
+	if err != nil {
+		log.Printf("[ListLocationsUseCase] Error listing locations: organizationID=%d, error=%v", organizationID, err)
+		return nil, err
+	}
+
+	return locations, nil
+}
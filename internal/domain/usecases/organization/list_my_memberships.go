@@ -0,0 +1,40 @@
+package organization
+
+import (
+	"citary-backend/internal/domain/entities"
+	"citary-backend/internal/domain/repositories"
+	"context"
+	"log"
+)
+
+// ListMyMembershipsUseCase handles the business logic for listing the organizations the current user works in
+type ListMyMembershipsUseCase struct {
+	membershipRepository repositories.OrganizationMembershipRepository
+}
+
+// NewListMyMembershipsUseCase creates a new instance of ListMyMembershipsUseCase
+func NewListMyMembershipsUseCase(membershipRepository repositories.OrganizationMembershipRepository) *ListMyMembershipsUseCase {
+	return &ListMyMembershipsUseCase{
+		membershipRepository: membershipRepository,
+	}
+}
+
+// Execute returns the active memberships of the given user, each with its organization role
+func (uc *ListMyMembershipsUseCase) Execute(ctx context.Context, userID int) ([]*entities.OrganizationMembership, error) {
+	log.Printf("[ListMyMembershipsUseCase] Execute: userID=%d", userID)
+
+	memberships, err := uc.membershipRepository.FindByUser(ctx, userID)
+	if err != nil {
+		log.Printf("[ListMyMembershipsUseCase] Error finding memberships: userID=%d, error=%v", userID, err)
+		return nil, err
+	}
+
+	active := make([]*entities.OrganizationMembership, 0, len(memberships))
+	for _, membership := range memberships {
+		if membership.IsActive() {
+			active = append(active, membership)
+		}
+	}
+
+	return active, nil
+}
@@ -0,0 +1,110 @@
+package organization
+
+import (
+	"citary-backend/internal/domain/dtos/organization"
+	"citary-backend/internal/domain/entities"
+	"citary-backend/internal/domain/errors"
+	"citary-backend/internal/domain/repositories"
+	"citary-backend/pkg/constants"
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+)
+
+// OnboardOrganizationUseCase handles the business logic for a user creating a clinic and becoming its owner
+type OnboardOrganizationUseCase struct {
+	userRepository         repositories.UserRepository
+	roleRepository         repositories.RoleRepository
+	organizationRepository repositories.OrganizationRepository
+}
+
+// NewOnboardOrganizationUseCase creates a new instance of OnboardOrganizationUseCase
+func NewOnboardOrganizationUseCase(
+	userRepository repositories.UserRepository,
+	roleRepository repositories.RoleRepository,
+	organizationRepository repositories.OrganizationRepository,
+) *OnboardOrganizationUseCase {
+	return &OnboardOrganizationUseCase{
+		userRepository:         userRepository,
+		roleRepository:         roleRepository,
+		organizationRepository: organizationRepository,
+	}
+}
+
+// Execute creates the organization and makes the user its owner
+// Users may own several organizations; the new one becomes their default organization.
+// Sessions outside of any organization move into it on their next refresh, others switch explicitly
+func (uc *OnboardOrganizationUseCase) Execute(ctx context.Context, userID int, dto organization.CreateOrganizationRequest) (*entities.Organization, error) {
+	log.Printf("[OnboardOrganizationUseCase] Execute: userID=%d, name=%s", userID, dto.Name)
+
+	// 1. Validate input data
+	if err := dto.Validate(); err != nil {
+		log.Printf("[OnboardOrganizationUseCase] Validation failed: %v", err)
+		return nil, errors.ErrBadRequest(err.Error())
+	}
+
+	// 2. Find the user (only verified users can onboard an organization)
+	user, err := uc.userRepository.FindByID(ctx, userID)
+	if err != nil {
+		log.Printf("[OnboardOrganizationUseCase] Error finding user: %v", err)
+		return nil, err
+	}
+
+	if user == nil || !user.IsActive() {
+		log.Printf("[OnboardOrganizationUseCase] User not found or inactive: userID=%d", userID)
+		return nil, errors.ErrNotFound(constants.ErrorMessages.UserNotFound)
+	}
+
+	if !user.EmailVerified {
+		log.Printf("[OnboardOrganizationUseCase] Email not verified: userID=%d", userID)
+		return nil, errors.ErrForbidden(constants.ErrorMessages.EmailNotVerified)
+	}
+
+	// 3. Get the owner role
+	ownerRole, err := uc.roleRepository.FindByCode(ctx, constants.RoleCodes.OrganizationOwner)
+	if err != nil {
+		log.Printf("[OnboardOrganizationUseCase] Error fetching owner role: %v", err)
+		return nil, err
+	}
+
+	if ownerRole == nil || !ownerRole.IsActive() {
+		log.Printf("[OnboardOrganizationUseCase] Owner role missing or inactive: code=%s", constants.RoleCodes.OrganizationOwner)
+		return nil, errors.ErrInternal(fmt.Errorf("role '%s' not configured in system", constants.RoleCodes.OrganizationOwner))
+	}
+
+	// 4. Check the slug is free
+	slug := dto.ResolvedSlug()
+	existing, err := uc.organizationRepository.FindBySlug(ctx, slug)
+	if err != nil {
+		log.Printf("[OnboardOrganizationUseCase] Error checking slug: %v", err)
+		return nil, err
+	}
+
+	if existing != nil {
+		log.Printf("[OnboardOrganizationUseCase] Slug already taken: slug=%s", slug)
+		return nil, errors.ErrConflict(constants.ErrorMessages.OrganizationSlugTaken)
+	}
+
+	// 5. Create the organization with the user as its owner member
+	newOrganization := &entities.Organization{
+		OwnerUserID:  userID,
+		Name:         strings.TrimSpace(dto.Name),
+		Slug:         slug,
+		Email:        dto.Email,
+		Phone:        dto.Phone,
+		Address:      dto.Address,
+		Timezone:     dto.ResolvedTimezone(),
+		CreatedDate:  time.Now(),
+		RecordStatus: constants.RecordStatus.Active,
+	}
+
+	if err := uc.organizationRepository.CreateWithOwner(ctx, newOrganization, ownerRole.ID); err != nil {
+		log.Printf("[OnboardOrganizationUseCase] Error creating organization: userID=%d, error=%v", userID, err)
+		return nil, err
+	}
+
+	log.Printf("[OnboardOrganizationUseCase] Organization onboarded: organizationID=%d, slug=%s, ownerUserID=%d", newOrganization.ID, newOrganization.Slug, userID)
+	return newOrganization, nil
+}
@@ -0,0 +1,54 @@
+package organization
+
+import (
+	"citary-backend/internal/domain/errors"
+	"citary-backend/internal/domain/repositories"
+	"citary-backend/pkg/constants"
+	"context"
+	"log"
+)
+
+// RevokeInvitationUseCase handles the business logic for withdrawing a pending invitation
+type RevokeInvitationUseCase struct {
+	invitationRepository repositories.OrganizationInvitationRepository
+}
+
+// NewRevokeInvitationUseCase creates a new instance of RevokeInvitationUseCase
+func NewRevokeInvitationUseCase(invitationRepository repositories.OrganizationInvitationRepository) *RevokeInvitationUseCase {
+	return &RevokeInvitationUseCase{
+		invitationRepository: invitationRepository,
+	}
+}
+
+// Execute revokes a pending invitation of the organization so its link can no longer be used
+// Invitations of other organizations are reported as not found
+func (uc *RevokeInvitationUseCase) Execute(ctx context.Context, organizationID, invitationID int) error {
+	log.Printf("[RevokeInvitationUseCase] Execute: organizationID=%d, invitationID=%d", organizationID, invitationID)
+
+	// 1. Find the invitation within the organization
+	invitation, err := uc.invitationRepository.FindByID(ctx, invitationID)
+	if err != nil {
+		log.Printf("[RevokeInvitationUseCase] Error finding invitation: %v", err)
+		return err
+	}
+
+	if invitation == nil || invitation.OrganizationID != organizationID {
+		log.Printf("[RevokeInvitationUseCase] Invitation not found: invitationID=%d, organizationID=%d", invitationID, organizationID)
+		return errors.ErrNotFound(constants.ErrorMessages.InvitationNotFound)
+	}
+
+	// 2. Revoke it (only pending invitations can be revoked)
+	revoked, err := uc.invitationRepository.Revoke(ctx, invitation.ID)
+	if err != nil {
+		log.Printf("[RevokeInvitationUseCase] Error revoking invitation: invitationID=%d, error=%v", invitation.ID, err)
+		return err
+	}
+
+	if !revoked {
+		log.Printf("[RevokeInvitationUseCase] Invitation no longer pending: invitationID=%d", invitation.ID)
+		return errors.ErrConflict(constants.ErrorMessages.InvitationNotPending)
+	}
+
+	log.Printf("[RevokeInvitationUseCase] Invitation revoked: invitationID=%d, organizationID=%d", invitation.ID, organizationID)
+	return nil
+}
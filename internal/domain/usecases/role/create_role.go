@@ -0,0 +1,91 @@
+package role
+
+import (
+	"citary-backend/internal/domain/dtos/role"
+	"citary-backend/internal/domain/entities"
+	"citary-backend/internal/domain/errors"
+	"citary-backend/internal/domain/repositories"
+	"citary-backend/pkg/constants"
+	"context"
+	"encoding/json"
+	"log"
+	"strings"
+	"time"
+)
+
+// CreateRoleUseCase handles the business logic for creating a role
+type CreateRoleUseCase struct {
+	roleRepository repositories.RoleRepository
+}
+
+// NewCreateRoleUseCase creates a new instance of CreateRoleUseCase
+func NewCreateRoleUseCase(roleRepository repositories.RoleRepository) *CreateRoleUseCase {
+	return &CreateRoleUseCase{
+		roleRepository: roleRepository,
+	}
+}
+
+// Execute creates a new active role
+func (uc *CreateRoleUseCase) Execute(ctx context.Context, dto role.CreateRoleRequest) (*entities.Role, error) {
+	log.Printf("[CreateRoleUseCase] Execute: code=%s", dto.Code)
+
+	// 1. Validate input data
+	if err := dto.Validate(); err != nil {
+		log.Printf("[CreateRoleUseCase] Validation failed: %v", err)
+		return nil, errors.ErrBadRequest(err.Error())
+	}
+
+	// 2. Business validation: the code must be unique
+	existing, err := uc.roleRepository.FindByCode(ctx, dto.Code)
+	if err != nil {
+		log.Printf("[CreateRoleUseCase] Error checking existing role: %v", err)
+		return nil, err
+	}
+
+	if existing != nil {
+		log.Printf("[CreateRoleUseCase] Role already exists: code=%s", dto.Code)
+		return nil, errors.ErrConflict(constants.ErrorMessages.RoleAlreadyExists)
+	}
+
+	// 3. Build the role entity
+	permissions, err := marshalPermissions(dto.Permissions)
+	if err != nil {
+		log.Printf("[CreateRoleUseCase] Error encoding permissions: %v", err)
+		return nil, errors.ErrInternal(err)
+	}
+
+	newRole := &entities.Role{
+		Name:         strings.TrimSpace(dto.Name),
+		Code:         dto.Code,
+		Description:  dto.Description,
+		Permissions:  permissions,
+		CreatedDate:  time.Now(),
+		RecordStatus: constants.RecordStatus.Active,
+	}
+
+	// 4. Persist the role
+	if err := uc.roleRepository.Create(ctx, newRole); err != nil {
+		log.Printf("[CreateRoleUseCase] Error creating role: %v", err)
+		return nil, err
+	}
+
+	log.Printf("[CreateRoleUseCase] Role created: roleID=%d, code=%s", newRole.ID, newRole.Code)
+
+	return newRole, nil
+}
+
+// marshalPermissions encodes validated permission codes into the JSONB array stored on the role
+func marshalPermissions(permissions []string) (*json.RawMessage, error) {
+	codes := make([]string, 0, len(permissions))
+	for _, permission := range permissions {
+		codes = append(codes, strings.TrimSpace(permission))
+	}
+
+	encoded, err := json.Marshal(codes)
+	if err != nil {
+		return nil, err
+	}
+
+	raw := json.RawMessage(encoded)
+	return &raw, nil
+}
@@ -0,0 +1,59 @@
+package role
+
+import (
+	"citary-backend/internal/domain/errors"
+	"citary-backend/internal/domain/repositories"
+	"citary-backend/pkg/constants"
+	"context"
+	"log"
+)
+
+// DeactivateRoleUseCase handles the business logic for deactivating a role
+type DeactivateRoleUseCase struct {
+	roleRepository repositories.RoleRepository
+}
+
+// NewDeactivateRoleUseCase creates a new instance of DeactivateRoleUseCase
+func NewDeactivateRoleUseCase(roleRepository repositories.RoleRepository) *DeactivateRoleUseCase {
+	return &DeactivateRoleUseCase{
+		roleRepository: roleRepository,
+	}
+}
+
+// Execute marks a role as inactive, which removes every permission it granted
+// The default signup role and super_admin can never be deactivated
+func (uc *DeactivateRoleUseCase) Execute(ctx context.Context, id int) error {
+	log.Printf("[DeactivateRoleUseCase] Execute: roleID=%d", id)
+
+	// 1. Find the role
+	existing, err := findRole(ctx, uc.roleRepository, id)
+	if err != nil {
+		return err
+	}
+
+	// 2. Business validation: platform roles are protected
+	if isProtectedRole(existing.Code) {
+		log.Printf("[DeactivateRoleUseCase] Protected role: roleID=%d, code=%s", id, existing.Code)
+		return errors.ErrConflict(constants.ErrorMessages.RoleProtected)
+	}
+
+	if !existing.IsActive() {
+		log.Printf("[DeactivateRoleUseCase] Role already inactive: roleID=%d", id)
+		return nil
+	}
+
+	// 3. Deactivate the role
+	if err := uc.roleRepository.UpdateStatus(ctx, id, constants.RecordStatus.Inactive); err != nil {
+		log.Printf("[DeactivateRoleUseCase] Error deactivating role: roleID=%d, error=%v", id, err)
+		return err
+	}
+
+	log.Printf("[DeactivateRoleUseCase] Role deactivated: roleID=%d, code=%s", id, existing.Code)
+
+	return nil
+}
+
+// isProtectedRole reports whether a role is required for the platform to keep working
+func isProtectedRole(code string) bool {
+	return code == constants.DefaultUserRole || code == constants.RoleCodes.SuperAdmin
+}
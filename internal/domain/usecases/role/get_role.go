@@ -0,0 +1,45 @@
+package role
+
+import (
+	"citary-backend/internal/domain/entities"
+	"citary-backend/internal/domain/errors"
+	"citary-backend/internal/domain/repositories"
+	"citary-backend/pkg/constants"
+	"context"
+	"log"
+)
+
+// GetRoleUseCase handles the business logic for reading a single role
+type GetRoleUseCase struct {
+	roleRepository repositories.RoleRepository
+}
+
+// NewGetRoleUseCase creates a new instance of GetRoleUseCase
+func NewGetRoleUseCase(roleRepository repositories.RoleRepository) *GetRoleUseCase {
+	return &GetRoleUseCase{
+		roleRepository: roleRepository,
+	}
+}
+
+// Execute returns the role with the given ID
+func (uc *GetRoleUseCase) Execute(ctx context.Context, id int) (*entities.Role, error) {
+	log.Printf("[GetRoleUseCase] Execute: roleID=%d", id)
+
+	return findRole(ctx, uc.roleRepository, id)
+}
+
+// findRole loads a role and turns a missing row into a not found error
+func findRole(ctx context.Context, roleRepository repositories.RoleRepository, id int) (*entities.Role, error) {
+	role, err := roleRepository.FindByID(ctx, id)
+	if err != nil {
+		log.Printf("[Role] Error finding role: roleID=%d, error=%v", id, err)
+		return nil, err
+	}
+
+	if role == nil {
+		log.Printf("[Role] Role not found: roleID=%d", id)
+		return nil, errors.ErrNotFound(constants.ErrorMessages.RoleNotFound)
+	}
+
+	return role, nil
+}
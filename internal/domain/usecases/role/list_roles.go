@@ -0,0 +1,33 @@
+package role
+
+import (
+	"citary-backend/internal/domain/entities"
+	"citary-backend/internal/domain/repositories"
+	"context"
+	"log"
+)
+
+// ListRolesUseCase handles the business logic for listing roles
+type ListRolesUseCase struct {
+	roleRepository repositories.RoleRepository
+}
+
+// NewListRolesUseCase creates a new instance of ListRolesUseCase
+func NewListRolesUseCase(roleRepository repositories.RoleRepository) *ListRolesUseCase {
+	return &ListRolesUseCase{
+		roleRepository: roleRepository,
+	}
+}
+
+// Execute returns every role, including deactivated ones
+func (uc *ListRolesUseCase) Execute(ctx context.Context) ([]*entities.Role, error) {
+	log.Printf("[ListRolesUseCase] Execute")
+
+	roles, err := uc.roleRepository.FindAll(ctx)
+	if err != nil {
+		log.Printf("[ListRolesUseCase] Error listing roles: %v", err)
+		return nil, err
+	}
+
+	return roles, nil
+}
@@ -0,0 +1,110 @@
+package role
+
+import (
+	"citary-backend/internal/domain/dtos/role"
+	"citary-backend/internal/domain/entities"
+	"citary-backend/internal/domain/errors"
+	"citary-backend/internal/domain/repositories"
+	"citary-backend/pkg/constants"
+	"context"
+	"encoding/json"
+	"log"
+	"sort"
+	"strings"
+)
+
+// UpdateRoleUseCase handles the business logic for editing a role
+type UpdateRoleUseCase struct {
+	roleRepository repositories.RoleRepository
+}
+
+// NewUpdateRoleUseCase creates a new instance of UpdateRoleUseCase
+func NewUpdateRoleUseCase(roleRepository repositories.RoleRepository) *UpdateRoleUseCase {
+	return &UpdateRoleUseCase{
+		roleRepository: roleRepository,
+	}
+}
+
+// Execute replaces the name, description and permissions of a role
+// Platform roles can be renamed and described, but their permissions are fixed: emptying the default role
+// or taking "*" away from the super admin would lock users out just like deactivating them
+func (uc *UpdateRoleUseCase) Execute(ctx context.Context, id int, dto role.UpdateRoleRequest) (*entities.Role, error) {
+	log.Printf("[UpdateRoleUseCase] Execute: roleID=%d", id)
+
+	// 1. Validate input data
+	if err := dto.Validate(); err != nil {
+		log.Printf("[UpdateRoleUseCase] Validation failed: %v", err)
+		return nil, errors.ErrBadRequest(err.Error())
+	}
+
+	// 2. Find the role
+	existing, err := findRole(ctx, uc.roleRepository, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. Business validation: platform roles keep their permissions
+	if isProtectedRole(existing.Code) {
+		unchanged, err := samePermissions(existing.Permissions, dto.Permissions)
+		if err != nil {
+			log.Printf("[UpdateRoleUseCase] Error decoding stored permissions: roleID=%d, error=%v", id, err)
+			return nil, errors.ErrInternal(err)
+		}
+
+		if !unchanged {
+			log.Printf("[UpdateRoleUseCase] Protected role permissions changed: roleID=%d, code=%s", id, existing.Code)
+			return nil, errors.ErrConflict(constants.ErrorMessages.RolePermissionsProtected)
+		}
+	}
+
+	// 4. Apply the changes
+	permissions, err := marshalPermissions(dto.Permissions)
+	if err != nil {
+		log.Printf("[UpdateRoleUseCase] Error encoding permissions: %v", err)
+		return nil, errors.ErrInternal(err)
+	}
+
+	existing.Name = strings.TrimSpace(dto.Name)
+	existing.Description = dto.Description
+	existing.Permissions = permissions
+
+	// 5. Persist the role
+	if err := uc.roleRepository.Update(ctx, existing); err != nil {
+		log.Printf("[UpdateRoleUseCase] Error updating role: roleID=%d, error=%v", id, err)
+		return nil, err
+	}
+
+	log.Printf("[UpdateRoleUseCase] Role updated: roleID=%d, code=%s", id, existing.Code)
+
+	return existing, nil
+}
+
+// samePermissions reports whether the requested permission codes are the stored ones, ignoring order,
+// duplicates and surrounding spaces
+func samePermissions(stored *json.RawMessage, requested []string) (bool, error) {
+	var current []string
+	if stored != nil && len(*stored) > 0 {
+		if err := json.Unmarshal(*stored, &current); err != nil {
+			return false, err
+		}
+	}
+
+	return permissionCodes(current) == permissionCodes(requested), nil
+}
+
+// permissionCodes normalizes permission codes into a comparable, order-independent form
+func permissionCodes(codes []string) string {
+	unique := make(map[string]bool, len(codes))
+	for _, code := range codes {
+		if code = strings.TrimSpace(code); code != "" {
+			unique[code] = true
+		}
+	}
+
+	normalized := make([]string, 0, len(unique))
+	for code := range unique {
+		normalized = append(normalized, code)
+	}
+	sort.Strings(normalized)
+	return strings.Join(normalized, "\n")
+}
@@ -0,0 +1,79 @@
+package user
+
+import (
+	"citary-backend/internal/domain/repositories"
+	"citary-backend/internal/domain/usecases/auth"
+	"citary-backend/pkg/constants"
+	"context"
+	"fmt"
+	"log"
+	"time"
+)
+
+// AnonymizeDeletedAccountsUseCase erases the personal data of deleted accounts whose grace period ended
+//
+// The account row is kept with a tombstone email so foreign keys and the audit trail stay intact.
+type AnonymizeDeletedAccountsUseCase struct {
+	userRepository      repositories.UserRepository
+	userEventRepository repositories.UserEventRepository
+}
+
+// NewAnonymizeDeletedAccountsUseCase creates a new instance of AnonymizeDeletedAccountsUseCase
+func NewAnonymizeDeletedAccountsUseCase(
+	userRepository repositories.UserRepository,
+	userEventRepository repositories.UserEventRepository,
+) *AnonymizeDeletedAccountsUseCase {
+	return &AnonymizeDeletedAccountsUseCase{
+		userRepository:      userRepository,
+		userEventRepository: userEventRepository,
+	}
+}
+
+// Execute anonymizes one batch of accounts that are due
+// A failure on one account is logged and does not stop the rest of the batch
+func (uc *AnonymizeDeletedAccountsUseCase) Execute(ctx context.Context) error {
+	// 1. Find the accounts whose grace period ended
+	now := time.Now()
+	userIDs, err := uc.userRepository.FindDueForAnonymization(ctx, now, constants.AccountDeletionConfig.AnonymizationBatchSize)
+	if err != nil {
+		log.Printf("[AnonymizeDeletedAccountsUseCase] Error finding due accounts: %v", err)
+		return err
+	}
+
+	if len(userIDs) == 0 {
+		return nil
+	}
+
+	// 2. Anonymize each account
+	anonymized := 0
+	for _, userID := range userIDs {
+		if err := uc.anonymize(ctx, userID, now); err != nil {
+			log.Printf("[AnonymizeDeletedAccountsUseCase] Error anonymizing account: userID=%d, error=%v", userID, err)
+			continue
+		}
+		anonymized++
+	}
+
+	log.Printf("[AnonymizeDeletedAccountsUseCase] Batch finished: due=%d, anonymized=%d", len(userIDs), anonymized)
+	return nil
+}
+
+// anonymize erases the account's personal data unless it was reactivated since it was found
+func (uc *AnonymizeDeletedAccountsUseCase) anonymize(ctx context.Context, userID int, now time.Time) error {
+	tombstoneEmail := fmt.Sprintf(constants.AccountDeletionConfig.TombstoneEmailFormat, userID)
+
+	ok, err := uc.userRepository.Anonymize(ctx, userID, tombstoneEmail, now)
+	if err != nil {
+		return err
+	}
+
+	if !ok {
+		log.Printf("[AnonymizeDeletedAccountsUseCase] Account no longer pending anonymization: userID=%d", userID)
+		return nil
+	}
+
+	auth.RecordUserEvent(ctx, uc.userEventRepository, userID, constants.UserEventType.Anonymized, "")
+
+	log.Printf("[AnonymizeDeletedAccountsUseCase] Account anonymized: userID=%d", userID)
+	return nil
+}
@@ -0,0 +1,87 @@
+package user
+
+import (
+	"citary-backend/internal/domain/dtos/user"
+	"citary-backend/internal/domain/errors"
+	"citary-backend/internal/domain/repositories"
+	"citary-backend/internal/domain/usecases/auth"
+	"citary-backend/pkg/constants"
+	"context"
+	"log"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// DeleteMyAccountUseCase handles the business logic for a user closing their own account
+//
+// The account is soft-deleted right away and its personal data is anonymized once the
+// grace period ends; until then the user can still restore it through reactivation.
+type DeleteMyAccountUseCase struct {
+	userRepository         repositories.UserRepository
+	refreshTokenRepository repositories.RefreshTokenRepository
+	userEventRepository    repositories.UserEventRepository
+}
+
+// NewDeleteMyAccountUseCase creates a new instance of DeleteMyAccountUseCase
+func NewDeleteMyAccountUseCase(
+	userRepository repositories.UserRepository,
+	refreshTokenRepository repositories.RefreshTokenRepository,
+	userEventRepository repositories.UserEventRepository,
+) *DeleteMyAccountUseCase {
+	return &DeleteMyAccountUseCase{
+		userRepository:         userRepository,
+		refreshTokenRepository: refreshTokenRepository,
+		userEventRepository:    userEventRepository,
+	}
+}
+
+// Execute verifies the current password, deactivates the account and signs the user out everywhere
+// Returns the moment the account's personal data becomes due for anonymization
+func (uc *DeleteMyAccountUseCase) Execute(ctx context.Context, userID int, dto user.DeleteAccountRequest) (time.Time, error) {
+	log.Printf("[DeleteMyAccountUseCase] Execute: userID=%d", userID)
+
+	// 1. Validate input data
+	if err := dto.Validate(); err != nil {
+		log.Printf("[DeleteMyAccountUseCase] Validation failed: %v", err)
+		return time.Time{}, errors.ErrBadRequest(err.Error())
+	}
+
+	// 2. Find the user
+	account, err := uc.userRepository.FindByID(ctx, userID)
+	if err != nil {
+		log.Printf("[DeleteMyAccountUseCase] Error finding user: %v", err)
+		return time.Time{}, err
+	}
+
+	if account == nil || !account.IsActive() {
+		log.Printf("[DeleteMyAccountUseCase] User not found or inactive: userID=%d", userID)
+		return time.Time{}, errors.ErrNotFound(constants.ErrorMessages.UserNotFound)
+	}
+
+	// 3. Check the current password
+	if err := bcrypt.CompareHashAndPassword([]byte(account.PasswordHash), []byte(dto.CurrentPassword)); err != nil {
+		log.Printf("[DeleteMyAccountUseCase] Incorrect current password: userID=%d", userID)
+		return time.Time{}, errors.ErrUnauthorized(constants.ErrorMessages.PasswordIncorrect)
+	}
+
+	// 4. Soft-delete the account and schedule its anonymization
+	deletedAt := time.Now()
+	anonymizeAfter := deletedAt.Add(constants.AccountDeletionConfig.GracePeriod)
+
+	if err := uc.userRepository.SoftDelete(ctx, userID, deletedAt, anonymizeAfter); err != nil {
+		log.Printf("[DeleteMyAccountUseCase] Error deleting account: userID=%d, error=%v", userID, err)
+		return time.Time{}, err
+	}
+
+	// 5. Invalidate every existing session
+	if err := uc.refreshTokenRepository.RevokeAllForUser(ctx, userID); err != nil {
+		log.Printf("[DeleteMyAccountUseCase] Error revoking sessions: userID=%d, error=%v", userID, err)
+		return time.Time{}, err
+	}
+
+	auth.RecordUserEvent(ctx, uc.userEventRepository, userID, constants.UserEventType.Deleted, dto.IPAddress)
+
+	log.Printf("[DeleteMyAccountUseCase] Account deleted: userID=%d, anonymizeAfter=%v", userID, anonymizeAfter)
+	return anonymizeAfter, nil
+}
@@ -0,0 +1,149 @@
+package user
+
+import (
+	"citary-backend/internal/domain/entities"
+	"citary-backend/internal/domain/repositories"
+	"context"
+	"log"
+	"time"
+)
+
+// UserDataExport represents everything stored about a user
+type UserDataExport struct {
+	ExportedAt   time.Time
+	Account      *MyProfile
+	Sessions     []*entities.RefreshToken
+	Consents     []*entities.UserConsent
+	Events       []*entities.UserEvent
+	Memberships  []*entities.OrganizationMembership
+	Invitations  []*entities.OrganizationInvitation
+	Doctors      []*entities.Doctor
+	Appointments []*entities.Appointment
+	CalendarFeed *entities.CalendarFeed
+}
+
+// ExportMyDataUseCase handles the business logic for a user downloading their own data
+type ExportMyDataUseCase struct {
+	userRepository         repositories.UserRepository
+	roleRepository         repositories.RoleRepository
+	userProfileRepository  repositories.UserProfileRepository
+	refreshTokenRepository repositories.RefreshTokenRepository
+	userConsentRepository  repositories.UserConsentRepository
+	userEventRepository    repositories.UserEventRepository
+	membershipRepository   repositories.OrganizationMembershipRepository
+	invitationRepository   repositories.OrganizationInvitationRepository
+	doctorRepository       repositories.DoctorRepository
+	appointmentRepository  repositories.AppointmentRepository
+	calendarFeedRepository repositories.CalendarFeedRepository
+}
+
+// NewExportMyDataUseCase creates a new instance of ExportMyDataUseCase
+func NewExportMyDataUseCase(
+	userRepository repositories.UserRepository,
+	roleRepository repositories.RoleRepository,
+	userProfileRepository repositories.UserProfileRepository,
+	refreshTokenRepository repositories.RefreshTokenRepository,
+	userConsentRepository repositories.UserConsentRepository,
+	userEventRepository repositories.UserEventRepository,
+	membershipRepository repositories.OrganizationMembershipRepository,
+	invitationRepository repositories.OrganizationInvitationRepository,
+	doctorRepository repositories.DoctorRepository,
+	appointmentRepository repositories.AppointmentRepository,
+	calendarFeedRepository repositories.CalendarFeedRepository,
+) *ExportMyDataUseCase {
+	return &ExportMyDataUseCase{
+		userRepository:         userRepository,
+		roleRepository:         roleRepository,
+		userProfileRepository:  userProfileRepository,
+		refreshTokenRepository: refreshTokenRepository,
+		userConsentRepository:  userConsentRepository,
+		userEventRepository:    userEventRepository,
+		membershipRepository:   membershipRepository,
+		invitationRepository:   invitationRepository,
+		doctorRepository:       doctorRepository,
+		appointmentRepository:  appointmentRepository,
+		calendarFeedRepository: calendarFeedRepository,
+	}
+}
+
+// Execute gathers the account, profile, sessions, consents, audit trail, memberships, invitations, doctor profiles, appointments and calendar feed of the given user
+func (uc *ExportMyDataUseCase) Execute(ctx context.Context, userID int) (*UserDataExport, error) {
+	log.Printf("[ExportMyDataUseCase] Execute: userID=%d", userID)
+
+	// 1. Load the account and profile
+	account, err := loadMyProfile(ctx, uc.userRepository, uc.roleRepository, uc.userProfileRepository, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Load the sessions
+	sessions, err := uc.refreshTokenRepository.FindByUser(ctx, userID)
+	if err != nil {
+		log.Printf("[ExportMyDataUseCase] Error finding sessions: userID=%d, error=%v", userID, err)
+		return nil, err
+	}
+
+	// 3. Load the consent history
+	consents, err := uc.userConsentRepository.FindByUser(ctx, userID)
+	if err != nil {
+		log.Printf("[ExportMyDataUseCase] Error finding consents: userID=%d, error=%v", userID, err)
+		return nil, err
+	}
+
+	// 4. Load the audit trail
+	events, err := uc.userEventRepository.FindByUser(ctx, userID)
+	if err != nil {
+		log.Printf("[ExportMyDataUseCase] Error finding events: userID=%d, error=%v", userID, err)
+		return nil, err
+	}
+
+	// 5. Load the organization memberships
+	memberships, err := uc.membershipRepository.FindByUser(ctx, userID)
+	if err != nil {
+		log.Printf("[ExportMyDataUseCase] Error finding memberships: userID=%d, error=%v", userID, err)
+		return nil, err
+	}
+
+	// 6. Load the invitations sent to the account's email or accepted by it
+	invitations, err := uc.invitationRepository.FindByInvitee(ctx, userID, account.User.Email)
+	if err != nil {
+		log.Printf("[ExportMyDataUseCase] Error finding invitations: userID=%d, error=%v", userID, err)
+		return nil, err
+	}
+
+	// 7. Load the doctor profiles
+	doctors, err := uc.doctorRepository.FindByUser(ctx, userID)
+	if err != nil {
+		log.Printf("[ExportMyDataUseCase] Error finding doctor profiles: userID=%d, error=%v", userID, err)
+		return nil, err
+	}
+
+	// 8. Load the appointments booked as a patient
+	appointments, err := uc.appointmentRepository.FindAllByPatient(ctx, userID)
+	if err != nil {
+		log.Printf("[ExportMyDataUseCase] Error finding appointments: userID=%d, error=%v", userID, err)
+		return nil, err
+	}
+
+	// 9. Load the calendar subscription feed, if any
+	calendarFeed, err := uc.calendarFeedRepository.FindByUser(ctx, userID)
+	if err != nil {
+		log.Printf("[ExportMyDataUseCase] Error finding calendar feed: userID=%d, error=%v", userID, err)
+		return nil, err
+	}
+
+	log.Printf("[ExportMyDataUseCase] Data exported: userID=%d, sessions=%d, events=%d, memberships=%d, invitations=%d, doctors=%d, appointments=%d, calendarFeed=%t", userID, len(sessions), len(events), len(memberships), len(invitations), len(doctors), len(appointments), calendarFeed != nil)
+
+	return &UserDataExport{
+		ExportedAt:   time.Now(),
+		Account:      account,
+		Sessions:     sessions,
+		Consents:     consents,
+		Events:       events,
+		Memberships:  memberships,
+		Invitations:  invitations,
+		Doctors:      doctors,
+		Appointments: appointments,
+		CalendarFeed: calendarFeed,
+	}, nil
+}
@@ -0,0 +1,96 @@
+package user
+
+import (
+	"citary-backend/internal/domain/entities"
+	"citary-backend/internal/domain/errors"
+	"citary-backend/internal/domain/repositories"
+	"citary-backend/pkg/constants"
+	"context"
+	"fmt"
+	"log"
+)
+
+// MyProfile represents the current user's account state together with their profile
+// Profile is never nil; users who never saved one get an empty profile
+type MyProfile struct {
+	User     *entities.User
+	RoleCode string
+	Profile  *entities.UserProfile
+}
+
+// GetMyProfileUseCase handles the business logic for reading the current user's profile
+type GetMyProfileUseCase struct {
+	userRepository        repositories.UserRepository
+	roleRepository        repositories.RoleRepository
+	userProfileRepository repositories.UserProfileRepository
+}
+
+// NewGetMyProfileUseCase creates a new instance of GetMyProfileUseCase
+func NewGetMyProfileUseCase(
+	userRepository repositories.UserRepository,
+	roleRepository repositories.RoleRepository,
+	userProfileRepository repositories.UserProfileRepository,
+) *GetMyProfileUseCase {
+	return &GetMyProfileUseCase{
+		userRepository:        userRepository,
+		roleRepository:        roleRepository,
+		userProfileRepository: userProfileRepository,
+	}
+}
+
+// Execute returns the account state and profile of the given user
+func (uc *GetMyProfileUseCase) Execute(ctx context.Context, userID int) (*MyProfile, error) {
+	log.Printf("[GetMyProfileUseCase] Execute: userID=%d", userID)
+
+	return loadMyProfile(ctx, uc.userRepository, uc.roleRepository, uc.userProfileRepository, userID)
+}
+
+// loadMyProfile gathers the user, their role code and their profile
+func loadMyProfile(
+	ctx context.Context,
+	userRepository repositories.UserRepository,
+	roleRepository repositories.RoleRepository,
+	userProfileRepository repositories.UserProfileRepository,
+	userID int,
+) (*MyProfile, error) {
+	// Find the user
+	user, err := userRepository.FindByID(ctx, userID)
+	if err != nil {
+		log.Printf("[MyProfile] Error finding user: userID=%d, error=%v", userID, err)
+		return nil, err
+	}
+
+	if user == nil || !user.IsActive() {
+		log.Printf("[MyProfile] User not found or inactive: userID=%d", userID)
+		return nil, errors.ErrNotFound(constants.ErrorMessages.UserNotFound)
+	}
+
+	// Resolve the role code
+	role, err := roleRepository.FindByID(ctx, user.RoleID)
+	if err != nil {
+		log.Printf("[MyProfile] Error fetching role: roleID=%d, error=%v", user.RoleID, err)
+		return nil, err
+	}
+
+	if role == nil {
+		log.Printf("[MyProfile] Role not found: roleID=%d", user.RoleID)
+		return nil, errors.ErrInternal(fmt.Errorf("role %d assigned to user %d not found", user.RoleID, user.ID))
+	}
+
+	// Load the profile (users who never saved one get an empty profile)
+	profile, err := userProfileRepository.FindByUserID(ctx, userID)
+	if err != nil {
+		log.Printf("[MyProfile] Error finding profile: userID=%d, error=%v", userID, err)
+		return nil, err
+	}
+
+	if profile == nil {
+		profile = &entities.UserProfile{UserID: userID, RecordStatus: constants.RecordStatus.Active}
+	}
+
+	return &MyProfile{
+		User:     user,
+		RoleCode: role.Code,
+		Profile:  profile,
+	}, nil
+}
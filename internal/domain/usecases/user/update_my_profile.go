@@ -0,0 +1,95 @@
+package user
+
+import (
+	"citary-backend/internal/domain/dtos/user"
+	"citary-backend/internal/domain/errors"
+	"citary-backend/internal/domain/repositories"
+	"context"
+	"log"
+	"strings"
+	"time"
+)
+
+// UpdateMyProfileUseCase handles the business logic for editing the current user's profile
+type UpdateMyProfileUseCase struct {
+	userRepository        repositories.UserRepository
+	roleRepository        repositories.RoleRepository
+	userProfileRepository repositories.UserProfileRepository
+}
+
+// NewUpdateMyProfileUseCase creates a new instance of UpdateMyProfileUseCase
+func NewUpdateMyProfileUseCase(
+	userRepository repositories.UserRepository,
+	roleRepository repositories.RoleRepository,
+	userProfileRepository repositories.UserProfileRepository,
+) *UpdateMyProfileUseCase {
+	return &UpdateMyProfileUseCase{
+		userRepository:        userRepository,
+		roleRepository:        roleRepository,
+		userProfileRepository: userProfileRepository,
+	}
+}
+
+// Execute applies a partial update to the user's profile, creating it on first use
+func (uc *UpdateMyProfileUseCase) Execute(ctx context.Context, userID int, dto user.UpdateProfileRequest) (*MyProfile, error) {
+	log.Printf("[UpdateMyProfileUseCase] Execute: userID=%d", userID)
+
+	// 1. Validate input data
+	if err := dto.Validate(); err != nil {
+		log.Printf("[UpdateMyProfileUseCase] Validation failed: %v", err)
+		return nil, errors.ErrBadRequest(err.Error())
+	}
+
+	// 2. Load the current state
+	current, err := loadMyProfile(ctx, uc.userRepository, uc.roleRepository, uc.userProfileRepository, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. Apply the sent fields
+	profile := current.Profile
+	applyOptional(&profile.FirstName, dto.FirstName)
+	applyOptional(&profile.LastName, dto.LastName)
+	applyOptional(&profile.Phone, dto.Phone)
+	applyOptional(&profile.Locale, dto.Locale)
+	applyOptional(&profile.AvatarURL, dto.AvatarURL)
+
+	if dto.BirthDate != nil {
+		birthDate, err := dto.ParsedBirthDate()
+		if err != nil {
+			return nil, errors.ErrBadRequest(err.Error())
+		}
+		profile.BirthDate = birthDate
+	}
+
+	now := time.Now()
+	if profile.ID == 0 {
+		profile.CreatedDate = now
+	}
+	profile.UpdatedDate = &now
+
+	// 4. Persist the profile
+	if err := uc.userProfileRepository.Upsert(ctx, profile); err != nil {
+		log.Printf("[UpdateMyProfileUseCase] Error saving profile: userID=%d, error=%v", userID, err)
+		return nil, err
+	}
+
+	log.Printf("[UpdateMyProfileUseCase] Profile updated: userID=%d, profileID=%d", userID, profile.ID)
+
+	return current, nil
+}
+
+// applyOptional copies a sent field onto the profile: nil leaves it unchanged, an empty string clears it
+func applyOptional(target **string, value *string) {
+	if value == nil {
+		return
+	}
+
+	trimmed := strings.TrimSpace(*value)
+	if trimmed == "" {
+		*target = nil
+		return
+	}
+
+	*target = &trimmed
+}
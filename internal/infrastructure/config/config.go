@@ -1,9 +1,13 @@
 package config
 
 import (
+	"fmt"
 	"log"
 	"os"
+	"sort"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/joho/godotenv"
 )
@@ -11,7 +15,9 @@ import (
 // Config holds application configuration
 type Config struct {
 	// Server configuration
-	Port int
+	// APIURL is the public base URL of this API, used in links that point back to it
+	Port   int
+	APIURL string
 
 	// Database configuration
 	DatabaseURL string
@@ -26,6 +32,22 @@ type Config struct {
 
 	// Frontend configuration
 	FrontendURL string
+
+	// JWT configuration
+	JWTSecret          string
+	JWTIssuer          string
+	JWTAccessTokenTTL  time.Duration
+	JWTRefreshTokenTTL time.Duration
+	MFAChallengeTTL    time.Duration
+
+	// Login lockout configuration
+	LoginMaxAttempts     int
+	LoginLockoutDuration time.Duration
+	LoginLockoutMax      time.Duration
+
+	// Appointment reminder configuration
+	// How long before an appointment each reminder email is sent, longest first
+	AppointmentReminderOffsets []time.Duration
 }
 
 // AppConfig is the global configuration instance
@@ -68,13 +90,42 @@ func Load() {
 		log.Fatal("SMTP_FROM_EMAIL environment variable is required")
 	}
 
+	jwtSecret := getEnv("JWT_SECRET", "")
+	if jwtSecret == "" {
+		log.Fatal("JWT_SECRET environment variable is required")
+	}
+
+	if len(jwtSecret) < 32 {
+		log.Fatal("JWT_SECRET must be at least 32 characters long")
+	}
+
 	// Optional variables with defaults
 	port := getEnvAsInt("PORT", 3001)
+	apiURL := strings.TrimSuffix(getEnv("API_URL", fmt.Sprintf("http://localhost:%d", port)), "/")
 	smtpFromName := getEnv("SMTP_FROM_NAME", "Citary")
 	frontendURL := getEnv("FRONTEND_URL", "http://localhost:3000")
+	jwtIssuer := getEnv("JWT_ISSUER", "citary")
+	jwtAccessTokenTTL := getEnvAsInt("JWT_ACCESS_TOKEN_TTL_MINUTES", 15)
+	jwtRefreshTokenTTL := getEnvAsInt("JWT_REFRESH_TOKEN_TTL_DAYS", 30)
+	mfaChallengeTTL := getEnvAsInt("MFA_CHALLENGE_TTL_MINUTES", 5)
+	loginMaxAttempts := getEnvAsInt("LOGIN_MAX_ATTEMPTS", 5)
+	loginLockoutMinutes := getEnvAsInt("LOGIN_LOCKOUT_MINUTES", 15)
+	loginLockoutMaxMinutes := getEnvAsInt("LOGIN_LOCKOUT_MAX_MINUTES", 24*60)
+
+	appointmentReminderOffsets, err := getEnvAsDurations("APPOINTMENT_REMINDER_OFFSETS", "24h,2h")
+	if err != nil {
+		log.Fatalf("APPOINTMENT_REMINDER_OFFSETS must be a comma-separated list of positive durations, e.g. 24h,2h: %v", err)
+	}
+
+	for _, offset := range appointmentReminderOffsets {
+		if offset%time.Minute != 0 {
+			log.Fatalf("APPOINTMENT_REMINDER_OFFSETS must use whole minutes, got %v", offset)
+		}
+	}
 
 	AppConfig = &Config{
 		Port:          port,
+		APIURL:        apiURL,
 		DatabaseURL:   databaseURL,
 		SMTPHost:      smtpHost,
 		SMTPPort:      smtpPort,
@@ -83,6 +134,18 @@ func Load() {
 		SMTPFromEmail: smtpFromEmail,
 		SMTPFromName:  smtpFromName,
 		FrontendURL:   frontendURL,
+
+		JWTSecret:          jwtSecret,
+		JWTIssuer:          jwtIssuer,
+		JWTAccessTokenTTL:  time.Duration(jwtAccessTokenTTL) * time.Minute,
+		JWTRefreshTokenTTL: time.Duration(jwtRefreshTokenTTL) * 24 * time.Hour,
+		MFAChallengeTTL:    time.Duration(mfaChallengeTTL) * time.Minute,
+
+		LoginMaxAttempts:     loginMaxAttempts,
+		LoginLockoutDuration: time.Duration(loginLockoutMinutes) * time.Minute,
+		LoginLockoutMax:      time.Duration(loginLockoutMaxMinutes) * time.Minute,
+
+		AppointmentReminderOffsets: appointmentReminderOffsets,
 	}
 
 	log.Printf("Configuration loaded: PORT=%d, SMTP_HOST=%s, FRONTEND_URL=%s",
@@ -105,3 +168,34 @@ func getEnvAsInt(key string, defaultValue int) int {
 	}
 	return defaultValue
 }
+
+// getEnvAsDurations retrieves an environment variable as a comma-separated list of positive durations
+// The result is deduplicated and sorted longest first; an empty list disables whatever it configures
+func getEnvAsDurations(key string, defaultValue string) ([]time.Duration, error) {
+	durations := []time.Duration{}
+	seen := make(map[time.Duration]bool)
+
+	for _, part := range strings.Split(getEnv(key, defaultValue), ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		duration, err := time.ParseDuration(part)
+		if err != nil {
+			return nil, err
+		}
+
+		if duration <= 0 {
+			return nil, fmt.Errorf("duration %q is not positive", part)
+		}
+
+		if !seen[duration] {
+			seen[duration] = true
+			durations = append(durations, duration)
+		}
+	}
+
+	sort.Slice(durations, func(i, j int) bool { return durations[i] > durations[j] })
+	return durations, nil
+}
@@ -1,14 +1,33 @@
 package di
 
 import (
+	"citary-backend/internal/domain/security"
+	"citary-backend/internal/domain/usecases/appointment"
 	"citary-backend/internal/domain/usecases/auth"
+	"citary-backend/internal/domain/usecases/availability"
+	"citary-backend/internal/domain/usecases/calendar"
+	"citary-backend/internal/domain/usecases/doctor"
+	"citary-backend/internal/domain/usecases/legal"
+	"citary-backend/internal/domain/usecases/organization"
+	"citary-backend/internal/domain/usecases/role"
+	"citary-backend/internal/domain/usecases/user"
 	"citary-backend/internal/infrastructure/config"
 	httpServer "citary-backend/internal/infrastructure/http"
+	appointmentHandler "citary-backend/internal/infrastructure/http/handlers/appointment"
 	authHandler "citary-backend/internal/infrastructure/http/handlers/auth"
+	availabilityHandler "citary-backend/internal/infrastructure/http/handlers/availability"
+	calendarHandler "citary-backend/internal/infrastructure/http/handlers/calendar"
+	doctorHandler "citary-backend/internal/infrastructure/http/handlers/doctor"
+	legalHandler "citary-backend/internal/infrastructure/http/handlers/legal"
+	organizationHandler "citary-backend/internal/infrastructure/http/handlers/organization"
+	roleHandler "citary-backend/internal/infrastructure/http/handlers/role"
+	userHandler "citary-backend/internal/infrastructure/http/handlers/user"
 	"citary-backend/internal/infrastructure/http/router"
+	"citary-backend/internal/infrastructure/jobs"
 	"citary-backend/internal/infrastructure/persistence/postgres"
 	"citary-backend/internal/infrastructure/persistence/postgres/repositories"
 	"citary-backend/internal/infrastructure/services"
+	"citary-backend/pkg/constants"
 	"context"
 	"log"
 	"time"
@@ -17,6 +36,7 @@ import (
 // Container holds all application dependencies
 type Container struct {
 	Server *httpServer.Server
+	jobs   *jobs.Runner
 	dbConn *postgres.Connection
 }
 
@@ -35,28 +55,245 @@ func NewContainer() *Container {
 	// Initialize repositories
 	userRepository := repositories.NewUserRepositoryImpl(dbConn.DB)
 	roleRepository := repositories.NewRoleRepositoryImpl(dbConn.DB)
+	refreshTokenRepository := repositories.NewRefreshTokenRepositoryImpl(dbConn.DB)
+	userTokenRepository := repositories.NewUserTokenRepositoryImpl(dbConn.DB)
+	recoveryCodeRepository := repositories.NewRecoveryCodeRepositoryImpl(dbConn.DB)
+	userProfileRepository := repositories.NewUserProfileRepositoryImpl(dbConn.DB)
+	userEventRepository := repositories.NewUserEventRepositoryImpl(dbConn.DB)
+	legalDocumentRepository := repositories.NewLegalDocumentRepositoryImpl(dbConn.DB)
+	userConsentRepository := repositories.NewUserConsentRepositoryImpl(dbConn.DB)
+	organizationRepository := repositories.NewOrganizationRepositoryImpl(dbConn.DB)
+	invitationRepository := repositories.NewOrganizationInvitationRepositoryImpl(dbConn.DB)
+	membershipRepository := repositories.NewOrganizationMembershipRepositoryImpl(dbConn.DB)
+	specialtyRepository := repositories.NewSpecialtyRepositoryImpl(dbConn.DB)
+	doctorRepository := repositories.NewDoctorRepositoryImpl(dbConn.DB)
+	locationRepository := repositories.NewLocationRepositoryImpl(dbConn.DB)
+	availabilityRepository := repositories.NewAvailabilityRepositoryImpl(dbConn.DB)
+	appointmentRepository := repositories.NewAppointmentRepositoryImpl(dbConn.DB)
+	appointmentReminderRepository := repositories.NewAppointmentReminderRepositoryImpl(dbConn.DB)
+	calendarFeedRepository := repositories.NewCalendarFeedRepositoryImpl(dbConn.DB)
 
 	// Initialize services
 	emailService := services.NewSMTPEmailService(cfg)
+	tokenService := services.NewJWTTokenService(cfg)
+	calendarService := services.NewICalCalendarService(cfg)
+
+	// Initialize authorization
+	authorizer := security.NewAuthorizer(roleRepository, membershipRepository)
+	accountGuard := security.NewAccountGuard(userRepository)
+	consentGuard := security.NewConsentGuard(legalDocumentRepository)
 
 	// Initialize use cases
-	signupUserUseCase := auth.NewSignupUserUseCase(userRepository, roleRepository, emailService)
+	sessionIssuer := auth.NewSessionIssuer(roleRepository, membershipRepository, refreshTokenRepository, tokenService)
+	consentRecorder := legal.NewConsentRecorder(legalDocumentRepository, userConsentRepository)
+	signupUserUseCase := auth.NewSignupUserUseCase(userRepository, roleRepository, emailService, userTokenRepository, userEventRepository, tokenService, consentRecorder)
+	verifyEmailUseCase := auth.NewVerifyEmailUseCase(userRepository, userTokenRepository, tokenService)
+	resendVerificationUseCase := auth.NewResendVerificationUseCase(userRepository, emailService)
+	lockoutPolicy := auth.LockoutPolicy{
+		MaxAttempts:     cfg.LoginMaxAttempts,
+		LockDuration:    cfg.LoginLockoutDuration,
+		MaxLockDuration: cfg.LoginLockoutMax,
+	}
+	loginUseCase := auth.NewLoginUseCase(userRepository, tokenService, sessionIssuer, lockoutPolicy)
+	refreshTokenUseCase := auth.NewRefreshTokenUseCase(userRepository, refreshTokenRepository, tokenService, sessionIssuer)
+	logoutUseCase := auth.NewLogoutUseCase(refreshTokenRepository, tokenService)
+	switchOrganizationUseCase := auth.NewSwitchOrganizationUseCase(userRepository, membershipRepository, refreshTokenRepository, tokenService, sessionIssuer)
+	requestPasswordResetUseCase := auth.NewRequestPasswordResetUseCase(userRepository, userTokenRepository, tokenService, emailService)
+	resetPasswordUseCase := auth.NewResetPasswordUseCase(userRepository, userTokenRepository, refreshTokenRepository, tokenService)
+	reactivateAccountUseCase := auth.NewReactivateAccountUseCase(userRepository, userTokenRepository, refreshTokenRepository, userEventRepository, tokenService, emailService)
+	changePasswordUseCase := auth.NewChangePasswordUseCase(userRepository, refreshTokenRepository)
+	requestEmailChangeUseCase := auth.NewRequestEmailChangeUseCase(userRepository, userTokenRepository, tokenService, emailService)
+	confirmEmailChangeUseCase := auth.NewConfirmEmailChangeUseCase(userRepository, userTokenRepository, tokenService, emailService)
+	setupTwoFactorUseCase := auth.NewSetupTwoFactorUseCase(userRepository)
+	confirmTwoFactorUseCase := auth.NewConfirmTwoFactorUseCase(userRepository, recoveryCodeRepository, tokenService)
+	disableTwoFactorUseCase := auth.NewDisableTwoFactorUseCase(userRepository, recoveryCodeRepository)
+	verifyTwoFactorLoginUseCase := auth.NewVerifyTwoFactorLoginUseCase(userRepository, recoveryCodeRepository, tokenService, sessionIssuer, lockoutPolicy)
+
+	listRolesUseCase := role.NewListRolesUseCase(roleRepository)
+	getRoleUseCase := role.NewGetRoleUseCase(roleRepository)
+	createRoleUseCase := role.NewCreateRoleUseCase(roleRepository)
+	updateRoleUseCase := role.NewUpdateRoleUseCase(roleRepository)
+	deactivateRoleUseCase := role.NewDeactivateRoleUseCase(roleRepository)
+
+	getMyProfileUseCase := user.NewGetMyProfileUseCase(userRepository, roleRepository, userProfileRepository)
+	updateMyProfileUseCase := user.NewUpdateMyProfileUseCase(userRepository, roleRepository, userProfileRepository)
+	deleteMyAccountUseCase := user.NewDeleteMyAccountUseCase(userRepository, refreshTokenRepository, userEventRepository)
+	exportMyDataUseCase := user.NewExportMyDataUseCase(userRepository, roleRepository, userProfileRepository, refreshTokenRepository, userConsentRepository, userEventRepository, membershipRepository, invitationRepository, doctorRepository, appointmentRepository, calendarFeedRepository)
+	anonymizeDeletedAccountsUseCase := user.NewAnonymizeDeletedAccountsUseCase(userRepository, userEventRepository)
+
+	getCurrentDocumentsUseCase := legal.NewGetCurrentDocumentsUseCase(legalDocumentRepository)
+	getPendingDocumentsUseCase := legal.NewGetPendingDocumentsUseCase(legalDocumentRepository)
+	acceptDocumentsUseCase := legal.NewAcceptDocumentsUseCase(userRepository, legalDocumentRepository, consentRecorder)
+
+	onboardOrganizationUseCase := organization.NewOnboardOrganizationUseCase(userRepository, roleRepository, organizationRepository)
+	getMyOrganizationUseCase := organization.NewGetMyOrganizationUseCase(userRepository, organizationRepository, membershipRepository)
+	listMyMembershipsUseCase := organization.NewListMyMembershipsUseCase(membershipRepository)
+	createInvitationUseCase := organization.NewCreateInvitationUseCase(userRepository, roleRepository, organizationRepository, membershipRepository, invitationRepository, tokenService, emailService)
+	listInvitationsUseCase := organization.NewListInvitationsUseCase(invitationRepository)
+	revokeInvitationUseCase := organization.NewRevokeInvitationUseCase(invitationRepository)
+	acceptInvitationUseCase := organization.NewAcceptInvitationUseCase(userRepository, roleRepository, invitationRepository, tokenService, consentRecorder)
+	createLocationUseCase := organization.NewCreateLocationUseCase(organizationRepository, locationRepository)
+	listLocationsUseCase := organization.NewListLocationsUseCase(locationRepository)
+	deactivateLocationUseCase := organization.NewDeactivateLocationUseCase(locationRepository)
+
+	listDoctorsUseCase := doctor.NewListDoctorsUseCase(doctorRepository)
+	getDoctorUseCase := doctor.NewGetDoctorUseCase(doctorRepository)
+	createDoctorUseCase := doctor.NewCreateDoctorUseCase(membershipRepository, specialtyRepository, doctorRepository)
+	updateDoctorUseCase := doctor.NewUpdateDoctorUseCase(specialtyRepository, doctorRepository)
+	deactivateDoctorUseCase := doctor.NewDeactivateDoctorUseCase(doctorRepository)
+	searchDoctorsUseCase := doctor.NewSearchDoctorsUseCase(doctorRepository)
+	getPublicDoctorUseCase := doctor.NewGetPublicDoctorUseCase(doctorRepository)
+	listSpecialtiesUseCase := doctor.NewListSpecialtiesUseCase(specialtyRepository)
+
+	listBlocksUseCase := availability.NewListBlocksUseCase(doctorRepository, availabilityRepository)
+	createBlockUseCase := availability.NewCreateBlockUseCase(doctorRepository, locationRepository, availabilityRepository)
+	deleteBlockUseCase := availability.NewDeleteBlockUseCase(doctorRepository, availabilityRepository)
+	listExceptionsUseCase := availability.NewListExceptionsUseCase(doctorRepository, availabilityRepository)
+	createExceptionUseCase := availability.NewCreateExceptionUseCase(doctorRepository, locationRepository, availabilityRepository)
+	deleteExceptionUseCase := availability.NewDeleteExceptionUseCase(doctorRepository, availabilityRepository)
+	getEffectiveAvailabilityUseCase := availability.NewGetEffectiveAvailabilityUseCase(doctorRepository, availabilityRepository)
+	listFreeSlotsUseCase := availability.NewListFreeSlotsUseCase(doctorRepository, membershipRepository, availabilityRepository)
+
+	bookAppointmentUseCase := appointment.NewBookAppointmentUseCase(doctorRepository, membershipRepository, availabilityRepository, appointmentRepository, userRepository, emailService, calendarService)
+	listMyAppointmentsUseCase := appointment.NewListMyAppointmentsUseCase(appointmentRepository)
+	listOrganizationAppointmentsUseCase := appointment.NewListOrganizationAppointmentsUseCase(appointmentRepository)
+	getAppointmentUseCase := appointment.NewGetAppointmentUseCase(appointmentRepository)
+	getAppointmentHistoryUseCase := appointment.NewGetAppointmentHistoryUseCase(appointmentRepository)
+	confirmAppointmentUseCase := appointment.NewConfirmAppointmentUseCase(appointmentRepository)
+	checkInAppointmentUseCase := appointment.NewCheckInAppointmentUseCase(appointmentRepository)
+	completeAppointmentUseCase := appointment.NewCompleteAppointmentUseCase(appointmentRepository)
+	markNoShowUseCase := appointment.NewMarkNoShowUseCase(appointmentRepository)
+	cancelAppointmentUseCase := appointment.NewCancelAppointmentUseCase(appointmentRepository)
+	rescheduleAppointmentUseCase := appointment.NewRescheduleAppointmentUseCase(doctorRepository, availabilityRepository, appointmentRepository)
+	sendAppointmentRemindersUseCase := appointment.NewSendAppointmentRemindersUseCase(appointmentRepository, appointmentReminderRepository, userRepository, emailService, cfg.AppointmentReminderOffsets)
+
+	regenerateCalendarFeedUseCase := calendar.NewRegenerateCalendarFeedUseCase(calendarFeedRepository, tokenService, cfg.APIURL)
+	revokeCalendarFeedUseCase := calendar.NewRevokeCalendarFeedUseCase(calendarFeedRepository)
+	getCalendarFeedUseCase := calendar.NewGetCalendarFeedUseCase(calendarFeedRepository, userRepository, appointmentRepository, tokenService, calendarService)
 
 	// Initialize HTTP handlers
-	authHandlerInstance := authHandler.NewAuthHandler(signupUserUseCase)
+	authHandlerInstance := authHandler.NewAuthHandler(
+		signupUserUseCase,
+		verifyEmailUseCase,
+		resendVerificationUseCase,
+		loginUseCase,
+		refreshTokenUseCase,
+		logoutUseCase,
+		requestPasswordResetUseCase,
+		resetPasswordUseCase,
+		changePasswordUseCase,
+		requestEmailChangeUseCase,
+		confirmEmailChangeUseCase,
+		reactivateAccountUseCase,
+		switchOrganizationUseCase,
+	)
+	twoFactorHandlerInstance := authHandler.NewTwoFactorHandler(
+		setupTwoFactorUseCase,
+		confirmTwoFactorUseCase,
+		disableTwoFactorUseCase,
+		verifyTwoFactorLoginUseCase,
+	)
+	roleHandlerInstance := roleHandler.NewRoleHandler(
+		listRolesUseCase,
+		getRoleUseCase,
+		createRoleUseCase,
+		updateRoleUseCase,
+		deactivateRoleUseCase,
+	)
+	meHandlerInstance := userHandler.NewMeHandler(getMyProfileUseCase, updateMyProfileUseCase, deleteMyAccountUseCase, exportMyDataUseCase)
+
+	legalHandlerInstance := legalHandler.NewLegalHandler(getCurrentDocumentsUseCase, getPendingDocumentsUseCase, acceptDocumentsUseCase)
+	organizationHandlerInstance := organizationHandler.NewOrganizationHandler(onboardOrganizationUseCase, getMyOrganizationUseCase, listMyMembershipsUseCase)
+	invitationHandlerInstance := organizationHandler.NewInvitationHandler(createInvitationUseCase, listInvitationsUseCase, revokeInvitationUseCase, acceptInvitationUseCase)
+	locationHandlerInstance := organizationHandler.NewLocationHandler(createLocationUseCase, listLocationsUseCase, deactivateLocationUseCase)
+	doctorHandlerInstance := doctorHandler.NewDoctorHandler(
+		listDoctorsUseCase,
+		getDoctorUseCase,
+		createDoctorUseCase,
+		updateDoctorUseCase,
+		deactivateDoctorUseCase,
+		searchDoctorsUseCase,
+		getPublicDoctorUseCase,
+		listSpecialtiesUseCase,
+	)
+	availabilityHandlerInstance := availabilityHandler.NewAvailabilityHandler(
+		listBlocksUseCase,
+		createBlockUseCase,
+		deleteBlockUseCase,
+		listExceptionsUseCase,
+		createExceptionUseCase,
+		deleteExceptionUseCase,
+		getEffectiveAvailabilityUseCase,
+		listFreeSlotsUseCase,
+	)
+	appointmentHandlerInstance := appointmentHandler.NewAppointmentHandler(
+		bookAppointmentUseCase,
+		listMyAppointmentsUseCase,
+		listOrganizationAppointmentsUseCase,
+		getAppointmentUseCase,
+		getAppointmentHistoryUseCase,
+		confirmAppointmentUseCase,
+		checkInAppointmentUseCase,
+		completeAppointmentUseCase,
+		markNoShowUseCase,
+		cancelAppointmentUseCase,
+		rescheduleAppointmentUseCase,
+	)
+
+	calendarHandlerInstance := calendarHandler.NewCalendarHandler(
+		regenerateCalendarFeedUseCase,
+		revokeCalendarFeedUseCase,
+		getCalendarFeedUseCase,
+	)
 
 	// Initialize router
-	routerInstance := router.NewRouter(authHandlerInstance)
+	routerInstance := router.NewRouter(
+		tokenService,
+		authorizer,
+		accountGuard,
+		consentGuard,
+		authHandlerInstance,
+		twoFactorHandlerInstance,
+		roleHandlerInstance,
+		meHandlerInstance,
+		legalHandlerInstance,
+		organizationHandlerInstance,
+		invitationHandlerInstance,
+		locationHandlerInstance,
+		doctorHandlerInstance,
+		availabilityHandlerInstance,
+		appointmentHandlerInstance,
+		calendarHandlerInstance,
+	)
 
 	// Initialize HTTP server
 	server := httpServer.NewServer(cfg.Port, routerInstance.SetupRoutes())
 
+	// Initialize background jobs
+	jobRunner := jobs.NewRunner()
+	jobRunner.ScheduleExclusive(dbConn.DB, "anonymize-deleted-accounts", constants.AccountDeletionConfig.AnonymizationInterval, anonymizeDeletedAccountsUseCase.Execute)
+	if len(cfg.AppointmentReminderOffsets) > 0 {
+		jobRunner.ScheduleExclusive(dbConn.DB, "send-appointment-reminders", constants.AppointmentConfig.ReminderInterval, sendAppointmentRemindersUseCase.Execute)
+	}
+
 	return &Container{
 		Server: server,
+		jobs:   jobRunner,
 		dbConn: dbConn,
 	}
 }
 
+// Start launches the background jobs and serves HTTP requests in the background until Shutdown
+func (c *Container) Start() {
+	c.jobs.Start()
+
+	go func() {
+		if err := c.Server.Start(); err != nil {
+			log.Fatalf("Error starting the server: %v", err)
+		}
+	}()
+}
+
 // Cleanup closes all resources and connections
 func (c *Container) Cleanup() {
 	log.Println("Closing connections...")
@@ -74,5 +311,7 @@ func (c *Container) Shutdown() {
 		log.Printf("Error during server shutdown: %v", err)
 	}
 
+	c.jobs.Stop()
+
 	c.Cleanup()
 }
@@ -0,0 +1,35 @@
+package dto
+
+import "time"
+
+// AppointmentResponse represents a booked appointment
+// Timezone is the location's IANA timezone, for displaying the start and end times
+type AppointmentResponse struct {
+	ID               int       `json:"id"`
+	Status           string    `json:"status"`
+	StartTime        time.Time `json:"startTime"`
+	EndTime          time.Time `json:"endTime"`
+	Reason           *string   `json:"reason"`
+	DoctorID         int       `json:"doctorId"`
+	DoctorFirstName  *string   `json:"doctorFirstName"`
+	DoctorLastName   *string   `json:"doctorLastName"`
+	OrganizationID   int       `json:"organizationId"`
+	OrganizationName string    `json:"organizationName"`
+	LocationID       int       `json:"locationId"`
+	LocationName     string    `json:"locationName"`
+	Timezone         string    `json:"timezone"`
+	CreatedDate      time.Time `json:"createdDate"`
+}
+
+// AppointmentStatusChangeResponse represents one entry of an appointment's audit trail
+// FromStatus is null for the booking itself
+type AppointmentStatusChangeResponse struct {
+	ID          int       `json:"id"`
+	FromStatus  *string   `json:"fromStatus"`
+	ToStatus    string    `json:"toStatus"`
+	StartTime   time.Time `json:"startTime"`
+	EndTime     time.Time `json:"endTime"`
+	ChangedBy   int       `json:"changedBy"`
+	Reason      *string   `json:"reason"`
+	CreatedDate time.Time `json:"createdDate"`
+}
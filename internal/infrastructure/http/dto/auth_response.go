@@ -9,3 +9,54 @@ type SignupResponse struct {
 	EmailVerified bool      `json:"emailVerified"`
 	CreatedDate   time.Time `json:"createdDate"`
 }
+
+// VerifyEmailResponse represents the API response for email verification
+type VerifyEmailResponse struct {
+	Email         string `json:"email"`
+	EmailVerified bool   `json:"emailVerified"`
+}
+
+// TokenResponse represents an issued access token and refresh token pair
+type TokenResponse struct {
+	AccessToken           string    `json:"accessToken"`
+	TokenType             string    `json:"tokenType"`
+	ExpiresIn             int       `json:"expiresIn"`
+	ExpiresAt             time.Time `json:"expiresAt"`
+	RefreshToken          string    `json:"refreshToken"`
+	RefreshTokenExpiresAt time.Time `json:"refreshTokenExpiresAt"`
+}
+
+// LoginResponse represents the API response for a successful login or token refresh
+type LoginResponse struct {
+	TokenResponse
+	User AuthUserResponse `json:"user"`
+}
+
+// AuthUserResponse represents the authenticated user returned alongside tokens
+// Role and OrganizationID describe the organization the session acts within and the role held there
+type AuthUserResponse struct {
+	ID             int        `json:"id"`
+	Email          string     `json:"email"`
+	EmailVerified  bool       `json:"emailVerified"`
+	Role           string     `json:"role"`
+	OrganizationID *int       `json:"organizationId,omitempty"`
+	LastLogin      *time.Time `json:"lastLogin,omitempty"`
+}
+
+// MFAChallengeResponse represents the API response for a login that still needs a second factor
+type MFAChallengeResponse struct {
+	MFARequired bool      `json:"mfaRequired"`
+	MFAToken    string    `json:"mfaToken"`
+	ExpiresAt   time.Time `json:"expiresAt"`
+}
+
+// TwoFactorSetupResponse represents a pending two-factor enrollment
+type TwoFactorSetupResponse struct {
+	Secret     string `json:"secret"`
+	OTPAuthURI string `json:"otpauthUri"`
+}
+
+// TwoFactorEnabledResponse represents the recovery codes issued when two-factor is enabled
+type TwoFactorEnabledResponse struct {
+	RecoveryCodes []string `json:"recoveryCodes"`
+}
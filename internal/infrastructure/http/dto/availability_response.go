@@ -0,0 +1,46 @@
+package dto
+
+import "time"
+
+// AvailabilityBlockResponse represents recurring weekly working hours of a doctor
+// Weekday counts from Sunday (0); times are "HH:MM" local to the location and dates are "YYYY-MM-DD"
+type AvailabilityBlockResponse struct {
+	ID          int       `json:"id"`
+	LocationID  int       `json:"locationId"`
+	Weekday     int       `json:"weekday"`
+	StartTime   string    `json:"startTime"`
+	EndTime     string    `json:"endTime"`
+	ValidFrom   *string   `json:"validFrom"`
+	ValidUntil  *string   `json:"validUntil"`
+	CreatedDate time.Time `json:"createdDate"`
+}
+
+// AvailabilityExceptionResponse represents a date-specific change to a doctor's weekly hours
+// Times are omitted for whole-day exceptions
+type AvailabilityExceptionResponse struct {
+	ID          int       `json:"id"`
+	LocationID  *int      `json:"locationId"`
+	Kind        string    `json:"kind"`
+	StartDate   string    `json:"startDate"`
+	EndDate     string    `json:"endDate"`
+	StartTime   *string   `json:"startTime,omitempty"`
+	EndTime     *string   `json:"endTime,omitempty"`
+	Reason      *string   `json:"reason"`
+	CreatedDate time.Time `json:"createdDate"`
+}
+
+// AvailabilityWindowResponse represents a span of time a doctor is available at a location
+type AvailabilityWindowResponse struct {
+	LocationID int       `json:"locationId"`
+	Start      time.Time `json:"start"`
+	End        time.Time `json:"end"`
+}
+
+// AvailabilitySlotResponse represents a bookable slot of a doctor
+// Start and End carry the UTC offset of the location so clients can show local wall-clock times
+type AvailabilitySlotResponse struct {
+	LocationID int       `json:"locationId"`
+	Timezone   string    `json:"timezone"`
+	Start      time.Time `json:"start"`
+	End        time.Time `json:"end"`
+}
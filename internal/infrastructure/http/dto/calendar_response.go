@@ -0,0 +1,7 @@
+package dto
+
+// CalendarFeedResponse represents a newly issued calendar subscription URL
+// The URL embeds a secret token and is only returned when it is issued
+type CalendarFeedResponse struct {
+	URL string `json:"url"`
+}
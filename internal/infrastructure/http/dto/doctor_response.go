@@ -0,0 +1,51 @@
+package dto
+
+import "time"
+
+// SpecialtyResponse represents a medical specialty
+type SpecialtyResponse struct {
+	ID   int    `json:"id"`
+	Code string `json:"code"`
+	Name string `json:"name"`
+}
+
+// DoctorResponse represents a doctor profile as managed by its organization
+type DoctorResponse struct {
+	ID                   int                 `json:"id"`
+	UserID               int                 `json:"userId"`
+	FirstName            *string             `json:"firstName"`
+	LastName             *string             `json:"lastName"`
+	LicenseNumber        string              `json:"licenseNumber"`
+	Bio                  *string             `json:"bio"`
+	Languages            []string            `json:"languages"`
+	ConsultationMinutes  int                 `json:"consultationMinutes"`
+	BufferMinutes        int                 `json:"bufferMinutes"`
+	ConsultationFeeCents *int                `json:"consultationFeeCents"`
+	FeeCurrency          string              `json:"feeCurrency"`
+	Specialties          []SpecialtyResponse `json:"specialties"`
+	Active               bool                `json:"active"`
+	CreatedDate          time.Time           `json:"createdDate"`
+	UpdatedDate          *time.Time          `json:"updatedDate,omitempty"`
+}
+
+// PublicDoctorResponse represents a doctor in the public listing patients search
+type PublicDoctorResponse struct {
+	ID                   int                        `json:"id"`
+	FirstName            *string                    `json:"firstName"`
+	LastName             *string                    `json:"lastName"`
+	LicenseNumber        string                     `json:"licenseNumber"`
+	Bio                  *string                    `json:"bio"`
+	Languages            []string                   `json:"languages"`
+	ConsultationMinutes  int                        `json:"consultationMinutes"`
+	ConsultationFeeCents *int                       `json:"consultationFeeCents"`
+	FeeCurrency          string                     `json:"feeCurrency"`
+	Specialties          []SpecialtyResponse        `json:"specialties"`
+	Organization         PublicOrganizationResponse `json:"organization"`
+}
+
+// PublicOrganizationResponse represents the clinic a publicly listed doctor practices at
+type PublicOrganizationResponse struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+	Slug string `json:"slug"`
+}
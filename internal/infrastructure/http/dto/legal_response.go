@@ -0,0 +1,21 @@
+package dto
+
+import "time"
+
+// LegalDocumentResponse represents a published legal document version
+type LegalDocumentResponse struct {
+	Type        string    `json:"type"`
+	Version     string    `json:"version"`
+	Title       string    `json:"title"`
+	URL         string    `json:"url"`
+	PublishedAt time.Time `json:"publishedAt"`
+}
+
+// ConsentResponse represents a user's acceptance of a legal document version
+type ConsentResponse struct {
+	Type       string    `json:"type"`
+	Version    string    `json:"version"`
+	AcceptedAt time.Time `json:"acceptedAt"`
+	IPAddress  *string   `json:"ipAddress"`
+	UserAgent  *string   `json:"userAgent"`
+}
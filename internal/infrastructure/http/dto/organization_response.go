@@ -0,0 +1,61 @@
+package dto
+
+import "time"
+
+// OrganizationResponse represents an organization (clinic)
+type OrganizationResponse struct {
+	ID          int        `json:"id"`
+	Name        string     `json:"name"`
+	Slug        string     `json:"slug"`
+	Email       *string    `json:"email"`
+	Phone       *string    `json:"phone"`
+	Address     *string    `json:"address"`
+	Timezone    string     `json:"timezone"`
+	OwnerUserID int        `json:"ownerUserId"`
+	CreatedDate time.Time  `json:"createdDate"`
+	UpdatedDate *time.Time `json:"updatedDate,omitempty"`
+}
+
+// MembershipResponse represents an organization the current user works in and their role there
+// Active marks the organization the current session acts within
+type MembershipResponse struct {
+	OrganizationID   int       `json:"organizationId"`
+	OrganizationName string    `json:"organizationName"`
+	Role             string    `json:"role"`
+	Active           bool      `json:"active"`
+	CreatedDate      time.Time `json:"createdDate"`
+}
+
+// InvitationResponse represents an organization invitation as seen by the organization
+// The token is never returned; it only travels in the invitation email
+type InvitationResponse struct {
+	ID               int        `json:"id"`
+	Email            string     `json:"email"`
+	Role             string     `json:"role"`
+	Status           string     `json:"status"`
+	InvitedByUserID  int        `json:"invitedByUserId"`
+	AcceptedByUserID *int       `json:"acceptedByUserId,omitempty"`
+	ExpiresAt        time.Time  `json:"expiresAt"`
+	AcceptedAt       *time.Time `json:"acceptedAt,omitempty"`
+	RevokedAt        *time.Time `json:"revokedAt,omitempty"`
+	CreatedDate      time.Time  `json:"createdDate"`
+}
+
+// AcceptInvitationResponse represents the outcome of accepting an invitation
+type AcceptInvitationResponse struct {
+	UserID         int    `json:"userId"`
+	OrganizationID int    `json:"organizationId"`
+	Role           string `json:"role"`
+	AccountCreated bool   `json:"accountCreated"`
+}
+
+// LocationResponse represents a place an organization sees patients at
+type LocationResponse struct {
+	ID          int        `json:"id"`
+	Name        string     `json:"name"`
+	Address     *string    `json:"address"`
+	Timezone    string     `json:"timezone"`
+	Active      bool       `json:"active"`
+	CreatedDate time.Time  `json:"createdDate"`
+	UpdatedDate *time.Time `json:"updatedDate,omitempty"`
+}
@@ -0,0 +1,26 @@
+package dto
+
+// PageResponse represents one page of a paginated listing
+type PageResponse struct {
+	Items      interface{} `json:"items"`
+	Page       int         `json:"page"`
+	PageSize   int         `json:"pageSize"`
+	TotalItems int         `json:"totalItems"`
+	TotalPages int         `json:"totalPages"`
+}
+
+// NewPageResponse builds a page from its items, its 1-based position and the total number of items
+func NewPageResponse(items interface{}, page, pageSize, totalItems int) PageResponse {
+	totalPages := 0
+	if pageSize > 0 {
+		totalPages = (totalItems + pageSize - 1) / pageSize
+	}
+
+	return PageResponse{
+		Items:      items,
+		Page:       page,
+		PageSize:   pageSize,
+		TotalItems: totalItems,
+		TotalPages: totalPages,
+	}
+}
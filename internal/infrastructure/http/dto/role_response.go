@@ -0,0 +1,17 @@
+package dto
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// RoleResponse represents a role returned by the role management API
+type RoleResponse struct {
+	ID          int              `json:"id"`
+	Name        string           `json:"name"`
+	Code        string           `json:"code"`
+	Description *string          `json:"description,omitempty"`
+	Permissions *json.RawMessage `json:"permissions"`
+	Active      bool             `json:"active"`
+	CreatedDate time.Time        `json:"createdDate"`
+}
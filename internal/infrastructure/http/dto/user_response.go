@@ -0,0 +1,108 @@
+package dto
+
+import "time"
+
+// MeResponse represents the current user's account state and profile
+type MeResponse struct {
+	ID               int             `json:"id"`
+	Email            string          `json:"email"`
+	EmailVerified    bool            `json:"emailVerified"`
+	Role             string          `json:"role"`
+	OrganizationID   *int            `json:"organizationId,omitempty"`
+	TwoFactorEnabled bool            `json:"twoFactorEnabled"`
+	LastLogin        *time.Time      `json:"lastLogin,omitempty"`
+	CreatedDate      time.Time       `json:"createdDate"`
+	Profile          ProfileResponse `json:"profile"`
+}
+
+// ProfileResponse represents the personal data of a user
+type ProfileResponse struct {
+	FirstName   *string    `json:"firstName"`
+	LastName    *string    `json:"lastName"`
+	Phone       *string    `json:"phone"`
+	BirthDate   *string    `json:"birthDate"`
+	Locale      *string    `json:"locale"`
+	AvatarURL   *string    `json:"avatarUrl"`
+	UpdatedDate *time.Time `json:"updatedDate,omitempty"`
+}
+
+// AccountDeletedResponse represents a deleted account awaiting anonymization
+type AccountDeletedResponse struct {
+	AnonymizeAfter time.Time `json:"anonymizeAfter"`
+}
+
+// DataExportResponse represents the archive of everything stored about the current user
+type DataExportResponse struct {
+	ExportedAt   time.Time                   `json:"exportedAt"`
+	Account      MeResponse                  `json:"account"`
+	Sessions     []SessionExportResponse     `json:"sessions"`
+	Consents     []ConsentResponse           `json:"consents"`
+	Events       []EventExportResponse       `json:"events"`
+	Memberships  []MembershipExportResponse  `json:"memberships"`
+	Invitations  []InvitationExportResponse  `json:"invitations"`
+	Doctors      []DoctorExportResponse      `json:"doctorProfiles"`
+	Appointments []AppointmentResponse       `json:"appointments"`
+	CalendarFeed *CalendarFeedExportResponse `json:"calendarFeed"`
+}
+
+// SessionExportResponse represents a refresh token session without its secret
+type SessionExportResponse struct {
+	DeviceName  *string    `json:"deviceName"`
+	UserAgent   *string    `json:"userAgent"`
+	IPAddress   *string    `json:"ipAddress"`
+	CreatedDate time.Time  `json:"createdDate"`
+	ExpiresAt   time.Time  `json:"expiresAt"`
+	RevokedAt   *time.Time `json:"revokedAt,omitempty"`
+}
+
+// CalendarFeedExportResponse represents the user's calendar subscription URL without its secret token
+// The token cannot be exported: only its hash is stored
+type CalendarFeedExportResponse struct {
+	CreatedDate time.Time `json:"createdDate"`
+}
+
+// MembershipExportResponse represents an organization the user belongs to and their role there
+type MembershipExportResponse struct {
+	OrganizationID   int        `json:"organizationId"`
+	OrganizationName string     `json:"organizationName"`
+	Role             string     `json:"role"`
+	Active           bool       `json:"active"`
+	CreatedDate      time.Time  `json:"createdDate"`
+	UpdatedDate      *time.Time `json:"updatedDate,omitempty"`
+}
+
+// InvitationExportResponse represents an invitation to join an organization sent to the user, without its token
+type InvitationExportResponse struct {
+	OrganizationID int        `json:"organizationId"`
+	Email          string     `json:"email"`
+	Role           string     `json:"role"`
+	Status         string     `json:"status"`
+	ExpiresAt      time.Time  `json:"expiresAt"`
+	AcceptedAt     *time.Time `json:"acceptedAt,omitempty"`
+	RevokedAt      *time.Time `json:"revokedAt,omitempty"`
+	CreatedDate    time.Time  `json:"createdDate"`
+}
+
+// DoctorExportResponse represents a doctor profile of the user in one organization
+type DoctorExportResponse struct {
+	OrganizationName     string     `json:"organizationName"`
+	LicenseNumber        string     `json:"licenseNumber"`
+	Bio                  *string    `json:"bio"`
+	Languages            []string   `json:"languages"`
+	Specialties          []string   `json:"specialties"`
+	ConsultationMinutes  int        `json:"consultationMinutes"`
+	BufferMinutes        int        `json:"bufferMinutes"`
+	ConsultationFeeCents *int       `json:"consultationFeeCents"`
+	FeeCurrency          string     `json:"feeCurrency"`
+	Active               bool       `json:"active"`
+	CreatedDate          time.Time  `json:"createdDate"`
+	UpdatedDate          *time.Time `json:"updatedDate,omitempty"`
+}
+
+// EventExportResponse represents an entry of the account audit trail
+type EventExportResponse struct {
+	Type        string    `json:"type"`
+	Details     *string   `json:"details,omitempty"`
+	IPAddress   *string   `json:"ipAddress"`
+	CreatedDate time.Time `json:"createdDate"`
+}
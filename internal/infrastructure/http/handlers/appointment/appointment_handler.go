@@ -0,0 +1,402 @@
+package appointment
+
+import (
+	appointmentDTO "citary-backend/internal/domain/dtos/appointment"
+	"citary-backend/internal/domain/entities"
+	"citary-backend/internal/domain/errors"
+	"citary-backend/internal/domain/security"
+	"citary-backend/internal/domain/usecases/appointment"
+	httpDTO "citary-backend/internal/infrastructure/http/dto"
+	"citary-backend/internal/infrastructure/http/response"
+	"citary-backend/pkg/constants"
+	"context"
+	"encoding/json"
+	stdErrors "errors"
+	"io"
+	"net/http"
+	"strconv"
+)
+
+// clinicTransitionFunc is the Execute signature shared by the clinic-only status transitions
+type clinicTransitionFunc func(ctx context.Context, organizationID, userID, id int, dto appointmentDTO.ChangeStatusRequest) (*entities.Appointment, error)
+
+// AppointmentHandler handles HTTP requests for booking appointments and moving them through their lifecycle
+// Patient routes act on the caller's own appointments; clinic routes act on the caller's organization
+type AppointmentHandler struct {
+	bookAppointmentUseCase              *appointment.BookAppointmentUseCase
+	listMyAppointmentsUseCase           *appointment.ListMyAppointmentsUseCase
+	listOrganizationAppointmentsUseCase *appointment.ListOrganizationAppointmentsUseCase
+	getAppointmentUseCase               *appointment.GetAppointmentUseCase
+	getAppointmentHistoryUseCase        *appointment.GetAppointmentHistoryUseCase
+	confirmAppointmentUseCase           *appointment.ConfirmAppointmentUseCase
+	checkInAppointmentUseCase           *appointment.CheckInAppointmentUseCase
+	completeAppointmentUseCase          *appointment.CompleteAppointmentUseCase
+	markNoShowUseCase                   *appointment.MarkNoShowUseCase
+	cancelAppointmentUseCase            *appointment.CancelAppointmentUseCase
+	rescheduleAppointmentUseCase        *appointment.RescheduleAppointmentUseCase
+}
+
+// NewAppointmentHandler creates a new instance of AppointmentHandler
+func NewAppointmentHandler(
+	bookAppointmentUseCase *appointment.BookAppointmentUseCase,
+	listMyAppointmentsUseCase *appointment.ListMyAppointmentsUseCase,
+	listOrganizationAppointmentsUseCase *appointment.ListOrganizationAppointmentsUseCase,
+	getAppointmentUseCase *appointment.GetAppointmentUseCase,
+	getAppointmentHistoryUseCase *appointment.GetAppointmentHistoryUseCase,
+	confirmAppointmentUseCase *appointment.ConfirmAppointmentUseCase,
+	checkInAppointmentUseCase *appointment.CheckInAppointmentUseCase,
+	completeAppointmentUseCase *appointment.CompleteAppointmentUseCase,
+	markNoShowUseCase *appointment.MarkNoShowUseCase,
+	cancelAppointmentUseCase *appointment.CancelAppointmentUseCase,
+	rescheduleAppointmentUseCase *appointment.RescheduleAppointmentUseCase,
+) *AppointmentHandler {
+	return &AppointmentHandler{
+		bookAppointmentUseCase:              bookAppointmentUseCase,
+		listMyAppointmentsUseCase:           listMyAppointmentsUseCase,
+		listOrganizationAppointmentsUseCase: listOrganizationAppointmentsUseCase,
+		getAppointmentUseCase:               getAppointmentUseCase,
+		getAppointmentHistoryUseCase:        getAppointmentHistoryUseCase,
+		confirmAppointmentUseCase:           confirmAppointmentUseCase,
+		checkInAppointmentUseCase:           checkInAppointmentUseCase,
+		completeAppointmentUseCase:          completeAppointmentUseCase,
+		markNoShowUseCase:                   markNoShowUseCase,
+		cancelAppointmentUseCase:            cancelAppointmentUseCase,
+		rescheduleAppointmentUseCase:        rescheduleAppointmentUseCase,
+	}
+}
+
+// BookAppointment handles requests by the caller to book a doctor
+func (h *AppointmentHandler) BookAppointment(w http.ResponseWriter, r *http.Request) {
+	principal, ok := security.PrincipalFromContext(r.Context())
+	if !ok {
+		response.SendError(w, constants.StatusCode.Unauthorized, constants.ErrorMessages.Unauthorized)
+		return
+	}
+
+	var req appointmentDTO.BookAppointmentRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.SendError(w, constants.StatusCode.BadRequest, "Invalid JSON")
+		return
+	}
+
+	booked, err := h.bookAppointmentUseCase.Execute(r.Context(), principal.UserID, req)
+	if err != nil {
+		response.HandleDomainError(w, err)
+		return
+	}
+
+	response.SendSuccess(w, constants.StatusCode.Created, constants.SuccessMessages.AppointmentBooked, newAppointmentResponse(booked))
+}
+
+// ListMyAppointments handles requests for the caller's upcoming appointments
+func (h *AppointmentHandler) ListMyAppointments(w http.ResponseWriter, r *http.Request) {
+	principal, ok := security.PrincipalFromContext(r.Context())
+	if !ok {
+		response.SendError(w, constants.StatusCode.Unauthorized, constants.ErrorMessages.Unauthorized)
+		return
+	}
+
+	appointments, err := h.listMyAppointmentsUseCase.Execute(r.Context(), principal.UserID)
+	if err != nil {
+		response.HandleDomainError(w, err)
+		return
+	}
+
+	response.SendSuccess(w, constants.StatusCode.Ok, constants.SuccessMessages.AppointmentsRetrieved, newAppointmentResponses(appointments))
+}
+
+// ListOrganizationAppointments handles requests for the appointments of the caller's organization in a time range
+// Query: from, to (RFC 3339)
+func (h *AppointmentHandler) ListOrganizationAppointments(w http.ResponseWriter, r *http.Request) {
+	organizationID, err := security.OrganizationScope(r.Context())
+	if err != nil {
+		response.HandleDomainError(w, err)
+		return
+	}
+
+	params := r.URL.Query()
+	query := appointmentDTO.ListAppointmentsQuery{
+		From: params.Get("from"),
+		To:   params.Get("to"),
+	}
+
+	appointments, err := h.listOrganizationAppointmentsUseCase.Execute(r.Context(), organizationID, query)
+	if err != nil {
+		response.HandleDomainError(w, err)
+		return
+	}
+
+	response.SendSuccess(w, constants.StatusCode.Ok, constants.SuccessMessages.AppointmentsRetrieved, newAppointmentResponses(appointments))
+}
+
+// GetMyAppointment handles requests for one of the caller's appointments
+func (h *AppointmentHandler) GetMyAppointment(w http.ResponseWriter, r *http.Request) {
+	h.getAppointment(w, r, patientActor)
+}
+
+// GetOrganizationAppointment handles requests for one appointment of the caller's organization
+func (h *AppointmentHandler) GetOrganizationAppointment(w http.ResponseWriter, r *http.Request) {
+	h.getAppointment(w, r, clinicActor)
+}
+
+// GetMyAppointmentHistory handles requests for the audit trail of one of the caller's appointments
+func (h *AppointmentHandler) GetMyAppointmentHistory(w http.ResponseWriter, r *http.Request) {
+	h.getAppointmentHistory(w, r, patientActor)
+}
+
+// GetOrganizationAppointmentHistory handles requests for the audit trail of an appointment of the caller's organization
+func (h *AppointmentHandler) GetOrganizationAppointmentHistory(w http.ResponseWriter, r *http.Request) {
+	h.getAppointmentHistory(w, r, clinicActor)
+}
+
+// CancelMyAppointment handles requests by a patient to cancel one of their appointments
+func (h *AppointmentHandler) CancelMyAppointment(w http.ResponseWriter, r *http.Request) {
+	h.cancelAppointment(w, r, patientActor)
+}
+
+// CancelOrganizationAppointment handles requests by clinic staff to cancel an appointment of their organization
+func (h *AppointmentHandler) CancelOrganizationAppointment(w http.ResponseWriter, r *http.Request) {
+	h.cancelAppointment(w, r, clinicActor)
+}
+
+// RescheduleMyAppointment handles requests by a patient to move one of their appointments
+func (h *AppointmentHandler) RescheduleMyAppointment(w http.ResponseWriter, r *http.Request) {
+	h.rescheduleAppointment(w, r, patientActor)
+}
+
+// RescheduleOrganizationAppointment handles requests by clinic staff to move an appointment of their organization
+func (h *AppointmentHandler) RescheduleOrganizationAppointment(w http.ResponseWriter, r *http.Request) {
+	h.rescheduleAppointment(w, r, clinicActor)
+}
+
+// ConfirmAppointment handles requests by clinic staff to confirm a requested appointment
+func (h *AppointmentHandler) ConfirmAppointment(w http.ResponseWriter, r *http.Request) {
+	h.clinicTransition(w, r, h.confirmAppointmentUseCase.Execute, constants.SuccessMessages.AppointmentConfirmed)
+}
+
+// CheckInAppointment handles requests by clinic staff to check a patient in
+func (h *AppointmentHandler) CheckInAppointment(w http.ResponseWriter, r *http.Request) {
+	h.clinicTransition(w, r, h.checkInAppointmentUseCase.Execute, constants.SuccessMessages.AppointmentCheckedIn)
+}
+
+// CompleteAppointment handles requests by clinic staff to close an attended appointment
+func (h *AppointmentHandler) CompleteAppointment(w http.ResponseWriter, r *http.Request) {
+	h.clinicTransition(w, r, h.completeAppointmentUseCase.Execute, constants.SuccessMessages.AppointmentCompleted)
+}
+
+// MarkNoShow handles requests by clinic staff to record that a patient missed their appointment
+func (h *AppointmentHandler) MarkNoShow(w http.ResponseWriter, r *http.Request) {
+	h.clinicTransition(w, r, h.markNoShowUseCase.Execute, constants.SuccessMessages.AppointmentMarkedNoShow)
+}
+
+// getAppointment reads one appointment as the actor resolved from the request
+func (h *AppointmentHandler) getAppointment(w http.ResponseWriter, r *http.Request, resolveActor actorResolver) {
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		response.SendError(w, constants.StatusCode.BadRequest, "Invalid appointment ID")
+		return
+	}
+
+	actor, err := resolveActor(r)
+	if err != nil {
+		response.HandleDomainError(w, err)
+		return
+	}
+
+	found, err := h.getAppointmentUseCase.Execute(r.Context(), actor, id)
+	if err != nil {
+		response.HandleDomainError(w, err)
+		return
+	}
+
+	response.SendSuccess(w, constants.StatusCode.Ok, constants.SuccessMessages.AppointmentRetrieved, newAppointmentResponse(found))
+}
+
+// getAppointmentHistory reads the audit trail of an appointment as the actor resolved from the request
+func (h *AppointmentHandler) getAppointmentHistory(w http.ResponseWriter, r *http.Request, resolveActor actorResolver) {
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		response.SendError(w, constants.StatusCode.BadRequest, "Invalid appointment ID")
+		return
+	}
+
+	actor, err := resolveActor(r)
+	if err != nil {
+		response.HandleDomainError(w, err)
+		return
+	}
+
+	changes, err := h.getAppointmentHistoryUseCase.Execute(r.Context(), actor, id)
+	if err != nil {
+		response.HandleDomainError(w, err)
+		return
+	}
+
+	data := make([]httpDTO.AppointmentStatusChangeResponse, 0, len(changes))
+	for _, change := range changes {
+		data = append(data, httpDTO.AppointmentStatusChangeResponse{
+			ID:          change.ID,
+			FromStatus:  change.FromStatus,
+			ToStatus:    change.ToStatus,
+			StartTime:   change.StartTime,
+			EndTime:     change.EndTime,
+			ChangedBy:   change.ChangedBy,
+			Reason:      change.Reason,
+			CreatedDate: change.CreatedDate,
+		})
+	}
+
+	response.SendSuccess(w, constants.StatusCode.Ok, constants.SuccessMessages.AppointmentHistoryRetrieved, data)
+}
+
+// cancelAppointment cancels an appointment as the actor resolved from the request
+func (h *AppointmentHandler) cancelAppointment(w http.ResponseWriter, r *http.Request, resolveActor actorResolver) {
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		response.SendError(w, constants.StatusCode.BadRequest, "Invalid appointment ID")
+		return
+	}
+
+	actor, err := resolveActor(r)
+	if err != nil {
+		response.HandleDomainError(w, err)
+		return
+	}
+
+	var req appointmentDTO.ChangeStatusRequest
+	if err := decodeOptionalBody(r, &req); err != nil {
+		response.SendError(w, constants.StatusCode.BadRequest, "Invalid JSON")
+		return
+	}
+
+	cancelled, err := h.cancelAppointmentUseCase.Execute(r.Context(), actor, id, req)
+	if err != nil {
+		response.HandleDomainError(w, err)
+		return
+	}
+
+	response.SendSuccess(w, constants.StatusCode.Ok, constants.SuccessMessages.AppointmentCancelled, newAppointmentResponse(cancelled))
+}
+
+// rescheduleAppointment moves an appointment as the actor resolved from the request
+func (h *AppointmentHandler) rescheduleAppointment(w http.ResponseWriter, r *http.Request, resolveActor actorResolver) {
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		response.SendError(w, constants.StatusCode.BadRequest, "Invalid appointment ID")
+		return
+	}
+
+	actor, err := resolveActor(r)
+	if err != nil {
+		response.HandleDomainError(w, err)
+		return
+	}
+
+	var req appointmentDTO.RescheduleAppointmentRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.SendError(w, constants.StatusCode.BadRequest, "Invalid JSON")
+		return
+	}
+
+	rescheduled, err := h.rescheduleAppointmentUseCase.Execute(r.Context(), actor, id, req)
+	if err != nil {
+		response.HandleDomainError(w, err)
+		return
+	}
+
+	response.SendSuccess(w, constants.StatusCode.Ok, constants.SuccessMessages.AppointmentRescheduled, newAppointmentResponse(rescheduled))
+}
+
+// clinicTransition runs a clinic-only status transition on an appointment of the caller's organization
+func (h *AppointmentHandler) clinicTransition(w http.ResponseWriter, r *http.Request, execute clinicTransitionFunc, successMessage string) {
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		response.SendError(w, constants.StatusCode.BadRequest, "Invalid appointment ID")
+		return
+	}
+
+	actor, err := clinicActor(r)
+	if err != nil {
+		response.HandleDomainError(w, err)
+		return
+	}
+
+	var req appointmentDTO.ChangeStatusRequest
+	if err := decodeOptionalBody(r, &req); err != nil {
+		response.SendError(w, constants.StatusCode.BadRequest, "Invalid JSON")
+		return
+	}
+
+	updated, err := execute(r.Context(), *actor.OrganizationID, actor.UserID, id, req)
+	if err != nil {
+		response.HandleDomainError(w, err)
+		return
+	}
+
+	response.SendSuccess(w, constants.StatusCode.Ok, successMessage, newAppointmentResponse(updated))
+}
+
+// actorResolver determines on whose behalf a request acts on appointments
+type actorResolver func(r *http.Request) (appointment.Actor, error)
+
+// patientActor resolves the caller as the patient of their own appointments
+func patientActor(r *http.Request) (appointment.Actor, error) {
+	principal, ok := security.PrincipalFromContext(r.Context())
+	if !ok {
+		return appointment.Actor{}, errors.ErrUnauthorized(constants.ErrorMessages.Unauthorized)
+	}
+	return appointment.PatientActor(principal.UserID), nil
+}
+
+// clinicActor resolves the caller as staff of the organization their session is scoped to
+func clinicActor(r *http.Request) (appointment.Actor, error) {
+	principal, ok := security.PrincipalFromContext(r.Context())
+	if !ok {
+		return appointment.Actor{}, errors.ErrUnauthorized(constants.ErrorMessages.Unauthorized)
+	}
+
+	organizationID, err := security.OrganizationScope(r.Context())
+	if err != nil {
+		return appointment.Actor{}, err
+	}
+
+	return appointment.ClinicActor(principal.UserID, organizationID), nil
+}
+
+// decodeOptionalBody decodes a JSON body into dst, treating an empty body as an empty object
+func decodeOptionalBody(r *http.Request, dst any) error {
+	err := json.NewDecoder(r.Body).Decode(dst)
+	if stdErrors.Is(err, io.EOF) {
+		return nil
+	}
+	return err
+}
+
+// newAppointmentResponses maps appointment entities to their API representation
+func newAppointmentResponses(appointments []*entities.Appointment) []httpDTO.AppointmentResponse {
+	data := make([]httpDTO.AppointmentResponse, 0, len(appointments))
+	for _, item := range appointments {
+		data = append(data, newAppointmentResponse(item))
+	}
+	return data
+}
+
+// newAppointmentResponse maps an appointment entity to its API representation
+func newAppointmentResponse(item *entities.Appointment) httpDTO.AppointmentResponse {
+	return httpDTO.AppointmentResponse{
+		ID:               item.ID,
+		Status:           item.Status,
+		StartTime:        item.StartTime,
+		EndTime:          item.EndTime,
+		Reason:           item.Reason,
+		DoctorID:         item.DoctorID,
+		DoctorFirstName:  item.DoctorFirstName,
+		DoctorLastName:   item.DoctorLastName,
+		OrganizationID:   item.OrganizationID,
+		OrganizationName: item.OrganizationName,
+		LocationID:       item.LocationID,
+		LocationName:     item.LocationName,
+		Timezone:         item.LocationTimezone,
+		CreatedDate:      item.CreatedDate,
+	}
+}
@@ -2,23 +2,64 @@ package auth
 
 import (
 	authDTO "citary-backend/internal/domain/dtos/auth"
+	"citary-backend/internal/domain/security"
 	"citary-backend/internal/domain/usecases/auth"
 	httpDTO "citary-backend/internal/infrastructure/http/dto"
+	"citary-backend/internal/infrastructure/http/request"
 	"citary-backend/internal/infrastructure/http/response"
 	"citary-backend/pkg/constants"
 	"encoding/json"
 	"net/http"
+	"time"
 )
 
 // AuthHandler handles HTTP requests for authentication operations
 type AuthHandler struct {
-	signupUserUseCase *auth.SignupUserUseCase
+	signupUserUseCase           *auth.SignupUserUseCase
+	verifyEmailUseCase          *auth.VerifyEmailUseCase
+	resendVerificationUseCase   *auth.ResendVerificationUseCase
+	loginUseCase                *auth.LoginUseCase
+	refreshTokenUseCase         *auth.RefreshTokenUseCase
+	logoutUseCase               *auth.LogoutUseCase
+	requestPasswordResetUseCase *auth.RequestPasswordResetUseCase
+	resetPasswordUseCase        *auth.ResetPasswordUseCase
+	changePasswordUseCase       *auth.ChangePasswordUseCase
+	requestEmailChangeUseCase   *auth.RequestEmailChangeUseCase
+	confirmEmailChangeUseCase   *auth.ConfirmEmailChangeUseCase
+	reactivateAccountUseCase    *auth.ReactivateAccountUseCase
+	switchOrganizationUseCase   *auth.SwitchOrganizationUseCase
 }
 
 // NewAuthHandler creates a new instance of AuthHandler
-func NewAuthHandler(signupUserUseCase *auth.SignupUserUseCase) *AuthHandler {
+func NewAuthHandler(
+	signupUserUseCase *auth.SignupUserUseCase,
+	verifyEmailUseCase *auth.VerifyEmailUseCase,
+	resendVerificationUseCase *auth.ResendVerificationUseCase,
+	loginUseCase *auth.LoginUseCase,
+	refreshTokenUseCase *auth.RefreshTokenUseCase,
+	logoutUseCase *auth.LogoutUseCase,
+	requestPasswordResetUseCase *auth.RequestPasswordResetUseCase,
+	resetPasswordUseCase *auth.ResetPasswordUseCase,
+	changePasswordUseCase *auth.ChangePasswordUseCase,
+	requestEmailChangeUseCase *auth.RequestEmailChangeUseCase,
+	confirmEmailChangeUseCase *auth.ConfirmEmailChangeUseCase,
+	reactivateAccountUseCase *auth.ReactivateAccountUseCase,
+	switchOrganizationUseCase *auth.SwitchOrganizationUseCase,
+) *AuthHandler {
 	return &AuthHandler{
-		signupUserUseCase: signupUserUseCase,
+		signupUserUseCase:           signupUserUseCase,
+		verifyEmailUseCase:          verifyEmailUseCase,
+		resendVerificationUseCase:   resendVerificationUseCase,
+		loginUseCase:                loginUseCase,
+		refreshTokenUseCase:         refreshTokenUseCase,
+		logoutUseCase:               logoutUseCase,
+		requestPasswordResetUseCase: requestPasswordResetUseCase,
+		resetPasswordUseCase:        resetPasswordUseCase,
+		changePasswordUseCase:       changePasswordUseCase,
+		requestEmailChangeUseCase:   requestEmailChangeUseCase,
+		confirmEmailChangeUseCase:   confirmEmailChangeUseCase,
+		reactivateAccountUseCase:    reactivateAccountUseCase,
+		switchOrganizationUseCase:   switchOrganizationUseCase,
 	}
 }
 
@@ -34,6 +75,8 @@ func (h *AuthHandler) SignupUser(w http.ResponseWriter, r *http.Request) {
 		response.SendError(w, constants.StatusCode.BadRequest, "Invalid JSON")
 		return
 	}
+	req.IPAddress = request.ClientIP(r)
+	req.UserAgent = request.UserAgent(r)
 
 	user, err := h.signupUserUseCase.Execute(r.Context(), req)
 	if err != nil {
@@ -50,3 +93,337 @@ func (h *AuthHandler) SignupUser(w http.ResponseWriter, r *http.Request) {
 
 	response.SendSuccess(w, constants.StatusCode.Created, constants.SuccessMessages.UserCreated, authResponse)
 }
+
+// VerifyEmail handles email verification requests
+func (h *AuthHandler) VerifyEmail(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		response.SendError(w, constants.StatusCode.BadRequest, "Method not allowed")
+		return
+	}
+
+	var req authDTO.VerifyEmailRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.SendError(w, constants.StatusCode.BadRequest, "Invalid JSON")
+		return
+	}
+
+	user, err := h.verifyEmailUseCase.Execute(r.Context(), req)
+	if err != nil {
+		response.HandleDomainError(w, err)
+		return
+	}
+
+	verifyResponse := httpDTO.VerifyEmailResponse{
+		Email:         user.Email,
+		EmailVerified: user.EmailVerified,
+	}
+
+	response.SendSuccess(w, constants.StatusCode.Ok, constants.SuccessMessages.EmailVerified, verifyResponse)
+}
+
+// ResendVerification handles requests for a new email verification link
+func (h *AuthHandler) ResendVerification(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		response.SendError(w, constants.StatusCode.BadRequest, "Method not allowed")
+		return
+	}
+
+	var req authDTO.ResendVerificationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.SendError(w, constants.StatusCode.BadRequest, "Invalid JSON")
+		return
+	}
+
+	if err := h.resendVerificationUseCase.Execute(r.Context(), req); err != nil {
+		response.HandleDomainError(w, err)
+		return
+	}
+
+	response.SendSuccess(w, constants.StatusCode.Accepted, constants.SuccessMessages.VerificationEmailSent, nil)
+}
+
+// Login handles password authentication requests
+func (h *AuthHandler) Login(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		response.SendError(w, constants.StatusCode.BadRequest, "Method not allowed")
+		return
+	}
+
+	var req authDTO.LoginRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.SendError(w, constants.StatusCode.BadRequest, "Invalid JSON")
+		return
+	}
+
+	req.UserAgent = request.UserAgent(r)
+	req.IPAddress = request.ClientIP(r)
+
+	result, err := h.loginUseCase.Execute(r.Context(), req)
+	if err != nil {
+		response.HandleDomainError(w, err)
+		return
+	}
+
+	if result.MFARequired {
+		challengeResponse := httpDTO.MFAChallengeResponse{
+			MFARequired: true,
+			MFAToken:    result.MFAChallenge.Token,
+			ExpiresAt:   result.MFAChallenge.ExpiresAt,
+		}
+		response.SendSuccess(w, constants.StatusCode.Ok, constants.SuccessMessages.TwoFactorRequired, challengeResponse)
+		return
+	}
+
+	response.SendSuccess(w, constants.StatusCode.Ok, constants.SuccessMessages.LoginSuccessful, newLoginResponse(result))
+}
+
+// RefreshToken handles refresh token rotation requests
+func (h *AuthHandler) RefreshToken(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		response.SendError(w, constants.StatusCode.BadRequest, "Method not allowed")
+		return
+	}
+
+	var req authDTO.RefreshTokenRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.SendError(w, constants.StatusCode.BadRequest, "Invalid JSON")
+		return
+	}
+
+	req.UserAgent = request.UserAgent(r)
+	req.IPAddress = request.ClientIP(r)
+
+	result, err := h.refreshTokenUseCase.Execute(r.Context(), req)
+	if err != nil {
+		response.HandleDomainError(w, err)
+		return
+	}
+
+	response.SendSuccess(w, constants.StatusCode.Ok, constants.SuccessMessages.TokenRefreshed, newLoginResponse(result))
+}
+
+// SwitchOrganization handles requests to move the caller's session into another organization
+func (h *AuthHandler) SwitchOrganization(w http.ResponseWriter, r *http.Request) {
+	principal, ok := security.PrincipalFromContext(r.Context())
+	if !ok {
+		response.SendError(w, constants.StatusCode.Unauthorized, constants.ErrorMessages.Unauthorized)
+		return
+	}
+
+	var req authDTO.SwitchOrganizationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.SendError(w, constants.StatusCode.BadRequest, "Invalid JSON")
+		return
+	}
+
+	req.UserAgent = request.UserAgent(r)
+	req.IPAddress = request.ClientIP(r)
+
+	result, err := h.switchOrganizationUseCase.Execute(r.Context(), principal.UserID, req)
+	if err != nil {
+		response.HandleDomainError(w, err)
+		return
+	}
+
+	response.SendSuccess(w, constants.StatusCode.Ok, constants.SuccessMessages.OrganizationSwitched, newLoginResponse(result))
+}
+
+// Logout handles requests to end the current session or every session of the user
+func (h *AuthHandler) Logout(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		response.SendError(w, constants.StatusCode.BadRequest, "Method not allowed")
+		return
+	}
+
+	var req authDTO.LogoutRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.SendError(w, constants.StatusCode.BadRequest, "Invalid JSON")
+		return
+	}
+
+	if err := h.logoutUseCase.Execute(r.Context(), req); err != nil {
+		response.HandleDomainError(w, err)
+		return
+	}
+
+	response.SendSuccess(w, constants.StatusCode.Ok, constants.SuccessMessages.LoggedOut, nil)
+}
+
+// ForgotPassword handles requests for a password reset link
+func (h *AuthHandler) ForgotPassword(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		response.SendError(w, constants.StatusCode.BadRequest, "Method not allowed")
+		return
+	}
+
+	var req authDTO.ForgotPasswordRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.SendError(w, constants.StatusCode.BadRequest, "Invalid JSON")
+		return
+	}
+
+	if err := h.requestPasswordResetUseCase.Execute(r.Context(), req); err != nil {
+		response.HandleDomainError(w, err)
+		return
+	}
+
+	response.SendSuccess(w, constants.StatusCode.Accepted, constants.SuccessMessages.PasswordResetRequested, nil)
+}
+
+// ResetPassword handles requests to set a new password with a reset token
+func (h *AuthHandler) ResetPassword(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		response.SendError(w, constants.StatusCode.BadRequest, "Method not allowed")
+		return
+	}
+
+	var req authDTO.ResetPasswordRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.SendError(w, constants.StatusCode.BadRequest, "Invalid JSON")
+		return
+	}
+
+	if err := h.resetPasswordUseCase.Execute(r.Context(), req); err != nil {
+		response.HandleDomainError(w, err)
+		return
+	}
+
+	response.SendSuccess(w, constants.StatusCode.Ok, constants.SuccessMessages.PasswordReset, nil)
+}
+
+// ReactivateAccount handles the reactivation link sent to inactive accounts
+func (h *AuthHandler) ReactivateAccount(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		response.SendError(w, constants.StatusCode.BadRequest, "Method not allowed")
+		return
+	}
+
+	var req authDTO.ReactivateAccountRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.SendError(w, constants.StatusCode.BadRequest, "Invalid JSON")
+		return
+	}
+
+	req.IPAddress = request.ClientIP(r)
+
+	user, err := h.reactivateAccountUseCase.Execute(r.Context(), req)
+	if err != nil {
+		response.HandleDomainError(w, err)
+		return
+	}
+
+	reactivateResponse := httpDTO.VerifyEmailResponse{
+		Email:         user.Email,
+		EmailVerified: user.EmailVerified,
+	}
+
+	response.SendSuccess(w, constants.StatusCode.Ok, constants.SuccessMessages.AccountReactivated, reactivateResponse)
+}
+
+// ChangePassword handles password changes by the authenticated user
+func (h *AuthHandler) ChangePassword(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		response.SendError(w, constants.StatusCode.BadRequest, "Method not allowed")
+		return
+	}
+
+	principal, ok := security.PrincipalFromContext(r.Context())
+	if !ok {
+		response.SendError(w, constants.StatusCode.Unauthorized, constants.ErrorMessages.Unauthorized)
+		return
+	}
+
+	var req authDTO.ChangePasswordRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.SendError(w, constants.StatusCode.BadRequest, "Invalid JSON")
+		return
+	}
+
+	if err := h.changePasswordUseCase.Execute(r.Context(), principal.UserID, req); err != nil {
+		response.HandleDomainError(w, err)
+		return
+	}
+
+	response.SendSuccess(w, constants.StatusCode.Ok, constants.SuccessMessages.PasswordChanged, nil)
+}
+
+// ChangeEmail handles requests by the authenticated user to move their account to a new email address
+func (h *AuthHandler) ChangeEmail(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		response.SendError(w, constants.StatusCode.BadRequest, "Method not allowed")
+		return
+	}
+
+	principal, ok := security.PrincipalFromContext(r.Context())
+	if !ok {
+		response.SendError(w, constants.StatusCode.Unauthorized, constants.ErrorMessages.Unauthorized)
+		return
+	}
+
+	var req authDTO.ChangeEmailRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.SendError(w, constants.StatusCode.BadRequest, "Invalid JSON")
+		return
+	}
+
+	if err := h.requestEmailChangeUseCase.Execute(r.Context(), principal.UserID, req); err != nil {
+		response.HandleDomainError(w, err)
+		return
+	}
+
+	response.SendSuccess(w, constants.StatusCode.Accepted, constants.SuccessMessages.EmailChangeRequested, nil)
+}
+
+// ConfirmEmailChange handles the confirmation link sent to a new email address
+func (h *AuthHandler) ConfirmEmailChange(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		response.SendError(w, constants.StatusCode.BadRequest, "Method not allowed")
+		return
+	}
+
+	var req authDTO.ConfirmEmailChangeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.SendError(w, constants.StatusCode.BadRequest, "Invalid JSON")
+		return
+	}
+
+	user, err := h.confirmEmailChangeUseCase.Execute(r.Context(), req)
+	if err != nil {
+		response.HandleDomainError(w, err)
+		return
+	}
+
+	emailResponse := httpDTO.VerifyEmailResponse{
+		Email:         user.Email,
+		EmailVerified: user.EmailVerified,
+	}
+
+	response.SendSuccess(w, constants.StatusCode.Ok, constants.SuccessMessages.EmailChanged, emailResponse)
+}
+
+// newTokenResponse maps an issued session to its API representation
+func newTokenResponse(session *auth.Session) httpDTO.TokenResponse {
+	return httpDTO.TokenResponse{
+		AccessToken:           session.AccessToken.Token,
+		TokenType:             "Bearer",
+		ExpiresIn:             int(time.Until(session.AccessToken.ExpiresAt).Seconds()),
+		ExpiresAt:             session.AccessToken.ExpiresAt,
+		RefreshToken:          session.RefreshToken.Token,
+		RefreshTokenExpiresAt: session.RefreshToken.ExpiresAt,
+	}
+}
+
+// newLoginResponse maps a login result to its API representation
+func newLoginResponse(result *auth.LoginResult) httpDTO.LoginResponse {
+	return httpDTO.LoginResponse{
+		TokenResponse: newTokenResponse(result.Session),
+		User: httpDTO.AuthUserResponse{
+			ID:             result.User.ID,
+			Email:          result.User.Email,
+			EmailVerified:  result.User.EmailVerified,
+			Role:           result.RoleCode,
+			OrganizationID: result.OrganizationID,
+			LastLogin:      result.User.LastLogin,
+		},
+	}
+}
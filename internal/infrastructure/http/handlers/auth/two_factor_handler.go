@@ -0,0 +1,145 @@
+package auth
+
+import (
+	authDTO "citary-backend/internal/domain/dtos/auth"
+	"citary-backend/internal/domain/security"
+	"citary-backend/internal/domain/usecases/auth"
+	httpDTO "citary-backend/internal/infrastructure/http/dto"
+	"citary-backend/internal/infrastructure/http/request"
+	"citary-backend/internal/infrastructure/http/response"
+	"citary-backend/pkg/constants"
+	"encoding/json"
+	"net/http"
+)
+
+// TwoFactorHandler handles HTTP requests for two-factor authentication
+type TwoFactorHandler struct {
+	setupTwoFactorUseCase       *auth.SetupTwoFactorUseCase
+	confirmTwoFactorUseCase     *auth.ConfirmTwoFactorUseCase
+	disableTwoFactorUseCase     *auth.DisableTwoFactorUseCase
+	verifyTwoFactorLoginUseCase *auth.VerifyTwoFactorLoginUseCase
+}
+
+// NewTwoFactorHandler creates a new instance of TwoFactorHandler
+func NewTwoFactorHandler(
+	setupTwoFactorUseCase *auth.SetupTwoFactorUseCase,
+	confirmTwoFactorUseCase *auth.ConfirmTwoFactorUseCase,
+	disableTwoFactorUseCase *auth.DisableTwoFactorUseCase,
+	verifyTwoFactorLoginUseCase *auth.VerifyTwoFactorLoginUseCase,
+) *TwoFactorHandler {
+	return &TwoFactorHandler{
+		setupTwoFactorUseCase:       setupTwoFactorUseCase,
+		confirmTwoFactorUseCase:     confirmTwoFactorUseCase,
+		disableTwoFactorUseCase:     disableTwoFactorUseCase,
+		verifyTwoFactorLoginUseCase: verifyTwoFactorLoginUseCase,
+	}
+}
+
+// Setup handles requests to start a two-factor enrollment for the authenticated user
+func (h *TwoFactorHandler) Setup(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		response.SendError(w, constants.StatusCode.BadRequest, "Method not allowed")
+		return
+	}
+
+	principal, ok := security.PrincipalFromContext(r.Context())
+	if !ok {
+		response.SendError(w, constants.StatusCode.Unauthorized, constants.ErrorMessages.Unauthorized)
+		return
+	}
+
+	setup, err := h.setupTwoFactorUseCase.Execute(r.Context(), principal.UserID)
+	if err != nil {
+		response.HandleDomainError(w, err)
+		return
+	}
+
+	setupResponse := httpDTO.TwoFactorSetupResponse{
+		Secret:     setup.Secret,
+		OTPAuthURI: setup.URI,
+	}
+
+	response.SendSuccess(w, constants.StatusCode.Ok, constants.SuccessMessages.TwoFactorSetupStarted, setupResponse)
+}
+
+// Confirm handles requests to complete a two-factor enrollment with a first code
+func (h *TwoFactorHandler) Confirm(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		response.SendError(w, constants.StatusCode.BadRequest, "Method not allowed")
+		return
+	}
+
+	principal, ok := security.PrincipalFromContext(r.Context())
+	if !ok {
+		response.SendError(w, constants.StatusCode.Unauthorized, constants.ErrorMessages.Unauthorized)
+		return
+	}
+
+	var req authDTO.ConfirmTwoFactorRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.SendError(w, constants.StatusCode.BadRequest, "Invalid JSON")
+		return
+	}
+
+	recoveryCodes, err := h.confirmTwoFactorUseCase.Execute(r.Context(), principal.UserID, req)
+	if err != nil {
+		response.HandleDomainError(w, err)
+		return
+	}
+
+	response.SendSuccess(w, constants.StatusCode.Ok, constants.SuccessMessages.TwoFactorEnabled, httpDTO.TwoFactorEnabledResponse{
+		RecoveryCodes: recoveryCodes,
+	})
+}
+
+// Disable handles requests to turn two-factor authentication off
+func (h *TwoFactorHandler) Disable(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		response.SendError(w, constants.StatusCode.BadRequest, "Method not allowed")
+		return
+	}
+
+	principal, ok := security.PrincipalFromContext(r.Context())
+	if !ok {
+		response.SendError(w, constants.StatusCode.Unauthorized, constants.ErrorMessages.Unauthorized)
+		return
+	}
+
+	var req authDTO.DisableTwoFactorRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.SendError(w, constants.StatusCode.BadRequest, "Invalid JSON")
+		return
+	}
+
+	if err := h.disableTwoFactorUseCase.Execute(r.Context(), principal.UserID, req); err != nil {
+		response.HandleDomainError(w, err)
+		return
+	}
+
+	response.SendSuccess(w, constants.StatusCode.Ok, constants.SuccessMessages.TwoFactorDisabled, nil)
+}
+
+// Verify handles the second login step, exchanging an MFA challenge and code for tokens
+func (h *TwoFactorHandler) Verify(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		response.SendError(w, constants.StatusCode.BadRequest, "Method not allowed")
+		return
+	}
+
+	var req authDTO.VerifyTwoFactorLoginRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.SendError(w, constants.StatusCode.BadRequest, "Invalid JSON")
+		return
+	}
+
+	req.UserAgent = request.UserAgent(r)
+	req.IPAddress = request.ClientIP(r)
+
+	result, err := h.verifyTwoFactorLoginUseCase.Execute(r.Context(), req)
+	if err != nil {
+		response.HandleDomainError(w, err)
+		return
+	}
+
+	response.SendSuccess(w, constants.StatusCode.Ok, constants.SuccessMessages.LoginSuccessful, newLoginResponse(result))
+}
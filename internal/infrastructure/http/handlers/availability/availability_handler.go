@@ -0,0 +1,349 @@
+package availability
+
+import (
+	availabilityDTO "citary-backend/internal/domain/dtos/availability"
+	"citary-backend/internal/domain/entities"
+	"citary-backend/internal/domain/security"
+	"citary-backend/internal/domain/usecases/availability"
+	httpDTO "citary-backend/internal/infrastructure/http/dto"
+	"citary-backend/internal/infrastructure/http/response"
+	"citary-backend/pkg/constants"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// AvailabilityHandler handles HTTP requests for doctor weekly hours, exceptions and effective availability
+type AvailabilityHandler struct {
+	listBlocksUseCase               *availability.ListBlocksUseCase
+	createBlockUseCase              *availability.CreateBlockUseCase
+	deleteBlockUseCase              *availability.DeleteBlockUseCase
+	listExceptionsUseCase           *availability.ListExceptionsUseCase
+	createExceptionUseCase          *availability.CreateExceptionUseCase
+	deleteExceptionUseCase          *availability.DeleteExceptionUseCase
+	getEffectiveAvailabilityUseCase *availability.GetEffectiveAvailabilityUseCase
+	listFreeSlotsUseCase            *availability.ListFreeSlotsUseCase
+}
+
+// NewAvailabilityHandler creates a new instance of AvailabilityHandler
+func NewAvailabilityHandler(
+	listBlocksUseCase *availability.ListBlocksUseCase,
+	createBlockUseCase *availability.CreateBlockUseCase,
+	deleteBlockUseCase *availability.DeleteBlockUseCase,
+	listExceptionsUseCase *availability.ListExceptionsUseCase,
+	createExceptionUseCase *availability.CreateExceptionUseCase,
+	deleteExceptionUseCase *availability.DeleteExceptionUseCase,
+	getEffectiveAvailabilityUseCase *availability.GetEffectiveAvailabilityUseCase,
+	listFreeSlotsUseCase *availability.ListFreeSlotsUseCase,
+) *AvailabilityHandler {
+	return &AvailabilityHandler{
+		listBlocksUseCase:               listBlocksUseCase,
+		createBlockUseCase:              createBlockUseCase,
+		deleteBlockUseCase:              deleteBlockUseCase,
+		listExceptionsUseCase:           listExceptionsUseCase,
+		createExceptionUseCase:          createExceptionUseCase,
+		deleteExceptionUseCase:          deleteExceptionUseCase,
+		getEffectiveAvailabilityUseCase: getEffectiveAvailabilityUseCase,
+		listFreeSlotsUseCase:            listFreeSlotsUseCase,
+	}
+}
+
+// ListBlocks handles requests for the weekly hours of a doctor of the caller's organization
+func (h *AvailabilityHandler) ListBlocks(w http.ResponseWriter, r *http.Request) {
+	doctorID, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		response.SendError(w, constants.StatusCode.BadRequest, "Invalid doctor ID")
+		return
+	}
+
+	organizationID, err := security.OrganizationScope(r.Context())
+	if err != nil {
+		response.HandleDomainError(w, err)
+		return
+	}
+
+	blocks, err := h.listBlocksUseCase.Execute(r.Context(), organizationID, doctorID)
+	if err != nil {
+		response.HandleDomainError(w, err)
+		return
+	}
+
+	data := make([]httpDTO.AvailabilityBlockResponse, 0, len(blocks))
+	for _, block := range blocks {
+		data = append(data, newBlockResponse(block))
+	}
+
+	response.SendSuccess(w, constants.StatusCode.Ok, constants.SuccessMessages.AvailabilityBlocksRetrieved, data)
+}
+
+// CreateBlock handles requests to add weekly hours to a doctor of the caller's organization
+func (h *AvailabilityHandler) CreateBlock(w http.ResponseWriter, r *http.Request) {
+	doctorID, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		response.SendError(w, constants.StatusCode.BadRequest, "Invalid doctor ID")
+		return
+	}
+
+	organizationID, err := security.OrganizationScope(r.Context())
+	if err != nil {
+		response.HandleDomainError(w, err)
+		return
+	}
+
+	var req availabilityDTO.CreateBlockRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.SendError(w, constants.StatusCode.BadRequest, "Invalid JSON")
+		return
+	}
+
+	block, err := h.createBlockUseCase.Execute(r.Context(), organizationID, doctorID, req)
+	if err != nil {
+		response.HandleDomainError(w, err)
+		return
+	}
+
+	response.SendSuccess(w, constants.StatusCode.Created, constants.SuccessMessages.AvailabilityBlockCreated, newBlockResponse(block))
+}
+
+// DeleteBlock handles requests to remove weekly hours from a doctor of the caller's organization
+func (h *AvailabilityHandler) DeleteBlock(w http.ResponseWriter, r *http.Request) {
+	doctorID, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		response.SendError(w, constants.StatusCode.BadRequest, "Invalid doctor ID")
+		return
+	}
+
+	blockID, err := strconv.Atoi(r.PathValue("blockId"))
+	if err != nil {
+		response.SendError(w, constants.StatusCode.BadRequest, "Invalid availability block ID")
+		return
+	}
+
+	organizationID, err := security.OrganizationScope(r.Context())
+	if err != nil {
+		response.HandleDomainError(w, err)
+		return
+	}
+
+	if err := h.deleteBlockUseCase.Execute(r.Context(), organizationID, doctorID, blockID); err != nil {
+		response.HandleDomainError(w, err)
+		return
+	}
+
+	response.SendSuccess(w, constants.StatusCode.Ok, constants.SuccessMessages.AvailabilityBlockDeleted, nil)
+}
+
+// ListExceptions handles requests for the current and upcoming exceptions of a doctor of the caller's organization
+func (h *AvailabilityHandler) ListExceptions(w http.ResponseWriter, r *http.Request) {
+	doctorID, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		response.SendError(w, constants.StatusCode.BadRequest, "Invalid doctor ID")
+		return
+	}
+
+	organizationID, err := security.OrganizationScope(r.Context())
+	if err != nil {
+		response.HandleDomainError(w, err)
+		return
+	}
+
+	exceptions, err := h.listExceptionsUseCase.Execute(r.Context(), organizationID, doctorID)
+	if err != nil {
+		response.HandleDomainError(w, err)
+		return
+	}
+
+	data := make([]httpDTO.AvailabilityExceptionResponse, 0, len(exceptions))
+	for _, exception := range exceptions {
+		data = append(data, newExceptionResponse(exception))
+	}
+
+	response.SendSuccess(w, constants.StatusCode.Ok, constants.SuccessMessages.AvailabilityExceptionsRetrieved, data)
+}
+
+// CreateException handles requests to add a vacation, holiday or extra shift to a doctor of the caller's organization
+func (h *AvailabilityHandler) CreateException(w http.ResponseWriter, r *http.Request) {
+	doctorID, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		response.SendError(w, constants.StatusCode.BadRequest, "Invalid doctor ID")
+		return
+	}
+
+	organizationID, err := security.OrganizationScope(r.Context())
+	if err != nil {
+		response.HandleDomainError(w, err)
+		return
+	}
+
+	var req availabilityDTO.CreateExceptionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.SendError(w, constants.StatusCode.BadRequest, "Invalid JSON")
+		return
+	}
+
+	exception, err := h.createExceptionUseCase.Execute(r.Context(), organizationID, doctorID, req)
+	if err != nil {
+		response.HandleDomainError(w, err)
+		return
+	}
+
+	response.SendSuccess(w, constants.StatusCode.Created, constants.SuccessMessages.AvailabilityExceptionCreated, newExceptionResponse(exception))
+}
+
+// DeleteException handles requests to remove an exception from a doctor of the caller's organization
+func (h *AvailabilityHandler) DeleteException(w http.ResponseWriter, r *http.Request) {
+	doctorID, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		response.SendError(w, constants.StatusCode.BadRequest, "Invalid doctor ID")
+		return
+	}
+
+	exceptionID, err := strconv.Atoi(r.PathValue("exceptionId"))
+	if err != nil {
+		response.SendError(w, constants.StatusCode.BadRequest, "Invalid availability exception ID")
+		return
+	}
+
+	organizationID, err := security.OrganizationScope(r.Context())
+	if err != nil {
+		response.HandleDomainError(w, err)
+		return
+	}
+
+	if err := h.deleteExceptionUseCase.Execute(r.Context(), organizationID, doctorID, exceptionID); err != nil {
+		response.HandleDomainError(w, err)
+		return
+	}
+
+	response.SendSuccess(w, constants.StatusCode.Ok, constants.SuccessMessages.AvailabilityExceptionDeleted, nil)
+}
+
+// GetEffectiveAvailability handles requests for the spans a doctor is available in a time range
+// Query: from, to (RFC 3339)
+func (h *AvailabilityHandler) GetEffectiveAvailability(w http.ResponseWriter, r *http.Request) {
+	doctorID, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		response.SendError(w, constants.StatusCode.BadRequest, "Invalid doctor ID")
+		return
+	}
+
+	organizationID, err := security.OrganizationScope(r.Context())
+	if err != nil {
+		response.HandleDomainError(w, err)
+		return
+	}
+
+	params := r.URL.Query()
+	query := availabilityDTO.EffectiveAvailabilityQuery{
+		From: params.Get("from"),
+		To:   params.Get("to"),
+	}
+
+	windows, err := h.getEffectiveAvailabilityUseCase.Execute(r.Context(), organizationID, doctorID, query)
+	if err != nil {
+		response.HandleDomainError(w, err)
+		return
+	}
+
+	data := make([]httpDTO.AvailabilityWindowResponse, 0, len(windows))
+	for _, window := range windows {
+		data = append(data, httpDTO.AvailabilityWindowResponse{
+			LocationID: window.LocationID,
+			Start:      window.Start,
+			End:        window.End,
+		})
+	}
+
+	response.SendSuccess(w, constants.StatusCode.Ok, constants.SuccessMessages.AvailabilityRetrieved, data)
+}
+
+// ListFreeSlots handles public requests for the bookable slots of a doctor in a time range
+// Query: from, to (RFC 3339)
+func (h *AvailabilityHandler) ListFreeSlots(w http.ResponseWriter, r *http.Request) {
+	doctorID, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		response.SendError(w, constants.StatusCode.BadRequest, "Invalid doctor ID")
+		return
+	}
+
+	params := r.URL.Query()
+	query := availabilityDTO.SlotsQuery{
+		From: params.Get("from"),
+		To:   params.Get("to"),
+	}
+
+	slots, err := h.listFreeSlotsUseCase.Execute(r.Context(), doctorID, query)
+	if err != nil {
+		response.HandleDomainError(w, err)
+		return
+	}
+
+	data := make([]httpDTO.AvailabilitySlotResponse, 0, len(slots))
+	for _, slot := range slots {
+		data = append(data, newSlotResponse(slot))
+	}
+
+	response.SendSuccess(w, constants.StatusCode.Ok, constants.SuccessMessages.SlotsRetrieved, data)
+}
+
+// newBlockResponse maps an availability block entity to its API representation
+func newBlockResponse(block *entities.AvailabilityBlock) httpDTO.AvailabilityBlockResponse {
+	return httpDTO.AvailabilityBlockResponse{
+		ID:          block.ID,
+		LocationID:  block.LocationID,
+		Weekday:     int(block.Weekday),
+		StartTime:   availabilityDTO.FormatTimeOfDay(block.StartMinute),
+		EndTime:     availabilityDTO.FormatTimeOfDay(block.EndMinute),
+		ValidFrom:   formatOptionalDate(block.ValidFrom),
+		ValidUntil:  formatOptionalDate(block.ValidUntil),
+		CreatedDate: block.CreatedDate,
+	}
+}
+
+// newExceptionResponse maps an availability exception entity to its API representation
+func newExceptionResponse(exception *entities.AvailabilityException) httpDTO.AvailabilityExceptionResponse {
+	res := httpDTO.AvailabilityExceptionResponse{
+		ID:          exception.ID,
+		LocationID:  exception.LocationID,
+		Kind:        exception.Kind,
+		StartDate:   exception.StartDate.Format(availabilityDTO.DateLayout),
+		EndDate:     exception.EndDate.Format(availabilityDTO.DateLayout),
+		Reason:      exception.Reason,
+		CreatedDate: exception.CreatedDate,
+	}
+
+	if !exception.IsWholeDay() {
+		startTime := availabilityDTO.FormatTimeOfDay(*exception.StartMinute)
+		endTime := availabilityDTO.FormatTimeOfDay(*exception.EndMinute)
+		res.StartTime = &startTime
+		res.EndTime = &endTime
+	}
+
+	return res
+}
+
+// newSlotResponse maps an availability slot to its API representation in the location's local time
+// Times stay in UTC if the location's timezone cannot be loaded
+func newSlotResponse(slot *entities.AvailabilitySlot) httpDTO.AvailabilitySlotResponse {
+	start, end := slot.Start.UTC(), slot.End.UTC()
+	if location, err := time.LoadLocation(slot.Timezone); err == nil {
+		start, end = start.In(location), end.In(location)
+	}
+
+	return httpDTO.AvailabilitySlotResponse{
+		LocationID: slot.LocationID,
+		Timezone:   slot.Timezone,
+		Start:      start,
+		End:        end,
+	}
+}
+
+// formatOptionalDate renders an optional calendar date, keeping nil as nil
+func formatOptionalDate(date *time.Time) *string {
+	if date == nil {
+		return nil
+	}
+	formatted := date.Format(availabilityDTO.DateLayout)
+	return &formatted
+}
@@ -0,0 +1,89 @@
+package calendar
+
+import (
+	"citary-backend/internal/domain/security"
+	"citary-backend/internal/domain/usecases/calendar"
+	httpDTO "citary-backend/internal/infrastructure/http/dto"
+	"citary-backend/internal/infrastructure/http/response"
+	"citary-backend/pkg/constants"
+	"net/http"
+	"strings"
+)
+
+// CalendarHandler handles HTTP requests for calendar subscription feeds
+type CalendarHandler struct {
+	regenerateCalendarFeedUseCase *calendar.RegenerateCalendarFeedUseCase
+	revokeCalendarFeedUseCase     *calendar.RevokeCalendarFeedUseCase
+	getCalendarFeedUseCase        *calendar.GetCalendarFeedUseCase
+}
+
+// NewCalendarHandler creates a new instance of CalendarHandler
+func NewCalendarHandler(
+	regenerateCalendarFeedUseCase *calendar.RegenerateCalendarFeedUseCase,
+	revokeCalendarFeedUseCase *calendar.RevokeCalendarFeedUseCase,
+	getCalendarFeedUseCase *calendar.GetCalendarFeedUseCase,
+) *CalendarHandler {
+	return &CalendarHandler{
+		regenerateCalendarFeedUseCase: regenerateCalendarFeedUseCase,
+		revokeCalendarFeedUseCase:     revokeCalendarFeedUseCase,
+		getCalendarFeedUseCase:        getCalendarFeedUseCase,
+	}
+}
+
+// RegenerateFeed handles requests to issue a new calendar subscription URL for the current user
+// Any previously issued URL stops working
+func (h *CalendarHandler) RegenerateFeed(w http.ResponseWriter, r *http.Request) {
+	principal, ok := security.PrincipalFromContext(r.Context())
+	if !ok {
+		response.SendError(w, constants.StatusCode.Unauthorized, constants.ErrorMessages.Unauthorized)
+		return
+	}
+
+	feedURL, err := h.regenerateCalendarFeedUseCase.Execute(r.Context(), principal.UserID)
+	if err != nil {
+		response.HandleDomainError(w, err)
+		return
+	}
+
+	response.SendSuccess(w, constants.StatusCode.Created, constants.SuccessMessages.CalendarFeedCreated, httpDTO.CalendarFeedResponse{
+		URL: feedURL,
+	})
+}
+
+// RevokeFeed handles requests to disable the current user's calendar subscription URL
+func (h *CalendarHandler) RevokeFeed(w http.ResponseWriter, r *http.Request) {
+	principal, ok := security.PrincipalFromContext(r.Context())
+	if !ok {
+		response.SendError(w, constants.StatusCode.Unauthorized, constants.ErrorMessages.Unauthorized)
+		return
+	}
+
+	if err := h.revokeCalendarFeedUseCase.Execute(r.Context(), principal.UserID); err != nil {
+		response.HandleDomainError(w, err)
+		return
+	}
+
+	response.SendSuccess(w, constants.StatusCode.Ok, constants.SuccessMessages.CalendarFeedRevoked, nil)
+}
+
+// GetFeed serves the iCalendar document of a subscription URL
+// Path: /calendar/{token}.ics - the token is the only credential, so the route is public
+func (h *CalendarHandler) GetFeed(w http.ResponseWriter, r *http.Request) {
+	token, ok := strings.CutSuffix(r.PathValue("file"), ".ics")
+	if !ok || token == "" {
+		response.SendError(w, constants.StatusCode.NotFound, constants.ErrorMessages.CalendarFeedNotFound)
+		return
+	}
+
+	document, err := h.getCalendarFeedUseCase.Execute(r.Context(), token)
+	if err != nil {
+		response.HandleDomainError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+	w.Header().Set("Content-Disposition", `inline; filename="citary.ics"`)
+	w.Header().Set("Cache-Control", "private, no-store")
+	w.WriteHeader(constants.StatusCode.Ok)
+	w.Write(document)
+}
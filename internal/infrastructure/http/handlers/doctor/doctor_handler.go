@@ -0,0 +1,292 @@
+package doctor
+
+import (
+	doctorDTO "citary-backend/internal/domain/dtos/doctor"
+	"citary-backend/internal/domain/entities"
+	"citary-backend/internal/domain/security"
+	"citary-backend/internal/domain/usecases/doctor"
+	httpDTO "citary-backend/internal/infrastructure/http/dto"
+	"citary-backend/internal/infrastructure/http/request"
+	"citary-backend/internal/infrastructure/http/response"
+	"citary-backend/pkg/constants"
+	"encoding/json"
+	"net/http"
+	"strconv"
+)
+
+// DoctorHandler handles HTTP requests for doctor profiles and the public doctor search
+type DoctorHandler struct {
+	listDoctorsUseCase      *doctor.ListDoctorsUseCase
+	getDoctorUseCase        *doctor.GetDoctorUseCase
+	createDoctorUseCase     *doctor.CreateDoctorUseCase
+	updateDoctorUseCase     *doctor.UpdateDoctorUseCase
+	deactivateDoctorUseCase *doctor.DeactivateDoctorUseCase
+	searchDoctorsUseCase    *doctor.SearchDoctorsUseCase
+	getPublicDoctorUseCase  *doctor.GetPublicDoctorUseCase
+	listSpecialtiesUseCase  *doctor.ListSpecialtiesUseCase
+}
+
+// NewDoctorHandler creates a new instance of DoctorHandler
+func NewDoctorHandler(
+	listDoctorsUseCase *doctor.ListDoctorsUseCase,
+	getDoctorUseCase *doctor.GetDoctorUseCase,
+	createDoctorUseCase *doctor.CreateDoctorUseCase,
+	updateDoctorUseCase *doctor.UpdateDoctorUseCase,
+	deactivateDoctorUseCase *doctor.DeactivateDoctorUseCase,
+	searchDoctorsUseCase *doctor.SearchDoctorsUseCase,
+	getPublicDoctorUseCase *doctor.GetPublicDoctorUseCase,
+	listSpecialtiesUseCase *doctor.ListSpecialtiesUseCase,
+) *DoctorHandler {
+	return &DoctorHandler{
+		listDoctorsUseCase:      listDoctorsUseCase,
+		getDoctorUseCase:        getDoctorUseCase,
+		createDoctorUseCase:     createDoctorUseCase,
+		updateDoctorUseCase:     updateDoctorUseCase,
+		deactivateDoctorUseCase: deactivateDoctorUseCase,
+		searchDoctorsUseCase:    searchDoctorsUseCase,
+		getPublicDoctorUseCase:  getPublicDoctorUseCase,
+		listSpecialtiesUseCase:  listSpecialtiesUseCase,
+	}
+}
+
+// ListDoctors handles requests for every doctor profile of the caller's organization
+func (h *DoctorHandler) ListDoctors(w http.ResponseWriter, r *http.Request) {
+	organizationID, err := security.OrganizationScope(r.Context())
+	if err != nil {
+		response.HandleDomainError(w, err)
+		return
+	}
+
+	doctors, err := h.listDoctorsUseCase.Execute(r.Context(), organizationID)
+	if err != nil {
+		response.HandleDomainError(w, err)
+		return
+	}
+
+	data := make([]httpDTO.DoctorResponse, 0, len(doctors))
+	for _, doctorEntity := range doctors {
+		data = append(data, newDoctorResponse(doctorEntity))
+	}
+
+	response.SendSuccess(w, constants.StatusCode.Ok, constants.SuccessMessages.DoctorsRetrieved, data)
+}
+
+// GetDoctor handles requests to read a doctor profile of the caller's organization
+func (h *DoctorHandler) GetDoctor(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		response.SendError(w, constants.StatusCode.BadRequest, "Invalid doctor ID")
+		return
+	}
+
+	organizationID, err := security.OrganizationScope(r.Context())
+	if err != nil {
+		response.HandleDomainError(w, err)
+		return
+	}
+
+	doctorEntity, err := h.getDoctorUseCase.Execute(r.Context(), organizationID, id)
+	if err != nil {
+		response.HandleDomainError(w, err)
+		return
+	}
+
+	response.SendSuccess(w, constants.StatusCode.Ok, constants.SuccessMessages.DoctorRetrieved, newDoctorResponse(doctorEntity))
+}
+
+// CreateDoctor handles requests to create a doctor profile in the caller's organization
+func (h *DoctorHandler) CreateDoctor(w http.ResponseWriter, r *http.Request) {
+	organizationID, err := security.OrganizationScope(r.Context())
+	if err != nil {
+		response.HandleDomainError(w, err)
+		return
+	}
+
+	var req doctorDTO.CreateDoctorRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.SendError(w, constants.StatusCode.BadRequest, "Invalid JSON")
+		return
+	}
+
+	doctorEntity, err := h.createDoctorUseCase.Execute(r.Context(), organizationID, req)
+	if err != nil {
+		response.HandleDomainError(w, err)
+		return
+	}
+
+	response.SendSuccess(w, constants.StatusCode.Created, constants.SuccessMessages.DoctorCreated, newDoctorResponse(doctorEntity))
+}
+
+// UpdateDoctor handles requests to edit a doctor profile of the caller's organization
+func (h *DoctorHandler) UpdateDoctor(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		response.SendError(w, constants.StatusCode.BadRequest, "Invalid doctor ID")
+		return
+	}
+
+	organizationID, err := security.OrganizationScope(r.Context())
+	if err != nil {
+		response.HandleDomainError(w, err)
+		return
+	}
+
+	var req doctorDTO.UpdateDoctorRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.SendError(w, constants.StatusCode.BadRequest, "Invalid JSON")
+		return
+	}
+
+	doctorEntity, err := h.updateDoctorUseCase.Execute(r.Context(), organizationID, id, req)
+	if err != nil {
+		response.HandleDomainError(w, err)
+		return
+	}
+
+	response.SendSuccess(w, constants.StatusCode.Ok, constants.SuccessMessages.DoctorUpdated, newDoctorResponse(doctorEntity))
+}
+
+// DeactivateDoctor handles requests to deactivate a doctor profile of the caller's organization
+func (h *DoctorHandler) DeactivateDoctor(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		response.SendError(w, constants.StatusCode.BadRequest, "Invalid doctor ID")
+		return
+	}
+
+	organizationID, err := security.OrganizationScope(r.Context())
+	if err != nil {
+		response.HandleDomainError(w, err)
+		return
+	}
+
+	if err := h.deactivateDoctorUseCase.Execute(r.Context(), organizationID, id); err != nil {
+		response.HandleDomainError(w, err)
+		return
+	}
+
+	response.SendSuccess(w, constants.StatusCode.Ok, constants.SuccessMessages.DoctorDeactivated, nil)
+}
+
+// SearchDoctors handles public, paginated doctor searches
+// Filters: specialty (code), organization (slug), name, language; paging: page, pageSize
+func (h *DoctorHandler) SearchDoctors(w http.ResponseWriter, r *http.Request) {
+	page, err := request.QueryInt(r, "page")
+	if err != nil {
+		response.SendError(w, constants.StatusCode.BadRequest, "Invalid page")
+		return
+	}
+
+	pageSize, err := request.QueryInt(r, "pageSize")
+	if err != nil {
+		response.SendError(w, constants.StatusCode.BadRequest, "Invalid page size")
+		return
+	}
+
+	params := r.URL.Query()
+	query := doctorDTO.SearchDoctorsQuery{
+		Specialty:    params.Get("specialty"),
+		Organization: params.Get("organization"),
+		Name:         params.Get("name"),
+		Language:     params.Get("language"),
+		Page:         page,
+		PageSize:     pageSize,
+	}
+
+	doctors, total, err := h.searchDoctorsUseCase.Execute(r.Context(), query)
+	if err != nil {
+		response.HandleDomainError(w, err)
+		return
+	}
+
+	items := make([]httpDTO.PublicDoctorResponse, 0, len(doctors))
+	for _, doctorEntity := range doctors {
+		items = append(items, newPublicDoctorResponse(doctorEntity))
+	}
+
+	response.SendSuccess(w, constants.StatusCode.Ok, constants.SuccessMessages.DoctorsRetrieved,
+		httpDTO.NewPageResponse(items, query.ResolvedPage(), query.ResolvedPageSize(), total))
+}
+
+// GetPublicDoctor handles public requests to read a listed doctor
+func (h *DoctorHandler) GetPublicDoctor(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		response.SendError(w, constants.StatusCode.BadRequest, "Invalid doctor ID")
+		return
+	}
+
+	doctorEntity, err := h.getPublicDoctorUseCase.Execute(r.Context(), id)
+	if err != nil {
+		response.HandleDomainError(w, err)
+		return
+	}
+
+	response.SendSuccess(w, constants.StatusCode.Ok, constants.SuccessMessages.DoctorRetrieved, newPublicDoctorResponse(doctorEntity))
+}
+
+// ListSpecialties handles public requests for the specialties doctors can be searched by
+func (h *DoctorHandler) ListSpecialties(w http.ResponseWriter, r *http.Request) {
+	specialties, err := h.listSpecialtiesUseCase.Execute(r.Context())
+	if err != nil {
+		response.HandleDomainError(w, err)
+		return
+	}
+
+	response.SendSuccess(w, constants.StatusCode.Ok, constants.SuccessMessages.SpecialtiesRetrieved, newSpecialtyResponses(specialties))
+}
+
+// newDoctorResponse maps a doctor entity to its organization-facing representation
+func newDoctorResponse(doctorEntity *entities.Doctor) httpDTO.DoctorResponse {
+	return httpDTO.DoctorResponse{
+		ID:                   doctorEntity.ID,
+		UserID:               doctorEntity.UserID,
+		FirstName:            doctorEntity.FirstName,
+		LastName:             doctorEntity.LastName,
+		LicenseNumber:        doctorEntity.LicenseNumber,
+		Bio:                  doctorEntity.Bio,
+		Languages:            doctorEntity.Languages,
+		ConsultationMinutes:  doctorEntity.ConsultationMinutes,
+		BufferMinutes:        doctorEntity.BufferMinutes,
+		ConsultationFeeCents: doctorEntity.ConsultationFeeCents,
+		FeeCurrency:          doctorEntity.FeeCurrency,
+		Specialties:          newSpecialtyResponses(doctorEntity.Specialties),
+		Active:               doctorEntity.IsActive(),
+		CreatedDate:          doctorEntity.CreatedDate,
+		UpdatedDate:          doctorEntity.UpdatedDate,
+	}
+}
+
+// newPublicDoctorResponse maps a doctor entity to its public representation
+func newPublicDoctorResponse(doctorEntity *entities.Doctor) httpDTO.PublicDoctorResponse {
+	return httpDTO.PublicDoctorResponse{
+		ID:                   doctorEntity.ID,
+		FirstName:            doctorEntity.FirstName,
+		LastName:             doctorEntity.LastName,
+		LicenseNumber:        doctorEntity.LicenseNumber,
+		Bio:                  doctorEntity.Bio,
+		Languages:            doctorEntity.Languages,
+		ConsultationMinutes:  doctorEntity.ConsultationMinutes,
+		ConsultationFeeCents: doctorEntity.ConsultationFeeCents,
+		FeeCurrency:          doctorEntity.FeeCurrency,
+		Specialties:          newSpecialtyResponses(doctorEntity.Specialties),
+		Organization: httpDTO.PublicOrganizationResponse{
+			ID:   doctorEntity.OrganizationID,
+			Name: doctorEntity.OrganizationName,
+			Slug: doctorEntity.OrganizationSlug,
+		},
+	}
+}
+
+// newSpecialtyResponses maps specialty entities to their API representation
+func newSpecialtyResponses(specialties []*entities.Specialty) []httpDTO.SpecialtyResponse {
+	data := make([]httpDTO.SpecialtyResponse, 0, len(specialties))
+	for _, specialty := range specialties {
+		data = append(data, httpDTO.SpecialtyResponse{
+			ID:   specialty.ID,
+			Code: specialty.Code,
+			Name: specialty.Name,
+		})
+	}
+	return data
+}
@@ -0,0 +1,103 @@
+package legal
+
+import (
+	legalDTO "citary-backend/internal/domain/dtos/legal"
+	"citary-backend/internal/domain/entities"
+	"citary-backend/internal/domain/security"
+	"citary-backend/internal/domain/usecases/legal"
+	httpDTO "citary-backend/internal/infrastructure/http/dto"
+	"citary-backend/internal/infrastructure/http/request"
+	"citary-backend/internal/infrastructure/http/response"
+	"citary-backend/pkg/constants"
+	"encoding/json"
+	"net/http"
+)
+
+// LegalHandler handles HTTP requests about legal documents and user consent
+type LegalHandler struct {
+	getCurrentDocumentsUseCase *legal.GetCurrentDocumentsUseCase
+	getPendingDocumentsUseCase *legal.GetPendingDocumentsUseCase
+	acceptDocumentsUseCase     *legal.AcceptDocumentsUseCase
+}
+
+// NewLegalHandler creates a new instance of LegalHandler
+func NewLegalHandler(
+	getCurrentDocumentsUseCase *legal.GetCurrentDocumentsUseCase,
+	getPendingDocumentsUseCase *legal.GetPendingDocumentsUseCase,
+	acceptDocumentsUseCase *legal.AcceptDocumentsUseCase,
+) *LegalHandler {
+	return &LegalHandler{
+		getCurrentDocumentsUseCase: getCurrentDocumentsUseCase,
+		getPendingDocumentsUseCase: getPendingDocumentsUseCase,
+		acceptDocumentsUseCase:     acceptDocumentsUseCase,
+	}
+}
+
+// ListDocuments handles requests for the legal documents currently in force
+func (h *LegalHandler) ListDocuments(w http.ResponseWriter, r *http.Request) {
+	documents, err := h.getCurrentDocumentsUseCase.Execute(r.Context())
+	if err != nil {
+		response.HandleDomainError(w, err)
+		return
+	}
+
+	response.SendSuccess(w, constants.StatusCode.Ok, constants.SuccessMessages.LegalDocumentsRetrieved, newLegalDocumentResponses(documents))
+}
+
+// ListPending handles requests for the current documents the caller has not accepted yet
+func (h *LegalHandler) ListPending(w http.ResponseWriter, r *http.Request) {
+	principal, ok := security.PrincipalFromContext(r.Context())
+	if !ok {
+		response.SendError(w, constants.StatusCode.Unauthorized, constants.ErrorMessages.Unauthorized)
+		return
+	}
+
+	documents, err := h.getPendingDocumentsUseCase.Execute(r.Context(), principal.UserID)
+	if err != nil {
+		response.HandleDomainError(w, err)
+		return
+	}
+
+	response.SendSuccess(w, constants.StatusCode.Ok, constants.SuccessMessages.LegalDocumentsRetrieved, newLegalDocumentResponses(documents))
+}
+
+// Accept handles requests to accept the current version of one or more legal documents
+// The response lists the documents that are still pending
+func (h *LegalHandler) Accept(w http.ResponseWriter, r *http.Request) {
+	principal, ok := security.PrincipalFromContext(r.Context())
+	if !ok {
+		response.SendError(w, constants.StatusCode.Unauthorized, constants.ErrorMessages.Unauthorized)
+		return
+	}
+
+	var req legalDTO.AcceptLegalDocumentsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.SendError(w, constants.StatusCode.BadRequest, "Invalid JSON")
+		return
+	}
+	req.IPAddress = request.ClientIP(r)
+	req.UserAgent = request.UserAgent(r)
+
+	pending, err := h.acceptDocumentsUseCase.Execute(r.Context(), principal.UserID, req)
+	if err != nil {
+		response.HandleDomainError(w, err)
+		return
+	}
+
+	response.SendSuccess(w, constants.StatusCode.Ok, constants.SuccessMessages.LegalDocumentsAccepted, newLegalDocumentResponses(pending))
+}
+
+// newLegalDocumentResponses maps legal documents to their API representation
+func newLegalDocumentResponses(documents []*entities.LegalDocument) []httpDTO.LegalDocumentResponse {
+	responses := make([]httpDTO.LegalDocumentResponse, 0, len(documents))
+	for _, document := range documents {
+		responses = append(responses, httpDTO.LegalDocumentResponse{
+			Type:        document.Type,
+			Version:     document.Version,
+			Title:       document.Title,
+			URL:         document.URL,
+			PublishedAt: document.PublishedAt,
+		})
+	}
+	return responses
+}
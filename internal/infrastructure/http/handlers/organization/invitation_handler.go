@@ -0,0 +1,162 @@
+package organization
+
+import (
+	organizationDTO "citary-backend/internal/domain/dtos/organization"
+	"citary-backend/internal/domain/entities"
+	"citary-backend/internal/domain/security"
+	"citary-backend/internal/domain/usecases/organization"
+	httpDTO "citary-backend/internal/infrastructure/http/dto"
+	"citary-backend/internal/infrastructure/http/request"
+	"citary-backend/internal/infrastructure/http/response"
+	"citary-backend/pkg/constants"
+	"encoding/json"
+	"net/http"
+	"strconv"
+)
+
+// InvitationHandler handles HTTP requests for organization invitations
+type InvitationHandler struct {
+	createInvitationUseCase *organization.CreateInvitationUseCase
+	listInvitationsUseCase  *organization.ListInvitationsUseCase
+	revokeInvitationUseCase *organization.RevokeInvitationUseCase
+	acceptInvitationUseCase *organization.AcceptInvitationUseCase
+}
+
+// NewInvitationHandler creates a new instance of InvitationHandler
+func NewInvitationHandler(
+	createInvitationUseCase *organization.CreateInvitationUseCase,
+	listInvitationsUseCase *organization.ListInvitationsUseCase,
+	revokeInvitationUseCase *organization.RevokeInvitationUseCase,
+	acceptInvitationUseCase *organization.AcceptInvitationUseCase,
+) *InvitationHandler {
+	return &InvitationHandler{
+		createInvitationUseCase: createInvitationUseCase,
+		listInvitationsUseCase:  listInvitationsUseCase,
+		revokeInvitationUseCase: revokeInvitationUseCase,
+		acceptInvitationUseCase: acceptInvitationUseCase,
+	}
+}
+
+// CreateInvitation handles requests to invite someone into the caller's organization
+func (h *InvitationHandler) CreateInvitation(w http.ResponseWriter, r *http.Request) {
+	principal, ok := security.PrincipalFromContext(r.Context())
+	if !ok {
+		response.SendError(w, constants.StatusCode.Unauthorized, constants.ErrorMessages.Unauthorized)
+		return
+	}
+
+	organizationID, err := security.OrganizationScope(r.Context())
+	if err != nil {
+		response.HandleDomainError(w, err)
+		return
+	}
+
+	var req organizationDTO.CreateInvitationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.SendError(w, constants.StatusCode.BadRequest, "Invalid JSON")
+		return
+	}
+
+	invitation, err := h.createInvitationUseCase.Execute(r.Context(), organizationID, principal.UserID, req)
+	if err != nil {
+		response.HandleDomainError(w, err)
+		return
+	}
+
+	response.SendSuccess(w, constants.StatusCode.Created, constants.SuccessMessages.InvitationSent, newInvitationResponse(invitation))
+}
+
+// ListInvitations handles requests for the invitations of the caller's organization
+func (h *InvitationHandler) ListInvitations(w http.ResponseWriter, r *http.Request) {
+	organizationID, err := security.OrganizationScope(r.Context())
+	if err != nil {
+		response.HandleDomainError(w, err)
+		return
+	}
+
+	invitations, err := h.listInvitationsUseCase.Execute(r.Context(), organizationID)
+	if err != nil {
+		response.HandleDomainError(w, err)
+		return
+	}
+
+	data := make([]httpDTO.InvitationResponse, 0, len(invitations))
+	for _, invitation := range invitations {
+		data = append(data, newInvitationResponse(invitation))
+	}
+
+	response.SendSuccess(w, constants.StatusCode.Ok, constants.SuccessMessages.InvitationsRetrieved, data)
+}
+
+// RevokeInvitation handles requests to revoke a pending invitation of the caller's organization
+func (h *InvitationHandler) RevokeInvitation(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		response.SendError(w, constants.StatusCode.BadRequest, "Invalid invitation ID")
+		return
+	}
+
+	organizationID, err := security.OrganizationScope(r.Context())
+	if err != nil {
+		response.HandleDomainError(w, err)
+		return
+	}
+
+	if err := h.revokeInvitationUseCase.Execute(r.Context(), organizationID, id); err != nil {
+		response.HandleDomainError(w, err)
+		return
+	}
+
+	response.SendSuccess(w, constants.StatusCode.Ok, constants.SuccessMessages.InvitationRevoked, nil)
+}
+
+// AcceptInvitation handles requests to redeem an invitation token
+// Anonymous callers may accept for an email without an account; existing accounts must be logged in
+func (h *InvitationHandler) AcceptInvitation(w http.ResponseWriter, r *http.Request) {
+	var req organizationDTO.AcceptInvitationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.SendError(w, constants.StatusCode.BadRequest, "Invalid JSON")
+		return
+	}
+	req.IPAddress = request.ClientIP(r)
+	req.UserAgent = request.UserAgent(r)
+
+	var callerUserID *int
+	if principal, ok := security.PrincipalFromContext(r.Context()); ok {
+		callerUserID = &principal.UserID
+	}
+
+	accepted, err := h.acceptInvitationUseCase.Execute(r.Context(), callerUserID, req)
+	if err != nil {
+		response.HandleDomainError(w, err)
+		return
+	}
+
+	statusCode := constants.StatusCode.Ok
+	if accepted.AccountCreated {
+		statusCode = constants.StatusCode.Created
+	}
+
+	response.SendSuccess(w, statusCode, constants.SuccessMessages.InvitationAccepted, httpDTO.AcceptInvitationResponse{
+		UserID:         accepted.UserID,
+		OrganizationID: accepted.OrganizationID,
+		Role:           accepted.RoleCode,
+		AccountCreated: accepted.AccountCreated,
+	})
+}
+
+// newInvitationResponse maps an invitation entity to its API representation
+func newInvitationResponse(invitation *entities.OrganizationInvitation) httpDTO.InvitationResponse {
+	return httpDTO.InvitationResponse{
+		ID:               invitation.ID,
+		Email:            invitation.Email,
+		Role:             invitation.RoleCode,
+		Status:           invitation.Status(),
+		InvitedByUserID:  invitation.InvitedByUserID,
+		AcceptedByUserID: invitation.AcceptedByUserID,
+		ExpiresAt:        invitation.ExpiresAt,
+		AcceptedAt:       invitation.AcceptedAt,
+		RevokedAt:        invitation.RevokedAt,
+		CreatedDate:      invitation.CreatedDate,
+	}
+}
@@ -0,0 +1,114 @@
+package organization
+
+import (
+	organizationDTO "citary-backend/internal/domain/dtos/organization"
+	"citary-backend/internal/domain/entities"
+	"citary-backend/internal/domain/security"
+	"citary-backend/internal/domain/usecases/organization"
+	httpDTO "citary-backend/internal/infrastructure/http/dto"
+	"citary-backend/internal/infrastructure/http/response"
+	"citary-backend/pkg/constants"
+	"encoding/json"
+	"net/http"
+	"strconv"
+)
+
+// LocationHandler handles HTTP requests for organization locations
+type LocationHandler struct {
+	createLocationUseCase     *organization.CreateLocationUseCase
+	listLocationsUseCase      *organization.ListLocationsUseCase
+	deactivateLocationUseCase *organization.DeactivateLocationUseCase
+}
+
+// NewLocationHandler creates a new instance of LocationHandler
+func NewLocationHandler(
+	createLocationUseCase *organization.CreateLocationUseCase,
+	listLocationsUseCase *organization.ListLocationsUseCase,
+	deactivateLocationUseCase *organization.DeactivateLocationUseCase,
+) *LocationHandler {
+	return &LocationHandler{
+		createLocationUseCase:     createLocationUseCase,
+		listLocationsUseCase:      listLocationsUseCase,
+		deactivateLocationUseCase: deactivateLocationUseCase,
+	}
+}
+
+// CreateLocation handles requests to add a location to the caller's organization
+func (h *LocationHandler) CreateLocation(w http.ResponseWriter, r *http.Request) {
+	organizationID, err := security.OrganizationScope(r.Context())
+	if err != nil {
+		response.HandleDomainError(w, err)
+		return
+	}
+
+	var req organizationDTO.CreateLocationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.SendError(w, constants.StatusCode.BadRequest, "Invalid JSON")
+		return
+	}
+
+	location, err := h.createLocationUseCase.Execute(r.Context(), organizationID, req)
+	if err != nil {
+		response.HandleDomainError(w, err)
+		return
+	}
+
+	response.SendSuccess(w, constants.StatusCode.Created, constants.SuccessMessages.LocationCreated, newLocationResponse(location))
+}
+
+// ListLocations handles requests for the locations of the caller's organization
+func (h *LocationHandler) ListLocations(w http.ResponseWriter, r *http.Request) {
+	organizationID, err := security.OrganizationScope(r.Context())
+	if err != nil {
+		response.HandleDomainError(w, err)
+		return
+	}
+
+	locations, err := h.listLocationsUseCase.Execute(r.Context(), organizationID)
+	if err != nil {
+		response.HandleDomainError(w, err)
+		return
+	}
+
+	data := make([]httpDTO.LocationResponse, 0, len(locations))
+	for _, location := range locations {
+		data = append(data, newLocationResponse(location))
+	}
+
+	response.SendSuccess(w, constants.StatusCode.Ok, constants.SuccessMessages.LocationsRetrieved, data)
+}
+
+// DeactivateLocation handles requests to close a location of the caller's organization
+func (h *LocationHandler) DeactivateLocation(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		response.SendError(w, constants.StatusCode.BadRequest, "Invalid location ID")
+		return
+	}
+
+	organizationID, err := security.OrganizationScope(r.Context())
+	if err != nil {
+		response.HandleDomainError(w, err)
+		return
+	}
+
+	if err := h.deactivateLocationUseCase.Execute(r.Context(), organizationID, id); err != nil {
+		response.HandleDomainError(w, err)
+		return
+	}
+
+	response.SendSuccess(w, constants.StatusCode.Ok, constants.SuccessMessages.LocationDeactivated, nil)
+}
+
+// newLocationResponse maps a location entity to its API representation
+func newLocationResponse(location *entities.Location) httpDTO.LocationResponse {
+	return httpDTO.LocationResponse{
+		ID:          location.ID,
+		Name:        location.Name,
+		Address:     location.Address,
+		Timezone:    location.Timezone,
+		Active:      location.IsActive(),
+		CreatedDate: location.CreatedDate,
+		UpdatedDate: location.UpdatedDate,
+	}
+}
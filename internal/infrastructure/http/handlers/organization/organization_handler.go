@@ -0,0 +1,117 @@
+package organization
+
+import (
+	organizationDTO "citary-backend/internal/domain/dtos/organization"
+	"citary-backend/internal/domain/entities"
+	"citary-backend/internal/domain/security"
+	"citary-backend/internal/domain/usecases/organization"
+	httpDTO "citary-backend/internal/infrastructure/http/dto"
+	"citary-backend/internal/infrastructure/http/response"
+	"citary-backend/pkg/constants"
+	"encoding/json"
+	"net/http"
+)
+
+// OrganizationHandler handles HTTP requests for organization onboarding and management
+type OrganizationHandler struct {
+	onboardOrganizationUseCase *organization.OnboardOrganizationUseCase
+	getMyOrganizationUseCase   *organization.GetMyOrganizationUseCase
+	listMyMembershipsUseCase   *organization.ListMyMembershipsUseCase
+}
+
+// NewOrganizationHandler creates a new instance of OrganizationHandler
+func NewOrganizationHandler(
+	onboardOrganizationUseCase *organization.OnboardOrganizationUseCase,
+	getMyOrganizationUseCase *organization.GetMyOrganizationUseCase,
+	listMyMembershipsUseCase *organization.ListMyMembershipsUseCase,
+) *OrganizationHandler {
+	return &OrganizationHandler{
+		onboardOrganizationUseCase: onboardOrganizationUseCase,
+		getMyOrganizationUseCase:   getMyOrganizationUseCase,
+		listMyMembershipsUseCase:   listMyMembershipsUseCase,
+	}
+}
+
+// CreateOrganization handles requests to onboard a new organization owned by the caller
+func (h *OrganizationHandler) CreateOrganization(w http.ResponseWriter, r *http.Request) {
+	principal, ok := security.PrincipalFromContext(r.Context())
+	if !ok {
+		response.SendError(w, constants.StatusCode.Unauthorized, constants.ErrorMessages.Unauthorized)
+		return
+	}
+
+	var req organizationDTO.CreateOrganizationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.SendError(w, constants.StatusCode.BadRequest, "Invalid JSON")
+		return
+	}
+
+	organizationEntity, err := h.onboardOrganizationUseCase.Execute(r.Context(), principal.UserID, req)
+	if err != nil {
+		response.HandleDomainError(w, err)
+		return
+	}
+
+	response.SendSuccess(w, constants.StatusCode.Created, constants.SuccessMessages.OrganizationCreated, newOrganizationResponse(organizationEntity))
+}
+
+// GetMyOrganization handles requests for the organization the caller's session acts within, or their default one
+func (h *OrganizationHandler) GetMyOrganization(w http.ResponseWriter, r *http.Request) {
+	principal, ok := security.PrincipalFromContext(r.Context())
+	if !ok {
+		response.SendError(w, constants.StatusCode.Unauthorized, constants.ErrorMessages.Unauthorized)
+		return
+	}
+
+	organizationEntity, err := h.getMyOrganizationUseCase.Execute(r.Context(), principal.UserID, principal.OrganizationID)
+	if err != nil {
+		response.HandleDomainError(w, err)
+		return
+	}
+
+	response.SendSuccess(w, constants.StatusCode.Ok, constants.SuccessMessages.OrganizationRetrieved, newOrganizationResponse(organizationEntity))
+}
+
+// ListMyOrganizations handles requests for every organization the caller is a member of
+func (h *OrganizationHandler) ListMyOrganizations(w http.ResponseWriter, r *http.Request) {
+	principal, ok := security.PrincipalFromContext(r.Context())
+	if !ok {
+		response.SendError(w, constants.StatusCode.Unauthorized, constants.ErrorMessages.Unauthorized)
+		return
+	}
+
+	memberships, err := h.listMyMembershipsUseCase.Execute(r.Context(), principal.UserID)
+	if err != nil {
+		response.HandleDomainError(w, err)
+		return
+	}
+
+	data := make([]httpDTO.MembershipResponse, 0, len(memberships))
+	for _, membership := range memberships {
+		data = append(data, httpDTO.MembershipResponse{
+			OrganizationID:   membership.OrganizationID,
+			OrganizationName: membership.OrganizationName,
+			Role:             membership.RoleCode,
+			Active:           principal.HasOrganization() && *principal.OrganizationID == membership.OrganizationID,
+			CreatedDate:      membership.CreatedDate,
+		})
+	}
+
+	response.SendSuccess(w, constants.StatusCode.Ok, constants.SuccessMessages.MembershipsRetrieved, data)
+}
+
+// newOrganizationResponse maps an organization entity to its API representation
+func newOrganizationResponse(organizationEntity *entities.Organization) httpDTO.OrganizationResponse {
+	return httpDTO.OrganizationResponse{
+		ID:          organizationEntity.ID,
+		Name:        organizationEntity.Name,
+		Slug:        organizationEntity.Slug,
+		Email:       organizationEntity.Email,
+		Phone:       organizationEntity.Phone,
+		Address:     organizationEntity.Address,
+		Timezone:    organizationEntity.Timezone,
+		OwnerUserID: organizationEntity.OwnerUserID,
+		CreatedDate: organizationEntity.CreatedDate,
+		UpdatedDate: organizationEntity.UpdatedDate,
+	}
+}
@@ -0,0 +1,141 @@
+package role
+
+import (
+	roleDTO "citary-backend/internal/domain/dtos/role"
+	"citary-backend/internal/domain/entities"
+	"citary-backend/internal/domain/usecases/role"
+	httpDTO "citary-backend/internal/infrastructure/http/dto"
+	"citary-backend/internal/infrastructure/http/response"
+	"citary-backend/pkg/constants"
+	"encoding/json"
+	"net/http"
+	"strconv"
+)
+
+// RoleHandler handles HTTP requests for role management
+type RoleHandler struct {
+	listRolesUseCase      *role.ListRolesUseCase
+	getRoleUseCase        *role.GetRoleUseCase
+	createRoleUseCase     *role.CreateRoleUseCase
+	updateRoleUseCase     *role.UpdateRoleUseCase
+	deactivateRoleUseCase *role.DeactivateRoleUseCase
+}
+
+// NewRoleHandler creates a new instance of RoleHandler
+func NewRoleHandler(
+	listRolesUseCase *role.ListRolesUseCase,
+	getRoleUseCase *role.GetRoleUseCase,
+	createRoleUseCase *role.CreateRoleUseCase,
+	updateRoleUseCase *role.UpdateRoleUseCase,
+	deactivateRoleUseCase *role.DeactivateRoleUseCase,
+) *RoleHandler {
+	return &RoleHandler{
+		listRolesUseCase:      listRolesUseCase,
+		getRoleUseCase:        getRoleUseCase,
+		createRoleUseCase:     createRoleUseCase,
+		updateRoleUseCase:     updateRoleUseCase,
+		deactivateRoleUseCase: deactivateRoleUseCase,
+	}
+}
+
+// ListRoles handles requests to list every role
+func (h *RoleHandler) ListRoles(w http.ResponseWriter, r *http.Request) {
+	roles, err := h.listRolesUseCase.Execute(r.Context())
+	if err != nil {
+		response.HandleDomainError(w, err)
+		return
+	}
+
+	roleResponses := make([]httpDTO.RoleResponse, 0, len(roles))
+	for _, roleEntity := range roles {
+		roleResponses = append(roleResponses, newRoleResponse(roleEntity))
+	}
+
+	response.SendSuccess(w, constants.StatusCode.Ok, constants.SuccessMessages.RolesRetrieved, roleResponses)
+}
+
+// GetRole handles requests to read a single role
+func (h *RoleHandler) GetRole(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		response.SendError(w, constants.StatusCode.BadRequest, "Invalid role ID")
+		return
+	}
+
+	roleEntity, err := h.getRoleUseCase.Execute(r.Context(), id)
+	if err != nil {
+		response.HandleDomainError(w, err)
+		return
+	}
+
+	response.SendSuccess(w, constants.StatusCode.Ok, constants.SuccessMessages.RoleRetrieved, newRoleResponse(roleEntity))
+}
+
+// CreateRole handles requests to create a role
+func (h *RoleHandler) CreateRole(w http.ResponseWriter, r *http.Request) {
+	var req roleDTO.CreateRoleRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.SendError(w, constants.StatusCode.BadRequest, "Invalid JSON")
+		return
+	}
+
+	roleEntity, err := h.createRoleUseCase.Execute(r.Context(), req)
+	if err != nil {
+		response.HandleDomainError(w, err)
+		return
+	}
+
+	response.SendSuccess(w, constants.StatusCode.Created, constants.SuccessMessages.RoleCreated, newRoleResponse(roleEntity))
+}
+
+// UpdateRole handles requests to edit a role
+func (h *RoleHandler) UpdateRole(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		response.SendError(w, constants.StatusCode.BadRequest, "Invalid role ID")
+		return
+	}
+
+	var req roleDTO.UpdateRoleRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.SendError(w, constants.StatusCode.BadRequest, "Invalid JSON")
+		return
+	}
+
+	roleEntity, err := h.updateRoleUseCase.Execute(r.Context(), id, req)
+	if err != nil {
+		response.HandleDomainError(w, err)
+		return
+	}
+
+	response.SendSuccess(w, constants.StatusCode.Ok, constants.SuccessMessages.RoleUpdated, newRoleResponse(roleEntity))
+}
+
+// DeactivateRole handles requests to deactivate a role
+func (h *RoleHandler) DeactivateRole(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		response.SendError(w, constants.StatusCode.BadRequest, "Invalid role ID")
+		return
+	}
+
+	if err := h.deactivateRoleUseCase.Execute(r.Context(), id); err != nil {
+		response.HandleDomainError(w, err)
+		return
+	}
+
+	response.SendSuccess(w, constants.StatusCode.Ok, constants.SuccessMessages.RoleDeactivated, nil)
+}
+
+// newRoleResponse maps a role entity to its API representation
+func newRoleResponse(role *entities.Role) httpDTO.RoleResponse {
+	return httpDTO.RoleResponse{
+		ID:          role.ID,
+		Name:        role.Name,
+		Code:        role.Code,
+		Description: role.Description,
+		Permissions: role.Permissions,
+		Active:      role.IsActive(),
+		CreatedDate: role.CreatedDate,
+	}
+}
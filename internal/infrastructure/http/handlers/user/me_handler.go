@@ -0,0 +1,276 @@
+package user
+
+import (
+	userDTO "citary-backend/internal/domain/dtos/user"
+	"citary-backend/internal/domain/security"
+	"citary-backend/internal/domain/usecases/user"
+	httpDTO "citary-backend/internal/infrastructure/http/dto"
+	"citary-backend/internal/infrastructure/http/request"
+	"citary-backend/internal/infrastructure/http/response"
+	"citary-backend/pkg/constants"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// MeHandler handles HTTP requests about the authenticated user
+type MeHandler struct {
+	getMyProfileUseCase    *user.GetMyProfileUseCase
+	updateMyProfileUseCase *user.UpdateMyProfileUseCase
+	deleteMyAccountUseCase *user.DeleteMyAccountUseCase
+	exportMyDataUseCase    *user.ExportMyDataUseCase
+}
+
+// NewMeHandler creates a new instance of MeHandler
+func NewMeHandler(
+	getMyProfileUseCase *user.GetMyProfileUseCase,
+	updateMyProfileUseCase *user.UpdateMyProfileUseCase,
+	deleteMyAccountUseCase *user.DeleteMyAccountUseCase,
+	exportMyDataUseCase *user.ExportMyDataUseCase,
+) *MeHandler {
+	return &MeHandler{
+		getMyProfileUseCase:    getMyProfileUseCase,
+		updateMyProfileUseCase: updateMyProfileUseCase,
+		deleteMyAccountUseCase: deleteMyAccountUseCase,
+		exportMyDataUseCase:    exportMyDataUseCase,
+	}
+}
+
+// GetMe handles requests for the current user's account and profile
+func (h *MeHandler) GetMe(w http.ResponseWriter, r *http.Request) {
+	principal, ok := security.PrincipalFromContext(r.Context())
+	if !ok {
+		response.SendError(w, constants.StatusCode.Unauthorized, constants.ErrorMessages.Unauthorized)
+		return
+	}
+
+	me, err := h.getMyProfileUseCase.Execute(r.Context(), principal.UserID)
+	if err != nil {
+		response.HandleDomainError(w, err)
+		return
+	}
+
+	response.SendSuccess(w, constants.StatusCode.Ok, constants.SuccessMessages.ProfileRetrieved, newMeResponse(me))
+}
+
+// UpdateMe handles partial updates of the current user's profile
+func (h *MeHandler) UpdateMe(w http.ResponseWriter, r *http.Request) {
+	principal, ok := security.PrincipalFromContext(r.Context())
+	if !ok {
+		response.SendError(w, constants.StatusCode.Unauthorized, constants.ErrorMessages.Unauthorized)
+		return
+	}
+
+	var req userDTO.UpdateProfileRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.SendError(w, constants.StatusCode.BadRequest, "Invalid JSON")
+		return
+	}
+
+	me, err := h.updateMyProfileUseCase.Execute(r.Context(), principal.UserID, req)
+	if err != nil {
+		response.HandleDomainError(w, err)
+		return
+	}
+
+	response.SendSuccess(w, constants.StatusCode.Ok, constants.SuccessMessages.ProfileUpdated, newMeResponse(me))
+}
+
+// DeleteMe handles requests to delete the current user's account
+func (h *MeHandler) DeleteMe(w http.ResponseWriter, r *http.Request) {
+	principal, ok := security.PrincipalFromContext(r.Context())
+	if !ok {
+		response.SendError(w, constants.StatusCode.Unauthorized, constants.ErrorMessages.Unauthorized)
+		return
+	}
+
+	var req userDTO.DeleteAccountRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.SendError(w, constants.StatusCode.BadRequest, "Invalid JSON")
+		return
+	}
+	req.IPAddress = request.ClientIP(r)
+
+	anonymizeAfter, err := h.deleteMyAccountUseCase.Execute(r.Context(), principal.UserID, req)
+	if err != nil {
+		response.HandleDomainError(w, err)
+		return
+	}
+
+	response.SendSuccess(w, constants.StatusCode.Ok, constants.SuccessMessages.AccountDeleted, httpDTO.AccountDeletedResponse{
+		AnonymizeAfter: anonymizeAfter,
+	})
+}
+
+// ExportMe handles requests for a downloadable archive of the current user's data
+func (h *MeHandler) ExportMe(w http.ResponseWriter, r *http.Request) {
+	principal, ok := security.PrincipalFromContext(r.Context())
+	if !ok {
+		response.SendError(w, constants.StatusCode.Unauthorized, constants.ErrorMessages.Unauthorized)
+		return
+	}
+
+	export, err := h.exportMyDataUseCase.Execute(r.Context(), principal.UserID)
+	if err != nil {
+		response.HandleDomainError(w, err)
+		return
+	}
+
+	filename := fmt.Sprintf("citary-data-%d-%s.json", principal.UserID, export.ExportedAt.Format("20060102"))
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+
+	response.SendSuccess(w, constants.StatusCode.Ok, constants.SuccessMessages.DataExported, newDataExportResponse(export))
+}
+
+// newMeResponse maps the current user's profile to its API representation
+func newMeResponse(me *user.MyProfile) httpDTO.MeResponse {
+	profile := httpDTO.ProfileResponse{
+		FirstName:   me.Profile.FirstName,
+		LastName:    me.Profile.LastName,
+		Phone:       me.Profile.Phone,
+		Locale:      me.Profile.Locale,
+		AvatarURL:   me.Profile.AvatarURL,
+		UpdatedDate: me.Profile.UpdatedDate,
+	}
+
+	if me.Profile.BirthDate != nil {
+		birthDate := me.Profile.BirthDate.Format(userDTO.BirthDateLayout)
+		profile.BirthDate = &birthDate
+	}
+
+	return httpDTO.MeResponse{
+		ID:               me.User.ID,
+		Email:            me.User.Email,
+		EmailVerified:    me.User.EmailVerified,
+		Role:             me.RoleCode,
+		OrganizationID:   me.User.OrganizationID,
+		TwoFactorEnabled: me.User.TwoFactorEnabled,
+		LastLogin:        me.User.LastLogin,
+		CreatedDate:      me.User.CreatedDate,
+		Profile:          profile,
+	}
+}
+
+// newDataExportResponse maps a user data export to its API representation
+// Secrets such as token hashes are never part of the export
+func newDataExportResponse(export *user.UserDataExport) httpDTO.DataExportResponse {
+	sessions := make([]httpDTO.SessionExportResponse, 0, len(export.Sessions))
+	for _, session := range export.Sessions {
+		sessions = append(sessions, httpDTO.SessionExportResponse{
+			DeviceName:  session.DeviceName,
+			UserAgent:   session.UserAgent,
+			IPAddress:   session.IPAddress,
+			CreatedDate: session.CreatedDate,
+			ExpiresAt:   session.ExpiresAt,
+			RevokedAt:   session.RevokedAt,
+		})
+	}
+
+	consents := make([]httpDTO.ConsentResponse, 0, len(export.Consents))
+	for _, consent := range export.Consents {
+		consents = append(consents, httpDTO.ConsentResponse{
+			Type:       consent.DocumentType,
+			Version:    consent.DocumentVersion,
+			AcceptedAt: consent.AcceptedAt,
+			IPAddress:  consent.IPAddress,
+			UserAgent:  consent.UserAgent,
+		})
+	}
+
+	events := make([]httpDTO.EventExportResponse, 0, len(export.Events))
+	for _, event := range export.Events {
+		events = append(events, httpDTO.EventExportResponse{
+			Type:        event.Type,
+			Details:     event.Details,
+			IPAddress:   event.IPAddress,
+			CreatedDate: event.CreatedDate,
+		})
+	}
+
+	memberships := make([]httpDTO.MembershipExportResponse, 0, len(export.Memberships))
+	for _, membership := range export.Memberships {
+		memberships = append(memberships, httpDTO.MembershipExportResponse{
+			OrganizationID:   membership.OrganizationID,
+			OrganizationName: membership.OrganizationName,
+			Role:             membership.RoleCode,
+			Active:           membership.IsActive(),
+			CreatedDate:      membership.CreatedDate,
+			UpdatedDate:      membership.UpdatedDate,
+		})
+	}
+
+	invitations := make([]httpDTO.InvitationExportResponse, 0, len(export.Invitations))
+	for _, invitation := range export.Invitations {
+		invitations = append(invitations, httpDTO.InvitationExportResponse{
+			OrganizationID: invitation.OrganizationID,
+			Email:          invitation.Email,
+			Role:           invitation.RoleCode,
+			Status:         invitation.Status(),
+			ExpiresAt:      invitation.ExpiresAt,
+			AcceptedAt:     invitation.AcceptedAt,
+			RevokedAt:      invitation.RevokedAt,
+			CreatedDate:    invitation.CreatedDate,
+		})
+	}
+
+	doctors := make([]httpDTO.DoctorExportResponse, 0, len(export.Doctors))
+	for _, doctor := range export.Doctors {
+		specialties := make([]string, 0, len(doctor.Specialties))
+		for _, specialty := range doctor.Specialties {
+			specialties = append(specialties, specialty.Code)
+		}
+
+		doctors = append(doctors, httpDTO.DoctorExportResponse{
+			OrganizationName:     doctor.OrganizationName,
+			LicenseNumber:        doctor.LicenseNumber,
+			Bio:                  doctor.Bio,
+			Languages:            doctor.Languages,
+			Specialties:          specialties,
+			ConsultationMinutes:  doctor.ConsultationMinutes,
+			BufferMinutes:        doctor.BufferMinutes,
+			ConsultationFeeCents: doctor.ConsultationFeeCents,
+			FeeCurrency:          doctor.FeeCurrency,
+			Active:               doctor.IsActive(),
+			CreatedDate:          doctor.CreatedDate,
+			UpdatedDate:          doctor.UpdatedDate,
+		})
+	}
+
+	appointments := make([]httpDTO.AppointmentResponse, 0, len(export.Appointments))
+	for _, appointment := range export.Appointments {
+		appointments = append(appointments, httpDTO.AppointmentResponse{
+			ID:               appointment.ID,
+			Status:           appointment.Status,
+			StartTime:        appointment.StartTime,
+			EndTime:          appointment.EndTime,
+			Reason:           appointment.Reason,
+			DoctorID:         appointment.DoctorID,
+			DoctorFirstName:  appointment.DoctorFirstName,
+			DoctorLastName:   appointment.DoctorLastName,
+			OrganizationID:   appointment.OrganizationID,
+			OrganizationName: appointment.OrganizationName,
+			LocationID:       appointment.LocationID,
+			LocationName:     appointment.LocationName,
+			Timezone:         appointment.LocationTimezone,
+			CreatedDate:      appointment.CreatedDate,
+		})
+	}
+
+	var calendarFeed *httpDTO.CalendarFeedExportResponse
+	if export.CalendarFeed != nil {
+		calendarFeed = &httpDTO.CalendarFeedExportResponse{CreatedDate: export.CalendarFeed.CreatedDate}
+	}
+
+	return httpDTO.DataExportResponse{
+		ExportedAt:   export.ExportedAt,
+		Account:      newMeResponse(export.Account),
+		Sessions:     sessions,
+		Consents:     consents,
+		Events:       events,
+		Memberships:  memberships,
+		Invitations:  invitations,
+		Doctors:      doctors,
+		Appointments: appointments,
+		CalendarFeed: calendarFeed,
+	}
+}
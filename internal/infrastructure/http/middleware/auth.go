@@ -0,0 +1,118 @@
+package middleware
+
+import (
+	"citary-backend/internal/domain/security"
+	"citary-backend/internal/domain/services"
+	"citary-backend/internal/infrastructure/http/request"
+	"citary-backend/internal/infrastructure/http/response"
+	"citary-backend/pkg/constants"
+	"log"
+	"net/http"
+	"strings"
+)
+
+// Authenticate middleware resolves a valid Bearer access token into a principal stored in the request context
+// Requests without a valid token continue anonymously; routes that need a caller are wrapped with RequireAuth
+// Tokens of accounts that were deleted or deactivated after they were issued are treated as invalid
+func Authenticate(tokenService services.TokenService, accountGuard *security.AccountGuard) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			token := request.BearerToken(r)
+			if token == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			claims, err := tokenService.ParseAccessToken(token)
+			if err != nil {
+				log.Printf("[Authenticate] Rejected access token: path=%s, error=%v", r.URL.Path, err)
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			allowed, err := accountGuard.Allows(r.Context(), claims.UserID)
+			if err != nil {
+				response.HandleDomainError(w, err)
+				return
+			}
+
+			if !allowed {
+				log.Printf("[Authenticate] Rejected access token of an unusable account: path=%s, userID=%d", r.URL.Path, claims.UserID)
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			principal := &security.Principal{
+				UserID:         claims.UserID,
+				RoleCode:       claims.RoleCode,
+				OrganizationID: claims.OrganizationID,
+			}
+
+			next.ServeHTTP(w, r.WithContext(security.WithPrincipal(r.Context(), principal)))
+		})
+	}
+}
+
+// RequireAuth wraps a route so it is only reachable by authenticated callers
+// Anonymous requests receive the standard 401 response
+func RequireAuth(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if _, ok := security.PrincipalFromContext(r.Context()); !ok {
+			response.SendError(w, constants.StatusCode.Unauthorized, constants.ErrorMessages.Unauthorized)
+			return
+		}
+
+		next(w, r)
+	}
+}
+
+// RequirePermission wraps a route so it is only reachable by callers whose role grants the permission
+// Anonymous requests receive 401 and authenticated callers without the permission receive 403
+func RequirePermission(authorizer *security.Authorizer, permission string) func(http.HandlerFunc) http.HandlerFunc {
+	return func(next http.HandlerFunc) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			if err := authorizer.Require(r.Context(), permission); err != nil {
+				response.HandleDomainError(w, err)
+				return
+			}
+
+			next(w, r)
+		}
+	}
+}
+
+// RequireConsent middleware answers 428 to authenticated callers who have not accepted the current legal documents
+// Routes needed to review and accept them, sign in or out, and exercise data rights stay reachable
+func RequireConsent(guard *security.ConsentGuard) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if isConsentExempt(r) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			if err := guard.Require(r.Context()); err != nil {
+				response.HandleDomainError(w, err)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// isConsentExempt reports whether a request is reachable without accepting the current legal documents
+func isConsentExempt(r *http.Request) bool {
+	path := r.URL.Path
+
+	switch {
+	case strings.HasPrefix(path, "/auth/"), strings.HasPrefix(path, "/legal/"), path == "/health":
+		return true
+	case path == "/me/export":
+		return true
+	case path == "/me" && r.Method == http.MethodDelete:
+		return true
+	}
+
+	return false
+}
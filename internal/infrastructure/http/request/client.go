@@ -0,0 +1,47 @@
+package request
+
+import (
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// ClientIP returns the originating client IP, honoring the first X-Forwarded-For hop set by the ingress
+func ClientIP(r *http.Request) string {
+	if forwarded := r.Header.Get("X-Forwarded-For"); forwarded != "" {
+		if ip := strings.TrimSpace(strings.Split(forwarded, ",")[0]); ip != "" {
+			return ip
+		}
+	}
+
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+// UserAgent returns the User-Agent header of the request
+func UserAgent(r *http.Request) string {
+	return r.UserAgent()
+}
+
+// BearerToken returns the token from an "Authorization: Bearer <token>" header, or an empty string
+func BearerToken(r *http.Request) string {
+	header := r.Header.Get("Authorization")
+	scheme, token, found := strings.Cut(header, " ")
+	if !found || !strings.EqualFold(scheme, "Bearer") {
+		return ""
+	}
+	return strings.TrimSpace(token)
+}
+
+// QueryInt returns the integer value of a query parameter, or 0 when the parameter is absent
+func QueryInt(r *http.Request, key string) (int, error) {
+	value := r.URL.Query().Get(key)
+	if value == "" {
+		return 0, nil
+	}
+	return strconv.Atoi(value)
+}
@@ -5,6 +5,7 @@ import (
 	"citary-backend/internal/infrastructure/http/dto"
 	"citary-backend/pkg/constants"
 	"encoding/json"
+	stdErrors "errors"
 	"net/http"
 )
 
@@ -40,8 +41,10 @@ func SendError(w http.ResponseWriter, statusCode int, message string) {
 }
 
 // HandleDomainError handles domain-specific errors and sends appropriate HTTP responses
+// Wrapped domain errors are unwrapped so their status code (401, 403, 409, 423, ...) is preserved
 func HandleDomainError(w http.ResponseWriter, err error) {
-	if domainErr, ok := err.(*errors.DomainError); ok {
+	var domainErr *errors.DomainError
+	if stdErrors.As(err, &domainErr) {
 		SendError(w, domainErr.StatusCode, domainErr.Message)
 		return
 	}
@@ -1,20 +1,78 @@
 package router
 
 import (
+	"citary-backend/internal/domain/security"
+	"citary-backend/internal/domain/services"
+	"citary-backend/internal/infrastructure/http/handlers/appointment"
 	"citary-backend/internal/infrastructure/http/handlers/auth"
+	"citary-backend/internal/infrastructure/http/handlers/availability"
+	"citary-backend/internal/infrastructure/http/handlers/calendar"
+	"citary-backend/internal/infrastructure/http/handlers/doctor"
+	"citary-backend/internal/infrastructure/http/handlers/legal"
+	"citary-backend/internal/infrastructure/http/handlers/organization"
+	"citary-backend/internal/infrastructure/http/handlers/role"
+	"citary-backend/internal/infrastructure/http/handlers/user"
 	"citary-backend/internal/infrastructure/http/middleware"
+	"citary-backend/pkg/constants"
 	"net/http"
 )
 
 // Router manages HTTP route configuration
 type Router struct {
-	authHandler *auth.AuthHandler
+	tokenService     services.TokenService
+	authorizer       *security.Authorizer
+	accountGuard     *security.AccountGuard
+	consentGuard     *security.ConsentGuard
+	authHandler      *auth.AuthHandler
+	twoFactorHandler *auth.TwoFactorHandler
+	roleHandler      *role.RoleHandler
+	meHandler        *user.MeHandler
+	legalHandler     *legal.LegalHandler
+	orgHandler       *organization.OrganizationHandler
+	inviteHandler    *organization.InvitationHandler
+	locationHandler  *organization.LocationHandler
+	doctorHandler    *doctor.DoctorHandler
+	availHandler     *availability.AvailabilityHandler
+	apptHandler      *appointment.AppointmentHandler
+	calendarHandler  *calendar.CalendarHandler
 }
 
 // NewRouter creates a new Router instance
-func NewRouter(authHandler *auth.AuthHandler) *Router {
+func NewRouter(
+	tokenService services.TokenService,
+	authorizer *security.Authorizer,
+	accountGuard *security.AccountGuard,
+	consentGuard *security.ConsentGuard,
+	authHandler *auth.AuthHandler,
+	twoFactorHandler *auth.TwoFactorHandler,
+	roleHandler *role.RoleHandler,
+	meHandler *user.MeHandler,
+	legalHandler *legal.LegalHandler,
+	orgHandler *organization.OrganizationHandler,
+	inviteHandler *organization.InvitationHandler,
+	locationHandler *organization.LocationHandler,
+	doctorHandler *doctor.DoctorHandler,
+	availHandler *availability.AvailabilityHandler,
+	apptHandler *appointment.AppointmentHandler,
+	calendarHandler *calendar.CalendarHandler,
+) *Router {
 	return &Router{
-		authHandler: authHandler,
+		tokenService:     tokenService,
+		authorizer:       authorizer,
+		accountGuard:     accountGuard,
+		consentGuard:     consentGuard,
+		authHandler:      authHandler,
+		twoFactorHandler: twoFactorHandler,
+		roleHandler:      roleHandler,
+		meHandler:        meHandler,
+		legalHandler:     legalHandler,
+		orgHandler:       orgHandler,
+		inviteHandler:    inviteHandler,
+		locationHandler:  locationHandler,
+		doctorHandler:    doctorHandler,
+		availHandler:     availHandler,
+		apptHandler:      apptHandler,
+		calendarHandler:  calendarHandler,
 	}
 }
 
@@ -24,6 +82,116 @@ func (rt *Router) SetupRoutes() http.Handler {
 
 	// Auth routes
 	mux.HandleFunc("/auth/signup", rt.authHandler.SignupUser)
+	mux.HandleFunc("/auth/verify-email", rt.authHandler.VerifyEmail)
+	mux.HandleFunc("/auth/resend-verification", rt.authHandler.ResendVerification)
+	mux.HandleFunc("/auth/login", rt.authHandler.Login)
+	mux.HandleFunc("/auth/refresh", rt.authHandler.RefreshToken)
+	mux.HandleFunc("/auth/logout", rt.authHandler.Logout)
+	mux.HandleFunc("/auth/forgot-password", rt.authHandler.ForgotPassword)
+	mux.HandleFunc("/auth/reset-password", rt.authHandler.ResetPassword)
+	mux.HandleFunc("/auth/reactivate", rt.authHandler.ReactivateAccount)
+	mux.HandleFunc("/auth/change-password", middleware.RequireAuth(rt.authHandler.ChangePassword))
+	mux.HandleFunc("/auth/change-email", middleware.RequireAuth(rt.authHandler.ChangeEmail))
+	mux.HandleFunc("/auth/confirm-email-change", rt.authHandler.ConfirmEmailChange)
+	mux.HandleFunc("POST /auth/switch-organization", middleware.RequireAuth(rt.authHandler.SwitchOrganization))
+
+	// Two-factor routes
+	mux.HandleFunc("/auth/2fa/setup", middleware.RequireAuth(rt.twoFactorHandler.Setup))
+	mux.HandleFunc("/auth/2fa/confirm", middleware.RequireAuth(rt.twoFactorHandler.Confirm))
+	mux.HandleFunc("/auth/2fa/disable", middleware.RequireAuth(rt.twoFactorHandler.Disable))
+	mux.HandleFunc("/auth/2fa/verify", rt.twoFactorHandler.Verify)
+
+	// Current user routes
+	mux.HandleFunc("GET /me", middleware.RequireAuth(rt.meHandler.GetMe))
+	mux.HandleFunc("PATCH /me", middleware.RequireAuth(rt.meHandler.UpdateMe))
+	mux.HandleFunc("DELETE /me", middleware.RequireAuth(rt.meHandler.DeleteMe))
+	mux.HandleFunc("GET /me/export", middleware.RequireAuth(rt.meHandler.ExportMe))
+	mux.HandleFunc("GET /me/organizations", middleware.RequireAuth(rt.orgHandler.ListMyOrganizations))
+
+	// Legal document routes
+	mux.HandleFunc("GET /legal/documents", rt.legalHandler.ListDocuments)
+	mux.HandleFunc("GET /legal/pending", middleware.RequireAuth(rt.legalHandler.ListPending))
+	mux.HandleFunc("POST /legal/accept", middleware.RequireAuth(rt.legalHandler.Accept))
+
+	// Organization routes
+	mux.HandleFunc("POST /organizations", middleware.RequireAuth(rt.orgHandler.CreateOrganization))
+	mux.HandleFunc("GET /organizations/mine", middleware.RequireAuth(rt.orgHandler.GetMyOrganization))
+
+	// Organization invitation routes
+	canInvite := middleware.RequirePermission(rt.authorizer, constants.Permissions.OrganizationsInvite)
+	mux.HandleFunc("POST /organizations/mine/invitations", canInvite(rt.inviteHandler.CreateInvitation))
+	mux.HandleFunc("GET /organizations/mine/invitations", canInvite(rt.inviteHandler.ListInvitations))
+	mux.HandleFunc("DELETE /organizations/mine/invitations/{id}", canInvite(rt.inviteHandler.RevokeInvitation))
+	mux.HandleFunc("POST /invitations/accept", rt.inviteHandler.AcceptInvitation)
+
+	// Organization location routes
+	canReadOrganization := middleware.RequirePermission(rt.authorizer, constants.Permissions.OrganizationsRead)
+	canManageOrganization := middleware.RequirePermission(rt.authorizer, constants.Permissions.OrganizationsManage)
+	mux.HandleFunc("GET /organizations/mine/locations", canReadOrganization(rt.locationHandler.ListLocations))
+	mux.HandleFunc("POST /organizations/mine/locations", canManageOrganization(rt.locationHandler.CreateLocation))
+	mux.HandleFunc("DELETE /organizations/mine/locations/{id}", canManageOrganization(rt.locationHandler.DeactivateLocation))
+
+	// Doctor management routes
+	canReadDoctors := middleware.RequirePermission(rt.authorizer, constants.Permissions.DoctorsRead)
+	canManageDoctors := middleware.RequirePermission(rt.authorizer, constants.Permissions.DoctorsManage)
+	mux.HandleFunc("GET /organizations/mine/doctors", canReadDoctors(rt.doctorHandler.ListDoctors))
+	mux.HandleFunc("GET /organizations/mine/doctors/{id}", canReadDoctors(rt.doctorHandler.GetDoctor))
+	mux.HandleFunc("POST /organizations/mine/doctors", canManageDoctors(rt.doctorHandler.CreateDoctor))
+	mux.HandleFunc("PUT /organizations/mine/doctors/{id}", canManageDoctors(rt.doctorHandler.UpdateDoctor))
+	mux.HandleFunc("DELETE /organizations/mine/doctors/{id}", canManageDoctors(rt.doctorHandler.DeactivateDoctor))
+
+	// Doctor availability routes
+	canManageAvailability := middleware.RequirePermission(rt.authorizer, constants.Permissions.AvailabilityManage)
+	mux.HandleFunc("GET /organizations/mine/doctors/{id}/availability", canReadDoctors(rt.availHandler.ListBlocks))
+	mux.HandleFunc("POST /organizations/mine/doctors/{id}/availability", canManageAvailability(rt.availHandler.CreateBlock))
+	mux.HandleFunc("DELETE /organizations/mine/doctors/{id}/availability/{blockId}", canManageAvailability(rt.availHandler.DeleteBlock))
+	mux.HandleFunc("GET /organizations/mine/doctors/{id}/availability/effective", canReadDoctors(rt.availHandler.GetEffectiveAvailability))
+	mux.HandleFunc("GET /organizations/mine/doctors/{id}/exceptions", canReadDoctors(rt.availHandler.ListExceptions))
+	mux.HandleFunc("POST /organizations/mine/doctors/{id}/exceptions", canManageAvailability(rt.availHandler.CreateException))
+	mux.HandleFunc("DELETE /organizations/mine/doctors/{id}/exceptions/{exceptionId}", canManageAvailability(rt.availHandler.DeleteException))
+
+	// Public doctor directory routes
+	mux.HandleFunc("GET /doctors", rt.doctorHandler.SearchDoctors)
+	mux.HandleFunc("GET /doctors/{id}", rt.doctorHandler.GetPublicDoctor)
+	mux.HandleFunc("GET /specialties", rt.doctorHandler.ListSpecialties)
+	mux.HandleFunc("GET /providers/{id}/slots", rt.availHandler.ListFreeSlots)
+
+	// Appointment routes
+	canBookAppointments := middleware.RequirePermission(rt.authorizer, constants.Permissions.AppointmentsCreate)
+	canReadAppointments := middleware.RequirePermission(rt.authorizer, constants.Permissions.AppointmentsRead)
+	mux.HandleFunc("POST /appointments", canBookAppointments(rt.apptHandler.BookAppointment))
+	mux.HandleFunc("GET /me/appointments", canReadAppointments(rt.apptHandler.ListMyAppointments))
+	mux.HandleFunc("GET /appointments/{id}", canReadAppointments(rt.apptHandler.GetMyAppointment))
+	mux.HandleFunc("GET /appointments/{id}/history", canReadAppointments(rt.apptHandler.GetMyAppointmentHistory))
+	mux.HandleFunc("POST /appointments/{id}/cancel", canBookAppointments(rt.apptHandler.CancelMyAppointment))
+	mux.HandleFunc("POST /appointments/{id}/reschedule", canBookAppointments(rt.apptHandler.RescheduleMyAppointment))
+
+	// Calendar feed routes (the feed itself is authenticated by the secret token in its URL)
+	mux.HandleFunc("POST /me/calendar-feed", middleware.RequireAuth(rt.calendarHandler.RegenerateFeed))
+	mux.HandleFunc("DELETE /me/calendar-feed", middleware.RequireAuth(rt.calendarHandler.RevokeFeed))
+	mux.HandleFunc("GET /calendar/{file}", rt.calendarHandler.GetFeed)
+
+	// Clinic appointment routes
+	canReadAnyAppointments := middleware.RequirePermission(rt.authorizer, constants.Permissions.AppointmentsReadAny)
+	canManageAppointments := middleware.RequirePermission(rt.authorizer, constants.Permissions.AppointmentsManage)
+	mux.HandleFunc("GET /organizations/mine/appointments", canReadAnyAppointments(rt.apptHandler.ListOrganizationAppointments))
+	mux.HandleFunc("GET /organizations/mine/appointments/{id}", canReadAnyAppointments(rt.apptHandler.GetOrganizationAppointment))
+	mux.HandleFunc("GET /organizations/mine/appointments/{id}/history", canReadAnyAppointments(rt.apptHandler.GetOrganizationAppointmentHistory))
+	mux.HandleFunc("POST /organizations/mine/appointments/{id}/confirm", canManageAppointments(rt.apptHandler.ConfirmAppointment))
+	mux.HandleFunc("POST /organizations/mine/appointments/{id}/check-in", canManageAppointments(rt.apptHandler.CheckInAppointment))
+	mux.HandleFunc("POST /organizations/mine/appointments/{id}/complete", canManageAppointments(rt.apptHandler.CompleteAppointment))
+	mux.HandleFunc("POST /organizations/mine/appointments/{id}/no-show", canManageAppointments(rt.apptHandler.MarkNoShow))
+	mux.HandleFunc("POST /organizations/mine/appointments/{id}/cancel", canManageAppointments(rt.apptHandler.CancelOrganizationAppointment))
+	mux.HandleFunc("POST /organizations/mine/appointments/{id}/reschedule", canManageAppointments(rt.apptHandler.RescheduleOrganizationAppointment))
+
+	// Role management routes
+	canReadRoles := middleware.RequirePermission(rt.authorizer, constants.Permissions.RolesRead)
+	canManageRoles := middleware.RequirePermission(rt.authorizer, constants.Permissions.RolesManage)
+	mux.HandleFunc("GET /admin/roles", canReadRoles(rt.roleHandler.ListRoles))
+	mux.HandleFunc("GET /admin/roles/{id}", canReadRoles(rt.roleHandler.GetRole))
+	mux.HandleFunc("POST /admin/roles", canManageRoles(rt.roleHandler.CreateRole))
+	mux.HandleFunc("PUT /admin/roles/{id}", canManageRoles(rt.roleHandler.UpdateRole))
+	mux.HandleFunc("DELETE /admin/roles/{id}", canManageRoles(rt.roleHandler.DeactivateRole))
 
 	// Health check route
 	mux.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
@@ -32,8 +200,10 @@ func (rt *Router) SetupRoutes() http.Handler {
 		w.Write([]byte(`{"status":"ok"}`))
 	})
 
-	// Apply middleware chain (order matters: Recovery -> CORS -> Logging -> routes)
-	handler := middleware.Recovery(mux)
+	// Apply middleware chain (order matters: Recovery -> CORS -> Logging -> Authenticate -> RequireConsent -> routes)
+	handler := middleware.RequireConsent(rt.consentGuard)(mux)
+	handler = middleware.Authenticate(rt.tokenService, rt.accountGuard)(handler)
+	handler = middleware.Recovery(handler)
 	handler = middleware.CORS(handler)
 	handler = middleware.Logging(handler)
 
@@ -0,0 +1,52 @@
+package jobs
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"hash/fnv"
+	"log"
+)
+
+// exclusive wraps a job so only one process sharing the database runs it at a time
+// The job holds a session-level Postgres advisory lock on a dedicated connection while it runs;
+// a replica that cannot take the lock skips the run instead of waiting for it
+func exclusive(db *sql.DB, name string, job Job) Job {
+	key := advisoryLockKey(name)
+
+	return func(ctx context.Context) error {
+		conn, err := db.Conn(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get a connection for the job lock: %w", err)
+		}
+		defer conn.Close()
+
+		var acquired bool
+		if err := conn.QueryRowContext(ctx, `SELECT pg_try_advisory_lock($1)`, key).Scan(&acquired); err != nil {
+			return fmt.Errorf("failed to take the job lock: %w", err)
+		}
+
+		if !acquired {
+			log.Printf("[JobRunner] Job %s skipped: running in another instance", name)
+			return nil
+		}
+
+		defer func() {
+			if _, err := conn.ExecContext(context.Background(), `SELECT pg_advisory_unlock($1)`, key); err != nil {
+				// Discard the connection so its session, and with it the lock, ends
+				log.Printf("[JobRunner] Job %s ERROR: failed to release the job lock, error=%v", name, err)
+				conn.Raw(func(any) error { return driver.ErrBadConn })
+			}
+		}()
+
+		return job(ctx)
+	}
+}
+
+// advisoryLockKey derives a stable advisory lock key from a job name
+func advisoryLockKey(name string) int64 {
+	hash := fnv.New64a()
+	hash.Write([]byte("citary:job:" + name))
+	return int64(hash.Sum64())
+}
@@ -0,0 +1,101 @@
+package jobs
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"sync"
+	"time"
+)
+
+// Job is a unit of background work run on a fixed interval
+type Job func(ctx context.Context) error
+
+// scheduledJob pairs a job with its name and interval
+type scheduledJob struct {
+	name     string
+	interval time.Duration
+	run      Job
+}
+
+// Runner executes scheduled jobs in background goroutines until stopped
+type Runner struct {
+	jobs   []scheduledJob
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewRunner creates a new instance of Runner
+func NewRunner() *Runner {
+	return &Runner{}
+}
+
+// Schedule registers a job to run every interval once the runner is started
+func (r *Runner) Schedule(name string, interval time.Duration, job Job) {
+	r.jobs = append(r.jobs, scheduledJob{name: name, interval: interval, run: job})
+}
+
+// ScheduleExclusive registers a job like Schedule, but runs it in at most one process at a time
+// Use it for jobs that must not overlap when several API replicas share the database
+func (r *Runner) ScheduleExclusive(db *sql.DB, name string, interval time.Duration, job Job) {
+	r.Schedule(name, interval, exclusive(db, name, job))
+}
+
+// Start launches every scheduled job; each job runs once immediately and then on its interval
+func (r *Runner) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	r.cancel = cancel
+
+	for _, job := range r.jobs {
+		r.wg.Add(1)
+		go r.loop(ctx, job)
+	}
+
+	log.Printf("[JobRunner] Started %d job(s)", len(r.jobs))
+}
+
+// Stop cancels running jobs and waits for them to return
+func (r *Runner) Stop() {
+	if r.cancel == nil {
+		return
+	}
+
+	log.Println("[JobRunner] Stopping jobs...")
+	r.cancel()
+	r.wg.Wait()
+}
+
+// loop runs a job on its interval until the context is cancelled
+func (r *Runner) loop(ctx context.Context, job scheduledJob) {
+	defer r.wg.Done()
+
+	ticker := time.NewTicker(job.interval)
+	defer ticker.Stop()
+
+	for {
+		r.execute(ctx, job)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// execute runs a single job iteration, logging failures and recovering from panics
+func (r *Runner) execute(ctx context.Context, job scheduledJob) {
+	defer func() {
+		if rec := recover(); rec != nil {
+			log.Printf("[JobRunner] PANIC in job %s: %v", job.name, rec)
+		}
+	}()
+
+	start := time.Now()
+	if err := job.run(ctx); err != nil {
+		log.Printf("[JobRunner] Job %s ERROR: error=%v, duration=%v", job.name, err, time.Since(start))
+		return
+	}
+
+	log.Printf("[JobRunner] Job %s completed: duration=%v", job.name, time.Since(start))
+}
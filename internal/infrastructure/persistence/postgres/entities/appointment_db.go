@@ -0,0 +1,31 @@
+package entities
+
+import (
+	"database/sql"
+	"time"
+)
+
+// AppointmentDB represents the appointment table structure in PostgreSQL
+// DocIdUser, UprFirstName, UprLastName, OrgName, LocName, LocAddress and LocTimezone are joined
+// from the doctor, its user profile, the organization and the location when reading
+type AppointmentDB struct {
+	AptID           int            `db:"apt_id"`
+	IdOrganization  int            `db:"id_organization"`
+	IdDoctor        int            `db:"id_doctor"`
+	IdLocation      int            `db:"id_location"`
+	IdPatient       int            `db:"id_patient"`
+	AptStartTime    time.Time      `db:"apt_start_time"`
+	AptEndTime      time.Time      `db:"apt_end_time"`
+	AptStatus       string         `db:"apt_status"`
+	AptReason       sql.NullString `db:"apt_reason"`
+	AptCreatedDate  time.Time      `db:"apt_created_date"`
+	AptUpdatedDate  sql.NullTime   `db:"apt_updated_date"`
+	AptRecordStatus string         `db:"apt_record_status"`
+	DocIdUser       int            `db:"id_user"`
+	UprFirstName    sql.NullString `db:"upr_first_name"`
+	UprLastName     sql.NullString `db:"upr_last_name"`
+	OrgName         string         `db:"org_name"`
+	LocName         string         `db:"loc_name"`
+	LocAddress      sql.NullString `db:"loc_address"`
+	LocTimezone     string         `db:"loc_timezone"`
+}
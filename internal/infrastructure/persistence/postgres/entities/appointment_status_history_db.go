@@ -0,0 +1,19 @@
+package entities
+
+import (
+	"database/sql"
+	"time"
+)
+
+// AppointmentStatusHistoryDB represents the appointment status history table structure in PostgreSQL
+type AppointmentStatusHistoryDB struct {
+	AshID          int            `db:"ash_id"`
+	IdAppointment  int            `db:"id_appointment"`
+	AshFromStatus  sql.NullString `db:"ash_from_status"`
+	AshToStatus    string         `db:"ash_to_status"`
+	AshStartTime   time.Time      `db:"ash_start_time"`
+	AshEndTime     time.Time      `db:"ash_end_time"`
+	IdChangedBy    int            `db:"id_changed_by"`
+	AshReason      sql.NullString `db:"ash_reason"`
+	AshCreatedDate time.Time      `db:"ash_created_date"`
+}
@@ -0,0 +1,37 @@
+package entities
+
+import (
+	"database/sql"
+	"time"
+)
+
+// AvailabilityBlockDB represents the availability block table structure in PostgreSQL
+// Times hold the TIME column text, e.g. "09:00:00"
+type AvailabilityBlockDB struct {
+	AvbID           int          `db:"avb_id"`
+	IdDoctor        int          `db:"id_doctor"`
+	IdLocation      int          `db:"id_location"`
+	AvbWeekday      int          `db:"avb_weekday"`
+	AvbStartTime    string       `db:"avb_start_time"`
+	AvbEndTime      string       `db:"avb_end_time"`
+	AvbValidFrom    sql.NullTime `db:"avb_valid_from"`
+	AvbValidUntil   sql.NullTime `db:"avb_valid_until"`
+	AvbCreatedDate  time.Time    `db:"avb_created_date"`
+	AvbUpdatedDate  sql.NullTime `db:"avb_updated_date"`
+	AvbRecordStatus string       `db:"avb_record_status"`
+}
+
+// AvailabilityExceptionDB represents the availability exception table structure in PostgreSQL
+type AvailabilityExceptionDB struct {
+	AvxID           int            `db:"avx_id"`
+	IdDoctor        int            `db:"id_doctor"`
+	IdLocation      sql.NullInt64  `db:"id_location"`
+	AvxKind         string         `db:"avx_kind"`
+	AvxStartDate    time.Time      `db:"avx_start_date"`
+	AvxEndDate      time.Time      `db:"avx_end_date"`
+	AvxStartTime    sql.NullString `db:"avx_start_time"`
+	AvxEndTime      sql.NullString `db:"avx_end_time"`
+	AvxReason       sql.NullString `db:"avx_reason"`
+	AvxCreatedDate  time.Time      `db:"avx_created_date"`
+	AvxRecordStatus string         `db:"avx_record_status"`
+}
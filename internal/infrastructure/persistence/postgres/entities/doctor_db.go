@@ -0,0 +1,30 @@
+package entities
+
+import (
+	"database/sql"
+	"time"
+)
+
+// DoctorDB represents the doctor table structure in PostgreSQL
+// UprFirstName, UprLastName, OrgName and OrgSlug are joined from the profile and organization tables when reading;
+// Specialties is aggregated from the doctor specialty table
+type DoctorDB struct {
+	DocID                  int            `db:"doc_id"`
+	IdUser                 int            `db:"id_user"`
+	IdOrganization         int            `db:"id_organization"`
+	DocLicenseNumber       string         `db:"doc_license_number"`
+	DocBio                 sql.NullString `db:"doc_bio"`
+	DocLanguages           []string       `db:"doc_languages"`
+	DocConsultationMinutes int            `db:"doc_consultation_minutes"`
+	DocBufferMinutes       int            `db:"doc_buffer_minutes"`
+	DocFeeCents            sql.NullInt64  `db:"doc_fee_cents"`
+	DocFeeCurrency         string         `db:"doc_fee_currency"`
+	DocCreatedDate         time.Time      `db:"doc_created_date"`
+	DocUpdatedDate         sql.NullTime   `db:"doc_updated_date"`
+	DocRecordStatus        string         `db:"doc_record_status"`
+	UprFirstName           sql.NullString `db:"upr_first_name"`
+	UprLastName            sql.NullString `db:"upr_last_name"`
+	OrgName                string         `db:"org_name"`
+	OrgSlug                string         `db:"org_slug"`
+	Specialties            []SpecialtyDB  `db:"-"`
+}
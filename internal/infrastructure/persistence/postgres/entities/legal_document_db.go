@@ -0,0 +1,15 @@
+package entities
+
+import "time"
+
+// LegalDocumentDB represents the legal document table structure in PostgreSQL
+type LegalDocumentDB struct {
+	LedID           int       `db:"led_id"`
+	LedType         string    `db:"led_type"`
+	LedVersion      string    `db:"led_version"`
+	LedTitle        string    `db:"led_title"`
+	LedURL          string    `db:"led_url"`
+	LedPublishedAt  time.Time `db:"led_published_at"`
+	LedCreatedDate  time.Time `db:"led_created_date"`
+	LedRecordStatus string    `db:"led_record_status"`
+}
@@ -0,0 +1,18 @@
+package entities
+
+import (
+	"database/sql"
+	"time"
+)
+
+// LocationDB represents the location table structure in PostgreSQL
+type LocationDB struct {
+	LocID           int            `db:"loc_id"`
+	IdOrganization  int            `db:"id_organization"`
+	LocName         string         `db:"loc_name"`
+	LocAddress      sql.NullString `db:"loc_address"`
+	LocTimezone     string         `db:"loc_timezone"`
+	LocCreatedDate  time.Time      `db:"loc_created_date"`
+	LocUpdatedDate  sql.NullTime   `db:"loc_updated_date"`
+	LocRecordStatus string         `db:"loc_record_status"`
+}
@@ -0,0 +1,21 @@
+package entities
+
+import (
+	"database/sql"
+	"time"
+)
+
+// OrganizationDB represents the organization table structure in PostgreSQL
+type OrganizationDB struct {
+	OrgID           int            `db:"org_id"`
+	IdUserOwner     int            `db:"id_user_owner"`
+	OrgName         string         `db:"org_name"`
+	OrgSlug         string         `db:"org_slug"`
+	OrgEmail        sql.NullString `db:"org_email"`
+	OrgPhone        sql.NullString `db:"org_phone"`
+	OrgAddress      sql.NullString `db:"org_address"`
+	OrgTimezone     string         `db:"org_timezone"`
+	OrgCreatedDate  time.Time      `db:"org_created_date"`
+	OrgUpdatedDate  sql.NullTime   `db:"org_updated_date"`
+	OrgRecordStatus string         `db:"org_record_status"`
+}
@@ -0,0 +1,24 @@
+package entities
+
+import (
+	"database/sql"
+	"time"
+)
+
+// OrganizationInvitationDB represents the organization invitation table structure in PostgreSQL
+// RolCode is joined from the role table when reading
+type OrganizationInvitationDB struct {
+	OinID           int           `db:"oin_id"`
+	IdOrganization  int           `db:"id_organization"`
+	IdRole          int           `db:"id_role"`
+	RolCode         string        `db:"rol_code"`
+	IdUserInvitedBy int           `db:"id_user_invited_by"`
+	IdUserAccepted  sql.NullInt64 `db:"id_user_accepted"`
+	OinEmail        string        `db:"oin_email"`
+	OinTokenHash    string        `db:"oin_token_hash"`
+	OinExpiresAt    time.Time     `db:"oin_expires_at"`
+	OinAcceptedAt   sql.NullTime  `db:"oin_accepted_at"`
+	OinRevokedAt    sql.NullTime  `db:"oin_revoked_at"`
+	OinCreatedDate  time.Time     `db:"oin_created_date"`
+	OinRecordStatus string        `db:"oin_record_status"`
+}
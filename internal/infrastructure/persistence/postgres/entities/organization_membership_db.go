@@ -0,0 +1,20 @@
+package entities
+
+import (
+	"database/sql"
+	"time"
+)
+
+// OrganizationMembershipDB represents the organization membership table structure in PostgreSQL
+// OrgName and RolCode are joined from the organization and role tables when reading
+type OrganizationMembershipDB struct {
+	OrmID           int          `db:"orm_id"`
+	IdOrganization  int          `db:"id_organization"`
+	OrgName         string       `db:"org_name"`
+	IdUser          int          `db:"id_user"`
+	IdRole          int          `db:"id_role"`
+	RolCode         string       `db:"rol_code"`
+	OrmCreatedDate  time.Time    `db:"orm_created_date"`
+	OrmUpdatedDate  sql.NullTime `db:"orm_updated_date"`
+	OrmRecordStatus string       `db:"orm_record_status"`
+}
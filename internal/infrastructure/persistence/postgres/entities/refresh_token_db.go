@@ -0,0 +1,23 @@
+package entities
+
+import (
+	"database/sql"
+	"time"
+)
+
+// RefreshTokenDB represents the refresh token table structure in PostgreSQL
+type RefreshTokenDB struct {
+	RefID           int            `db:"ref_id"`
+	IdUser          int            `db:"id_user"`
+	IdOrganization  sql.NullInt64  `db:"id_organization"`
+	RefFamilyID     string         `db:"ref_family_id"`
+	RefTokenHash    string         `db:"ref_token_hash"`
+	RefDeviceName   sql.NullString `db:"ref_device_name"`
+	RefUserAgent    sql.NullString `db:"ref_user_agent"`
+	RefIPAddress    sql.NullString `db:"ref_ip_address"`
+	RefExpiresAt    time.Time      `db:"ref_expires_at"`
+	RefRevokedAt    sql.NullTime   `db:"ref_revoked_at"`
+	RefReplacedBy   sql.NullInt64  `db:"ref_replaced_by"`
+	RefCreatedDate  time.Time      `db:"ref_created_date"`
+	RefRecordStatus string         `db:"ref_record_status"`
+}
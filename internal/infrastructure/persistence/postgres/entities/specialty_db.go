@@ -0,0 +1,13 @@
+package entities
+
+import "time"
+
+// SpecialtyDB represents the specialty table structure in PostgreSQL
+// The json tags match the objects aggregated into doctor rows
+type SpecialtyDB struct {
+	SpeID           int       `db:"spe_id" json:"spe_id"`
+	SpeCode         string    `db:"spe_code" json:"spe_code"`
+	SpeName         string    `db:"spe_name" json:"spe_name"`
+	SpeCreatedDate  time.Time `db:"spe_created_date" json:"-"`
+	SpeRecordStatus string    `db:"spe_record_status" json:"spe_record_status"`
+}
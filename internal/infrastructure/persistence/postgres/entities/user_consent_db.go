@@ -0,0 +1,21 @@
+package entities
+
+import (
+	"database/sql"
+	"time"
+)
+
+// UserConsentDB represents the user consent table structure in PostgreSQL
+// LedType and LedVersion are joined from the legal document table when reading
+type UserConsentDB struct {
+	UcoID           int            `db:"uco_id"`
+	IdUser          int            `db:"id_user"`
+	IdLegalDocument int            `db:"id_legal_document"`
+	LedType         string         `db:"led_type"`
+	LedVersion      string         `db:"led_version"`
+	UcoAcceptedAt   time.Time      `db:"uco_accepted_at"`
+	UcoIPAddress    sql.NullString `db:"uco_ip_address"`
+	UcoUserAgent    sql.NullString `db:"uco_user_agent"`
+	UcoCreatedDate  time.Time      `db:"uco_created_date"`
+	UcoRecordStatus string         `db:"uco_record_status"`
+}
@@ -9,16 +9,23 @@ import (
 type UserDB struct {
 	UseID                         int            `db:"use_id"`
 	IdRole                        int            `db:"id_role"`
+	IdOrganization                sql.NullInt64  `db:"id_organization"`
 	UseEmail                      string         `db:"use_email"`
 	UsePasswordHash               string         `db:"use_password_hash"`
 	UseEmailVerified              bool           `db:"use_email_verified"`
 	UseVerificationToken          sql.NullString `db:"use_verification_token"`
 	UseVerificationTokenExpiresAt sql.NullTime   `db:"use_verification_token_expires_at"`
+	UseVerificationSentAt         sql.NullTime   `db:"use_verification_sent_at"`
+	UseVerificationSendCount      int            `db:"use_verification_send_count"`
+	UseTwoFactorEnabled           bool           `db:"use_two_factor_enabled"`
+	UseTwoFactorSecret            sql.NullString `db:"use_two_factor_secret"`
 	UseLastLogin                  sql.NullTime   `db:"use_last_login"`
 	UseLoginAttempts              int            `db:"use_login_attempts"`
 	UseLockedUntil                sql.NullTime   `db:"use_locked_until"`
 	UseTermsAcceptedAt            sql.NullTime   `db:"use_terms_accepted_at"`
 	UsePrivacyAcceptedAt          sql.NullTime   `db:"use_privacy_accepted_at"`
+	UseDeletedAt                  sql.NullTime   `db:"use_deleted_at"`
+	UseAnonymizeAfter             sql.NullTime   `db:"use_anonymize_after"`
 	UseCreatedDate                time.Time      `db:"use_created_date"`
 	UseRecordStatus               string         `db:"use_record_status"`
 }
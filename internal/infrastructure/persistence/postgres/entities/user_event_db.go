@@ -0,0 +1,17 @@
+package entities
+
+import (
+	"database/sql"
+	"time"
+)
+
+// UserEventDB represents the user event table structure in PostgreSQL
+type UserEventDB struct {
+	UevID           int            `db:"uev_id"`
+	IdUser          int            `db:"id_user"`
+	UevType         string         `db:"uev_type"`
+	UevDetails      sql.NullString `db:"uev_details"`
+	UevIPAddress    sql.NullString `db:"uev_ip_address"`
+	UevCreatedDate  time.Time      `db:"uev_created_date"`
+	UevRecordStatus string         `db:"uev_record_status"`
+}
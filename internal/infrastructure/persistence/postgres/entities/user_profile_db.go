@@ -0,0 +1,21 @@
+package entities
+
+import (
+	"database/sql"
+	"time"
+)
+
+// UserProfileDB represents the user profile table structure in PostgreSQL
+type UserProfileDB struct {
+	UprID           int            `db:"upr_id"`
+	IdUser          int            `db:"id_user"`
+	UprFirstName    sql.NullString `db:"upr_first_name"`
+	UprLastName     sql.NullString `db:"upr_last_name"`
+	UprPhone        sql.NullString `db:"upr_phone"`
+	UprBirthDate    sql.NullTime   `db:"upr_birth_date"`
+	UprLocale       sql.NullString `db:"upr_locale"`
+	UprAvatarURL    sql.NullString `db:"upr_avatar_url"`
+	UprCreatedDate  time.Time      `db:"upr_created_date"`
+	UprUpdatedDate  sql.NullTime   `db:"upr_updated_date"`
+	UprRecordStatus string         `db:"upr_record_status"`
+}
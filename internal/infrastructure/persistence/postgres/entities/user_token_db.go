@@ -0,0 +1,19 @@
+package entities
+
+import (
+	"database/sql"
+	"time"
+)
+
+// UserTokenDB represents the user token table structure in PostgreSQL
+type UserTokenDB struct {
+	UtoID           int            `db:"uto_id"`
+	IdUser          int            `db:"id_user"`
+	UtoPurpose      string         `db:"uto_purpose"`
+	UtoTokenHash    string         `db:"uto_token_hash"`
+	UtoPayload      sql.NullString `db:"uto_payload"`
+	UtoExpiresAt    time.Time      `db:"uto_expires_at"`
+	UtoUsedAt       sql.NullTime   `db:"uto_used_at"`
+	UtoCreatedDate  time.Time      `db:"uto_created_date"`
+	UtoRecordStatus string         `db:"uto_record_status"`
+}
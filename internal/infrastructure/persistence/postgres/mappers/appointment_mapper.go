@@ -0,0 +1,88 @@
+package mappers
+
+import (
+	domainEntities "citary-backend/internal/domain/entities"
+	dbEntities "citary-backend/internal/infrastructure/persistence/postgres/entities"
+)
+
+// AppointmentMapper handles conversion between domain and database entities
+type AppointmentMapper struct{}
+
+// NewAppointmentMapper creates a new AppointmentMapper instance
+func NewAppointmentMapper() *AppointmentMapper {
+	return &AppointmentMapper{}
+}
+
+// ToDBEntity converts a domain Appointment entity to a database AppointmentDB entity
+// Joined read-only fields are not mapped
+func (m *AppointmentMapper) ToDBEntity(appointment *domainEntities.Appointment) *dbEntities.AppointmentDB {
+	return &dbEntities.AppointmentDB{
+		AptID:           appointment.ID,
+		IdOrganization:  appointment.OrganizationID,
+		IdDoctor:        appointment.DoctorID,
+		IdLocation:      appointment.LocationID,
+		IdPatient:       appointment.PatientID,
+		AptStartTime:    appointment.StartTime,
+		AptEndTime:      appointment.EndTime,
+		AptStatus:       appointment.Status,
+		AptReason:       toNullString(appointment.Reason),
+		AptCreatedDate:  appointment.CreatedDate,
+		AptUpdatedDate:  toNullTime(appointment.UpdatedDate),
+		AptRecordStatus: appointment.RecordStatus,
+	}
+}
+
+// ToDomainEntity converts a database AppointmentDB entity to a domain Appointment entity
+func (m *AppointmentMapper) ToDomainEntity(dbEntity *dbEntities.AppointmentDB) *domainEntities.Appointment {
+	return &domainEntities.Appointment{
+		ID:               dbEntity.AptID,
+		OrganizationID:   dbEntity.IdOrganization,
+		DoctorID:         dbEntity.IdDoctor,
+		LocationID:       dbEntity.IdLocation,
+		PatientID:        dbEntity.IdPatient,
+		StartTime:        dbEntity.AptStartTime,
+		EndTime:          dbEntity.AptEndTime,
+		Status:           dbEntity.AptStatus,
+		Reason:           fromNullString(dbEntity.AptReason),
+		DoctorUserID:     dbEntity.DocIdUser,
+		DoctorFirstName:  fromNullString(dbEntity.UprFirstName),
+		DoctorLastName:   fromNullString(dbEntity.UprLastName),
+		OrganizationName: dbEntity.OrgName,
+		LocationName:     dbEntity.LocName,
+		LocationAddress:  fromNullString(dbEntity.LocAddress),
+		LocationTimezone: dbEntity.LocTimezone,
+		CreatedDate:      dbEntity.AptCreatedDate,
+		UpdatedDate:      fromNullTime(dbEntity.AptUpdatedDate),
+		RecordStatus:     dbEntity.AptRecordStatus,
+	}
+}
+
+// ChangeToDBEntity converts a domain AppointmentStatusChange entity to a database AppointmentStatusHistoryDB entity
+func (m *AppointmentMapper) ChangeToDBEntity(change *domainEntities.AppointmentStatusChange) *dbEntities.AppointmentStatusHistoryDB {
+	return &dbEntities.AppointmentStatusHistoryDB{
+		AshID:          change.ID,
+		IdAppointment:  change.AppointmentID,
+		AshFromStatus:  toNullString(change.FromStatus),
+		AshToStatus:    change.ToStatus,
+		AshStartTime:   change.StartTime,
+		AshEndTime:     change.EndTime,
+		IdChangedBy:    change.ChangedBy,
+		AshReason:      toNullString(change.Reason),
+		AshCreatedDate: change.CreatedDate,
+	}
+}
+
+// ChangeToDomainEntity converts a database AppointmentStatusHistoryDB entity to a domain AppointmentStatusChange entity
+func (m *AppointmentMapper) ChangeToDomainEntity(dbEntity *dbEntities.AppointmentStatusHistoryDB) *domainEntities.AppointmentStatusChange {
+	return &domainEntities.AppointmentStatusChange{
+		ID:            dbEntity.AshID,
+		AppointmentID: dbEntity.IdAppointment,
+		FromStatus:    fromNullString(dbEntity.AshFromStatus),
+		ToStatus:      dbEntity.AshToStatus,
+		StartTime:     dbEntity.AshStartTime,
+		EndTime:       dbEntity.AshEndTime,
+		ChangedBy:     dbEntity.IdChangedBy,
+		Reason:        fromNullString(dbEntity.AshReason),
+		CreatedDate:   dbEntity.AshCreatedDate,
+	}
+}
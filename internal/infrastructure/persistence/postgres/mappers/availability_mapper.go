@@ -0,0 +1,95 @@
+package mappers
+
+import (
+	domainEntities "citary-backend/internal/domain/entities"
+	dbEntities "citary-backend/internal/infrastructure/persistence/postgres/entities"
+	"database/sql"
+	"time"
+)
+
+// AvailabilityMapper handles conversion between domain and database availability entities
+type AvailabilityMapper struct{}
+
+// NewAvailabilityMapper creates a new AvailabilityMapper instance
+func NewAvailabilityMapper() *AvailabilityMapper {
+	return &AvailabilityMapper{}
+}
+
+// BlockToDBEntity converts a domain AvailabilityBlock entity to a database AvailabilityBlockDB entity
+func (m *AvailabilityMapper) BlockToDBEntity(block *domainEntities.AvailabilityBlock) *dbEntities.AvailabilityBlockDB {
+	return &dbEntities.AvailabilityBlockDB{
+		AvbID:           block.ID,
+		IdDoctor:        block.DoctorID,
+		IdLocation:      block.LocationID,
+		AvbWeekday:      int(block.Weekday),
+		AvbStartTime:    toTimeOfDay(block.StartMinute),
+		AvbEndTime:      toTimeOfDay(block.EndMinute),
+		AvbValidFrom:    toNullTime(block.ValidFrom),
+		AvbValidUntil:   toNullTime(block.ValidUntil),
+		AvbCreatedDate:  block.CreatedDate,
+		AvbUpdatedDate:  toNullTime(block.UpdatedDate),
+		AvbRecordStatus: block.RecordStatus,
+	}
+}
+
+// BlockToDomainEntity converts a database AvailabilityBlockDB entity to a domain AvailabilityBlock entity
+func (m *AvailabilityMapper) BlockToDomainEntity(dbEntity *dbEntities.AvailabilityBlockDB) *domainEntities.AvailabilityBlock {
+	return &domainEntities.AvailabilityBlock{
+		ID:           dbEntity.AvbID,
+		DoctorID:     dbEntity.IdDoctor,
+		LocationID:   dbEntity.IdLocation,
+		Weekday:      time.Weekday(dbEntity.AvbWeekday),
+		StartMinute:  fromTimeOfDay(dbEntity.AvbStartTime),
+		EndMinute:    fromTimeOfDay(dbEntity.AvbEndTime),
+		ValidFrom:    fromNullTime(dbEntity.AvbValidFrom),
+		ValidUntil:   fromNullTime(dbEntity.AvbValidUntil),
+		CreatedDate:  dbEntity.AvbCreatedDate,
+		UpdatedDate:  fromNullTime(dbEntity.AvbUpdatedDate),
+		RecordStatus: dbEntity.AvbRecordStatus,
+	}
+}
+
+// ExceptionToDBEntity converts a domain AvailabilityException entity to a database AvailabilityExceptionDB entity
+func (m *AvailabilityMapper) ExceptionToDBEntity(exception *domainEntities.AvailabilityException) *dbEntities.AvailabilityExceptionDB {
+	dbEntity := &dbEntities.AvailabilityExceptionDB{
+		AvxID:           exception.ID,
+		IdDoctor:        exception.DoctorID,
+		AvxKind:         exception.Kind,
+		AvxStartDate:    exception.StartDate,
+		AvxEndDate:      exception.EndDate,
+		AvxStartTime:    toNullTimeOfDay(exception.StartMinute),
+		AvxEndTime:      toNullTimeOfDay(exception.EndMinute),
+		AvxReason:       toNullString(exception.Reason),
+		AvxCreatedDate:  exception.CreatedDate,
+		AvxRecordStatus: exception.RecordStatus,
+	}
+
+	if exception.LocationID != nil {
+		dbEntity.IdLocation = sql.NullInt64{Int64: int64(*exception.LocationID), Valid: true}
+	}
+
+	return dbEntity
+}
+
+// ExceptionToDomainEntity converts a database AvailabilityExceptionDB entity to a domain AvailabilityException entity
+func (m *AvailabilityMapper) ExceptionToDomainEntity(dbEntity *dbEntities.AvailabilityExceptionDB) *domainEntities.AvailabilityException {
+	exception := &domainEntities.AvailabilityException{
+		ID:           dbEntity.AvxID,
+		DoctorID:     dbEntity.IdDoctor,
+		Kind:         dbEntity.AvxKind,
+		StartDate:    dbEntity.AvxStartDate,
+		EndDate:      dbEntity.AvxEndDate,
+		StartMinute:  fromNullTimeOfDay(dbEntity.AvxStartTime),
+		EndMinute:    fromNullTimeOfDay(dbEntity.AvxEndTime),
+		Reason:       fromNullString(dbEntity.AvxReason),
+		CreatedDate:  dbEntity.AvxCreatedDate,
+		RecordStatus: dbEntity.AvxRecordStatus,
+	}
+
+	if dbEntity.IdLocation.Valid {
+		locationID := int(dbEntity.IdLocation.Int64)
+		exception.LocationID = &locationID
+	}
+
+	return exception
+}
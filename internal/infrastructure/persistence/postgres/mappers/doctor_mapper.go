@@ -0,0 +1,94 @@
+package mappers
+
+import (
+	domainEntities "citary-backend/internal/domain/entities"
+	dbEntities "citary-backend/internal/infrastructure/persistence/postgres/entities"
+	"database/sql"
+)
+
+// DoctorMapper handles conversion between domain and database entities
+type DoctorMapper struct {
+	specialtyMapper *SpecialtyMapper
+}
+
+// NewDoctorMapper creates a new DoctorMapper instance
+func NewDoctorMapper() *DoctorMapper {
+	return &DoctorMapper{
+		specialtyMapper: NewSpecialtyMapper(),
+	}
+}
+
+// ToDBEntity converts a domain Doctor entity to a database DoctorDB entity
+// Joined, read-only fields are not copied
+func (m *DoctorMapper) ToDBEntity(doctor *domainEntities.Doctor) *dbEntities.DoctorDB {
+	languages := doctor.Languages
+	if languages == nil {
+		languages = []string{}
+	}
+
+	dbEntity := &dbEntities.DoctorDB{
+		DocID:                  doctor.ID,
+		IdUser:                 doctor.UserID,
+		IdOrganization:         doctor.OrganizationID,
+		DocLicenseNumber:       doctor.LicenseNumber,
+		DocBio:                 toNullString(doctor.Bio),
+		DocLanguages:           languages,
+		DocConsultationMinutes: doctor.ConsultationMinutes,
+		DocBufferMinutes:       doctor.BufferMinutes,
+		DocFeeCurrency:         doctor.FeeCurrency,
+		DocCreatedDate:         doctor.CreatedDate,
+		DocRecordStatus:        doctor.RecordStatus,
+	}
+
+	if doctor.ConsultationFeeCents != nil {
+		dbEntity.DocFeeCents = sql.NullInt64{Int64: int64(*doctor.ConsultationFeeCents), Valid: true}
+	}
+
+	if doctor.UpdatedDate != nil {
+		dbEntity.DocUpdatedDate = sql.NullTime{Time: *doctor.UpdatedDate, Valid: true}
+	}
+
+	return dbEntity
+}
+
+// ToDomainEntity converts a database DoctorDB entity to a domain Doctor entity
+func (m *DoctorMapper) ToDomainEntity(dbEntity *dbEntities.DoctorDB) *domainEntities.Doctor {
+	doctor := &domainEntities.Doctor{
+		ID:                  dbEntity.DocID,
+		UserID:              dbEntity.IdUser,
+		OrganizationID:      dbEntity.IdOrganization,
+		LicenseNumber:       dbEntity.DocLicenseNumber,
+		Bio:                 fromNullString(dbEntity.DocBio),
+		Languages:           dbEntity.DocLanguages,
+		ConsultationMinutes: dbEntity.DocConsultationMinutes,
+		BufferMinutes:       dbEntity.DocBufferMinutes,
+		FeeCurrency:         dbEntity.DocFeeCurrency,
+		Specialties:         make([]*domainEntities.Specialty, 0, len(dbEntity.Specialties)),
+		FirstName:           fromNullString(dbEntity.UprFirstName),
+		LastName:            fromNullString(dbEntity.UprLastName),
+		OrganizationName:    dbEntity.OrgName,
+		OrganizationSlug:    dbEntity.OrgSlug,
+		CreatedDate:         dbEntity.DocCreatedDate,
+		RecordStatus:        dbEntity.DocRecordStatus,
+	}
+
+	if doctor.Languages == nil {
+		doctor.Languages = []string{}
+	}
+
+	if dbEntity.DocFeeCents.Valid {
+		feeCents := int(dbEntity.DocFeeCents.Int64)
+		doctor.ConsultationFeeCents = &feeCents
+	}
+
+	for i := range dbEntity.Specialties {
+		doctor.Specialties = append(doctor.Specialties, m.specialtyMapper.ToDomainEntity(&dbEntity.Specialties[i]))
+	}
+
+	if dbEntity.DocUpdatedDate.Valid {
+		updatedDate := dbEntity.DocUpdatedDate.Time
+		doctor.UpdatedDate = &updatedDate
+	}
+
+	return doctor
+}
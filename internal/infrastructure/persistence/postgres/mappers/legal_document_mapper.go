@@ -0,0 +1,28 @@
+package mappers
+
+import (
+	domainEntities "citary-backend/internal/domain/entities"
+	dbEntities "citary-backend/internal/infrastructure/persistence/postgres/entities"
+)
+
+// LegalDocumentMapper handles conversion between domain and database entities
+type LegalDocumentMapper struct{}
+
+// NewLegalDocumentMapper creates a new LegalDocumentMapper instance
+func NewLegalDocumentMapper() *LegalDocumentMapper {
+	return &LegalDocumentMapper{}
+}
+
+// ToDomainEntity converts a database LegalDocumentDB entity to a domain LegalDocument entity
+func (m *LegalDocumentMapper) ToDomainEntity(dbEntity *dbEntities.LegalDocumentDB) *domainEntities.LegalDocument {
+	return &domainEntities.LegalDocument{
+		ID:           dbEntity.LedID,
+		Type:         dbEntity.LedType,
+		Version:      dbEntity.LedVersion,
+		Title:        dbEntity.LedTitle,
+		URL:          dbEntity.LedURL,
+		PublishedAt:  dbEntity.LedPublishedAt,
+		CreatedDate:  dbEntity.LedCreatedDate,
+		RecordStatus: dbEntity.LedRecordStatus,
+	}
+}
@@ -0,0 +1,54 @@
+package mappers
+
+import (
+	domainEntities "citary-backend/internal/domain/entities"
+	dbEntities "citary-backend/internal/infrastructure/persistence/postgres/entities"
+	"database/sql"
+)
+
+// LocationMapper handles conversion between domain and database entities
+type LocationMapper struct{}
+
+// NewLocationMapper creates a new LocationMapper instance
+func NewLocationMapper() *LocationMapper {
+	return &LocationMapper{}
+}
+
+// ToDBEntity converts a domain Location entity to a database LocationDB entity
+func (m *LocationMapper) ToDBEntity(location *domainEntities.Location) *dbEntities.LocationDB {
+	dbEntity := &dbEntities.LocationDB{
+		LocID:           location.ID,
+		IdOrganization:  location.OrganizationID,
+		LocName:         location.Name,
+		LocAddress:      toNullString(location.Address),
+		LocTimezone:     location.Timezone,
+		LocCreatedDate:  location.CreatedDate,
+		LocRecordStatus: location.RecordStatus,
+	}
+
+	if location.UpdatedDate != nil {
+		dbEntity.LocUpdatedDate = sql.NullTime{Time: *location.UpdatedDate, Valid: true}
+	}
+
+	return dbEntity
+}
+
+// ToDomainEntity converts a database LocationDB entity to a domain Location entity
+func (m *LocationMapper) ToDomainEntity(dbEntity *dbEntities.LocationDB) *domainEntities.Location {
+	location := &domainEntities.Location{
+		ID:             dbEntity.LocID,
+		OrganizationID: dbEntity.IdOrganization,
+		Name:           dbEntity.LocName,
+		Address:        fromNullString(dbEntity.LocAddress),
+		Timezone:       dbEntity.LocTimezone,
+		CreatedDate:    dbEntity.LocCreatedDate,
+		RecordStatus:   dbEntity.LocRecordStatus,
+	}
+
+	if dbEntity.LocUpdatedDate.Valid {
+		updatedDate := dbEntity.LocUpdatedDate.Time
+		location.UpdatedDate = &updatedDate
+	}
+
+	return location
+}
@@ -0,0 +1,40 @@
+package mappers
+
+import (
+	"database/sql"
+	"time"
+)
+
+// toNullString converts an optional domain string into a nullable column value
+func toNullString(value *string) sql.NullString {
+	if value == nil {
+		return sql.NullString{}
+	}
+	return sql.NullString{String: *value, Valid: true}
+}
+
+// fromNullString converts a nullable column value into an optional domain string
+func fromNullString(value sql.NullString) *string {
+	if !value.Valid {
+		return nil
+	}
+	s := value.String
+	return &s
+}
+
+// toNullTime converts an optional domain time into a nullable column value
+func toNullTime(value *time.Time) sql.NullTime {
+	if value == nil {
+		return sql.NullTime{}
+	}
+	return sql.NullTime{Time: *value, Valid: true}
+}
+
+// fromNullTime converts a nullable column value into an optional domain time
+func fromNullTime(value sql.NullTime) *time.Time {
+	if !value.Valid {
+		return nil
+	}
+	t := value.Time
+	return &t
+}
@@ -0,0 +1,64 @@
+package mappers
+
+import (
+	domainEntities "citary-backend/internal/domain/entities"
+	dbEntities "citary-backend/internal/infrastructure/persistence/postgres/entities"
+)
+
+// OrganizationInvitationMapper handles conversion between domain and database entities
+type OrganizationInvitationMapper struct{}
+
+// NewOrganizationInvitationMapper creates a new OrganizationInvitationMapper instance
+func NewOrganizationInvitationMapper() *OrganizationInvitationMapper {
+	return &OrganizationInvitationMapper{}
+}
+
+// ToDBEntity converts a domain OrganizationInvitation entity to a database OrganizationInvitationDB entity
+// Acceptance and revocation are only ever written by their dedicated conditional updates
+func (m *OrganizationInvitationMapper) ToDBEntity(invitation *domainEntities.OrganizationInvitation) *dbEntities.OrganizationInvitationDB {
+	return &dbEntities.OrganizationInvitationDB{
+		OinID:           invitation.ID,
+		IdOrganization:  invitation.OrganizationID,
+		IdRole:          invitation.RoleID,
+		RolCode:         invitation.RoleCode,
+		IdUserInvitedBy: invitation.InvitedByUserID,
+		OinEmail:        invitation.Email,
+		OinTokenHash:    invitation.TokenHash,
+		OinExpiresAt:    invitation.ExpiresAt,
+		OinCreatedDate:  invitation.CreatedDate,
+		OinRecordStatus: invitation.RecordStatus,
+	}
+}
+
+// ToDomainEntity converts a database OrganizationInvitationDB entity to a domain OrganizationInvitation entity
+func (m *OrganizationInvitationMapper) ToDomainEntity(dbEntity *dbEntities.OrganizationInvitationDB) *domainEntities.OrganizationInvitation {
+	invitation := &domainEntities.OrganizationInvitation{
+		ID:              dbEntity.OinID,
+		OrganizationID:  dbEntity.IdOrganization,
+		RoleID:          dbEntity.IdRole,
+		RoleCode:        dbEntity.RolCode,
+		InvitedByUserID: dbEntity.IdUserInvitedBy,
+		Email:           dbEntity.OinEmail,
+		TokenHash:       dbEntity.OinTokenHash,
+		ExpiresAt:       dbEntity.OinExpiresAt,
+		CreatedDate:     dbEntity.OinCreatedDate,
+		RecordStatus:    dbEntity.OinRecordStatus,
+	}
+
+	if dbEntity.IdUserAccepted.Valid {
+		acceptedBy := int(dbEntity.IdUserAccepted.Int64)
+		invitation.AcceptedByUserID = &acceptedBy
+	}
+
+	if dbEntity.OinAcceptedAt.Valid {
+		acceptedAt := dbEntity.OinAcceptedAt.Time
+		invitation.AcceptedAt = &acceptedAt
+	}
+
+	if dbEntity.OinRevokedAt.Valid {
+		revokedAt := dbEntity.OinRevokedAt.Time
+		invitation.RevokedAt = &revokedAt
+	}
+
+	return invitation
+}
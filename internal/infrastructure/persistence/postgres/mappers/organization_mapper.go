@@ -0,0 +1,60 @@
+package mappers
+
+import (
+	domainEntities "citary-backend/internal/domain/entities"
+	dbEntities "citary-backend/internal/infrastructure/persistence/postgres/entities"
+	"database/sql"
+)
+
+// OrganizationMapper handles conversion between domain and database entities
+type OrganizationMapper struct{}
+
+// NewOrganizationMapper creates a new OrganizationMapper instance
+func NewOrganizationMapper() *OrganizationMapper {
+	return &OrganizationMapper{}
+}
+
+// ToDBEntity converts a domain Organization entity to a database OrganizationDB entity
+func (m *OrganizationMapper) ToDBEntity(organization *domainEntities.Organization) *dbEntities.OrganizationDB {
+	dbEntity := &dbEntities.OrganizationDB{
+		OrgID:           organization.ID,
+		IdUserOwner:     organization.OwnerUserID,
+		OrgName:         organization.Name,
+		OrgSlug:         organization.Slug,
+		OrgEmail:        toNullString(organization.Email),
+		OrgPhone:        toNullString(organization.Phone),
+		OrgAddress:      toNullString(organization.Address),
+		OrgTimezone:     organization.Timezone,
+		OrgCreatedDate:  organization.CreatedDate,
+		OrgRecordStatus: organization.RecordStatus,
+	}
+
+	if organization.UpdatedDate != nil {
+		dbEntity.OrgUpdatedDate = sql.NullTime{Time: *organization.UpdatedDate, Valid: true}
+	}
+
+	return dbEntity
+}
+
+// ToDomainEntity converts a database OrganizationDB entity to a domain Organization entity
+func (m *OrganizationMapper) ToDomainEntity(dbEntity *dbEntities.OrganizationDB) *domainEntities.Organization {
+	organization := &domainEntities.Organization{
+		ID:           dbEntity.OrgID,
+		OwnerUserID:  dbEntity.IdUserOwner,
+		Name:         dbEntity.OrgName,
+		Slug:         dbEntity.OrgSlug,
+		Email:        fromNullString(dbEntity.OrgEmail),
+		Phone:        fromNullString(dbEntity.OrgPhone),
+		Address:      fromNullString(dbEntity.OrgAddress),
+		Timezone:     dbEntity.OrgTimezone,
+		CreatedDate:  dbEntity.OrgCreatedDate,
+		RecordStatus: dbEntity.OrgRecordStatus,
+	}
+
+	if dbEntity.OrgUpdatedDate.Valid {
+		updatedDate := dbEntity.OrgUpdatedDate.Time
+		organization.UpdatedDate = &updatedDate
+	}
+
+	return organization
+}
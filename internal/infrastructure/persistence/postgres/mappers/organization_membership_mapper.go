@@ -0,0 +1,35 @@
+package mappers
+
+import (
+	domainEntities "citary-backend/internal/domain/entities"
+	dbEntities "citary-backend/internal/infrastructure/persistence/postgres/entities"
+)
+
+// OrganizationMembershipMapper handles conversion between domain and database entities
+type OrganizationMembershipMapper struct{}
+
+// NewOrganizationMembershipMapper creates a new OrganizationMembershipMapper instance
+func NewOrganizationMembershipMapper() *OrganizationMembershipMapper {
+	return &OrganizationMembershipMapper{}
+}
+
+// ToDomainEntity converts a database OrganizationMembershipDB entity to a domain OrganizationMembership entity
+func (m *OrganizationMembershipMapper) ToDomainEntity(dbEntity *dbEntities.OrganizationMembershipDB) *domainEntities.OrganizationMembership {
+	membership := &domainEntities.OrganizationMembership{
+		ID:               dbEntity.OrmID,
+		OrganizationID:   dbEntity.IdOrganization,
+		OrganizationName: dbEntity.OrgName,
+		UserID:           dbEntity.IdUser,
+		RoleID:           dbEntity.IdRole,
+		RoleCode:         dbEntity.RolCode,
+		CreatedDate:      dbEntity.OrmCreatedDate,
+		RecordStatus:     dbEntity.OrmRecordStatus,
+	}
+
+	if dbEntity.OrmUpdatedDate.Valid {
+		updatedDate := dbEntity.OrmUpdatedDate.Time
+		membership.UpdatedDate = &updatedDate
+	}
+
+	return membership
+}
@@ -0,0 +1,101 @@
+package mappers
+
+import (
+	domainEntities "citary-backend/internal/domain/entities"
+	dbEntities "citary-backend/internal/infrastructure/persistence/postgres/entities"
+	"database/sql"
+)
+
+// RefreshTokenMapper handles conversion between domain and database entities
+type RefreshTokenMapper struct{}
+
+// NewRefreshTokenMapper creates a new RefreshTokenMapper instance
+func NewRefreshTokenMapper() *RefreshTokenMapper {
+	return &RefreshTokenMapper{}
+}
+
+// ToDBEntity converts a domain RefreshToken entity to a database RefreshTokenDB entity
+func (m *RefreshTokenMapper) ToDBEntity(token *domainEntities.RefreshToken) *dbEntities.RefreshTokenDB {
+	dbEntity := &dbEntities.RefreshTokenDB{
+		RefID:           token.ID,
+		IdUser:          token.UserID,
+		RefFamilyID:     token.FamilyID,
+		RefTokenHash:    token.TokenHash,
+		RefExpiresAt:    token.ExpiresAt,
+		RefCreatedDate:  token.CreatedDate,
+		RefRecordStatus: token.RecordStatus,
+	}
+
+	// Handle optional fields
+	if token.OrganizationID != nil {
+		dbEntity.IdOrganization = sql.NullInt64{Int64: int64(*token.OrganizationID), Valid: true}
+	}
+
+	if token.DeviceName != nil {
+		dbEntity.RefDeviceName = sql.NullString{String: *token.DeviceName, Valid: true}
+	}
+
+	if token.UserAgent != nil {
+		dbEntity.RefUserAgent = sql.NullString{String: *token.UserAgent, Valid: true}
+	}
+
+	if token.IPAddress != nil {
+		dbEntity.RefIPAddress = sql.NullString{String: *token.IPAddress, Valid: true}
+	}
+
+	if token.RevokedAt != nil {
+		dbEntity.RefRevokedAt = sql.NullTime{Time: *token.RevokedAt, Valid: true}
+	}
+
+	if token.ReplacedByID != nil {
+		dbEntity.RefReplacedBy = sql.NullInt64{Int64: int64(*token.ReplacedByID), Valid: true}
+	}
+
+	return dbEntity
+}
+
+// ToDomainEntity converts a database RefreshTokenDB entity to a domain RefreshToken entity
+func (m *RefreshTokenMapper) ToDomainEntity(dbEntity *dbEntities.RefreshTokenDB) *domainEntities.RefreshToken {
+	token := &domainEntities.RefreshToken{
+		ID:           dbEntity.RefID,
+		UserID:       dbEntity.IdUser,
+		FamilyID:     dbEntity.RefFamilyID,
+		TokenHash:    dbEntity.RefTokenHash,
+		ExpiresAt:    dbEntity.RefExpiresAt,
+		CreatedDate:  dbEntity.RefCreatedDate,
+		RecordStatus: dbEntity.RefRecordStatus,
+	}
+
+	// Handle optional fields
+	if dbEntity.IdOrganization.Valid {
+		organizationID := int(dbEntity.IdOrganization.Int64)
+		token.OrganizationID = &organizationID
+	}
+
+	if dbEntity.RefDeviceName.Valid {
+		deviceName := dbEntity.RefDeviceName.String
+		token.DeviceName = &deviceName
+	}
+
+	if dbEntity.RefUserAgent.Valid {
+		userAgent := dbEntity.RefUserAgent.String
+		token.UserAgent = &userAgent
+	}
+
+	if dbEntity.RefIPAddress.Valid {
+		ipAddress := dbEntity.RefIPAddress.String
+		token.IPAddress = &ipAddress
+	}
+
+	if dbEntity.RefRevokedAt.Valid {
+		revokedAt := dbEntity.RefRevokedAt.Time
+		token.RevokedAt = &revokedAt
+	}
+
+	if dbEntity.RefReplacedBy.Valid {
+		replacedBy := int(dbEntity.RefReplacedBy.Int64)
+		token.ReplacedByID = &replacedBy
+	}
+
+	return token
+}
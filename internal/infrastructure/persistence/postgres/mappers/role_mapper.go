@@ -3,6 +3,7 @@ package mappers
 import (
 	"citary-backend/internal/domain/entities"
 	dbEntities "citary-backend/internal/infrastructure/persistence/postgres/entities"
+	"database/sql"
 	"encoding/json"
 )
 
@@ -38,3 +39,26 @@ func (m *RoleMapper) ToDomainEntity(dbEntity *dbEntities.RoleDB) *entities.Role
 
 	return role
 }
+
+// ToDBEntity converts a domain Role entity to a database RoleDB entity
+func (m *RoleMapper) ToDBEntity(role *entities.Role) *dbEntities.RoleDB {
+	dbEntity := &dbEntities.RoleDB{
+		RolID:           role.ID,
+		RolName:         role.Name,
+		RolCode:         role.Code,
+		RolCreatedDate:  role.CreatedDate,
+		RolRecordStatus: role.RecordStatus,
+	}
+
+	// Handle optional description
+	if role.Description != nil {
+		dbEntity.RolDescription = sql.NullString{String: *role.Description, Valid: true}
+	}
+
+	// Handle optional permissions (JSONB)
+	if role.Permissions != nil {
+		dbEntity.RolPermissions = []byte(*role.Permissions)
+	}
+
+	return dbEntity
+}
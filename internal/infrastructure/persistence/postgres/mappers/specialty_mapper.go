@@ -0,0 +1,25 @@
+package mappers
+
+import (
+	domainEntities "citary-backend/internal/domain/entities"
+	dbEntities "citary-backend/internal/infrastructure/persistence/postgres/entities"
+)
+
+// SpecialtyMapper handles conversion between domain and database entities
+type SpecialtyMapper struct{}
+
+// NewSpecialtyMapper creates a new SpecialtyMapper instance
+func NewSpecialtyMapper() *SpecialtyMapper {
+	return &SpecialtyMapper{}
+}
+
+// ToDomainEntity converts a database SpecialtyDB entity to a domain Specialty entity
+func (m *SpecialtyMapper) ToDomainEntity(dbEntity *dbEntities.SpecialtyDB) *domainEntities.Specialty {
+	return &domainEntities.Specialty{
+		ID:           dbEntity.SpeID,
+		Code:         dbEntity.SpeCode,
+		Name:         dbEntity.SpeName,
+		CreatedDate:  dbEntity.SpeCreatedDate,
+		RecordStatus: dbEntity.SpeRecordStatus,
+	}
+}
@@ -0,0 +1,35 @@
+package mappers
+
+import (
+	"database/sql"
+	"fmt"
+)
+
+// toTimeOfDay formats minutes after midnight as a PostgreSQL TIME literal ("HH:MM:00"); 1440 becomes "24:00:00"
+func toTimeOfDay(minutes int) string {
+	return fmt.Sprintf("%02d:%02d:00", minutes/60, minutes%60)
+}
+
+// fromTimeOfDay parses a PostgreSQL TIME value ("HH:MM:SS") into minutes after midnight; seconds are dropped
+func fromTimeOfDay(value string) int {
+	var hours, minutes int
+	fmt.Sscanf(value, "%d:%d", &hours, &minutes)
+	return hours*60 + minutes
+}
+
+// toNullTimeOfDay converts optional minutes after midnight into a nullable TIME column value
+func toNullTimeOfDay(minutes *int) sql.NullString {
+	if minutes == nil {
+		return sql.NullString{}
+	}
+	return sql.NullString{String: toTimeOfDay(*minutes), Valid: true}
+}
+
+// fromNullTimeOfDay converts a nullable TIME column value into optional minutes after midnight
+func fromNullTimeOfDay(value sql.NullString) *int {
+	if !value.Valid {
+		return nil
+	}
+	minutes := fromTimeOfDay(value.String)
+	return &minutes
+}
@@ -0,0 +1,46 @@
+package mappers
+
+import (
+	domainEntities "citary-backend/internal/domain/entities"
+	dbEntities "citary-backend/internal/infrastructure/persistence/postgres/entities"
+)
+
+// UserConsentMapper handles conversion between domain and database entities
+type UserConsentMapper struct{}
+
+// NewUserConsentMapper creates a new UserConsentMapper instance
+func NewUserConsentMapper() *UserConsentMapper {
+	return &UserConsentMapper{}
+}
+
+// ToDBEntity converts a domain UserConsent entity to a database UserConsentDB entity
+func (m *UserConsentMapper) ToDBEntity(consent *domainEntities.UserConsent) *dbEntities.UserConsentDB {
+	return &dbEntities.UserConsentDB{
+		UcoID:           consent.ID,
+		IdUser:          consent.UserID,
+		IdLegalDocument: consent.LegalDocumentID,
+		LedType:         consent.DocumentType,
+		LedVersion:      consent.DocumentVersion,
+		UcoAcceptedAt:   consent.AcceptedAt,
+		UcoIPAddress:    toNullString(consent.IPAddress),
+		UcoUserAgent:    toNullString(consent.UserAgent),
+		UcoCreatedDate:  consent.CreatedDate,
+		UcoRecordStatus: consent.RecordStatus,
+	}
+}
+
+// ToDomainEntity converts a database UserConsentDB entity to a domain UserConsent entity
+func (m *UserConsentMapper) ToDomainEntity(dbEntity *dbEntities.UserConsentDB) *domainEntities.UserConsent {
+	return &domainEntities.UserConsent{
+		ID:              dbEntity.UcoID,
+		UserID:          dbEntity.IdUser,
+		LegalDocumentID: dbEntity.IdLegalDocument,
+		DocumentType:    dbEntity.LedType,
+		DocumentVersion: dbEntity.LedVersion,
+		AcceptedAt:      dbEntity.UcoAcceptedAt,
+		IPAddress:       fromNullString(dbEntity.UcoIPAddress),
+		UserAgent:       fromNullString(dbEntity.UcoUserAgent),
+		CreatedDate:     dbEntity.UcoCreatedDate,
+		RecordStatus:    dbEntity.UcoRecordStatus,
+	}
+}
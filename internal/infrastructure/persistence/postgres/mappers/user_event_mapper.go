@@ -0,0 +1,40 @@
+package mappers
+
+import (
+	domainEntities "citary-backend/internal/domain/entities"
+	dbEntities "citary-backend/internal/infrastructure/persistence/postgres/entities"
+)
+
+// UserEventMapper handles conversion between domain and database entities
+type UserEventMapper struct{}
+
+// NewUserEventMapper creates a new UserEventMapper instance
+func NewUserEventMapper() *UserEventMapper {
+	return &UserEventMapper{}
+}
+
+// ToDBEntity converts a domain UserEvent entity to a database UserEventDB entity
+func (m *UserEventMapper) ToDBEntity(event *domainEntities.UserEvent) *dbEntities.UserEventDB {
+	return &dbEntities.UserEventDB{
+		UevID:           event.ID,
+		IdUser:          event.UserID,
+		UevType:         event.Type,
+		UevDetails:      toNullString(event.Details),
+		UevIPAddress:    toNullString(event.IPAddress),
+		UevCreatedDate:  event.CreatedDate,
+		UevRecordStatus: event.RecordStatus,
+	}
+}
+
+// ToDomainEntity converts a database UserEventDB entity to a domain UserEvent entity
+func (m *UserEventMapper) ToDomainEntity(dbEntity *dbEntities.UserEventDB) *domainEntities.UserEvent {
+	return &domainEntities.UserEvent{
+		ID:           dbEntity.UevID,
+		UserID:       dbEntity.IdUser,
+		Type:         dbEntity.UevType,
+		Details:      fromNullString(dbEntity.UevDetails),
+		IPAddress:    fromNullString(dbEntity.UevIPAddress),
+		CreatedDate:  dbEntity.UevCreatedDate,
+		RecordStatus: dbEntity.UevRecordStatus,
+	}
+}
@@ -17,17 +17,23 @@ func NewUserMapper() *UserMapper {
 // ToDBEntity converts a domain User entity to a database UserDB entity
 func (m *UserMapper) ToDBEntity(user *domainEntities.User) *dbEntities.UserDB {
 	dbEntity := &dbEntities.UserDB{
-		UseID:            user.ID,
-		IdRole:           user.RoleID,
-		UseEmail:         user.Email,
-		UsePasswordHash:  user.PasswordHash,
-		UseEmailVerified: user.EmailVerified,
-		UseLoginAttempts: user.LoginAttempts,
-		UseCreatedDate:   user.CreatedDate,
-		UseRecordStatus:  user.RecordStatus,
+		UseID:                    user.ID,
+		IdRole:                   user.RoleID,
+		UseEmail:                 user.Email,
+		UsePasswordHash:          user.PasswordHash,
+		UseEmailVerified:         user.EmailVerified,
+		UseVerificationSendCount: user.VerificationSendCount,
+		UseTwoFactorEnabled:      user.TwoFactorEnabled,
+		UseLoginAttempts:         user.LoginAttempts,
+		UseCreatedDate:           user.CreatedDate,
+		UseRecordStatus:          user.RecordStatus,
 	}
 
 	// Handle optional fields
+	if user.OrganizationID != nil {
+		dbEntity.IdOrganization = sql.NullInt64{Int64: int64(*user.OrganizationID), Valid: true}
+	}
+
 	if user.VerificationToken != nil {
 		dbEntity.UseVerificationToken = sql.NullString{String: *user.VerificationToken, Valid: true}
 	}
@@ -36,6 +42,14 @@ func (m *UserMapper) ToDBEntity(user *domainEntities.User) *dbEntities.UserDB {
 		dbEntity.UseVerificationTokenExpiresAt = sql.NullTime{Time: *user.VerificationTokenExpiresAt, Valid: true}
 	}
 
+	if user.VerificationSentAt != nil {
+		dbEntity.UseVerificationSentAt = sql.NullTime{Time: *user.VerificationSentAt, Valid: true}
+	}
+
+	if user.TwoFactorSecret != nil {
+		dbEntity.UseTwoFactorSecret = sql.NullString{String: *user.TwoFactorSecret, Valid: true}
+	}
+
 	if user.LastLogin != nil {
 		dbEntity.UseLastLogin = sql.NullTime{Time: *user.LastLogin, Valid: true}
 	}
@@ -52,23 +66,38 @@ func (m *UserMapper) ToDBEntity(user *domainEntities.User) *dbEntities.UserDB {
 		dbEntity.UsePrivacyAcceptedAt = sql.NullTime{Time: *user.PrivacyAcceptedAt, Valid: true}
 	}
 
+	if user.DeletedAt != nil {
+		dbEntity.UseDeletedAt = sql.NullTime{Time: *user.DeletedAt, Valid: true}
+	}
+
+	if user.AnonymizeAfter != nil {
+		dbEntity.UseAnonymizeAfter = sql.NullTime{Time: *user.AnonymizeAfter, Valid: true}
+	}
+
 	return dbEntity
 }
 
 // ToDomainEntity converts a database UserDB entity to a domain User entity
 func (m *UserMapper) ToDomainEntity(dbEntity *dbEntities.UserDB) *domainEntities.User {
 	user := &domainEntities.User{
-		ID:            dbEntity.UseID,
-		RoleID:        dbEntity.IdRole,
-		Email:         dbEntity.UseEmail,
-		PasswordHash:  dbEntity.UsePasswordHash,
-		EmailVerified: dbEntity.UseEmailVerified,
-		LoginAttempts: dbEntity.UseLoginAttempts,
-		CreatedDate:   dbEntity.UseCreatedDate,
-		RecordStatus:  dbEntity.UseRecordStatus,
+		ID:                    dbEntity.UseID,
+		RoleID:                dbEntity.IdRole,
+		Email:                 dbEntity.UseEmail,
+		PasswordHash:          dbEntity.UsePasswordHash,
+		EmailVerified:         dbEntity.UseEmailVerified,
+		VerificationSendCount: dbEntity.UseVerificationSendCount,
+		TwoFactorEnabled:      dbEntity.UseTwoFactorEnabled,
+		LoginAttempts:         dbEntity.UseLoginAttempts,
+		CreatedDate:           dbEntity.UseCreatedDate,
+		RecordStatus:          dbEntity.UseRecordStatus,
 	}
 
 	// Handle optional fields
+	if dbEntity.IdOrganization.Valid {
+		organizationID := int(dbEntity.IdOrganization.Int64)
+		user.OrganizationID = &organizationID
+	}
+
 	if dbEntity.UseVerificationToken.Valid {
 		token := dbEntity.UseVerificationToken.String
 		user.VerificationToken = &token
@@ -79,6 +108,16 @@ func (m *UserMapper) ToDomainEntity(dbEntity *dbEntities.UserDB) *domainEntities
 		user.VerificationTokenExpiresAt = &expiresAt
 	}
 
+	if dbEntity.UseVerificationSentAt.Valid {
+		sentAt := dbEntity.UseVerificationSentAt.Time
+		user.VerificationSentAt = &sentAt
+	}
+
+	if dbEntity.UseTwoFactorSecret.Valid {
+		secret := dbEntity.UseTwoFactorSecret.String
+		user.TwoFactorSecret = &secret
+	}
+
 	if dbEntity.UseLastLogin.Valid {
 		lastLogin := dbEntity.UseLastLogin.Time
 		user.LastLogin = &lastLogin
@@ -99,5 +138,15 @@ func (m *UserMapper) ToDomainEntity(dbEntity *dbEntities.UserDB) *domainEntities
 		user.PrivacyAcceptedAt = &privacyAccepted
 	}
 
+	if dbEntity.UseDeletedAt.Valid {
+		deletedAt := dbEntity.UseDeletedAt.Time
+		user.DeletedAt = &deletedAt
+	}
+
+	if dbEntity.UseAnonymizeAfter.Valid {
+		anonymizeAfter := dbEntity.UseAnonymizeAfter.Time
+		user.AnonymizeAfter = &anonymizeAfter
+	}
+
 	return user
 }
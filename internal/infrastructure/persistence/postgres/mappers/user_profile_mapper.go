@@ -0,0 +1,69 @@
+package mappers
+
+import (
+	domainEntities "citary-backend/internal/domain/entities"
+	dbEntities "citary-backend/internal/infrastructure/persistence/postgres/entities"
+	"database/sql"
+)
+
+// UserProfileMapper handles conversion between domain and database entities
+type UserProfileMapper struct{}
+
+// NewUserProfileMapper creates a new UserProfileMapper instance
+func NewUserProfileMapper() *UserProfileMapper {
+	return &UserProfileMapper{}
+}
+
+// ToDBEntity converts a domain UserProfile entity to a database UserProfileDB entity
+func (m *UserProfileMapper) ToDBEntity(profile *domainEntities.UserProfile) *dbEntities.UserProfileDB {
+	dbEntity := &dbEntities.UserProfileDB{
+		UprID:           profile.ID,
+		IdUser:          profile.UserID,
+		UprFirstName:    toNullString(profile.FirstName),
+		UprLastName:     toNullString(profile.LastName),
+		UprPhone:        toNullString(profile.Phone),
+		UprLocale:       toNullString(profile.Locale),
+		UprAvatarURL:    toNullString(profile.AvatarURL),
+		UprCreatedDate:  profile.CreatedDate,
+		UprRecordStatus: profile.RecordStatus,
+	}
+
+	// Handle optional dates
+	if profile.BirthDate != nil {
+		dbEntity.UprBirthDate = sql.NullTime{Time: *profile.BirthDate, Valid: true}
+	}
+
+	if profile.UpdatedDate != nil {
+		dbEntity.UprUpdatedDate = sql.NullTime{Time: *profile.UpdatedDate, Valid: true}
+	}
+
+	return dbEntity
+}
+
+// ToDomainEntity converts a database UserProfileDB entity to a domain UserProfile entity
+func (m *UserProfileMapper) ToDomainEntity(dbEntity *dbEntities.UserProfileDB) *domainEntities.UserProfile {
+	profile := &domainEntities.UserProfile{
+		ID:           dbEntity.UprID,
+		UserID:       dbEntity.IdUser,
+		FirstName:    fromNullString(dbEntity.UprFirstName),
+		LastName:     fromNullString(dbEntity.UprLastName),
+		Phone:        fromNullString(dbEntity.UprPhone),
+		Locale:       fromNullString(dbEntity.UprLocale),
+		AvatarURL:    fromNullString(dbEntity.UprAvatarURL),
+		CreatedDate:  dbEntity.UprCreatedDate,
+		RecordStatus: dbEntity.UprRecordStatus,
+	}
+
+	// Handle optional dates
+	if dbEntity.UprBirthDate.Valid {
+		birthDate := dbEntity.UprBirthDate.Time
+		profile.BirthDate = &birthDate
+	}
+
+	if dbEntity.UprUpdatedDate.Valid {
+		updatedDate := dbEntity.UprUpdatedDate.Time
+		profile.UpdatedDate = &updatedDate
+	}
+
+	return profile
+}
@@ -0,0 +1,65 @@
+package mappers
+
+import (
+	domainEntities "citary-backend/internal/domain/entities"
+	dbEntities "citary-backend/internal/infrastructure/persistence/postgres/entities"
+	"database/sql"
+)
+
+// UserTokenMapper handles conversion between domain and database entities
+type UserTokenMapper struct{}
+
+// NewUserTokenMapper creates a new UserTokenMapper instance
+func NewUserTokenMapper() *UserTokenMapper {
+	return &UserTokenMapper{}
+}
+
+// ToDBEntity converts a domain UserToken entity to a database UserTokenDB entity
+func (m *UserTokenMapper) ToDBEntity(token *domainEntities.UserToken) *dbEntities.UserTokenDB {
+	dbEntity := &dbEntities.UserTokenDB{
+		UtoID:           token.ID,
+		IdUser:          token.UserID,
+		UtoPurpose:      token.Purpose,
+		UtoTokenHash:    token.TokenHash,
+		UtoExpiresAt:    token.ExpiresAt,
+		UtoCreatedDate:  token.CreatedDate,
+		UtoRecordStatus: token.RecordStatus,
+	}
+
+	// Handle optional fields
+	if token.Payload != nil {
+		dbEntity.UtoPayload = sql.NullString{String: *token.Payload, Valid: true}
+	}
+
+	if token.UsedAt != nil {
+		dbEntity.UtoUsedAt = sql.NullTime{Time: *token.UsedAt, Valid: true}
+	}
+
+	return dbEntity
+}
+
+// ToDomainEntity converts a database UserTokenDB entity to a domain UserToken entity
+func (m *UserTokenMapper) ToDomainEntity(dbEntity *dbEntities.UserTokenDB) *domainEntities.UserToken {
+	token := &domainEntities.UserToken{
+		ID:           dbEntity.UtoID,
+		UserID:       dbEntity.IdUser,
+		Purpose:      dbEntity.UtoPurpose,
+		TokenHash:    dbEntity.UtoTokenHash,
+		ExpiresAt:    dbEntity.UtoExpiresAt,
+		CreatedDate:  dbEntity.UtoCreatedDate,
+		RecordStatus: dbEntity.UtoRecordStatus,
+	}
+
+	// Handle optional fields
+	if dbEntity.UtoPayload.Valid {
+		payload := dbEntity.UtoPayload.String
+		token.Payload = &payload
+	}
+
+	if dbEntity.UtoUsedAt.Valid {
+		usedAt := dbEntity.UtoUsedAt.Time
+		token.UsedAt = &usedAt
+	}
+
+	return token
+}
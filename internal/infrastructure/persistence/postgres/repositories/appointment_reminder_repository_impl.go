@@ -0,0 +1,70 @@
+package repositories
+
+import (
+	"citary-backend/internal/domain/entities"
+	"citary-backend/internal/domain/errors"
+	"context"
+	"database/sql"
+	"log"
+	"time"
+)
+
+// AppointmentReminderRepositoryImpl implements the AppointmentReminderRepository interface using PostgreSQL
+type AppointmentReminderRepositoryImpl struct {
+	db *sql.DB
+}
+
+// NewAppointmentReminderRepositoryImpl creates a new instance of AppointmentReminderRepositoryImpl
+func NewAppointmentReminderRepositoryImpl(db *sql.DB) *AppointmentReminderRepositoryImpl {
+	return &AppointmentReminderRepositoryImpl{db: db}
+}
+
+// Claim records a reminder before it is sent and sets its ID
+// The uq_appointment_reminder constraint decides between concurrent claims of the same reminder
+func (r *AppointmentReminderRepositoryImpl) Claim(ctx context.Context, reminder *entities.AppointmentReminder) (bool, error) {
+	start := time.Now()
+	log.Printf("[AppointmentReminderRepository] Claim: appointmentID=%d, offset=%d", reminder.AppointmentID, reminder.OffsetMinutes)
+
+	query := `
+		INSERT INTO data.data_appointment_reminder (id_appointment, apr_offset_minutes, apr_start_time, apr_sent_date)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT ON CONSTRAINT uq_appointment_reminder DO NOTHING
+		RETURNING apr_id
+	`
+
+	err := r.db.QueryRowContext(ctx, query,
+		reminder.AppointmentID,
+		reminder.OffsetMinutes,
+		reminder.StartTime,
+		reminder.SentDate,
+	).Scan(&reminder.ID)
+
+	duration := time.Since(start)
+
+	if err == sql.ErrNoRows {
+		log.Printf("[AppointmentReminderRepository] Claim: already claimed, appointmentID=%d, offset=%d, duration=%v", reminder.AppointmentID, reminder.OffsetMinutes, duration)
+		return false, nil
+	}
+
+	if err != nil {
+		log.Printf("[AppointmentReminderRepository] Claim ERROR: appointmentID=%d, error=%v, duration=%v", reminder.AppointmentID, err, duration)
+		return false, errors.ErrInternal(err)
+	}
+
+	log.Printf("[AppointmentReminderRepository] Claim: success, reminderID=%d, appointmentID=%d, duration=%v", reminder.ID, reminder.AppointmentID, duration)
+	return true, nil
+}
+
+// Release deletes a claimed reminder whose email could not be sent
+func (r *AppointmentReminderRepositoryImpl) Release(ctx context.Context, id int) error {
+	start := time.Now()
+	log.Printf("[AppointmentReminderRepository] Release: reminderID=%d", id)
+
+	if _, err := r.db.ExecContext(ctx, `DELETE FROM data.data_appointment_reminder WHERE apr_id = $1`, id); err != nil {
+		log.Printf("[AppointmentReminderRepository] Release ERROR: reminderID=%d, error=%v, duration=%v", id, err, time.Since(start))
+		return errors.ErrInternal(err)
+	}
+
+	log.Printf("[AppointmentReminderRepository] Release: success, reminderID=%d, duration=%v", id, time.Since(start))
+	return nil
+}
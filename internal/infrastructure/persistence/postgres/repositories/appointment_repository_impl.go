@@ -0,0 +1,584 @@
+package repositories
+
+import (
+	"citary-backend/internal/domain/entities"
+	"citary-backend/internal/domain/errors"
+	dbEntities "citary-backend/internal/infrastructure/persistence/postgres/entities"
+	"citary-backend/internal/infrastructure/persistence/postgres/mappers"
+	"citary-backend/pkg/constants"
+	"context"
+	"database/sql"
+	"log"
+	"time"
+)
+
+// appointmentSelectColumns lists the appointment columns read by every appointment query, in scan order
+// The doctor's user and name, the organization name and the location are joined for display
+const appointmentSelectColumns = `
+		SELECT a.apt_id, a.id_organization, a.id_doctor, a.id_location, a.id_patient,
+		       a.apt_start_time, a.apt_end_time, a.apt_status, a.apt_reason,
+		       a.apt_created_date, a.apt_updated_date, a.apt_record_status,
+		       d.id_user, p.upr_first_name, p.upr_last_name, o.org_name, l.loc_name, l.loc_address, l.loc_timezone
+		FROM data.data_appointment a
+		JOIN data.data_doctor d ON d.doc_id = a.id_doctor
+		JOIN data.data_organization o ON o.org_id = a.id_organization
+		JOIN data.data_location l ON l.loc_id = a.id_location
+		LEFT JOIN data.data_user_profile p ON p.id_user = d.id_user`
+
+// AppointmentRepositoryImpl implements the AppointmentRepository interface using PostgreSQL
+type AppointmentRepositoryImpl struct {
+	db     *sql.DB
+	mapper *mappers.AppointmentMapper
+}
+
+// NewAppointmentRepositoryImpl creates a new instance of AppointmentRepositoryImpl
+func NewAppointmentRepositoryImpl(db *sql.DB) *AppointmentRepositoryImpl {
+	return &AppointmentRepositoryImpl{
+		db:     db,
+		mapper: mappers.NewAppointmentMapper(),
+	}
+}
+
+// FindByID retrieves an appointment by its ID
+// Returns (nil, nil) if not found - business layer decides if that's an error
+func (r *AppointmentRepositoryImpl) FindByID(ctx context.Context, id int) (*entities.Appointment, error) {
+	start := time.Now()
+	log.Printf("[AppointmentRepository] FindByID: id=%d", id)
+
+	query := appointmentSelectColumns + `
+		WHERE a.apt_id = $1`
+
+	dbEntity, err := r.scanAppointment(r.db.QueryRowContext(ctx, query, id))
+
+	duration := time.Since(start)
+
+	if err == sql.ErrNoRows {
+		log.Printf("[AppointmentRepository] FindByID: appointment not found, id=%d, duration=%v", id, duration)
+		return nil, nil
+	}
+
+	if err != nil {
+		log.Printf("[AppointmentRepository] FindByID ERROR: id=%d, error=%v, duration=%v", id, err, duration)
+		return nil, errors.ErrInternal(err)
+	}
+
+	log.Printf("[AppointmentRepository] FindByID: success, id=%d, status=%s, duration=%v", id, dbEntity.AptStatus, duration)
+	return r.mapper.ToDomainEntity(dbEntity), nil
+}
+
+// FindByPatient retrieves the active appointments of a patient that end after the given time, soonest first
+func (r *AppointmentRepositoryImpl) FindByPatient(ctx context.Context, patientID int, endingAfter time.Time) ([]*entities.Appointment, error) {
+	start := time.Now()
+	log.Printf("[AppointmentRepository] FindByPatient: patientID=%d", patientID)
+
+	query := appointmentSelectColumns + `
+		WHERE a.id_patient = $1 AND a.apt_record_status = $2 AND a.apt_end_time > $3
+		ORDER BY a.apt_start_time, a.apt_id`
+
+	rows, err := r.db.QueryContext(ctx, query, patientID, constants.RecordStatus.Active, endingAfter)
+	if err != nil {
+		log.Printf("[AppointmentRepository] FindByPatient ERROR: patientID=%d, error=%v, duration=%v", patientID, err, time.Since(start))
+		return nil, errors.ErrInternal(err)
+	}
+	defer rows.Close()
+
+	appointments := []*entities.Appointment{}
+	for rows.Next() {
+		dbEntity, err := r.scanAppointment(rows)
+		if err != nil {
+			log.Printf("[AppointmentRepository] FindByPatient ERROR: scan failed, patientID=%d, error=%v", patientID, err)
+			return nil, errors.ErrInternal(err)
+		}
+		appointments = append(appointments, r.mapper.ToDomainEntity(dbEntity))
+	}
+
+	if err := rows.Err(); err != nil {
+		log.Printf("[AppointmentRepository] FindByPatient ERROR: patientID=%d, error=%v", patientID, err)
+		return nil, errors.ErrInternal(err)
+	}
+
+	log.Printf("[AppointmentRepository] FindByPatient: success, patientID=%d, count=%d, duration=%v", patientID, len(appointments), time.Since(start))
+	return appointments, nil
+}
+
+// FindAllByPatient retrieves every appointment of a patient, whatever its status or time, oldest first
+func (r *AppointmentRepositoryImpl) FindAllByPatient(ctx context.Context, patientID int) ([]*entities.Appointment, error) {
+	start := time.Now()
+	log.Printf("[AppointmentRepository] FindAllByPatient: patientID=%d", patientID)
+
+	query := appointmentSelectColumns + `
+		WHERE a.id_patient = $1
+		ORDER BY a.apt_start_time, a.apt_id`
+
+	rows, err := r.db.QueryContext(ctx, query, patientID)
+	if err != nil {
+		log.Printf("[AppointmentRepository] FindAllByPatient ERROR: patientID=%d, error=%v, duration=%v", patientID, err, time.Since(start))
+		return nil, errors.ErrInternal(err)
+	}
+	defer rows.Close()
+
+	appointments := []*entities.Appointment{}
+	for rows.Next() {
+		dbEntity, err := r.scanAppointment(rows)
+		if err != nil {
+			log.Printf("[AppointmentRepository] FindAllByPatient ERROR: scan failed, patientID=%d, error=%v", patientID, err)
+			return nil, errors.ErrInternal(err)
+		}
+		appointments = append(appointments, r.mapper.ToDomainEntity(dbEntity))
+	}
+
+	if err := rows.Err(); err != nil {
+		log.Printf("[AppointmentRepository] FindAllByPatient ERROR: patientID=%d, error=%v", patientID, err)
+		return nil, errors.ErrInternal(err)
+	}
+
+	log.Printf("[AppointmentRepository] FindAllByPatient: success, patientID=%d, count=%d, duration=%v", patientID, len(appointments), time.Since(start))
+	return appointments, nil
+}
+
+// FindByOrganization retrieves the active appointments of an organization starting within [from, to), soonest first
+func (r *AppointmentRepositoryImpl) FindByOrganization(ctx context.Context, organizationID int, from, to time.Time) ([]*entities.Appointment, error) {
+	start := time.Now()
+	log.Printf("[AppointmentRepository] FindByOrganization: organizationID=%d, from=%s, to=%s", organizationID, from.Format(time.RFC3339), to.Format(time.RFC3339))
+
+	query := appointmentSelectColumns + `
+		WHERE a.id_organization = $1 AND a.apt_record_status = $2
+		  AND a.apt_start_time >= $3 AND a.apt_start_time < $4
+		ORDER BY a.apt_start_time, a.apt_id`
+
+	rows, err := r.db.QueryContext(ctx, query, organizationID, constants.RecordStatus.Active, from, to)
+	if err != nil {
+		log.Printf("[AppointmentRepository] FindByOrganization ERROR: organizationID=%d, error=%v, duration=%v", organizationID, err, time.Since(start))
+		return nil, errors.ErrInternal(err)
+	}
+	defer rows.Close()
+
+	appointments := []*entities.Appointment{}
+	for rows.Next() {
+		dbEntity, err := r.scanAppointment(rows)
+		if err != nil {
+			log.Printf("[AppointmentRepository] FindByOrganization ERROR: scan failed, organizationID=%d, error=%v", organizationID, err)
+			return nil, errors.ErrInternal(err)
+		}
+		appointments = append(appointments, r.mapper.ToDomainEntity(dbEntity))
+	}
+
+	if err := rows.Err(); err != nil {
+		log.Printf("[AppointmentRepository] FindByOrganization ERROR: organizationID=%d, error=%v", organizationID, err)
+		return nil, errors.ErrInternal(err)
+	}
+
+	log.Printf("[AppointmentRepository] FindByOrganization: success, organizationID=%d, count=%d, duration=%v", organizationID, len(appointments), time.Since(start))
+	return appointments, nil
+}
+
+// FindForCalendar retrieves the active appointments a user attends as patient or as doctor that end after the given time
+func (r *AppointmentRepositoryImpl) FindForCalendar(ctx context.Context, userID int, endingAfter time.Time) ([]*entities.Appointment, error) {
+	start := time.Now()
+	log.Printf("[AppointmentRepository] FindForCalendar: userID=%d", userID)
+
+	query := appointmentSelectColumns + `
+		WHERE (a.id_patient = $1 OR d.id_user = $1)
+		  AND a.apt_record_status = $2 AND a.apt_end_time > $3
+		ORDER BY a.apt_start_time, a.apt_id`
+
+	rows, err := r.db.QueryContext(ctx, query, userID, constants.RecordStatus.Active, endingAfter)
+	if err != nil {
+		log.Printf("[AppointmentRepository] FindForCalendar ERROR: userID=%d, error=%v, duration=%v", userID, err, time.Since(start))
+		return nil, errors.ErrInternal(err)
+	}
+	defer rows.Close()
+
+	appointments := []*entities.Appointment{}
+	for rows.Next() {
+		dbEntity, err := r.scanAppointment(rows)
+		if err != nil {
+			log.Printf("[AppointmentRepository] FindForCalendar ERROR: scan failed, userID=%d, error=%v", userID, err)
+			return nil, errors.ErrInternal(err)
+		}
+		appointments = append(appointments, r.mapper.ToDomainEntity(dbEntity))
+	}
+
+	if err := rows.Err(); err != nil {
+		log.Printf("[AppointmentRepository] FindForCalendar ERROR: userID=%d, error=%v", userID, err)
+		return nil, errors.ErrInternal(err)
+	}
+
+	log.Printf("[AppointmentRepository] FindForCalendar: success, userID=%d, count=%d, duration=%v", userID, len(appointments), time.Since(start))
+	return appointments, nil
+}
+
+// FindDueForReminder retrieves requested and confirmed appointments starting within (from, to]
+// that have no reminder recorded for offsetMinutes at their current start time
+func (r *AppointmentRepositoryImpl) FindDueForReminder(ctx context.Context, offsetMinutes int, from, to time.Time, limit int) ([]*entities.Appointment, error) {
+	start := time.Now()
+	log.Printf("[AppointmentRepository] FindDueForReminder: offset=%d, from=%s, to=%s, limit=%d", offsetMinutes, from.Format(time.RFC3339), to.Format(time.RFC3339), limit)
+
+	query := appointmentSelectColumns + `
+		WHERE a.apt_record_status = $1
+		  AND a.apt_status IN ($2, $3)
+		  AND a.apt_start_time > $4 AND a.apt_start_time <= $5
+		  AND a.apt_created_date < a.apt_start_time - make_interval(mins => $6)
+		  AND NOT EXISTS (
+		      SELECT 1 FROM data.data_appointment_reminder rm
+		      WHERE rm.id_appointment = a.apt_id
+		        AND rm.apr_offset_minutes = $6
+		        AND rm.apr_start_time = a.apt_start_time
+		  )
+		ORDER BY a.apt_start_time, a.apt_id
+		LIMIT $7`
+
+	rows, err := r.db.QueryContext(ctx, query,
+		constants.RecordStatus.Active,
+		constants.AppointmentStatus.Requested,
+		constants.AppointmentStatus.Confirmed,
+		from,
+		to,
+		offsetMinutes,
+		limit,
+	)
+	if err != nil {
+		log.Printf("[AppointmentRepository] FindDueForReminder ERROR: offset=%d, error=%v, duration=%v", offsetMinutes, err, time.Since(start))
+		return nil, errors.ErrInternal(err)
+	}
+	defer rows.Close()
+
+	appointments := []*entities.Appointment{}
+	for rows.Next() {
+		dbEntity, err := r.scanAppointment(rows)
+		if err != nil {
+			log.Printf("[AppointmentRepository] FindDueForReminder ERROR: scan failed, offset=%d, error=%v", offsetMinutes, err)
+			return nil, errors.ErrInternal(err)
+		}
+		appointments = append(appointments, r.mapper.ToDomainEntity(dbEntity))
+	}
+
+	if err := rows.Err(); err != nil {
+		log.Printf("[AppointmentRepository] FindDueForReminder ERROR: offset=%d, error=%v", offsetMinutes, err)
+		return nil, errors.ErrInternal(err)
+	}
+
+	log.Printf("[AppointmentRepository] FindDueForReminder: success, offset=%d, count=%d, duration=%v", offsetMinutes, len(appointments), time.Since(start))
+	return appointments, nil
+}
+
+// Create persists a new appointment, sets its ID and records the booking in its history
+// Overlaps are rejected by the ex_appointment_doctor_overlap exclusion constraint, so two concurrent
+// bookings of the same time cannot both succeed
+func (r *AppointmentRepositoryImpl) Create(ctx context.Context, appointment *entities.Appointment) error {
+	start := time.Now()
+	log.Printf("[AppointmentRepository] Create: doctorID=%d, patientID=%d, startTime=%s", appointment.DoctorID, appointment.PatientID, appointment.StartTime.Format(time.RFC3339))
+
+	dbEntity := r.mapper.ToDBEntity(appointment)
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		log.Printf("[AppointmentRepository] Create ERROR: failed to begin transaction, error=%v", err)
+		return errors.ErrInternal(err)
+	}
+	defer tx.Rollback()
+
+	taken, err := r.lockAndCheckSlot(ctx, tx, appointment.DoctorID, 0, appointment.StartTime, appointment.EndTime)
+	if err != nil {
+		log.Printf("[AppointmentRepository] Create ERROR: failed to check the slot, doctorID=%d, error=%v", appointment.DoctorID, err)
+		return errors.ErrInternal(err)
+	}
+
+	if taken {
+		log.Printf("[AppointmentRepository] Create: slot within another booking or its buffer, doctorID=%d, startTime=%s, duration=%v", appointment.DoctorID, appointment.StartTime.Format(time.RFC3339), time.Since(start))
+		return errors.ErrConflict(constants.ErrorMessages.AppointmentSlotTaken)
+	}
+
+	err = tx.QueryRowContext(ctx, `
+		INSERT INTO data.data_appointment (
+			id_organization, id_doctor, id_location, id_patient, apt_start_time, apt_end_time,
+			apt_status, apt_reason, apt_created_date, apt_record_status
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		RETURNING apt_id`,
+		dbEntity.IdOrganization,
+		dbEntity.IdDoctor,
+		dbEntity.IdLocation,
+		dbEntity.IdPatient,
+		dbEntity.AptStartTime,
+		dbEntity.AptEndTime,
+		dbEntity.AptStatus,
+		dbEntity.AptReason,
+		dbEntity.AptCreatedDate,
+		dbEntity.AptRecordStatus,
+	).Scan(&appointment.ID)
+
+	if isExclusionViolation(err) {
+		log.Printf("[AppointmentRepository] Create: slot already taken, doctorID=%d, startTime=%s, duration=%v", appointment.DoctorID, appointment.StartTime.Format(time.RFC3339), time.Since(start))
+		return errors.ErrConflict(constants.ErrorMessages.AppointmentSlotTaken)
+	}
+
+	if err != nil {
+		log.Printf("[AppointmentRepository] Create ERROR: doctorID=%d, error=%v", appointment.DoctorID, err)
+		return errors.ErrInternal(err)
+	}
+
+	booking := &entities.AppointmentStatusChange{
+		AppointmentID: appointment.ID,
+		ToStatus:      appointment.Status,
+		StartTime:     appointment.StartTime,
+		EndTime:       appointment.EndTime,
+		ChangedBy:     appointment.PatientID,
+		CreatedDate:   appointment.CreatedDate,
+	}
+
+	if err := r.insertHistory(ctx, tx, booking); err != nil {
+		log.Printf("[AppointmentRepository] Create ERROR: failed to record history, appointmentID=%d, error=%v", appointment.ID, err)
+		return errors.ErrInternal(err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		log.Printf("[AppointmentRepository] Create ERROR: failed to commit, error=%v", err)
+		return errors.ErrInternal(err)
+	}
+
+	log.Printf("[AppointmentRepository] Create: success, appointmentID=%d, doctorID=%d, duration=%v", appointment.ID, appointment.DoctorID, time.Since(start))
+	return nil
+}
+
+// ApplyChange moves an appointment to the status and times of the change and records it in the history
+// The UPDATE only matches while the appointment is still in change.FromStatus, so two concurrent
+// transitions cannot both apply
+func (r *AppointmentRepositoryImpl) ApplyChange(ctx context.Context, change *entities.AppointmentStatusChange) (bool, error) {
+	start := time.Now()
+	log.Printf("[AppointmentRepository] ApplyChange: appointmentID=%d, toStatus=%s, changedBy=%d", change.AppointmentID, change.ToStatus, change.ChangedBy)
+
+	dbEntity := r.mapper.ChangeToDBEntity(change)
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		log.Printf("[AppointmentRepository] ApplyChange ERROR: failed to begin transaction, error=%v", err)
+		return false, errors.ErrInternal(err)
+	}
+	defer tx.Rollback()
+
+	// Moving the appointment must respect the buffers like a new booking does
+	var doctorID int
+	var currentStart, currentEnd time.Time
+	err = tx.QueryRowContext(ctx, `
+		SELECT id_doctor, apt_start_time, apt_end_time
+		FROM data.data_appointment
+		WHERE apt_id = $1`,
+		change.AppointmentID,
+	).Scan(&doctorID, &currentStart, &currentEnd)
+
+	if err == sql.ErrNoRows {
+		log.Printf("[AppointmentRepository] ApplyChange: appointment not found, appointmentID=%d, duration=%v", change.AppointmentID, time.Since(start))
+		return false, nil
+	}
+
+	if err != nil {
+		log.Printf("[AppointmentRepository] ApplyChange ERROR: failed to load appointment, appointmentID=%d, error=%v", change.AppointmentID, err)
+		return false, errors.ErrInternal(err)
+	}
+
+	if !change.StartTime.Equal(currentStart) || !change.EndTime.Equal(currentEnd) {
+		taken, err := r.lockAndCheckSlot(ctx, tx, doctorID, change.AppointmentID, change.StartTime, change.EndTime)
+		if err != nil {
+			log.Printf("[AppointmentRepository] ApplyChange ERROR: failed to check the slot, appointmentID=%d, error=%v", change.AppointmentID, err)
+			return false, errors.ErrInternal(err)
+		}
+
+		if taken {
+			log.Printf("[AppointmentRepository] ApplyChange: slot within another booking or its buffer, appointmentID=%d, startTime=%s, duration=%v", change.AppointmentID, change.StartTime.Format(time.RFC3339), time.Since(start))
+			return false, errors.ErrConflict(constants.ErrorMessages.AppointmentSlotTaken)
+		}
+	}
+
+	result, err := tx.ExecContext(ctx, `
+		UPDATE data.data_appointment
+		SET apt_status = $2,
+		    apt_start_time = $3,
+		    apt_end_time = $4,
+		    apt_updated_date = $5
+		WHERE apt_id = $1 AND apt_status = $6 AND apt_record_status = $7`,
+		dbEntity.IdAppointment,
+		dbEntity.AshToStatus,
+		dbEntity.AshStartTime,
+		dbEntity.AshEndTime,
+		dbEntity.AshCreatedDate,
+		dbEntity.AshFromStatus,
+		constants.RecordStatus.Active,
+	)
+
+	if isExclusionViolation(err) {
+		log.Printf("[AppointmentRepository] ApplyChange: slot already taken, appointmentID=%d, startTime=%s, duration=%v", change.AppointmentID, change.StartTime.Format(time.RFC3339), time.Since(start))
+		return false, errors.ErrConflict(constants.ErrorMessages.AppointmentSlotTaken)
+	}
+
+	if err != nil {
+		log.Printf("[AppointmentRepository] ApplyChange ERROR: appointmentID=%d, error=%v", change.AppointmentID, err)
+		return false, errors.ErrInternal(err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		log.Printf("[AppointmentRepository] ApplyChange ERROR: appointmentID=%d, error=%v", change.AppointmentID, err)
+		return false, errors.ErrInternal(err)
+	}
+
+	if affected == 0 {
+		log.Printf("[AppointmentRepository] ApplyChange: status changed concurrently, appointmentID=%d, duration=%v", change.AppointmentID, time.Since(start))
+		return false, nil
+	}
+
+	if err := r.insertHistory(ctx, tx, change); err != nil {
+		log.Printf("[AppointmentRepository] ApplyChange ERROR: failed to record history, appointmentID=%d, error=%v", change.AppointmentID, err)
+		return false, errors.ErrInternal(err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		log.Printf("[AppointmentRepository] ApplyChange ERROR: failed to commit, error=%v", err)
+		return false, errors.ErrInternal(err)
+	}
+
+	log.Printf("[AppointmentRepository] ApplyChange: success, appointmentID=%d, toStatus=%s, duration=%v", change.AppointmentID, change.ToStatus, time.Since(start))
+	return true, nil
+}
+
+// FindHistory retrieves the recorded changes of an appointment, oldest first
+func (r *AppointmentRepositoryImpl) FindHistory(ctx context.Context, appointmentID int) ([]*entities.AppointmentStatusChange, error) {
+	start := time.Now()
+	log.Printf("[AppointmentRepository] FindHistory: appointmentID=%d", appointmentID)
+
+	query := `
+		SELECT ash_id, id_appointment, ash_from_status, ash_to_status, ash_start_time, ash_end_time,
+		       id_changed_by, ash_reason, ash_created_date
+		FROM data.data_appointment_status_history
+		WHERE id_appointment = $1
+		ORDER BY ash_created_date, ash_id`
+
+	rows, err := r.db.QueryContext(ctx, query, appointmentID)
+	if err != nil {
+		log.Printf("[AppointmentRepository] FindHistory ERROR: appointmentID=%d, error=%v, duration=%v", appointmentID, err, time.Since(start))
+		return nil, errors.ErrInternal(err)
+	}
+	defer rows.Close()
+
+	changes := []*entities.AppointmentStatusChange{}
+	for rows.Next() {
+		var dbEntity dbEntities.AppointmentStatusHistoryDB
+		err := rows.Scan(
+			&dbEntity.AshID,
+			&dbEntity.IdAppointment,
+			&dbEntity.AshFromStatus,
+			&dbEntity.AshToStatus,
+			&dbEntity.AshStartTime,
+			&dbEntity.AshEndTime,
+			&dbEntity.IdChangedBy,
+			&dbEntity.AshReason,
+			&dbEntity.AshCreatedDate,
+		)
+		if err != nil {
+			log.Printf("[AppointmentRepository] FindHistory ERROR: scan failed, appointmentID=%d, error=%v", appointmentID, err)
+			return nil, errors.ErrInternal(err)
+		}
+		changes = append(changes, r.mapper.ChangeToDomainEntity(&dbEntity))
+	}
+
+	if err := rows.Err(); err != nil {
+		log.Printf("[AppointmentRepository] FindHistory ERROR: appointmentID=%d, error=%v", appointmentID, err)
+		return nil, errors.ErrInternal(err)
+	}
+
+	log.Printf("[AppointmentRepository] FindHistory: success, appointmentID=%d, count=%d, duration=%v", appointmentID, len(changes), time.Since(start))
+	return changes, nil
+}
+
+// lockAndCheckSlot locks the doctor's row for the rest of the transaction and reports whether [startTime, endTime)
+// overlaps another appointment holding the doctor, widened by the doctor's buffer on both sides
+// The lock serializes every booking and move of the doctor, so two concurrent ones cannot both pass the check
+// ignoreAppointmentID excludes the appointment being moved (0 excludes none)
+func (r *AppointmentRepositoryImpl) lockAndCheckSlot(ctx context.Context, tx *sql.Tx, doctorID, ignoreAppointmentID int, startTime, endTime time.Time) (bool, error) {
+	var bufferMinutes int
+	if err := tx.QueryRowContext(ctx, `
+		SELECT doc_buffer_minutes
+		FROM data.data_doctor
+		WHERE doc_id = $1
+		FOR UPDATE`,
+		doctorID,
+	).Scan(&bufferMinutes); err != nil {
+		return false, err
+	}
+
+	var taken bool
+	err := tx.QueryRowContext(ctx, `
+		SELECT EXISTS (
+			SELECT 1
+			FROM data.data_appointment
+			WHERE id_doctor = $1
+			  AND apt_id <> $2
+			  AND apt_record_status = $3
+			  AND apt_status NOT IN ($4, $5)
+			  AND tstzrange(apt_start_time - make_interval(mins => $6), apt_end_time + make_interval(mins => $6), '[)')
+			      && tstzrange($7, $8, '[)')
+		)`,
+		doctorID,
+		ignoreAppointmentID,
+		constants.RecordStatus.Active,
+		constants.AppointmentStatus.CancelledByPatient,
+		constants.AppointmentStatus.CancelledByClinic,
+		bufferMinutes,
+		startTime,
+		endTime,
+	).Scan(&taken)
+
+	return taken, err
+}
+
+// insertHistory records an appointment change within the given transaction and sets its ID
+func (r *AppointmentRepositoryImpl) insertHistory(ctx context.Context, tx *sql.Tx, change *entities.AppointmentStatusChange) error {
+	dbEntity := r.mapper.ChangeToDBEntity(change)
+
+	return tx.QueryRowContext(ctx, `
+		INSERT INTO data.data_appointment_status_history (
+			id_appointment, ash_from_status, ash_to_status, ash_start_time, ash_end_time,
+			id_changed_by, ash_reason, ash_created_date
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING ash_id`,
+		dbEntity.IdAppointment,
+		dbEntity.AshFromStatus,
+		dbEntity.AshToStatus,
+		dbEntity.AshStartTime,
+		dbEntity.AshEndTime,
+		dbEntity.IdChangedBy,
+		dbEntity.AshReason,
+		dbEntity.AshCreatedDate,
+	).Scan(&change.ID)
+}
+
+// scanAppointment reads one appointment row in appointmentSelectColumns order
+func (r *AppointmentRepositoryImpl) scanAppointment(row rowScanner) (*dbEntities.AppointmentDB, error) {
+	var dbEntity dbEntities.AppointmentDB
+
+	err := row.Scan(
+		&dbEntity.AptID,
+		&dbEntity.IdOrganization,
+		&dbEntity.IdDoctor,
+		&dbEntity.IdLocation,
+		&dbEntity.IdPatient,
+		&dbEntity.AptStartTime,
+		&dbEntity.AptEndTime,
+		&dbEntity.AptStatus,
+		&dbEntity.AptReason,
+		&dbEntity.AptCreatedDate,
+		&dbEntity.AptUpdatedDate,
+		&dbEntity.AptRecordStatus,
+		&dbEntity.DocIdUser,
+		&dbEntity.UprFirstName,
+		&dbEntity.UprLastName,
+		&dbEntity.OrgName,
+		&dbEntity.LocName,
+		&dbEntity.LocAddress,
+		&dbEntity.LocTimezone,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dbEntity, nil
+}
@@ -0,0 +1,552 @@
+package repositories
+
+import (
+	"citary-backend/internal/domain/entities"
+	"citary-backend/internal/domain/errors"
+	domainRepositories "citary-backend/internal/domain/repositories"
+	"citary-backend/internal/domain/scheduling"
+	dbEntities "citary-backend/internal/infrastructure/persistence/postgres/entities"
+	"citary-backend/internal/infrastructure/persistence/postgres/mappers"
+	"citary-backend/pkg/constants"
+	"context"
+	"database/sql"
+	"log"
+	"time"
+)
+
+// availabilityBlockSelectColumns lists the data.data_availability_block columns read by every block query, in scan order
+const availabilityBlockSelectColumns = `
+		SELECT avb_id, id_doctor, id_location, avb_weekday, avb_start_time, avb_end_time,
+		       avb_valid_from, avb_valid_until, avb_created_date, avb_updated_date, avb_record_status
+		FROM data.data_availability_block`
+
+// availabilityExceptionSelectColumns lists the data.data_availability_exception columns read by every exception query, in scan order
+const availabilityExceptionSelectColumns = `
+		SELECT avx_id, id_doctor, id_location, avx_kind, avx_start_date, avx_end_date,
+		       avx_start_time, avx_end_time, avx_reason, avx_created_date, avx_record_status
+		FROM data.data_availability_exception`
+
+// AvailabilityRepositoryImpl implements the AvailabilityRepository interface using PostgreSQL
+type AvailabilityRepositoryImpl struct {
+	db     *sql.DB
+	mapper *mappers.AvailabilityMapper
+}
+
+// NewAvailabilityRepositoryImpl creates a new instance of AvailabilityRepositoryImpl
+func NewAvailabilityRepositoryImpl(db *sql.DB) *AvailabilityRepositoryImpl {
+	return &AvailabilityRepositoryImpl{
+		db:     db,
+		mapper: mappers.NewAvailabilityMapper(),
+	}
+}
+
+// FindBlocksByDoctor retrieves the active weekly availability blocks of a doctor ordered by weekday and start time
+func (r *AvailabilityRepositoryImpl) FindBlocksByDoctor(ctx context.Context, doctorID int) ([]*entities.AvailabilityBlock, error) {
+	start := time.Now()
+	log.Printf("[AvailabilityRepository] FindBlocksByDoctor: doctorID=%d", doctorID)
+
+	query := availabilityBlockSelectColumns + `
+		WHERE id_doctor = $1 AND avb_record_status = $2
+		ORDER BY avb_weekday, avb_start_time, avb_id`
+
+	rows, err := r.db.QueryContext(ctx, query, doctorID, constants.RecordStatus.Active)
+	if err != nil {
+		log.Printf("[AvailabilityRepository] FindBlocksByDoctor ERROR: doctorID=%d, error=%v, duration=%v", doctorID, err, time.Since(start))
+		return nil, errors.ErrInternal(err)
+	}
+	defer rows.Close()
+
+	blocks := []*entities.AvailabilityBlock{}
+	for rows.Next() {
+		dbEntity, err := r.scanBlock(rows)
+		if err != nil {
+			log.Printf("[AvailabilityRepository] FindBlocksByDoctor ERROR: scan failed, doctorID=%d, error=%v", doctorID, err)
+			return nil, errors.ErrInternal(err)
+		}
+		blocks = append(blocks, r.mapper.BlockToDomainEntity(dbEntity))
+	}
+
+	if err := rows.Err(); err != nil {
+		log.Printf("[AvailabilityRepository] FindBlocksByDoctor ERROR: doctorID=%d, error=%v", doctorID, err)
+		return nil, errors.ErrInternal(err)
+	}
+
+	log.Printf("[AvailabilityRepository] FindBlocksByDoctor: success, doctorID=%d, count=%d, duration=%v", doctorID, len(blocks), time.Since(start))
+	return blocks, nil
+}
+
+// CreateBlock persists a new availability block and sets its ID
+// The doctor row is locked so concurrent requests cannot both pass the overlap check
+func (r *AvailabilityRepositoryImpl) CreateBlock(ctx context.Context, block *entities.AvailabilityBlock) error {
+	start := time.Now()
+	log.Printf("[AvailabilityRepository] CreateBlock: doctorID=%d, locationID=%d, weekday=%d", block.DoctorID, block.LocationID, block.Weekday)
+
+	dbEntity := r.mapper.BlockToDBEntity(block)
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		log.Printf("[AvailabilityRepository] CreateBlock ERROR: failed to begin transaction, error=%v", err)
+		return errors.ErrInternal(err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `
+		SELECT doc_id FROM data.data_doctor
+		WHERE doc_id = $1
+		FOR UPDATE`,
+		dbEntity.IdDoctor,
+	); err != nil {
+		log.Printf("[AvailabilityRepository] CreateBlock ERROR: failed to lock doctor, doctorID=%d, error=%v", block.DoctorID, err)
+		return errors.ErrInternal(err)
+	}
+
+	var overlaps bool
+	err = tx.QueryRowContext(ctx, `
+		SELECT EXISTS (
+		    SELECT 1 FROM data.data_availability_block
+		    WHERE id_doctor = $1
+		      AND avb_record_status = $2
+		      AND avb_weekday = $3
+		      AND avb_start_time < $5::time
+		      AND avb_end_time > $4::time
+		      AND daterange(avb_valid_from, avb_valid_until, '[]') && daterange($6::date, $7::date, '[]')
+		)`,
+		dbEntity.IdDoctor,
+		constants.RecordStatus.Active,
+		dbEntity.AvbWeekday,
+		dbEntity.AvbStartTime,
+		dbEntity.AvbEndTime,
+		dbEntity.AvbValidFrom,
+		dbEntity.AvbValidUntil,
+	).Scan(&overlaps)
+
+	if err != nil {
+		log.Printf("[AvailabilityRepository] CreateBlock ERROR: overlap check failed, doctorID=%d, error=%v", block.DoctorID, err)
+		return errors.ErrInternal(err)
+	}
+
+	if overlaps {
+		log.Printf("[AvailabilityRepository] CreateBlock: overlaps an existing block, doctorID=%d, weekday=%d, duration=%v", block.DoctorID, block.Weekday, time.Since(start))
+		return errors.ErrConflict(constants.ErrorMessages.AvailabilityBlockOverlap)
+	}
+
+	err = tx.QueryRowContext(ctx, `
+		INSERT INTO data.data_availability_block (
+			id_doctor, id_location, avb_weekday, avb_start_time, avb_end_time,
+			avb_valid_from, avb_valid_until, avb_created_date, avb_record_status
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		RETURNING avb_id`,
+		dbEntity.IdDoctor,
+		dbEntity.IdLocation,
+		dbEntity.AvbWeekday,
+		dbEntity.AvbStartTime,
+		dbEntity.AvbEndTime,
+		dbEntity.AvbValidFrom,
+		dbEntity.AvbValidUntil,
+		dbEntity.AvbCreatedDate,
+		dbEntity.AvbRecordStatus,
+	).Scan(&block.ID)
+
+	if err != nil {
+		log.Printf("[AvailabilityRepository] CreateBlock ERROR: doctorID=%d, error=%v", block.DoctorID, err)
+		return errors.ErrInternal(err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		log.Printf("[AvailabilityRepository] CreateBlock ERROR: failed to commit, error=%v", err)
+		return errors.ErrInternal(err)
+	}
+
+	log.Printf("[AvailabilityRepository] CreateBlock: success, blockID=%d, doctorID=%d, duration=%v", block.ID, block.DoctorID, time.Since(start))
+	return nil
+}
+
+// DeactivateBlock deactivates an availability block of a doctor
+func (r *AvailabilityRepositoryImpl) DeactivateBlock(ctx context.Context, doctorID, id int) (bool, error) {
+	start := time.Now()
+	log.Printf("[AvailabilityRepository] DeactivateBlock: doctorID=%d, blockID=%d", doctorID, id)
+
+	query := `
+		UPDATE data.data_availability_block
+		SET avb_record_status = $3,
+		    avb_updated_date = $4
+		WHERE avb_id = $1 AND id_doctor = $2 AND avb_record_status = $5
+	`
+
+	result, err := r.db.ExecContext(ctx, query, id, doctorID, constants.RecordStatus.Inactive, time.Now(), constants.RecordStatus.Active)
+	if err != nil {
+		log.Printf("[AvailabilityRepository] DeactivateBlock ERROR: blockID=%d, error=%v, duration=%v", id, err, time.Since(start))
+		return false, errors.ErrInternal(err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		log.Printf("[AvailabilityRepository] DeactivateBlock ERROR: blockID=%d, error=%v, duration=%v", id, err, time.Since(start))
+		return false, errors.ErrInternal(err)
+	}
+
+	log.Printf("[AvailabilityRepository] DeactivateBlock: success, blockID=%d, deactivated=%t, duration=%v", id, affected > 0, time.Since(start))
+	return affected > 0, nil
+}
+
+// FindExceptionsByDoctor retrieves the active exceptions of a doctor that end on or after the given date
+func (r *AvailabilityRepositoryImpl) FindExceptionsByDoctor(ctx context.Context, doctorID int, endingFrom time.Time) ([]*entities.AvailabilityException, error) {
+	start := time.Now()
+	log.Printf("[AvailabilityRepository] FindExceptionsByDoctor: doctorID=%d, endingFrom=%s", doctorID, endingFrom.Format(time.DateOnly))
+
+	query := availabilityExceptionSelectColumns + `
+		WHERE id_doctor = $1 AND avx_record_status = $2 AND avx_end_date >= $3
+		ORDER BY avx_start_date, avx_start_time NULLS FIRST, avx_id`
+
+	rows, err := r.db.QueryContext(ctx, query, doctorID, constants.RecordStatus.Active, endingFrom)
+	if err != nil {
+		log.Printf("[AvailabilityRepository] FindExceptionsByDoctor ERROR: doctorID=%d, error=%v, duration=%v", doctorID, err, time.Since(start))
+		return nil, errors.ErrInternal(err)
+	}
+	defer rows.Close()
+
+	exceptions := []*entities.AvailabilityException{}
+	for rows.Next() {
+		dbEntity, err := r.scanException(rows)
+		if err != nil {
+			log.Printf("[AvailabilityRepository] FindExceptionsByDoctor ERROR: scan failed, doctorID=%d, error=%v", doctorID, err)
+			return nil, errors.ErrInternal(err)
+		}
+		exceptions = append(exceptions, r.mapper.ExceptionToDomainEntity(dbEntity))
+	}
+
+	if err := rows.Err(); err != nil {
+		log.Printf("[AvailabilityRepository] FindExceptionsByDoctor ERROR: doctorID=%d, error=%v", doctorID, err)
+		return nil, errors.ErrInternal(err)
+	}
+
+	log.Printf("[AvailabilityRepository] FindExceptionsByDoctor: success, doctorID=%d, count=%d, duration=%v", doctorID, len(exceptions), time.Since(start))
+	return exceptions, nil
+}
+
+// CreateException persists a new availability exception and sets its ID
+func (r *AvailabilityRepositoryImpl) CreateException(ctx context.Context, exception *entities.AvailabilityException) error {
+	start := time.Now()
+	log.Printf("[AvailabilityRepository] CreateException: doctorID=%d, kind=%s", exception.DoctorID, exception.Kind)
+
+	dbEntity := r.mapper.ExceptionToDBEntity(exception)
+
+	query := `
+		INSERT INTO data.data_availability_exception (
+			id_doctor, id_location, avx_kind, avx_start_date, avx_end_date,
+			avx_start_time, avx_end_time, avx_reason, avx_created_date, avx_record_status
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		RETURNING avx_id
+	`
+
+	err := r.db.QueryRowContext(
+		ctx,
+		query,
+		dbEntity.IdDoctor,
+		dbEntity.IdLocation,
+		dbEntity.AvxKind,
+		dbEntity.AvxStartDate,
+		dbEntity.AvxEndDate,
+		dbEntity.AvxStartTime,
+		dbEntity.AvxEndTime,
+		dbEntity.AvxReason,
+		dbEntity.AvxCreatedDate,
+		dbEntity.AvxRecordStatus,
+	).Scan(&exception.ID)
+
+	duration := time.Since(start)
+
+	if err != nil {
+		log.Printf("[AvailabilityRepository] CreateException ERROR: doctorID=%d, error=%v, duration=%v", exception.DoctorID, err, duration)
+		return errors.ErrInternal(err)
+	}
+
+	log.Printf("[AvailabilityRepository] CreateException: success, exceptionID=%d, doctorID=%d, duration=%v", exception.ID, exception.DoctorID, duration)
+	return nil
+}
+
+// DeactivateException deactivates an availability exception of a doctor
+func (r *AvailabilityRepositoryImpl) DeactivateException(ctx context.Context, doctorID, id int) (bool, error) {
+	start := time.Now()
+	log.Printf("[AvailabilityRepository] DeactivateException: doctorID=%d, exceptionID=%d", doctorID, id)
+
+	query := `
+		UPDATE data.data_availability_exception
+		SET avx_record_status = $3
+		WHERE avx_id = $1 AND id_doctor = $2 AND avx_record_status = $4
+	`
+
+	result, err := r.db.ExecContext(ctx, query, id, doctorID, constants.RecordStatus.Inactive, constants.RecordStatus.Active)
+	if err != nil {
+		log.Printf("[AvailabilityRepository] DeactivateException ERROR: exceptionID=%d, error=%v, duration=%v", id, err, time.Since(start))
+		return false, errors.ErrInternal(err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		log.Printf("[AvailabilityRepository] DeactivateException ERROR: exceptionID=%d, error=%v, duration=%v", id, err, time.Since(start))
+		return false, errors.ErrInternal(err)
+	}
+
+	log.Printf("[AvailabilityRepository] DeactivateException: success, exceptionID=%d, deactivated=%t, duration=%v", id, affected > 0, time.Since(start))
+	return affected > 0, nil
+}
+
+// FindEffectiveAvailability combines the weekly blocks and exceptions of a doctor into the windows
+// the doctor is available in between from and to
+func (r *AvailabilityRepositoryImpl) FindEffectiveAvailability(ctx context.Context, doctorID int, from, to time.Time) ([]*entities.AvailabilityWindow, error) {
+	start := time.Now()
+	log.Printf("[AvailabilityRepository] FindEffectiveAvailability: doctorID=%d, from=%s, to=%s", doctorID, from.Format(time.RFC3339), to.Format(time.RFC3339))
+
+	schedule, _, err := r.loadFreeTime(ctx, domainRepositories.FreeTimeFilter{DoctorID: doctorID, From: from, To: to}, false)
+	if err != nil {
+		log.Printf("[AvailabilityRepository] FindEffectiveAvailability ERROR: doctorID=%d, error=%v, duration=%v", doctorID, err, time.Since(start))
+		return nil, err
+	}
+
+	windows := schedule.Windows(from, to)
+
+	log.Printf("[AvailabilityRepository] FindEffectiveAvailability: success, doctorID=%d, windows=%d, duration=%v", doctorID, len(windows), time.Since(start))
+	return windows, nil
+}
+
+// FindFreeWindows retrieves the effective availability of a doctor with active bookings and their buffers removed
+func (r *AvailabilityRepositoryImpl) FindFreeWindows(ctx context.Context, filter domainRepositories.FreeTimeFilter) ([]*entities.AvailabilityWindow, error) {
+	start := time.Now()
+	log.Printf("[AvailabilityRepository] FindFreeWindows: doctorID=%d, from=%s, to=%s, buffer=%d", filter.DoctorID, filter.From.Format(time.RFC3339), filter.To.Format(time.RFC3339), filter.BufferMinutes)
+
+	schedule, bookings, err := r.loadFreeTime(ctx, filter, true)
+	if err != nil {
+		log.Printf("[AvailabilityRepository] FindFreeWindows ERROR: doctorID=%d, error=%v, duration=%v", filter.DoctorID, err, time.Since(start))
+		return nil, err
+	}
+
+	buffer := time.Duration(filter.BufferMinutes) * time.Minute
+	windows := scheduling.FreeWindows(schedule.Windows(filter.From, filter.To), bookings, buffer)
+
+	log.Printf("[AvailabilityRepository] FindFreeWindows: success, doctorID=%d, windows=%d, duration=%v", filter.DoctorID, len(windows), time.Since(start))
+	return windows, nil
+}
+
+// FindFreeSlots splits the free windows of a doctor into consecutive slots separated by the buffer
+// The schedule, exceptions and bookings are read in a single query and the slots computed from them in memory
+// Free time is resolved from a day before From so that slots stay aligned to the start of a window
+// that began before the range
+func (r *AvailabilityRepositoryImpl) FindFreeSlots(ctx context.Context, filter domainRepositories.FreeTimeFilter, slotMinutes int) ([]*entities.AvailabilitySlot, error) {
+	start := time.Now()
+	log.Printf("[AvailabilityRepository] FindFreeSlots: doctorID=%d, from=%s, to=%s, slot=%d, buffer=%d", filter.DoctorID, filter.From.Format(time.RFC3339), filter.To.Format(time.RFC3339), slotMinutes, filter.BufferMinutes)
+
+	resolved := filter
+	resolved.From = filter.From.Add(-24 * time.Hour)
+
+	schedule, bookings, err := r.loadFreeTime(ctx, resolved, true)
+	if err != nil {
+		log.Printf("[AvailabilityRepository] FindFreeSlots ERROR: doctorID=%d, error=%v, duration=%v", filter.DoctorID, err, time.Since(start))
+		return nil, err
+	}
+
+	buffer := time.Duration(filter.BufferMinutes) * time.Minute
+	free := scheduling.FreeWindows(schedule.Windows(resolved.From, resolved.To), bookings, buffer)
+	slots := scheduling.Slots(free, schedule.Locations, filter.From, time.Duration(slotMinutes)*time.Minute, buffer)
+
+	log.Printf("[AvailabilityRepository] FindFreeSlots: success, doctorID=%d, slots=%d, duration=%v", filter.DoctorID, len(slots), time.Since(start))
+	return slots, nil
+}
+
+// freeTimeRow kinds, in the order loadFreeTime returns them
+const (
+	freeTimeRowOrganization = iota
+	freeTimeRowLocation
+	freeTimeRowBlock
+	freeTimeRowException
+	freeTimeRowBooking
+)
+
+// freeTimeQuery reads everything the free time of a doctor is computed from in a single pass:
+// the organization timezone, the active locations, weekly blocks and exceptions, and the active
+// bookings overlapping the buffered range, as one tagged row each
+// Blocks and exceptions come ordered as FindBlocksByDoctor and FindExceptionsByDoctor return them
+const freeTimeQuery = `
+		WITH doctor AS (
+			SELECT d.doc_id, d.id_organization, o.org_timezone
+			FROM data.data_doctor d
+			JOIN data.data_organization o ON o.org_id = d.id_organization
+			WHERE d.doc_id = $1
+		)
+		SELECT 0 AS kind, NULL::integer AS id, NULL::integer AS id_location, NULL::smallint AS weekday,
+		       NULL::text AS start_time, NULL::text AS end_time, NULL::date AS start_date, NULL::date AS end_date,
+		       NULL::text AS exception_kind, NULL::timestamptz AS starts_at, NULL::timestamptz AS ends_at,
+		       doctor.org_timezone AS timezone
+		FROM doctor
+		UNION ALL
+		SELECT 1, l.loc_id, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, l.loc_timezone
+		FROM doctor
+		JOIN data.data_location l ON l.id_organization = doctor.id_organization AND l.loc_record_status = $2
+		UNION ALL
+		SELECT 2, b.avb_id, b.id_location, b.avb_weekday, b.avb_start_time::text, b.avb_end_time::text,
+		       b.avb_valid_from, b.avb_valid_until, NULL, NULL, NULL, NULL
+		FROM doctor
+		JOIN data.data_availability_block b ON b.id_doctor = doctor.doc_id AND b.avb_record_status = $2
+		UNION ALL
+		SELECT 3, x.avx_id, x.id_location, NULL, x.avx_start_time::text, x.avx_end_time::text,
+		       x.avx_start_date, x.avx_end_date, x.avx_kind, NULL, NULL, NULL
+		FROM doctor
+		JOIN data.data_availability_exception x ON x.id_doctor = doctor.doc_id AND x.avx_record_status = $2 AND x.avx_end_date >= $3
+		UNION ALL
+		SELECT 4, a.apt_id, NULL, NULL, NULL, NULL, NULL, NULL, NULL, a.apt_start_time, a.apt_end_time, NULL
+		FROM doctor
+		JOIN data.data_appointment a ON a.id_doctor = doctor.doc_id
+		WHERE $9::boolean
+		  AND a.apt_record_status = $2
+		  AND a.apt_status NOT IN ($4, $5)
+		  AND a.apt_id <> $6
+		  AND a.apt_start_time < $8
+		  AND a.apt_end_time > $7
+		ORDER BY kind, weekday, start_date, start_time NULLS FIRST, id`
+
+// loadFreeTime reads the schedule of a doctor that can apply from filter.From on, with the timezones of the
+// organization and its active locations, and, when withBookings is set, the active bookings the filter does
+// not ignore that overlap the range padded by the buffer
+// A doctor that does not exist gets an empty schedule and no bookings
+func (r *AvailabilityRepositoryImpl) loadFreeTime(ctx context.Context, filter domainRepositories.FreeTimeFilter, withBookings bool) (*scheduling.Schedule, []scheduling.Span, error) {
+	schedule := &scheduling.Schedule{
+		Blocks:     []*entities.AvailabilityBlock{},
+		Exceptions: []*entities.AvailabilityException{},
+		Locations:  map[int]*time.Location{},
+	}
+	bookings := []scheduling.Span{}
+
+	buffer := time.Duration(filter.BufferMinutes) * time.Minute
+
+	// Local dates from the day before the range are expanded, so exceptions ending that day still count
+	rows, err := r.db.QueryContext(
+		ctx,
+		freeTimeQuery,
+		filter.DoctorID,
+		constants.RecordStatus.Active,
+		filter.From.UTC().AddDate(0, 0, -1),
+		constants.AppointmentStatus.CancelledByPatient,
+		constants.AppointmentStatus.CancelledByClinic,
+		filter.IgnoreAppointmentID,
+		filter.From.Add(-buffer),
+		filter.To.Add(buffer),
+		withBookings,
+	)
+	if err != nil {
+		return nil, nil, errors.ErrInternal(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			kind          int
+			id            sql.NullInt64
+			locationID    sql.NullInt64
+			weekday       sql.NullInt64
+			startTime     sql.NullString
+			endTime       sql.NullString
+			startDate     sql.NullTime
+			endDate       sql.NullTime
+			exceptionKind sql.NullString
+			startsAt      sql.NullTime
+			endsAt        sql.NullTime
+			timezone      sql.NullString
+		)
+		if err := rows.Scan(&kind, &id, &locationID, &weekday, &startTime, &endTime, &startDate, &endDate, &exceptionKind, &startsAt, &endsAt, &timezone); err != nil {
+			return nil, nil, errors.ErrInternal(err)
+		}
+
+		switch kind {
+		case freeTimeRowOrganization:
+			if schedule.Timezone, err = time.LoadLocation(timezone.String); err != nil {
+				return nil, nil, errors.ErrInternal(err)
+			}
+		case freeTimeRowLocation:
+			loc, err := time.LoadLocation(timezone.String)
+			if err != nil {
+				return nil, nil, errors.ErrInternal(err)
+			}
+			schedule.Locations[int(id.Int64)] = loc
+		case freeTimeRowBlock:
+			schedule.Blocks = append(schedule.Blocks, r.mapper.BlockToDomainEntity(&dbEntities.AvailabilityBlockDB{
+				AvbID:           int(id.Int64),
+				IdDoctor:        filter.DoctorID,
+				IdLocation:      int(locationID.Int64),
+				AvbWeekday:      int(weekday.Int64),
+				AvbStartTime:    startTime.String,
+				AvbEndTime:      endTime.String,
+				AvbValidFrom:    startDate,
+				AvbValidUntil:   endDate,
+				AvbRecordStatus: constants.RecordStatus.Active,
+			}))
+		case freeTimeRowException:
+			schedule.Exceptions = append(schedule.Exceptions, r.mapper.ExceptionToDomainEntity(&dbEntities.AvailabilityExceptionDB{
+				AvxID:           int(id.Int64),
+				IdDoctor:        filter.DoctorID,
+				IdLocation:      locationID,
+				AvxKind:         exceptionKind.String,
+				AvxStartDate:    startDate.Time,
+				AvxEndDate:      endDate.Time,
+				AvxStartTime:    startTime,
+				AvxEndTime:      endTime,
+				AvxRecordStatus: constants.RecordStatus.Active,
+			}))
+		case freeTimeRowBooking:
+			bookings = append(bookings, scheduling.Span{Start: startsAt.Time, End: endsAt.Time})
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, nil, errors.ErrInternal(err)
+	}
+
+	return schedule, bookings, nil
+}
+
+// scanBlock reads one availability block row in availabilityBlockSelectColumns order
+func (r *AvailabilityRepositoryImpl) scanBlock(row rowScanner) (*dbEntities.AvailabilityBlockDB, error) {
+	var dbEntity dbEntities.AvailabilityBlockDB
+
+	err := row.Scan(
+		&dbEntity.AvbID,
+		&dbEntity.IdDoctor,
+		&dbEntity.IdLocation,
+		&dbEntity.AvbWeekday,
+		&dbEntity.AvbStartTime,
+		&dbEntity.AvbEndTime,
+		&dbEntity.AvbValidFrom,
+		&dbEntity.AvbValidUntil,
+		&dbEntity.AvbCreatedDate,
+		&dbEntity.AvbUpdatedDate,
+		&dbEntity.AvbRecordStatus,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dbEntity, nil
+}
+
+// scanException reads one availability exception row in availabilityExceptionSelectColumns order
+func (r *AvailabilityRepositoryImpl) scanException(row rowScanner) (*dbEntities.AvailabilityExceptionDB, error) {
+	var dbEntity dbEntities.AvailabilityExceptionDB
+
+	err := row.Scan(
+		&dbEntity.AvxID,
+		&dbEntity.IdDoctor,
+		&dbEntity.IdLocation,
+		&dbEntity.AvxKind,
+		&dbEntity.AvxStartDate,
+		&dbEntity.AvxEndDate,
+		&dbEntity.AvxStartTime,
+		&dbEntity.AvxEndTime,
+		&dbEntity.AvxReason,
+		&dbEntity.AvxCreatedDate,
+		&dbEntity.AvxRecordStatus,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dbEntity, nil
+}
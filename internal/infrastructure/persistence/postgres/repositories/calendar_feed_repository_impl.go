@@ -0,0 +1,138 @@
+package repositories
+
+import (
+	"citary-backend/internal/domain/entities"
+	"citary-backend/internal/domain/errors"
+	"context"
+	"database/sql"
+	"log"
+	"time"
+)
+
+// CalendarFeedRepositoryImpl implements the CalendarFeedRepository interface using PostgreSQL
+type CalendarFeedRepositoryImpl struct {
+	db *sql.DB
+}
+
+// NewCalendarFeedRepositoryImpl creates a new instance of CalendarFeedRepositoryImpl
+func NewCalendarFeedRepositoryImpl(db *sql.DB) *CalendarFeedRepositoryImpl {
+	return &CalendarFeedRepositoryImpl{db: db}
+}
+
+// FindByTokenHash retrieves a feed by the hash of its token
+// Returns (nil, nil) if not found - business layer decides if that's an error
+func (r *CalendarFeedRepositoryImpl) FindByTokenHash(ctx context.Context, tokenHash string) (*entities.CalendarFeed, error) {
+	start := time.Now()
+	log.Printf("[CalendarFeedRepository] FindByTokenHash")
+
+	query := `
+		SELECT cfd_id, id_user, cfd_token_hash, cfd_created_date
+		FROM data.data_calendar_feed
+		WHERE cfd_token_hash = $1`
+
+	var feed entities.CalendarFeed
+	err := r.db.QueryRowContext(ctx, query, tokenHash).Scan(
+		&feed.ID,
+		&feed.UserID,
+		&feed.TokenHash,
+		&feed.CreatedDate,
+	)
+
+	duration := time.Since(start)
+
+	if err == sql.ErrNoRows {
+		log.Printf("[CalendarFeedRepository] FindByTokenHash: feed not found, duration=%v", duration)
+		return nil, nil
+	}
+
+	if err != nil {
+		log.Printf("[CalendarFeedRepository] FindByTokenHash ERROR: error=%v, duration=%v", err, duration)
+		return nil, errors.ErrInternal(err)
+	}
+
+	log.Printf("[CalendarFeedRepository] FindByTokenHash: success, userID=%d, duration=%v", feed.UserID, duration)
+	return &feed, nil
+}
+
+// FindByUser retrieves the user's feed
+// Returns (nil, nil) if the user has none
+func (r *CalendarFeedRepositoryImpl) FindByUser(ctx context.Context, userID int) (*entities.CalendarFeed, error) {
+	start := time.Now()
+	log.Printf("[CalendarFeedRepository] FindByUser: userID=%d", userID)
+
+	query := `
+		SELECT cfd_id, id_user, cfd_token_hash, cfd_created_date
+		FROM data.data_calendar_feed
+		WHERE id_user = $1`
+
+	var feed entities.CalendarFeed
+	err := r.db.QueryRowContext(ctx, query, userID).Scan(
+		&feed.ID,
+		&feed.UserID,
+		&feed.TokenHash,
+		&feed.CreatedDate,
+	)
+
+	duration := time.Since(start)
+
+	if err == sql.ErrNoRows {
+		log.Printf("[CalendarFeedRepository] FindByUser: feed not found, userID=%d, duration=%v", userID, duration)
+		return nil, nil
+	}
+
+	if err != nil {
+		log.Printf("[CalendarFeedRepository] FindByUser ERROR: userID=%d, error=%v, duration=%v", userID, err, duration)
+		return nil, errors.ErrInternal(err)
+	}
+
+	log.Printf("[CalendarFeedRepository] FindByUser: success, feedID=%d, userID=%d, duration=%v", feed.ID, userID, duration)
+	return &feed, nil
+}
+
+// Replace stores the user's feed, replacing any previous one, and sets its ID
+func (r *CalendarFeedRepositoryImpl) Replace(ctx context.Context, feed *entities.CalendarFeed) error {
+	start := time.Now()
+	log.Printf("[CalendarFeedRepository] Replace: userID=%d", feed.UserID)
+
+	query := `
+		INSERT INTO data.data_calendar_feed (id_user, cfd_token_hash, cfd_created_date)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (id_user) DO UPDATE
+		SET cfd_token_hash = EXCLUDED.cfd_token_hash,
+		    cfd_created_date = EXCLUDED.cfd_created_date
+		RETURNING cfd_id
+	`
+
+	err := r.db.QueryRowContext(ctx, query, feed.UserID, feed.TokenHash, feed.CreatedDate).Scan(&feed.ID)
+
+	duration := time.Since(start)
+
+	if err != nil {
+		log.Printf("[CalendarFeedRepository] Replace ERROR: userID=%d, error=%v, duration=%v", feed.UserID, err, duration)
+		return errors.ErrInternal(err)
+	}
+
+	log.Printf("[CalendarFeedRepository] Replace: success, feedID=%d, userID=%d, duration=%v", feed.ID, feed.UserID, duration)
+	return nil
+}
+
+// DeleteForUser removes the user's feed
+func (r *CalendarFeedRepositoryImpl) DeleteForUser(ctx context.Context, userID int) (bool, error) {
+	start := time.Now()
+	log.Printf("[CalendarFeedRepository] DeleteForUser: userID=%d", userID)
+
+	result, err := r.db.ExecContext(ctx, `DELETE FROM data.data_calendar_feed WHERE id_user = $1`, userID)
+	if err != nil {
+		log.Printf("[CalendarFeedRepository] DeleteForUser ERROR: userID=%d, error=%v, duration=%v", userID, err, time.Since(start))
+		return false, errors.ErrInternal(err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		log.Printf("[CalendarFeedRepository] DeleteForUser ERROR: userID=%d, error=%v, duration=%v", userID, err, time.Since(start))
+		return false, errors.ErrInternal(err)
+	}
+
+	log.Printf("[CalendarFeedRepository] DeleteForUser: success, userID=%d, deleted=%t, duration=%v", userID, affected > 0, time.Since(start))
+	return affected > 0, nil
+}
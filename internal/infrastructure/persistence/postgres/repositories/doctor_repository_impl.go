@@ -0,0 +1,472 @@
+package repositories
+
+import (
+	"citary-backend/internal/domain/entities"
+	"citary-backend/internal/domain/errors"
+	domainRepositories "citary-backend/internal/domain/repositories"
+	dbEntities "citary-backend/internal/infrastructure/persistence/postgres/entities"
+	"citary-backend/internal/infrastructure/persistence/postgres/mappers"
+	"citary-backend/pkg/constants"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// Named unique constraints of data.data_doctor, told apart to report the right conflict
+const (
+	doctorUserConstraint    = "uq_doctor_organization_user"
+	doctorLicenseConstraint = "uq_doctor_organization_license"
+)
+
+// doctorFromClause joins a doctor with its organization and the profile of its user
+const doctorFromClause = `
+		FROM data.data_doctor d
+		JOIN data.data_organization o ON o.org_id = d.id_organization
+		LEFT JOIN data.data_user_profile p ON p.id_user = d.id_user`
+
+// doctorSelectColumns lists the doctor columns read by every doctor query, in scan order
+// Specialties are aggregated into a JSON array so one row carries the whole profile
+const doctorSelectColumns = `
+		SELECT d.doc_id, d.id_user, d.id_organization, d.doc_license_number, d.doc_bio, d.doc_languages,
+		       d.doc_consultation_minutes, d.doc_buffer_minutes, d.doc_fee_cents, d.doc_fee_currency,
+		       d.doc_created_date, d.doc_updated_date, d.doc_record_status,
+		       p.upr_first_name, p.upr_last_name, o.org_name, o.org_slug,
+		       (SELECT COALESCE(json_agg(json_build_object(
+		                   'spe_id', s.spe_id, 'spe_code', s.spe_code,
+		                   'spe_name', s.spe_name, 'spe_record_status', s.spe_record_status
+		               ) ORDER BY s.spe_name), '[]')
+		        FROM data.data_doctor_specialty ds
+		        JOIN core.core_specialty s ON s.spe_id = ds.id_specialty
+		        WHERE ds.id_doctor = d.doc_id)` + doctorFromClause
+
+// doctorPublicCondition restricts a query to doctors patients may see; $1 is the active record status
+const doctorPublicCondition = `
+		WHERE d.doc_record_status = $1
+		  AND o.org_record_status = $1
+		  AND EXISTS (SELECT 1 FROM data.data_user u WHERE u.use_id = d.id_user AND u.use_record_status = $1)`
+
+// likeEscaper escapes the LIKE wildcards of user input so it is matched literally
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// DoctorRepositoryImpl implements the DoctorRepository interface using PostgreSQL
+type DoctorRepositoryImpl struct {
+	db     *sql.DB
+	mapper *mappers.DoctorMapper
+}
+
+// NewDoctorRepositoryImpl creates a new instance of DoctorRepositoryImpl
+func NewDoctorRepositoryImpl(db *sql.DB) *DoctorRepositoryImpl {
+	return &DoctorRepositoryImpl{
+		db:     db,
+		mapper: mappers.NewDoctorMapper(),
+	}
+}
+
+// FindByID retrieves a doctor profile of an organization by its ID
+// Returns (nil, nil) if not found - business layer decides if that's an error
+func (r *DoctorRepositoryImpl) FindByID(ctx context.Context, organizationID, id int) (*entities.Doctor, error) {
+	start := time.Now()
+	log.Printf("[DoctorRepository] FindByID: organizationID=%d, id=%d", organizationID, id)
+
+	query := doctorSelectColumns + `
+		WHERE d.doc_id = $1 AND d.id_organization = $2`
+
+	dbEntity, err := r.scanDoctor(r.db.QueryRowContext(ctx, query, id, organizationID))
+
+	duration := time.Since(start)
+
+	if err == sql.ErrNoRows {
+		log.Printf("[DoctorRepository] FindByID: doctor not found, organizationID=%d, id=%d, duration=%v", organizationID, id, duration)
+		return nil, nil
+	}
+
+	if err != nil {
+		log.Printf("[DoctorRepository] FindByID ERROR: organizationID=%d, id=%d, error=%v, duration=%v", organizationID, id, err, duration)
+		return nil, errors.ErrInternal(err)
+	}
+
+	log.Printf("[DoctorRepository] FindByID: success, organizationID=%d, id=%d, status=%s, duration=%v", organizationID, id, dbEntity.DocRecordStatus, duration)
+	return r.mapper.ToDomainEntity(dbEntity), nil
+}
+
+// FindPublicByID retrieves an active doctor profile of an active organization by its ID
+// Returns (nil, nil) if not found - business layer decides if that's an error
+func (r *DoctorRepositoryImpl) FindPublicByID(ctx context.Context, id int) (*entities.Doctor, error) {
+	start := time.Now()
+	log.Printf("[DoctorRepository] FindPublicByID: id=%d", id)
+
+	query := doctorSelectColumns + doctorPublicCondition + `
+		  AND d.doc_id = $2`
+
+	dbEntity, err := r.scanDoctor(r.db.QueryRowContext(ctx, query, constants.RecordStatus.Active, id))
+
+	duration := time.Since(start)
+
+	if err == sql.ErrNoRows {
+		log.Printf("[DoctorRepository] FindPublicByID: doctor not found, id=%d, duration=%v", id, duration)
+		return nil, nil
+	}
+
+	if err != nil {
+		log.Printf("[DoctorRepository] FindPublicByID ERROR: id=%d, error=%v, duration=%v", id, err, duration)
+		return nil, errors.ErrInternal(err)
+	}
+
+	log.Printf("[DoctorRepository] FindPublicByID: success, id=%d, duration=%v", id, duration)
+	return r.mapper.ToDomainEntity(dbEntity), nil
+}
+
+// FindByOrganization retrieves every doctor profile of an organization, active or not, ordered by name
+func (r *DoctorRepositoryImpl) FindByOrganization(ctx context.Context, organizationID int) ([]*entities.Doctor, error) {
+	start := time.Now()
+	log.Printf("[DoctorRepository] FindByOrganization: organizationID=%d", organizationID)
+
+	query := doctorSelectColumns + `
+		WHERE d.id_organization = $1
+		ORDER BY p.upr_last_name NULLS LAST, p.upr_first_name NULLS LAST, d.doc_id`
+
+	doctors, err := r.queryDoctors(ctx, query, organizationID)
+	if err != nil {
+		log.Printf("[DoctorRepository] FindByOrganization ERROR: organizationID=%d, error=%v, duration=%v", organizationID, err, time.Since(start))
+		return nil, errors.ErrInternal(err)
+	}
+
+	log.Printf("[DoctorRepository] FindByOrganization: success, organizationID=%d, count=%d, duration=%v", organizationID, len(doctors), time.Since(start))
+	return doctors, nil
+}
+
+// FindByUser retrieves every doctor profile of a user across organizations, active or not, oldest first
+func (r *DoctorRepositoryImpl) FindByUser(ctx context.Context, userID int) ([]*entities.Doctor, error) {
+	start := time.Now()
+	log.Printf("[DoctorRepository] FindByUser: userID=%d", userID)
+
+	query := doctorSelectColumns + `
+		WHERE d.id_user = $1
+		ORDER BY d.doc_created_date, d.doc_id`
+
+	doctors, err := r.queryDoctors(ctx, query, userID)
+	if err != nil {
+		log.Printf("[DoctorRepository] FindByUser ERROR: userID=%d, error=%v, duration=%v", userID, err, time.Since(start))
+		return nil, errors.ErrInternal(err)
+	}
+
+	log.Printf("[DoctorRepository] FindByUser: success, userID=%d, count=%d, duration=%v", userID, len(doctors), time.Since(start))
+	return doctors, nil
+}
+
+// Search retrieves one page of publicly visible doctors matching the filter and the total number of matches
+func (r *DoctorRepositoryImpl) Search(ctx context.Context, filter domainRepositories.DoctorSearchFilter) ([]*entities.Doctor, int, error) {
+	start := time.Now()
+	log.Printf("[DoctorRepository] Search: specialty=%q, organization=%q, name=%q, language=%q, limit=%d, offset=%d",
+		filter.SpecialtyCode, filter.OrganizationSlug, filter.Name, filter.Language, filter.Limit, filter.Offset)
+
+	// Build the shared WHERE clause; $1 is always the active record status
+	var conditions strings.Builder
+	conditions.WriteString(doctorPublicCondition)
+	args := []any{constants.RecordStatus.Active}
+
+	addCondition := func(format string, value any) {
+		args = append(args, value)
+		conditions.WriteString("\n\t\t  AND " + fmt.Sprintf(format, len(args)))
+	}
+
+	if filter.SpecialtyCode != "" {
+		addCondition(`EXISTS (
+		      SELECT 1 FROM data.data_doctor_specialty ds
+		      JOIN core.core_specialty s ON s.spe_id = ds.id_specialty
+		      WHERE ds.id_doctor = d.doc_id AND s.spe_code = $%d)`, filter.SpecialtyCode)
+	}
+
+	if filter.OrganizationSlug != "" {
+		addCondition(`o.org_slug = $%d`, filter.OrganizationSlug)
+	}
+
+	if filter.Name != "" {
+		addCondition(`CONCAT_WS(' ', p.upr_first_name, p.upr_last_name) ILIKE $%d`, "%"+likeEscaper.Replace(filter.Name)+"%")
+	}
+
+	if filter.Language != "" {
+		addCondition(`$%d = ANY(d.doc_languages)`, filter.Language)
+	}
+
+	// Count every match
+	var total int
+	countQuery := `
+		SELECT COUNT(*)` + doctorFromClause + conditions.String()
+
+	if err := r.db.QueryRowContext(ctx, countQuery, args...).Scan(&total); err != nil {
+		log.Printf("[DoctorRepository] Search ERROR: count failed, error=%v, duration=%v", err, time.Since(start))
+		return nil, 0, errors.ErrInternal(err)
+	}
+
+	if total == 0 || filter.Offset >= total {
+		log.Printf("[DoctorRepository] Search: success, total=%d, count=0, duration=%v", total, time.Since(start))
+		return []*entities.Doctor{}, total, nil
+	}
+
+	// Read the requested page
+	pageQuery := doctorSelectColumns + conditions.String() + fmt.Sprintf(`
+		ORDER BY p.upr_last_name NULLS LAST, p.upr_first_name NULLS LAST, d.doc_id
+		LIMIT $%d OFFSET $%d`, len(args)+1, len(args)+2)
+
+	doctors, err := r.queryDoctors(ctx, pageQuery, append(args, filter.Limit, filter.Offset)...)
+	if err != nil {
+		log.Printf("[DoctorRepository] Search ERROR: error=%v, duration=%v", err, time.Since(start))
+		return nil, 0, errors.ErrInternal(err)
+	}
+
+	log.Printf("[DoctorRepository] Search: success, total=%d, count=%d, duration=%v", total, len(doctors), time.Since(start))
+	return doctors, total, nil
+}
+
+// Create persists a new doctor profile with its specialties and sets its ID
+func (r *DoctorRepositoryImpl) Create(ctx context.Context, doctor *entities.Doctor) error {
+	start := time.Now()
+	log.Printf("[DoctorRepository] Create: organizationID=%d, userID=%d", doctor.OrganizationID, doctor.UserID)
+
+	dbEntity := r.mapper.ToDBEntity(doctor)
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		log.Printf("[DoctorRepository] Create ERROR: failed to begin transaction, error=%v", err)
+		return errors.ErrInternal(err)
+	}
+	defer tx.Rollback()
+
+	err = tx.QueryRowContext(ctx, `
+		INSERT INTO data.data_doctor (
+			id_user, id_organization, doc_license_number, doc_bio, doc_languages,
+			doc_consultation_minutes, doc_buffer_minutes, doc_fee_cents, doc_fee_currency,
+			doc_created_date, doc_record_status
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		RETURNING doc_id`,
+		dbEntity.IdUser,
+		dbEntity.IdOrganization,
+		dbEntity.DocLicenseNumber,
+		dbEntity.DocBio,
+		pq.Array(dbEntity.DocLanguages),
+		dbEntity.DocConsultationMinutes,
+		dbEntity.DocBufferMinutes,
+		dbEntity.DocFeeCents,
+		dbEntity.DocFeeCurrency,
+		dbEntity.DocCreatedDate,
+		dbEntity.DocRecordStatus,
+	).Scan(&doctor.ID)
+
+	if err != nil {
+		if conflict := r.conflictError(err); conflict != nil {
+			log.Printf("[DoctorRepository] Create: conflict, organizationID=%d, userID=%d, error=%v", doctor.OrganizationID, doctor.UserID, err)
+			return conflict
+		}
+		log.Printf("[DoctorRepository] Create ERROR: organizationID=%d, userID=%d, error=%v", doctor.OrganizationID, doctor.UserID, err)
+		return errors.ErrInternal(err)
+	}
+
+	if err := r.insertSpecialties(ctx, tx, doctor.ID, doctor.SpecialtyIDs()); err != nil {
+		log.Printf("[DoctorRepository] Create ERROR: failed to save specialties, doctorID=%d, error=%v", doctor.ID, err)
+		return errors.ErrInternal(err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		log.Printf("[DoctorRepository] Create ERROR: failed to commit, error=%v", err)
+		return errors.ErrInternal(err)
+	}
+
+	log.Printf("[DoctorRepository] Create: success, doctorID=%d, duration=%v", doctor.ID, time.Since(start))
+	return nil
+}
+
+// Update persists the editable fields of a doctor profile and replaces its specialties
+func (r *DoctorRepositoryImpl) Update(ctx context.Context, doctor *entities.Doctor) error {
+	start := time.Now()
+	log.Printf("[DoctorRepository] Update: doctorID=%d", doctor.ID)
+
+	dbEntity := r.mapper.ToDBEntity(doctor)
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		log.Printf("[DoctorRepository] Update ERROR: failed to begin transaction, error=%v", err)
+		return errors.ErrInternal(err)
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, `
+		UPDATE data.data_doctor
+		SET doc_license_number = $3,
+		    doc_bio = $4,
+		    doc_languages = $5,
+		    doc_consultation_minutes = $6,
+		    doc_buffer_minutes = $7,
+		    doc_fee_cents = $8,
+		    doc_fee_currency = $9,
+		    doc_updated_date = $10
+		WHERE doc_id = $1 AND id_organization = $2`,
+		dbEntity.DocID,
+		dbEntity.IdOrganization,
+		dbEntity.DocLicenseNumber,
+		dbEntity.DocBio,
+		pq.Array(dbEntity.DocLanguages),
+		dbEntity.DocConsultationMinutes,
+		dbEntity.DocBufferMinutes,
+		dbEntity.DocFeeCents,
+		dbEntity.DocFeeCurrency,
+		dbEntity.DocUpdatedDate,
+	)
+
+	if err != nil {
+		if conflict := r.conflictError(err); conflict != nil {
+			log.Printf("[DoctorRepository] Update: conflict, doctorID=%d, error=%v", doctor.ID, err)
+			return conflict
+		}
+		log.Printf("[DoctorRepository] Update ERROR: doctorID=%d, error=%v", doctor.ID, err)
+		return errors.ErrInternal(err)
+	}
+
+	if _, err := tx.ExecContext(ctx, `
+		DELETE FROM data.data_doctor_specialty
+		WHERE id_doctor = $1`,
+		doctor.ID,
+	); err != nil {
+		log.Printf("[DoctorRepository] Update ERROR: failed to clear specialties, doctorID=%d, error=%v", doctor.ID, err)
+		return errors.ErrInternal(err)
+	}
+
+	if err := r.insertSpecialties(ctx, tx, doctor.ID, doctor.SpecialtyIDs()); err != nil {
+		log.Printf("[DoctorRepository] Update ERROR: failed to save specialties, doctorID=%d, error=%v", doctor.ID, err)
+		return errors.ErrInternal(err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		log.Printf("[DoctorRepository] Update ERROR: failed to commit, error=%v", err)
+		return errors.ErrInternal(err)
+	}
+
+	log.Printf("[DoctorRepository] Update: success, doctorID=%d, duration=%v", doctor.ID, time.Since(start))
+	return nil
+}
+
+// UpdateStatus sets the record status of a doctor profile of an organization
+func (r *DoctorRepositoryImpl) UpdateStatus(ctx context.Context, organizationID, id int, status string) (bool, error) {
+	start := time.Now()
+	log.Printf("[DoctorRepository] UpdateStatus: organizationID=%d, doctorID=%d, status=%s", organizationID, id, status)
+
+	query := `
+		UPDATE data.data_doctor
+		SET doc_record_status = $3,
+		    doc_updated_date = $4
+		WHERE doc_id = $1 AND id_organization = $2
+	`
+
+	result, err := r.db.ExecContext(ctx, query, id, organizationID, status, time.Now())
+	if err != nil {
+		log.Printf("[DoctorRepository] UpdateStatus ERROR: doctorID=%d, error=%v, duration=%v", id, err, time.Since(start))
+		return false, errors.ErrInternal(err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		log.Printf("[DoctorRepository] UpdateStatus ERROR: doctorID=%d, error=%v, duration=%v", id, err, time.Since(start))
+		return false, errors.ErrInternal(err)
+	}
+
+	log.Printf("[DoctorRepository] UpdateStatus: success, doctorID=%d, updated=%t, duration=%v", id, affected > 0, time.Since(start))
+	return affected > 0, nil
+}
+
+// insertSpecialties links a doctor to the given specialties inside a transaction
+func (r *DoctorRepositoryImpl) insertSpecialties(ctx context.Context, tx *sql.Tx, doctorID int, specialtyIDs []int) error {
+	if len(specialtyIDs) == 0 {
+		return nil
+	}
+
+	ids := make([]int64, 0, len(specialtyIDs))
+	for _, id := range specialtyIDs {
+		ids = append(ids, int64(id))
+	}
+
+	_, err := tx.ExecContext(ctx, `
+		INSERT INTO data.data_doctor_specialty (id_doctor, id_specialty)
+		SELECT $1, UNNEST($2::int[])
+		ON CONFLICT DO NOTHING`,
+		doctorID, pq.Array(ids),
+	)
+	return err
+}
+
+// conflictError translates a violation of the doctor unique constraints into a domain conflict, or returns nil
+func (r *DoctorRepositoryImpl) conflictError(err error) error {
+	switch {
+	case isUniqueViolationOn(err, doctorUserConstraint):
+		return errors.ErrConflict(constants.ErrorMessages.DoctorAlreadyExists)
+	case isUniqueViolationOn(err, doctorLicenseConstraint):
+		return errors.ErrConflict(constants.ErrorMessages.DoctorLicenseTaken)
+	default:
+		return nil
+	}
+}
+
+// queryDoctors runs a doctor list query and maps every row
+func (r *DoctorRepositoryImpl) queryDoctors(ctx context.Context, query string, args ...any) ([]*entities.Doctor, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	doctors := []*entities.Doctor{}
+	for rows.Next() {
+		dbEntity, err := r.scanDoctor(rows)
+		if err != nil {
+			return nil, err
+		}
+		doctors = append(doctors, r.mapper.ToDomainEntity(dbEntity))
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return doctors, nil
+}
+
+// scanDoctor reads one doctor row in doctorSelectColumns order and decodes its aggregated specialties
+func (r *DoctorRepositoryImpl) scanDoctor(row rowScanner) (*dbEntities.DoctorDB, error) {
+	var dbEntity dbEntities.DoctorDB
+	var specialties []byte
+
+	err := row.Scan(
+		&dbEntity.DocID,
+		&dbEntity.IdUser,
+		&dbEntity.IdOrganization,
+		&dbEntity.DocLicenseNumber,
+		&dbEntity.DocBio,
+		pq.Array(&dbEntity.DocLanguages),
+		&dbEntity.DocConsultationMinutes,
+		&dbEntity.DocBufferMinutes,
+		&dbEntity.DocFeeCents,
+		&dbEntity.DocFeeCurrency,
+		&dbEntity.DocCreatedDate,
+		&dbEntity.DocUpdatedDate,
+		&dbEntity.DocRecordStatus,
+		&dbEntity.UprFirstName,
+		&dbEntity.UprLastName,
+		&dbEntity.OrgName,
+		&dbEntity.OrgSlug,
+		&specialties,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(specialties, &dbEntity.Specialties); err != nil {
+		return nil, fmt.Errorf("invalid doctor specialties: %w", err)
+	}
+
+	return &dbEntity, nil
+}
@@ -0,0 +1,16 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+)
+
+// queryRower is satisfied by both *sql.DB and *sql.Tx so inserts can run inside or outside a transaction
+type queryRower interface {
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// rowScanner is satisfied by both *sql.Row and *sql.Rows so one scan helper serves single and list queries
+type rowScanner interface {
+	Scan(dest ...any) error
+}
@@ -0,0 +1,111 @@
+package repositories
+
+import (
+	"citary-backend/internal/domain/entities"
+	"citary-backend/internal/domain/errors"
+	dbEntities "citary-backend/internal/infrastructure/persistence/postgres/entities"
+	"citary-backend/internal/infrastructure/persistence/postgres/mappers"
+	"citary-backend/pkg/constants"
+	"context"
+	"database/sql"
+	"log"
+	"time"
+)
+
+// currentLegalDocumentsQuery selects the latest published active version of each document type
+const currentLegalDocumentsQuery = `
+		SELECT DISTINCT ON (led_type)
+		       led_id, led_type, led_version, led_title, led_url,
+		       led_published_at, led_created_date, led_record_status
+		FROM data.data_legal_document
+		WHERE led_published_at <= NOW() AND led_record_status = $1
+		ORDER BY led_type, led_published_at DESC`
+
+// LegalDocumentRepositoryImpl implements the LegalDocumentRepository interface using PostgreSQL
+type LegalDocumentRepositoryImpl struct {
+	db     *sql.DB
+	mapper *mappers.LegalDocumentMapper
+}
+
+// NewLegalDocumentRepositoryImpl creates a new instance of LegalDocumentRepositoryImpl
+func NewLegalDocumentRepositoryImpl(db *sql.DB) *LegalDocumentRepositoryImpl {
+	return &LegalDocumentRepositoryImpl{
+		db:     db,
+		mapper: mappers.NewLegalDocumentMapper(),
+	}
+}
+
+// FindCurrent retrieves the latest published active version of each document type
+func (r *LegalDocumentRepositoryImpl) FindCurrent(ctx context.Context) ([]*entities.LegalDocument, error) {
+	start := time.Now()
+	log.Printf("[LegalDocumentRepository] FindCurrent")
+
+	documents, err := r.query(ctx, currentLegalDocumentsQuery, constants.RecordStatus.Active)
+
+	duration := time.Since(start)
+
+	if err != nil {
+		log.Printf("[LegalDocumentRepository] FindCurrent ERROR: error=%v, duration=%v", err, duration)
+		return nil, errors.ErrInternal(err)
+	}
+
+	log.Printf("[LegalDocumentRepository] FindCurrent: success, count=%d, duration=%v", len(documents), duration)
+	return documents, nil
+}
+
+// FindPendingForUser retrieves the current documents the user has not accepted yet
+func (r *LegalDocumentRepositoryImpl) FindPendingForUser(ctx context.Context, userID int) ([]*entities.LegalDocument, error) {
+	start := time.Now()
+	log.Printf("[LegalDocumentRepository] FindPendingForUser: userID=%d", userID)
+
+	query := `
+		SELECT doc.*
+		FROM (` + currentLegalDocumentsQuery + `) AS doc
+		WHERE NOT EXISTS (
+			SELECT 1
+			FROM data.data_user_consent c
+			WHERE c.id_user = $2 AND c.id_legal_document = doc.led_id
+		)
+		ORDER BY doc.led_type`
+
+	documents, err := r.query(ctx, query, constants.RecordStatus.Active, userID)
+
+	duration := time.Since(start)
+
+	if err != nil {
+		log.Printf("[LegalDocumentRepository] FindPendingForUser ERROR: userID=%d, error=%v, duration=%v", userID, err, duration)
+		return nil, errors.ErrInternal(err)
+	}
+
+	log.Printf("[LegalDocumentRepository] FindPendingForUser: success, userID=%d, pending=%d, duration=%v", userID, len(documents), duration)
+	return documents, nil
+}
+
+// query runs a legal document SELECT and maps every row
+func (r *LegalDocumentRepositoryImpl) query(ctx context.Context, query string, args ...interface{}) ([]*entities.LegalDocument, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	documents := []*entities.LegalDocument{}
+	for rows.Next() {
+		var dbEntity dbEntities.LegalDocumentDB
+		if err := rows.Scan(
+			&dbEntity.LedID,
+			&dbEntity.LedType,
+			&dbEntity.LedVersion,
+			&dbEntity.LedTitle,
+			&dbEntity.LedURL,
+			&dbEntity.LedPublishedAt,
+			&dbEntity.LedCreatedDate,
+			&dbEntity.LedRecordStatus,
+		); err != nil {
+			return nil, err
+		}
+		documents = append(documents, r.mapper.ToDomainEntity(&dbEntity))
+	}
+
+	return documents, rows.Err()
+}
@@ -0,0 +1,180 @@
+package repositories
+
+import (
+	"citary-backend/internal/domain/entities"
+	"citary-backend/internal/domain/errors"
+	dbEntities "citary-backend/internal/infrastructure/persistence/postgres/entities"
+	"citary-backend/internal/infrastructure/persistence/postgres/mappers"
+	"context"
+	"database/sql"
+	"log"
+	"time"
+)
+
+// locationSelectColumns lists the data.data_location columns read by every location query, in scan order
+const locationSelectColumns = `
+		SELECT loc_id, id_organization, loc_name, loc_address, loc_timezone,
+		       loc_created_date, loc_updated_date, loc_record_status
+		FROM data.data_location`
+
+// LocationRepositoryImpl implements the LocationRepository interface using PostgreSQL
+type LocationRepositoryImpl struct {
+	db     *sql.DB
+	mapper *mappers.LocationMapper
+}
+
+// NewLocationRepositoryImpl creates a new instance of LocationRepositoryImpl
+func NewLocationRepositoryImpl(db *sql.DB) *LocationRepositoryImpl {
+	return &LocationRepositoryImpl{
+		db:     db,
+		mapper: mappers.NewLocationMapper(),
+	}
+}
+
+// FindByID retrieves a location of an organization by its ID
+// Returns (nil, nil) if not found - business layer decides if that's an error
+func (r *LocationRepositoryImpl) FindByID(ctx context.Context, organizationID, id int) (*entities.Location, error) {
+	start := time.Now()
+	log.Printf("[LocationRepository] FindByID: organizationID=%d, id=%d", organizationID, id)
+
+	query := locationSelectColumns + `
+		WHERE loc_id = $1 AND id_organization = $2`
+
+	dbEntity, err := r.scanLocation(r.db.QueryRowContext(ctx, query, id, organizationID))
+
+	duration := time.Since(start)
+
+	if err == sql.ErrNoRows {
+		log.Printf("[LocationRepository] FindByID: location not found, organizationID=%d, id=%d, duration=%v", organizationID, id, duration)
+		return nil, nil
+	}
+
+	if err != nil {
+		log.Printf("[LocationRepository] FindByID ERROR: organizationID=%d, id=%d, error=%v, duration=%v", organizationID, id, err, duration)
+		return nil, errors.ErrInternal(err)
+	}
+
+	log.Printf("[LocationRepository] FindByID: success, organizationID=%d, id=%d, status=%s, duration=%v", organizationID, id, dbEntity.LocRecordStatus, duration)
+	return r.mapper.ToDomainEntity(dbEntity), nil
+}
+
+// FindByOrganization retrieves every location of an organization, active or not, ordered by name
+func (r *LocationRepositoryImpl) FindByOrganization(ctx context.Context, organizationID int) ([]*entities.Location, error) {
+	start := time.Now()
+	log.Printf("[LocationRepository] FindByOrganization: organizationID=%d", organizationID)
+
+	query := locationSelectColumns + `
+		WHERE id_organization = $1
+		ORDER BY loc_name, loc_id`
+
+	rows, err := r.db.QueryContext(ctx, query, organizationID)
+	if err != nil {
+		log.Printf("[LocationRepository] FindByOrganization ERROR: organizationID=%d, error=%v, duration=%v", organizationID, err, time.Since(start))
+		return nil, errors.ErrInternal(err)
+	}
+	defer rows.Close()
+
+	locations := []*entities.Location{}
+	for rows.Next() {
+		dbEntity, err := r.scanLocation(rows)
+		if err != nil {
+			log.Printf("[LocationRepository] FindByOrganization ERROR: scan failed, organizationID=%d, error=%v", organizationID, err)
+			return nil, errors.ErrInternal(err)
+		}
+		locations = append(locations, r.mapper.ToDomainEntity(dbEntity))
+	}
+
+	if err := rows.Err(); err != nil {
+		log.Printf("[LocationRepository] FindByOrganization ERROR: organizationID=%d, error=%v", organizationID, err)
+		return nil, errors.ErrInternal(err)
+	}
+
+	log.Printf("[LocationRepository] FindByOrganization: success, organizationID=%d, count=%d, duration=%v", organizationID, len(locations), time.Since(start))
+	return locations, nil
+}
+
+// Create persists a new location and sets its ID
+func (r *LocationRepositoryImpl) Create(ctx context.Context, location *entities.Location) error {
+	start := time.Now()
+	log.Printf("[LocationRepository] Create: organizationID=%d, name=%s", location.OrganizationID, location.Name)
+
+	dbEntity := r.mapper.ToDBEntity(location)
+
+	query := `
+		INSERT INTO data.data_location (
+			id_organization, loc_name, loc_address, loc_timezone,
+			loc_created_date, loc_record_status
+		) VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING loc_id
+	`
+
+	err := r.db.QueryRowContext(
+		ctx,
+		query,
+		dbEntity.IdOrganization,
+		dbEntity.LocName,
+		dbEntity.LocAddress,
+		dbEntity.LocTimezone,
+		dbEntity.LocCreatedDate,
+		dbEntity.LocRecordStatus,
+	).Scan(&location.ID)
+
+	duration := time.Since(start)
+
+	if err != nil {
+		log.Printf("[LocationRepository] Create ERROR: organizationID=%d, error=%v, duration=%v", location.OrganizationID, err, duration)
+		return errors.ErrInternal(err)
+	}
+
+	log.Printf("[LocationRepository] Create: success, locationID=%d, duration=%v", location.ID, duration)
+	return nil
+}
+
+// UpdateStatus sets the record status of a location of an organization
+func (r *LocationRepositoryImpl) UpdateStatus(ctx context.Context, organizationID, id int, status string) (bool, error) {
+	start := time.Now()
+	log.Printf("[LocationRepository] UpdateStatus: organizationID=%d, locationID=%d, status=%s", organizationID, id, status)
+
+	query := `
+		UPDATE data.data_location
+		SET loc_record_status = $3,
+		    loc_updated_date = $4
+		WHERE loc_id = $1 AND id_organization = $2
+	`
+
+	result, err := r.db.ExecContext(ctx, query, id, organizationID, status, time.Now())
+	if err != nil {
+		log.Printf("[LocationRepository] UpdateStatus ERROR: locationID=%d, error=%v, duration=%v", id, err, time.Since(start))
+		return false, errors.ErrInternal(err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		log.Printf("[LocationRepository] UpdateStatus ERROR: locationID=%d, error=%v, duration=%v", id, err, time.Since(start))
+		return false, errors.ErrInternal(err)
+	}
+
+	log.Printf("[LocationRepository] UpdateStatus: success, locationID=%d, updated=%t, duration=%v", id, affected > 0, time.Since(start))
+	return affected > 0, nil
+}
+
+// scanLocation reads one location row in locationSelectColumns order
+func (r *LocationRepositoryImpl) scanLocation(row rowScanner) (*dbEntities.LocationDB, error) {
+	var dbEntity dbEntities.LocationDB
+
+	err := row.Scan(
+		&dbEntity.LocID,
+		&dbEntity.IdOrganization,
+		&dbEntity.LocName,
+		&dbEntity.LocAddress,
+		&dbEntity.LocTimezone,
+		&dbEntity.LocCreatedDate,
+		&dbEntity.LocUpdatedDate,
+		&dbEntity.LocRecordStatus,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dbEntity, nil
+}
@@ -0,0 +1,422 @@
+package repositories
+
+import (
+	"citary-backend/internal/domain/entities"
+	"citary-backend/internal/domain/errors"
+	dbEntities "citary-backend/internal/infrastructure/persistence/postgres/entities"
+	"citary-backend/internal/infrastructure/persistence/postgres/mappers"
+	"citary-backend/pkg/constants"
+	"context"
+	"database/sql"
+	"log"
+	"time"
+)
+
+// invitationSelectColumns lists the invitation columns read by every invitation query, in scan order
+// The invited role's code is joined so callers can show and check it without a second lookup
+const invitationSelectColumns = `
+		SELECT i.oin_id, i.id_organization, i.id_role, r.rol_code, i.id_user_invited_by, i.id_user_accepted,
+		       i.oin_email, i.oin_token_hash, i.oin_expires_at, i.oin_accepted_at, i.oin_revoked_at,
+		       i.oin_created_date, i.oin_record_status
+		FROM data.data_organization_invitation i
+		JOIN core.core_role r ON r.rol_id = i.id_role`
+
+// OrganizationInvitationRepositoryImpl implements the OrganizationInvitationRepository interface using PostgreSQL
+type OrganizationInvitationRepositoryImpl struct {
+	db     *sql.DB
+	mapper *mappers.OrganizationInvitationMapper
+	users  *UserRepositoryImpl
+}
+
+// NewOrganizationInvitationRepositoryImpl creates a new instance of OrganizationInvitationRepositoryImpl
+func NewOrganizationInvitationRepositoryImpl(db *sql.DB) *OrganizationInvitationRepositoryImpl {
+	return &OrganizationInvitationRepositoryImpl{
+		db:     db,
+		mapper: mappers.NewOrganizationInvitationMapper(),
+		users:  NewUserRepositoryImpl(db),
+	}
+}
+
+// Create persists a new invitation
+// A unique violation means another open invitation for the same email was created in the meantime
+func (r *OrganizationInvitationRepositoryImpl) Create(ctx context.Context, invitation *entities.OrganizationInvitation) error {
+	start := time.Now()
+	log.Printf("[OrganizationInvitationRepository] Create: organizationID=%d, email=%s, roleID=%d", invitation.OrganizationID, invitation.Email, invitation.RoleID)
+
+	dbEntity := r.mapper.ToDBEntity(invitation)
+
+	query := `
+		INSERT INTO data.data_organization_invitation (
+			id_organization, id_role, id_user_invited_by, oin_email, oin_token_hash,
+			oin_expires_at, oin_created_date, oin_record_status
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING oin_id`
+
+	err := r.db.QueryRowContext(
+		ctx,
+		query,
+		dbEntity.IdOrganization,
+		dbEntity.IdRole,
+		dbEntity.IdUserInvitedBy,
+		dbEntity.OinEmail,
+		dbEntity.OinTokenHash,
+		dbEntity.OinExpiresAt,
+		dbEntity.OinCreatedDate,
+		dbEntity.OinRecordStatus,
+	).Scan(&invitation.ID)
+
+	duration := time.Since(start)
+
+	if isUniqueViolation(err) {
+		log.Printf("[OrganizationInvitationRepository] Create: open invitation already exists, organizationID=%d, email=%s, duration=%v", invitation.OrganizationID, invitation.Email, duration)
+		return errors.ErrConflict(constants.ErrorMessages.InvitationAlreadyPending)
+	}
+
+	if err != nil {
+		log.Printf("[OrganizationInvitationRepository] Create ERROR: organizationID=%d, email=%s, error=%v, duration=%v", invitation.OrganizationID, invitation.Email, err, duration)
+		return errors.ErrInternal(err)
+	}
+
+	log.Printf("[OrganizationInvitationRepository] Create: success, invitationID=%d, organizationID=%d, duration=%v", invitation.ID, invitation.OrganizationID, duration)
+	return nil
+}
+
+// FindByID retrieves an invitation by its ID
+// Returns (nil, nil) if not found - business layer decides if that's an error
+func (r *OrganizationInvitationRepositoryImpl) FindByID(ctx context.Context, id int) (*entities.OrganizationInvitation, error) {
+	start := time.Now()
+	log.Printf("[OrganizationInvitationRepository] FindByID: id=%d", id)
+
+	query := invitationSelectColumns + `
+		WHERE i.oin_id = $1`
+
+	dbEntity, err := r.scanInvitation(r.db.QueryRowContext(ctx, query, id))
+
+	duration := time.Since(start)
+
+	if err == sql.ErrNoRows {
+		log.Printf("[OrganizationInvitationRepository] FindByID: invitation not found, id=%d, duration=%v", id, duration)
+		return nil, nil
+	}
+
+	if err != nil {
+		log.Printf("[OrganizationInvitationRepository] FindByID ERROR: id=%d, error=%v, duration=%v", id, err, duration)
+		return nil, errors.ErrInternal(err)
+	}
+
+	log.Printf("[OrganizationInvitationRepository] FindByID: success, id=%d, organizationID=%d, duration=%v", id, dbEntity.IdOrganization, duration)
+	return r.mapper.ToDomainEntity(dbEntity), nil
+}
+
+// FindByTokenHash retrieves an invitation by the hash of its emailed token
+// Returns (nil, nil) if not found - business layer decides if that's an error
+func (r *OrganizationInvitationRepositoryImpl) FindByTokenHash(ctx context.Context, tokenHash string) (*entities.OrganizationInvitation, error) {
+	start := time.Now()
+	log.Printf("[OrganizationInvitationRepository] FindByTokenHash")
+
+	query := invitationSelectColumns + `
+		WHERE i.oin_token_hash = $1`
+
+	dbEntity, err := r.scanInvitation(r.db.QueryRowContext(ctx, query, tokenHash))
+
+	duration := time.Since(start)
+
+	if err == sql.ErrNoRows {
+		log.Printf("[OrganizationInvitationRepository] FindByTokenHash: invitation not found, duration=%v", duration)
+		return nil, nil
+	}
+
+	if err != nil {
+		log.Printf("[OrganizationInvitationRepository] FindByTokenHash ERROR: error=%v, duration=%v", err, duration)
+		return nil, errors.ErrInternal(err)
+	}
+
+	log.Printf("[OrganizationInvitationRepository] FindByTokenHash: success, invitationID=%d, duration=%v", dbEntity.OinID, duration)
+	return r.mapper.ToDomainEntity(dbEntity), nil
+}
+
+// FindByOrganization retrieves every invitation of an organization, newest first
+func (r *OrganizationInvitationRepositoryImpl) FindByOrganization(ctx context.Context, organizationID int) ([]*entities.OrganizationInvitation, error) {
+	start := time.Now()
+	log.Printf("[OrganizationInvitationRepository] FindByOrganization: organizationID=%d", organizationID)
+
+	query := invitationSelectColumns + `
+		WHERE i.id_organization = $1
+		ORDER BY i.oin_created_date DESC, i.oin_id DESC`
+
+	rows, err := r.db.QueryContext(ctx, query, organizationID)
+	if err != nil {
+		log.Printf("[OrganizationInvitationRepository] FindByOrganization ERROR: organizationID=%d, error=%v, duration=%v", organizationID, err, time.Since(start))
+		return nil, errors.ErrInternal(err)
+	}
+	defer rows.Close()
+
+	invitations := []*entities.OrganizationInvitation{}
+	for rows.Next() {
+		dbEntity, err := r.scanInvitation(rows)
+		if err != nil {
+			log.Printf("[OrganizationInvitationRepository] FindByOrganization ERROR: scan failed, organizationID=%d, error=%v", organizationID, err)
+			return nil, errors.ErrInternal(err)
+		}
+		invitations = append(invitations, r.mapper.ToDomainEntity(dbEntity))
+	}
+
+	if err := rows.Err(); err != nil {
+		log.Printf("[OrganizationInvitationRepository] FindByOrganization ERROR: organizationID=%d, error=%v", organizationID, err)
+		return nil, errors.ErrInternal(err)
+	}
+
+	log.Printf("[OrganizationInvitationRepository] FindByOrganization: success, organizationID=%d, count=%d, duration=%v", organizationID, len(invitations), time.Since(start))
+	return invitations, nil
+}
+
+// FindByInvitee retrieves every invitation sent to the email address or accepted by the user, newest first
+func (r *OrganizationInvitationRepositoryImpl) FindByInvitee(ctx context.Context, userID int, email string) ([]*entities.OrganizationInvitation, error) {
+	start := time.Now()
+	log.Printf("[OrganizationInvitationRepository] FindByInvitee: userID=%d", userID)
+
+	query := invitationSelectColumns + `
+		WHERE i.oin_email = $2 OR i.id_user_accepted = $1
+		ORDER BY i.oin_created_date DESC, i.oin_id DESC`
+
+	rows, err := r.db.QueryContext(ctx, query, userID, email)
+	if err != nil {
+		log.Printf("[OrganizationInvitationRepository] FindByInvitee ERROR: userID=%d, error=%v, duration=%v", userID, err, time.Since(start))
+		return nil, errors.ErrInternal(err)
+	}
+	defer rows.Close()
+
+	invitations := []*entities.OrganizationInvitation{}
+	for rows.Next() {
+		dbEntity, err := r.scanInvitation(rows)
+		if err != nil {
+			log.Printf("[OrganizationInvitationRepository] FindByInvitee ERROR: scan failed, userID=%d, error=%v", userID, err)
+			return nil, errors.ErrInternal(err)
+		}
+		invitations = append(invitations, r.mapper.ToDomainEntity(dbEntity))
+	}
+
+	if err := rows.Err(); err != nil {
+		log.Printf("[OrganizationInvitationRepository] FindByInvitee ERROR: userID=%d, error=%v", userID, err)
+		return nil, errors.ErrInternal(err)
+	}
+
+	log.Printf("[OrganizationInvitationRepository] FindByInvitee: success, userID=%d, count=%d, duration=%v", userID, len(invitations), time.Since(start))
+	return invitations, nil
+}
+
+// RevokePendingForEmail revokes the organization's open invitations for an email address
+func (r *OrganizationInvitationRepositoryImpl) RevokePendingForEmail(ctx context.Context, organizationID int, email string) error {
+	start := time.Now()
+	log.Printf("[OrganizationInvitationRepository] RevokePendingForEmail: organizationID=%d, email=%s", organizationID, email)
+
+	query := `
+		UPDATE data.data_organization_invitation
+		SET oin_revoked_at = NOW()
+		WHERE id_organization = $1
+		  AND oin_email = $2
+		  AND oin_accepted_at IS NULL
+		  AND oin_revoked_at IS NULL`
+
+	result, err := r.db.ExecContext(ctx, query, organizationID, email)
+
+	duration := time.Since(start)
+
+	if err != nil {
+		log.Printf("[OrganizationInvitationRepository] RevokePendingForEmail ERROR: organizationID=%d, error=%v, duration=%v", organizationID, err, duration)
+		return errors.ErrInternal(err)
+	}
+
+	rows, _ := result.RowsAffected()
+	log.Printf("[OrganizationInvitationRepository] RevokePendingForEmail: success, organizationID=%d, revoked=%d, duration=%v", organizationID, rows, duration)
+	return nil
+}
+
+// Revoke revokes a single invitation
+// The update only matches pending invitations, so it cannot undo an acceptance that happened in the meantime
+func (r *OrganizationInvitationRepositoryImpl) Revoke(ctx context.Context, id int) (bool, error) {
+	start := time.Now()
+	log.Printf("[OrganizationInvitationRepository] Revoke: id=%d", id)
+
+	query := `
+		UPDATE data.data_organization_invitation
+		SET oin_revoked_at = NOW()
+		WHERE oin_id = $1
+		  AND oin_accepted_at IS NULL
+		  AND oin_revoked_at IS NULL`
+
+	result, err := r.db.ExecContext(ctx, query, id)
+
+	duration := time.Since(start)
+
+	if err != nil {
+		log.Printf("[OrganizationInvitationRepository] Revoke ERROR: id=%d, error=%v, duration=%v", id, err, duration)
+		return false, errors.ErrInternal(err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, errors.ErrInternal(err)
+	}
+
+	log.Printf("[OrganizationInvitationRepository] Revoke: success, id=%d, revoked=%t, duration=%v", id, rows > 0, duration)
+	return rows > 0, nil
+}
+
+// AcceptForUser atomically consumes the invitation and makes the user a member of its organization with its role
+// A former member whose membership was deactivated is reinstated with the invited role
+// The organization becomes the user's default one only if they had none, so accepting never moves existing sessions
+func (r *OrganizationInvitationRepositoryImpl) AcceptForUser(ctx context.Context, invitation *entities.OrganizationInvitation, userID int) error {
+	start := time.Now()
+	log.Printf("[OrganizationInvitationRepository] AcceptForUser: invitationID=%d, userID=%d", invitation.ID, userID)
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		log.Printf("[OrganizationInvitationRepository] AcceptForUser ERROR: failed to begin transaction, error=%v", err)
+		return errors.ErrInternal(err)
+	}
+	defer tx.Rollback()
+
+	if err := r.accept(ctx, tx, invitation, userID); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		log.Printf("[OrganizationInvitationRepository] AcceptForUser ERROR: failed to commit, error=%v", err)
+		return errors.ErrInternal(err)
+	}
+
+	log.Printf("[OrganizationInvitationRepository] AcceptForUser: success, invitationID=%d, userID=%d, organizationID=%d, duration=%v", invitation.ID, userID, invitation.OrganizationID, time.Since(start))
+	return nil
+}
+
+// AcceptForNewUser creates the invited account with its consents and accepts the invitation for it in one transaction
+// Each consent's UserID is set to the new user's ID; if accepting fails the account is not created
+func (r *OrganizationInvitationRepositoryImpl) AcceptForNewUser(ctx context.Context, invitation *entities.OrganizationInvitation, user *entities.User, consents []*entities.UserConsent) error {
+	start := time.Now()
+	log.Printf("[OrganizationInvitationRepository] AcceptForNewUser: invitationID=%d, email=%s, consents=%d", invitation.ID, user.Email, len(consents))
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		log.Printf("[OrganizationInvitationRepository] AcceptForNewUser ERROR: failed to begin transaction, error=%v", err)
+		return errors.ErrInternal(err)
+	}
+	defer tx.Rollback()
+
+	if err := r.users.insert(ctx, tx, user); err != nil {
+		log.Printf("[OrganizationInvitationRepository] AcceptForNewUser ERROR: failed to create user, invitationID=%d, error=%v", invitation.ID, err)
+		return errors.ErrInternal(err)
+	}
+
+	for _, consent := range consents {
+		consent.UserID = user.ID
+		if err := r.users.consents.insert(ctx, tx, consent); err != nil && err != sql.ErrNoRows {
+			log.Printf("[OrganizationInvitationRepository] AcceptForNewUser ERROR: failed to record consent, userID=%d, documentID=%d, error=%v", user.ID, consent.LegalDocumentID, err)
+			return errors.ErrInternal(err)
+		}
+	}
+
+	if err := r.accept(ctx, tx, invitation, user.ID); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		log.Printf("[OrganizationInvitationRepository] AcceptForNewUser ERROR: failed to commit, error=%v", err)
+		return errors.ErrInternal(err)
+	}
+
+	log.Printf("[OrganizationInvitationRepository] AcceptForNewUser: success, invitationID=%d, userID=%d, organizationID=%d, duration=%v", invitation.ID, user.ID, invitation.OrganizationID, time.Since(start))
+	return nil
+}
+
+// accept consumes the invitation, upserts the membership and selects the default organization within the given transaction
+func (r *OrganizationInvitationRepositoryImpl) accept(ctx context.Context, tx *sql.Tx, invitation *entities.OrganizationInvitation, userID int) error {
+	result, err := tx.ExecContext(ctx, `
+		UPDATE data.data_organization_invitation
+		SET oin_accepted_at = NOW(),
+		    id_user_accepted = $2
+		WHERE oin_id = $1
+		  AND oin_accepted_at IS NULL
+		  AND oin_revoked_at IS NULL
+		  AND oin_expires_at > NOW()`,
+		invitation.ID, userID,
+	)
+	if err != nil {
+		log.Printf("[OrganizationInvitationRepository] accept ERROR: failed to consume invitation, invitationID=%d, error=%v", invitation.ID, err)
+		return errors.ErrInternal(err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return errors.ErrInternal(err)
+	}
+
+	if rows == 0 {
+		log.Printf("[OrganizationInvitationRepository] accept: invitation no longer pending, invitationID=%d", invitation.ID)
+		return errors.ErrConflict(constants.ErrorMessages.InvitationNotPending)
+	}
+
+	result, err = tx.ExecContext(ctx, `
+		INSERT INTO data.data_organization_membership (
+			id_organization, id_user, id_role, orm_created_date, orm_record_status
+		) VALUES ($1, $2, $3, NOW(), $4)
+		ON CONFLICT (id_organization, id_user) DO UPDATE
+		SET id_role = EXCLUDED.id_role,
+		    orm_updated_date = NOW(),
+		    orm_record_status = EXCLUDED.orm_record_status
+		WHERE data.data_organization_membership.orm_record_status <> EXCLUDED.orm_record_status`,
+		invitation.OrganizationID, userID, invitation.RoleID, constants.RecordStatus.Active,
+	)
+	if err != nil {
+		log.Printf("[OrganizationInvitationRepository] accept ERROR: failed to create membership, userID=%d, error=%v", userID, err)
+		return errors.ErrInternal(err)
+	}
+
+	rows, err = result.RowsAffected()
+	if err != nil {
+		return errors.ErrInternal(err)
+	}
+
+	if rows == 0 {
+		log.Printf("[OrganizationInvitationRepository] accept: user is already a member, userID=%d, organizationID=%d", userID, invitation.OrganizationID)
+		return errors.ErrConflict(constants.ErrorMessages.AlreadyOrganizationMember)
+	}
+
+	if _, err := tx.ExecContext(ctx, `
+		UPDATE data.data_user
+		SET id_organization = COALESCE(id_organization, $2)
+		WHERE use_id = $1`,
+		userID, invitation.OrganizationID,
+	); err != nil {
+		log.Printf("[OrganizationInvitationRepository] accept ERROR: failed to select default organization, userID=%d, error=%v", userID, err)
+		return errors.ErrInternal(err)
+	}
+
+	return nil
+}
+
+// scanInvitation reads one invitation row in invitationSelectColumns order
+func (r *OrganizationInvitationRepositoryImpl) scanInvitation(row rowScanner) (*dbEntities.OrganizationInvitationDB, error) {
+	var dbEntity dbEntities.OrganizationInvitationDB
+
+	err := row.Scan(
+		&dbEntity.OinID,
+		&dbEntity.IdOrganization,
+		&dbEntity.IdRole,
+		&dbEntity.RolCode,
+		&dbEntity.IdUserInvitedBy,
+		&dbEntity.IdUserAccepted,
+		&dbEntity.OinEmail,
+		&dbEntity.OinTokenHash,
+		&dbEntity.OinExpiresAt,
+		&dbEntity.OinAcceptedAt,
+		&dbEntity.OinRevokedAt,
+		&dbEntity.OinCreatedDate,
+		&dbEntity.OinRecordStatus,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dbEntity, nil
+}
@@ -0,0 +1,120 @@
+package repositories
+
+import (
+	"citary-backend/internal/domain/entities"
+	"citary-backend/internal/domain/errors"
+	dbEntities "citary-backend/internal/infrastructure/persistence/postgres/entities"
+	"citary-backend/internal/infrastructure/persistence/postgres/mappers"
+	"citary-backend/pkg/constants"
+	"context"
+	"database/sql"
+	"log"
+	"time"
+)
+
+// membershipSelectColumns lists the membership columns read by every membership query, in scan order
+// Memberships of inactive organizations are never returned
+const membershipSelectColumns = `
+		SELECT m.orm_id, m.id_organization, o.org_name, m.id_user, m.id_role, r.rol_code,
+		       m.orm_created_date, m.orm_updated_date, m.orm_record_status
+		FROM data.data_organization_membership m
+		JOIN data.data_organization o ON o.org_id = m.id_organization AND o.org_record_status = $1
+		JOIN core.core_role r ON r.rol_id = m.id_role`
+
+// OrganizationMembershipRepositoryImpl implements the OrganizationMembershipRepository interface using PostgreSQL
+type OrganizationMembershipRepositoryImpl struct {
+	db     *sql.DB
+	mapper *mappers.OrganizationMembershipMapper
+}
+
+// NewOrganizationMembershipRepositoryImpl creates a new instance of OrganizationMembershipRepositoryImpl
+func NewOrganizationMembershipRepositoryImpl(db *sql.DB) *OrganizationMembershipRepositoryImpl {
+	return &OrganizationMembershipRepositoryImpl{
+		db:     db,
+		mapper: mappers.NewOrganizationMembershipMapper(),
+	}
+}
+
+// FindByUserAndOrganization retrieves the membership of a user in an organization
+// Returns (nil, nil) if not found - business layer decides if that's an error
+func (r *OrganizationMembershipRepositoryImpl) FindByUserAndOrganization(ctx context.Context, userID, organizationID int) (*entities.OrganizationMembership, error) {
+	start := time.Now()
+	log.Printf("[OrganizationMembershipRepository] FindByUserAndOrganization: userID=%d, organizationID=%d", userID, organizationID)
+
+	query := membershipSelectColumns + `
+		WHERE m.id_user = $2 AND m.id_organization = $3`
+
+	dbEntity, err := r.scanMembership(r.db.QueryRowContext(ctx, query, constants.RecordStatus.Active, userID, organizationID))
+
+	duration := time.Since(start)
+
+	if err == sql.ErrNoRows {
+		log.Printf("[OrganizationMembershipRepository] FindByUserAndOrganization: membership not found, userID=%d, organizationID=%d, duration=%v", userID, organizationID, duration)
+		return nil, nil
+	}
+
+	if err != nil {
+		log.Printf("[OrganizationMembershipRepository] FindByUserAndOrganization ERROR: userID=%d, organizationID=%d, error=%v, duration=%v", userID, organizationID, err, duration)
+		return nil, errors.ErrInternal(err)
+	}
+
+	log.Printf("[OrganizationMembershipRepository] FindByUserAndOrganization: success, userID=%d, organizationID=%d, role=%s, duration=%v", userID, organizationID, dbEntity.RolCode, duration)
+	return r.mapper.ToDomainEntity(dbEntity), nil
+}
+
+// FindByUser retrieves every membership of a user, oldest first
+func (r *OrganizationMembershipRepositoryImpl) FindByUser(ctx context.Context, userID int) ([]*entities.OrganizationMembership, error) {
+	start := time.Now()
+	log.Printf("[OrganizationMembershipRepository] FindByUser: userID=%d", userID)
+
+	query := membershipSelectColumns + `
+		WHERE m.id_user = $2
+		ORDER BY m.orm_created_date, m.orm_id`
+
+	rows, err := r.db.QueryContext(ctx, query, constants.RecordStatus.Active, userID)
+	if err != nil {
+		log.Printf("[OrganizationMembershipRepository] FindByUser ERROR: userID=%d, error=%v, duration=%v", userID, err, time.Since(start))
+		return nil, errors.ErrInternal(err)
+	}
+	defer rows.Close()
+
+	memberships := []*entities.OrganizationMembership{}
+	for rows.Next() {
+		dbEntity, err := r.scanMembership(rows)
+		if err != nil {
+			log.Printf("[OrganizationMembershipRepository] FindByUser ERROR: scan failed, userID=%d, error=%v", userID, err)
+			return nil, errors.ErrInternal(err)
+		}
+		memberships = append(memberships, r.mapper.ToDomainEntity(dbEntity))
+	}
+
+	if err := rows.Err(); err != nil {
+		log.Printf("[OrganizationMembershipRepository] FindByUser ERROR: userID=%d, error=%v", userID, err)
+		return nil, errors.ErrInternal(err)
+	}
+
+	log.Printf("[OrganizationMembershipRepository] FindByUser: success, userID=%d, count=%d, duration=%v", userID, len(memberships), time.Since(start))
+	return memberships, nil
+}
+
+// scanMembership reads one membership row in membershipSelectColumns order
+func (r *OrganizationMembershipRepositoryImpl) scanMembership(row rowScanner) (*dbEntities.OrganizationMembershipDB, error) {
+	var dbEntity dbEntities.OrganizationMembershipDB
+
+	err := row.Scan(
+		&dbEntity.OrmID,
+		&dbEntity.IdOrganization,
+		&dbEntity.OrgName,
+		&dbEntity.IdUser,
+		&dbEntity.IdRole,
+		&dbEntity.RolCode,
+		&dbEntity.OrmCreatedDate,
+		&dbEntity.OrmUpdatedDate,
+		&dbEntity.OrmRecordStatus,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dbEntity, nil
+}
@@ -0,0 +1,182 @@
+package repositories
+
+import (
+	"citary-backend/internal/domain/entities"
+	"citary-backend/internal/domain/errors"
+	dbEntities "citary-backend/internal/infrastructure/persistence/postgres/entities"
+	"citary-backend/internal/infrastructure/persistence/postgres/mappers"
+	"citary-backend/pkg/constants"
+	"context"
+	"database/sql"
+	"log"
+	"time"
+)
+
+// organizationSelectColumns lists the data.data_organization columns read by every organization query, in scan order
+const organizationSelectColumns = `
+		SELECT org_id, id_user_owner, org_name, org_slug, org_email, org_phone, org_address,
+		       org_timezone, org_created_date, org_updated_date, org_record_status
+		FROM data.data_organization`
+
+// OrganizationRepositoryImpl implements the OrganizationRepository interface using PostgreSQL
+type OrganizationRepositoryImpl struct {
+	db     *sql.DB
+	mapper *mappers.OrganizationMapper
+}
+
+// NewOrganizationRepositoryImpl creates a new instance of OrganizationRepositoryImpl
+func NewOrganizationRepositoryImpl(db *sql.DB) *OrganizationRepositoryImpl {
+	return &OrganizationRepositoryImpl{
+		db:     db,
+		mapper: mappers.NewOrganizationMapper(),
+	}
+}
+
+// FindByID retrieves an organization by its ID
+// Returns (nil, nil) if not found - business layer decides if that's an error
+func (r *OrganizationRepositoryImpl) FindByID(ctx context.Context, id int) (*entities.Organization, error) {
+	start := time.Now()
+	log.Printf("[OrganizationRepository] FindByID: id=%d", id)
+
+	query := organizationSelectColumns + `
+		WHERE org_id = $1`
+
+	dbEntity, err := r.scanOrganization(r.db.QueryRowContext(ctx, query, id))
+
+	duration := time.Since(start)
+
+	if err == sql.ErrNoRows {
+		log.Printf("[OrganizationRepository] FindByID: organization not found, id=%d, duration=%v", id, duration)
+		return nil, nil
+	}
+
+	if err != nil {
+		log.Printf("[OrganizationRepository] FindByID ERROR: id=%d, error=%v, duration=%v", id, err, duration)
+		return nil, errors.ErrInternal(err)
+	}
+
+	log.Printf("[OrganizationRepository] FindByID: success, id=%d, slug=%s, duration=%v", id, dbEntity.OrgSlug, duration)
+	return r.mapper.ToDomainEntity(dbEntity), nil
+}
+
+// FindBySlug retrieves an organization by its unique slug
+// Returns (nil, nil) if not found - business layer decides if that's an error
+func (r *OrganizationRepositoryImpl) FindBySlug(ctx context.Context, slug string) (*entities.Organization, error) {
+	start := time.Now()
+	log.Printf("[OrganizationRepository] FindBySlug: slug=%s", slug)
+
+	query := organizationSelectColumns + `
+		WHERE org_slug = $1`
+
+	dbEntity, err := r.scanOrganization(r.db.QueryRowContext(ctx, query, slug))
+
+	duration := time.Since(start)
+
+	if err == sql.ErrNoRows {
+		log.Printf("[OrganizationRepository] FindBySlug: organization not found, slug=%s, duration=%v", slug, duration)
+		return nil, nil
+	}
+
+	if err != nil {
+		log.Printf("[OrganizationRepository] FindBySlug ERROR: slug=%s, error=%v, duration=%v", slug, err, duration)
+		return nil, errors.ErrInternal(err)
+	}
+
+	log.Printf("[OrganizationRepository] FindBySlug: success, slug=%s, id=%d, duration=%v", slug, dbEntity.OrgID, duration)
+	return r.mapper.ToDomainEntity(dbEntity), nil
+}
+
+// CreateWithOwner atomically persists a new organization, makes its owner a member with the given role
+// and selects it as the owner's default organization
+func (r *OrganizationRepositoryImpl) CreateWithOwner(ctx context.Context, organization *entities.Organization, ownerRoleID int) error {
+	start := time.Now()
+	log.Printf("[OrganizationRepository] CreateWithOwner: slug=%s, ownerUserID=%d", organization.Slug, organization.OwnerUserID)
+
+	dbEntity := r.mapper.ToDBEntity(organization)
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		log.Printf("[OrganizationRepository] CreateWithOwner ERROR: failed to begin transaction, error=%v", err)
+		return errors.ErrInternal(err)
+	}
+	defer tx.Rollback()
+
+	err = tx.QueryRowContext(ctx, `
+		INSERT INTO data.data_organization (
+			id_user_owner, org_name, org_slug, org_email, org_phone, org_address,
+			org_timezone, org_created_date, org_record_status
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		RETURNING org_id`,
+		dbEntity.IdUserOwner,
+		dbEntity.OrgName,
+		dbEntity.OrgSlug,
+		dbEntity.OrgEmail,
+		dbEntity.OrgPhone,
+		dbEntity.OrgAddress,
+		dbEntity.OrgTimezone,
+		dbEntity.OrgCreatedDate,
+		dbEntity.OrgRecordStatus,
+	).Scan(&organization.ID)
+
+	if isUniqueViolation(err) {
+		log.Printf("[OrganizationRepository] CreateWithOwner: slug already taken, slug=%s, duration=%v", organization.Slug, time.Since(start))
+		return errors.ErrConflict(constants.ErrorMessages.OrganizationSlugTaken)
+	}
+
+	if err != nil {
+		log.Printf("[OrganizationRepository] CreateWithOwner ERROR: slug=%s, error=%v", organization.Slug, err)
+		return errors.ErrInternal(err)
+	}
+
+	if _, err := tx.ExecContext(ctx, `
+		INSERT INTO data.data_organization_membership (
+			id_organization, id_user, id_role, orm_created_date, orm_record_status
+		) VALUES ($1, $2, $3, $4, $5)`,
+		organization.ID, organization.OwnerUserID, ownerRoleID, dbEntity.OrgCreatedDate, constants.RecordStatus.Active,
+	); err != nil {
+		log.Printf("[OrganizationRepository] CreateWithOwner ERROR: failed to create owner membership, userID=%d, error=%v", organization.OwnerUserID, err)
+		return errors.ErrInternal(err)
+	}
+
+	if _, err := tx.ExecContext(ctx, `
+		UPDATE data.data_user
+		SET id_organization = $2
+		WHERE use_id = $1`,
+		organization.OwnerUserID, organization.ID,
+	); err != nil {
+		log.Printf("[OrganizationRepository] CreateWithOwner ERROR: failed to select default organization, userID=%d, error=%v", organization.OwnerUserID, err)
+		return errors.ErrInternal(err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		log.Printf("[OrganizationRepository] CreateWithOwner ERROR: failed to commit, error=%v", err)
+		return errors.ErrInternal(err)
+	}
+
+	log.Printf("[OrganizationRepository] CreateWithOwner: success, organizationID=%d, slug=%s, duration=%v", organization.ID, organization.Slug, time.Since(start))
+	return nil
+}
+
+// scanOrganization reads one organization row in organizationSelectColumns order
+func (r *OrganizationRepositoryImpl) scanOrganization(row rowScanner) (*dbEntities.OrganizationDB, error) {
+	var dbEntity dbEntities.OrganizationDB
+
+	err := row.Scan(
+		&dbEntity.OrgID,
+		&dbEntity.IdUserOwner,
+		&dbEntity.OrgName,
+		&dbEntity.OrgSlug,
+		&dbEntity.OrgEmail,
+		&dbEntity.OrgPhone,
+		&dbEntity.OrgAddress,
+		&dbEntity.OrgTimezone,
+		&dbEntity.OrgCreatedDate,
+		&dbEntity.OrgUpdatedDate,
+		&dbEntity.OrgRecordStatus,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dbEntity, nil
+}
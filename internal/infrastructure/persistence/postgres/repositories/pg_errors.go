@@ -0,0 +1,35 @@
+package repositories
+
+import (
+	stdErrors "errors"
+
+	"github.com/lib/pq"
+)
+
+// PostgreSQL error codes the repositories translate into domain errors
+const (
+	pgUniqueViolation    = "23505"
+	pgExclusionViolation = "23P01"
+)
+
+// isUniqueViolation reports whether err is a PostgreSQL unique constraint violation
+func isUniqueViolation(err error) bool {
+	return hasPgErrorCode(err, pgUniqueViolation)
+}
+
+// isExclusionViolation reports whether err is a PostgreSQL exclusion constraint violation
+func isExclusionViolation(err error) bool {
+	return hasPgErrorCode(err, pgExclusionViolation)
+}
+
+// isUniqueViolationOn reports whether err is a violation of the named PostgreSQL unique constraint
+func isUniqueViolationOn(err error, constraint string) bool {
+	var pgErr *pq.Error
+	return stdErrors.As(err, &pgErr) && string(pgErr.Code) == pgUniqueViolation && pgErr.Constraint == constraint
+}
+
+// hasPgErrorCode reports whether err wraps a PostgreSQL error with the given SQLSTATE code
+func hasPgErrorCode(err error, code string) bool {
+	var pgErr *pq.Error
+	return stdErrors.As(err, &pgErr) && string(pgErr.Code) == code
+}
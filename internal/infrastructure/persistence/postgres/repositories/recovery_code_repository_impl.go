@@ -0,0 +1,107 @@
+package repositories
+
+import (
+	"citary-backend/internal/domain/errors"
+	"context"
+	"database/sql"
+	"log"
+	"time"
+)
+
+// RecoveryCodeRepositoryImpl implements the RecoveryCodeRepository interface using PostgreSQL
+type RecoveryCodeRepositoryImpl struct {
+	db *sql.DB
+}
+
+// NewRecoveryCodeRepositoryImpl creates a new instance of RecoveryCodeRepositoryImpl
+func NewRecoveryCodeRepositoryImpl(db *sql.DB) *RecoveryCodeRepositoryImpl {
+	return &RecoveryCodeRepositoryImpl{
+		db: db,
+	}
+}
+
+// ReplaceForUser atomically discards the user's existing codes and stores the new set
+func (r *RecoveryCodeRepositoryImpl) ReplaceForUser(ctx context.Context, userID int, codeHashes []string) error {
+	start := time.Now()
+	log.Printf("[RecoveryCodeRepository] ReplaceForUser: userID=%d, count=%d", userID, len(codeHashes))
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		log.Printf("[RecoveryCodeRepository] ReplaceForUser ERROR: failed to begin transaction, error=%v", err)
+		return errors.ErrInternal(err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM data.data_recovery_code WHERE id_user = $1`, userID); err != nil {
+		log.Printf("[RecoveryCodeRepository] ReplaceForUser ERROR: userID=%d, error=%v", userID, err)
+		return errors.ErrInternal(err)
+	}
+
+	for _, codeHash := range codeHashes {
+		if _, err := tx.ExecContext(ctx, `
+			INSERT INTO data.data_recovery_code (id_user, rco_code_hash, rco_created_date, rco_record_status)
+			VALUES ($1, $2, NOW(), '0')`,
+			userID, codeHash,
+		); err != nil {
+			log.Printf("[RecoveryCodeRepository] ReplaceForUser ERROR: userID=%d, error=%v", userID, err)
+			return errors.ErrInternal(err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		log.Printf("[RecoveryCodeRepository] ReplaceForUser ERROR: failed to commit, userID=%d, error=%v", userID, err)
+		return errors.ErrInternal(err)
+	}
+
+	log.Printf("[RecoveryCodeRepository] ReplaceForUser: success, userID=%d, duration=%v", userID, time.Since(start))
+	return nil
+}
+
+// Consume atomically marks an unused code as used
+func (r *RecoveryCodeRepositoryImpl) Consume(ctx context.Context, userID int, codeHash string) (bool, error) {
+	start := time.Now()
+	log.Printf("[RecoveryCodeRepository] Consume: userID=%d", userID)
+
+	query := `
+		UPDATE data.data_recovery_code
+		SET rco_used_at = NOW()
+		WHERE rco_id = (
+			SELECT rco_id FROM data.data_recovery_code
+			WHERE id_user = $1 AND rco_code_hash = $2 AND rco_used_at IS NULL
+			LIMIT 1
+			FOR UPDATE SKIP LOCKED
+		)
+	`
+
+	result, err := r.db.ExecContext(ctx, query, userID, codeHash)
+	if err != nil {
+		log.Printf("[RecoveryCodeRepository] Consume ERROR: userID=%d, error=%v, duration=%v", userID, err, time.Since(start))
+		return false, errors.ErrInternal(err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, errors.ErrInternal(err)
+	}
+
+	log.Printf("[RecoveryCodeRepository] Consume: success, userID=%d, consumed=%v, duration=%v", userID, rows > 0, time.Since(start))
+	return rows > 0, nil
+}
+
+// DeleteForUser discards every code belonging to the user
+func (r *RecoveryCodeRepositoryImpl) DeleteForUser(ctx context.Context, userID int) error {
+	start := time.Now()
+	log.Printf("[RecoveryCodeRepository] DeleteForUser: userID=%d", userID)
+
+	_, err := r.db.ExecContext(ctx, `DELETE FROM data.data_recovery_code WHERE id_user = $1`, userID)
+
+	duration := time.Since(start)
+
+	if err != nil {
+		log.Printf("[RecoveryCodeRepository] DeleteForUser ERROR: userID=%d, error=%v, duration=%v", userID, err, duration)
+		return errors.ErrInternal(err)
+	}
+
+	log.Printf("[RecoveryCodeRepository] DeleteForUser: success, userID=%d, duration=%v", userID, duration)
+	return nil
+}
@@ -0,0 +1,284 @@
+package repositories
+
+import (
+	"citary-backend/internal/domain/entities"
+	"citary-backend/internal/domain/errors"
+	dbEntities "citary-backend/internal/infrastructure/persistence/postgres/entities"
+	"citary-backend/internal/infrastructure/persistence/postgres/mappers"
+	"context"
+	"database/sql"
+	"log"
+	"time"
+)
+
+// RefreshTokenRepositoryImpl implements the RefreshTokenRepository interface using PostgreSQL
+type RefreshTokenRepositoryImpl struct {
+	db     *sql.DB
+	mapper *mappers.RefreshTokenMapper
+}
+
+// NewRefreshTokenRepositoryImpl creates a new instance of RefreshTokenRepositoryImpl
+func NewRefreshTokenRepositoryImpl(db *sql.DB) *RefreshTokenRepositoryImpl {
+	return &RefreshTokenRepositoryImpl{
+		db:     db,
+		mapper: mappers.NewRefreshTokenMapper(),
+	}
+}
+
+// Create persists a new refresh token
+func (r *RefreshTokenRepositoryImpl) Create(ctx context.Context, token *entities.RefreshToken) error {
+	start := time.Now()
+	log.Printf("[RefreshTokenRepository] Create: userID=%d, familyID=%s", token.UserID, token.FamilyID)
+
+	err := r.insert(ctx, r.db, token)
+
+	duration := time.Since(start)
+
+	if err != nil {
+		log.Printf("[RefreshTokenRepository] Create ERROR: userID=%d, error=%v, duration=%v", token.UserID, err, duration)
+		return errors.ErrInternal(err)
+	}
+
+	log.Printf("[RefreshTokenRepository] Create: success, userID=%d, tokenID=%d, duration=%v", token.UserID, token.ID, duration)
+	return nil
+}
+
+// FindByTokenHash retrieves a refresh token by the hash of its opaque value
+// Returns (nil, nil) if not found - business layer decides if that's an error
+func (r *RefreshTokenRepositoryImpl) FindByTokenHash(ctx context.Context, tokenHash string) (*entities.RefreshToken, error) {
+	start := time.Now()
+	log.Printf("[RefreshTokenRepository] FindByTokenHash")
+
+	query := `
+		SELECT ref_id, id_user, id_organization, ref_family_id, ref_token_hash, ref_device_name,
+		       ref_user_agent, ref_ip_address, ref_expires_at, ref_revoked_at,
+		       ref_replaced_by, ref_created_date, ref_record_status
+		FROM data.data_refresh_token
+		WHERE ref_token_hash = $1`
+
+	var dbEntity dbEntities.RefreshTokenDB
+
+	err := r.db.QueryRowContext(ctx, query, tokenHash).Scan(
+		&dbEntity.RefID,
+		&dbEntity.IdUser,
+		&dbEntity.IdOrganization,
+		&dbEntity.RefFamilyID,
+		&dbEntity.RefTokenHash,
+		&dbEntity.RefDeviceName,
+		&dbEntity.RefUserAgent,
+		&dbEntity.RefIPAddress,
+		&dbEntity.RefExpiresAt,
+		&dbEntity.RefRevokedAt,
+		&dbEntity.RefReplacedBy,
+		&dbEntity.RefCreatedDate,
+		&dbEntity.RefRecordStatus,
+	)
+
+	duration := time.Since(start)
+
+	if err == sql.ErrNoRows {
+		log.Printf("[RefreshTokenRepository] FindByTokenHash: token not found, duration=%v", duration)
+		return nil, nil
+	}
+
+	if err != nil {
+		log.Printf("[RefreshTokenRepository] FindByTokenHash ERROR: error=%v, duration=%v", err, duration)
+		return nil, errors.ErrInternal(err)
+	}
+
+	log.Printf("[RefreshTokenRepository] FindByTokenHash: success, tokenID=%d, userID=%d, duration=%v", dbEntity.RefID, dbEntity.IdUser, duration)
+	return r.mapper.ToDomainEntity(&dbEntity), nil
+}
+
+// Rotate atomically revokes the current token and persists its replacement
+// The conditional UPDATE guarantees only one concurrent caller can rotate a given token
+func (r *RefreshTokenRepositoryImpl) Rotate(ctx context.Context, current *entities.RefreshToken, next *entities.RefreshToken) (bool, error) {
+	start := time.Now()
+	log.Printf("[RefreshTokenRepository] Rotate: tokenID=%d, familyID=%s", current.ID, current.FamilyID)
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		log.Printf("[RefreshTokenRepository] Rotate ERROR: failed to begin transaction, error=%v", err)
+		return false, errors.ErrInternal(err)
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx, `
+		UPDATE data.data_refresh_token
+		SET ref_revoked_at = NOW()
+		WHERE ref_id = $1 AND ref_revoked_at IS NULL`,
+		current.ID,
+	)
+	if err != nil {
+		log.Printf("[RefreshTokenRepository] Rotate ERROR: tokenID=%d, error=%v", current.ID, err)
+		return false, errors.ErrInternal(err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, errors.ErrInternal(err)
+	}
+
+	if rows == 0 {
+		log.Printf("[RefreshTokenRepository] Rotate: token already revoked, tokenID=%d, duration=%v", current.ID, time.Since(start))
+		return false, nil
+	}
+
+	if err := r.insert(ctx, tx, next); err != nil {
+		log.Printf("[RefreshTokenRepository] Rotate ERROR: failed to insert replacement, tokenID=%d, error=%v", current.ID, err)
+		return false, errors.ErrInternal(err)
+	}
+
+	if _, err := tx.ExecContext(ctx, `
+		UPDATE data.data_refresh_token
+		SET ref_replaced_by = $2
+		WHERE ref_id = $1`,
+		current.ID, next.ID,
+	); err != nil {
+		log.Printf("[RefreshTokenRepository] Rotate ERROR: failed to link replacement, tokenID=%d, error=%v", current.ID, err)
+		return false, errors.ErrInternal(err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		log.Printf("[RefreshTokenRepository] Rotate ERROR: failed to commit, tokenID=%d, error=%v", current.ID, err)
+		return false, errors.ErrInternal(err)
+	}
+
+	now := time.Now()
+	current.RevokedAt = &now
+	current.ReplacedByID = &next.ID
+
+	log.Printf("[RefreshTokenRepository] Rotate: success, tokenID=%d, replacedBy=%d, duration=%v", current.ID, next.ID, time.Since(start))
+	return true, nil
+}
+
+// RevokeFamily revokes every active token in a token family
+func (r *RefreshTokenRepositoryImpl) RevokeFamily(ctx context.Context, familyID string) error {
+	start := time.Now()
+	log.Printf("[RefreshTokenRepository] RevokeFamily: familyID=%s", familyID)
+
+	query := `
+		UPDATE data.data_refresh_token
+		SET ref_revoked_at = NOW()
+		WHERE ref_family_id = $1 AND ref_revoked_at IS NULL
+	`
+
+	result, err := r.db.ExecContext(ctx, query, familyID)
+
+	duration := time.Since(start)
+
+	if err != nil {
+		log.Printf("[RefreshTokenRepository] RevokeFamily ERROR: familyID=%s, error=%v, duration=%v", familyID, err, duration)
+		return errors.ErrInternal(err)
+	}
+
+	rows, _ := result.RowsAffected()
+	log.Printf("[RefreshTokenRepository] RevokeFamily: success, familyID=%s, revoked=%d, duration=%v", familyID, rows, duration)
+	return nil
+}
+
+// RevokeAllForUser revokes every active token belonging to a user
+func (r *RefreshTokenRepositoryImpl) RevokeAllForUser(ctx context.Context, userID int) error {
+	start := time.Now()
+	log.Printf("[RefreshTokenRepository] RevokeAllForUser: userID=%d", userID)
+
+	query := `
+		UPDATE data.data_refresh_token
+		SET ref_revoked_at = NOW()
+		WHERE id_user = $1 AND ref_revoked_at IS NULL
+	`
+
+	result, err := r.db.ExecContext(ctx, query, userID)
+
+	duration := time.Since(start)
+
+	if err != nil {
+		log.Printf("[RefreshTokenRepository] RevokeAllForUser ERROR: userID=%d, error=%v, duration=%v", userID, err, duration)
+		return errors.ErrInternal(err)
+	}
+
+	rows, _ := result.RowsAffected()
+	log.Printf("[RefreshTokenRepository] RevokeAllForUser: success, userID=%d, revoked=%d, duration=%v", userID, rows, duration)
+	return nil
+}
+
+// FindByUser retrieves every refresh token issued to a user, newest first
+func (r *RefreshTokenRepositoryImpl) FindByUser(ctx context.Context, userID int) ([]*entities.RefreshToken, error) {
+	start := time.Now()
+	log.Printf("[RefreshTokenRepository] FindByUser: userID=%d", userID)
+
+	query := `
+		SELECT ref_id, id_user, id_organization, ref_family_id, ref_token_hash, ref_device_name, ref_user_agent,
+		       ref_ip_address, ref_expires_at, ref_revoked_at, ref_replaced_by,
+		       ref_created_date, ref_record_status
+		FROM data.data_refresh_token
+		WHERE id_user = $1
+		ORDER BY ref_created_date DESC`
+
+	rows, err := r.db.QueryContext(ctx, query, userID)
+	if err != nil {
+		log.Printf("[RefreshTokenRepository] FindByUser ERROR: userID=%d, error=%v, duration=%v", userID, err, time.Since(start))
+		return nil, errors.ErrInternal(err)
+	}
+	defer rows.Close()
+
+	tokens := []*entities.RefreshToken{}
+	for rows.Next() {
+		var dbEntity dbEntities.RefreshTokenDB
+		if err := rows.Scan(
+			&dbEntity.RefID,
+			&dbEntity.IdUser,
+			&dbEntity.IdOrganization,
+			&dbEntity.RefFamilyID,
+			&dbEntity.RefTokenHash,
+			&dbEntity.RefDeviceName,
+			&dbEntity.RefUserAgent,
+			&dbEntity.RefIPAddress,
+			&dbEntity.RefExpiresAt,
+			&dbEntity.RefRevokedAt,
+			&dbEntity.RefReplacedBy,
+			&dbEntity.RefCreatedDate,
+			&dbEntity.RefRecordStatus,
+		); err != nil {
+			log.Printf("[RefreshTokenRepository] FindByUser ERROR: scan failed, userID=%d, error=%v", userID, err)
+			return nil, errors.ErrInternal(err)
+		}
+		tokens = append(tokens, r.mapper.ToDomainEntity(&dbEntity))
+	}
+
+	if err := rows.Err(); err != nil {
+		log.Printf("[RefreshTokenRepository] FindByUser ERROR: userID=%d, error=%v, duration=%v", userID, err, time.Since(start))
+		return nil, errors.ErrInternal(err)
+	}
+
+	log.Printf("[RefreshTokenRepository] FindByUser: success, userID=%d, count=%d, duration=%v", userID, len(tokens), time.Since(start))
+	return tokens, nil
+}
+
+// insert writes a refresh token row using the given executor and sets the generated ID
+func (r *RefreshTokenRepositoryImpl) insert(ctx context.Context, q queryRower, token *entities.RefreshToken) error {
+	dbEntity := r.mapper.ToDBEntity(token)
+
+	query := `
+		INSERT INTO data.data_refresh_token (
+			id_user, id_organization, ref_family_id, ref_token_hash, ref_device_name, ref_user_agent,
+			ref_ip_address, ref_expires_at, ref_created_date, ref_record_status
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		RETURNING ref_id
+	`
+
+	return q.QueryRowContext(
+		ctx,
+		query,
+		dbEntity.IdUser,
+		dbEntity.IdOrganization,
+		dbEntity.RefFamilyID,
+		dbEntity.RefTokenHash,
+		dbEntity.RefDeviceName,
+		dbEntity.RefUserAgent,
+		dbEntity.RefIPAddress,
+		dbEntity.RefExpiresAt,
+		dbEntity.RefCreatedDate,
+		dbEntity.RefRecordStatus,
+	).Scan(&token.ID)
+}
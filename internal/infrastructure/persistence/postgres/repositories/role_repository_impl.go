@@ -11,6 +11,12 @@ import (
 	"time"
 )
 
+// roleSelectColumns lists the core.core_role columns read by every role query, in scan order
+const roleSelectColumns = `
+		SELECT rol_id, rol_name, rol_code, rol_description, rol_permissions,
+		       rol_created_date, rol_record_status
+		FROM core.core_role`
+
 // RoleRepositoryImpl implements the RoleRepository interface using PostgreSQL
 type RoleRepositoryImpl struct {
 	db     *sql.DB
@@ -32,23 +38,10 @@ func (r *RoleRepositoryImpl) FindByCode(ctx context.Context, code string) (*enti
 	start := time.Now()
 	log.Printf("[RoleRepository] FindByCode: code=%s", code)
 
-	query := `
-		SELECT rol_id, rol_name, rol_code, rol_description, rol_permissions,
-		       rol_created_date, rol_record_status
-		FROM core.core_role
+	query := roleSelectColumns + `
 		WHERE rol_code = $1`
 
-	var dbEntity dbEntities.RoleDB
-
-	err := r.db.QueryRowContext(ctx, query, code).Scan(
-		&dbEntity.RolID,
-		&dbEntity.RolName,
-		&dbEntity.RolCode,
-		&dbEntity.RolDescription,
-		&dbEntity.RolPermissions,
-		&dbEntity.RolCreatedDate,
-		&dbEntity.RolRecordStatus,
-	)
+	dbEntity, err := r.scanRole(r.db.QueryRowContext(ctx, query, code))
 
 	duration := time.Since(start)
 
@@ -66,5 +59,176 @@ func (r *RoleRepositoryImpl) FindByCode(ctx context.Context, code string) (*enti
 
 	log.Printf("[RoleRepository] FindByCode: success, code=%s, roleID=%d, roleName=%s, status=%s, duration=%v",
 		code, dbEntity.RolID, dbEntity.RolName, dbEntity.RolRecordStatus, duration)
-	return r.mapper.ToDomainEntity(&dbEntity), nil
+	return r.mapper.ToDomainEntity(dbEntity), nil
+}
+
+// FindByID retrieves a role by its ID
+// Returns (nil, nil) if not found - business layer decides if that's an error
+func (r *RoleRepositoryImpl) FindByID(ctx context.Context, id int) (*entities.Role, error) {
+	start := time.Now()
+	log.Printf("[RoleRepository] FindByID: id=%d", id)
+
+	query := roleSelectColumns + `
+		WHERE rol_id = $1`
+
+	dbEntity, err := r.scanRole(r.db.QueryRowContext(ctx, query, id))
+
+	duration := time.Since(start)
+
+	if err == sql.ErrNoRows {
+		log.Printf("[RoleRepository] FindByID: role not found, id=%d, duration=%v", id, duration)
+		return nil, nil
+	}
+
+	if err != nil {
+		log.Printf("[RoleRepository] FindByID ERROR: id=%d, error=%v, duration=%v", id, err, duration)
+		return nil, errors.ErrInternal(err)
+	}
+
+	log.Printf("[RoleRepository] FindByID: success, id=%d, roleCode=%s, status=%s, duration=%v",
+		id, dbEntity.RolCode, dbEntity.RolRecordStatus, duration)
+	return r.mapper.ToDomainEntity(dbEntity), nil
+}
+
+// FindAll retrieves every role, active or not, ordered by name
+func (r *RoleRepositoryImpl) FindAll(ctx context.Context) ([]*entities.Role, error) {
+	start := time.Now()
+	log.Printf("[RoleRepository] FindAll")
+
+	query := roleSelectColumns + `
+		ORDER BY rol_name`
+
+	rows, err := r.db.QueryContext(ctx, query)
+	if err != nil {
+		log.Printf("[RoleRepository] FindAll ERROR: error=%v, duration=%v", err, time.Since(start))
+		return nil, errors.ErrInternal(err)
+	}
+	defer rows.Close()
+
+	roles := make([]*entities.Role, 0)
+	for rows.Next() {
+		dbEntity, err := r.scanRole(rows)
+		if err != nil {
+			log.Printf("[RoleRepository] FindAll ERROR: scan failed, error=%v, duration=%v", err, time.Since(start))
+			return nil, errors.ErrInternal(err)
+		}
+		roles = append(roles, r.mapper.ToDomainEntity(dbEntity))
+	}
+
+	if err := rows.Err(); err != nil {
+		log.Printf("[RoleRepository] FindAll ERROR: error=%v, duration=%v", err, time.Since(start))
+		return nil, errors.ErrInternal(err)
+	}
+
+	log.Printf("[RoleRepository] FindAll: success, count=%d, duration=%v", len(roles), time.Since(start))
+	return roles, nil
+}
+
+// Create persists a new role and sets its ID
+func (r *RoleRepositoryImpl) Create(ctx context.Context, role *entities.Role) error {
+	start := time.Now()
+	log.Printf("[RoleRepository] Create: code=%s", role.Code)
+
+	dbEntity := r.mapper.ToDBEntity(role)
+
+	query := `
+		INSERT INTO core.core_role (
+			rol_name, rol_code, rol_description, rol_permissions,
+			rol_created_date, rol_record_status
+		) VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING rol_id
+	`
+
+	err := r.db.QueryRowContext(
+		ctx,
+		query,
+		dbEntity.RolName,
+		dbEntity.RolCode,
+		dbEntity.RolDescription,
+		dbEntity.RolPermissions,
+		dbEntity.RolCreatedDate,
+		dbEntity.RolRecordStatus,
+	).Scan(&role.ID)
+
+	duration := time.Since(start)
+
+	if err != nil {
+		log.Printf("[RoleRepository] Create ERROR: code=%s, error=%v, duration=%v", role.Code, err, duration)
+		return errors.ErrInternal(err)
+	}
+
+	log.Printf("[RoleRepository] Create: success, code=%s, roleID=%d, duration=%v", role.Code, role.ID, duration)
+	return nil
+}
+
+// Update persists the name, description and permissions of an existing role
+func (r *RoleRepositoryImpl) Update(ctx context.Context, role *entities.Role) error {
+	start := time.Now()
+	log.Printf("[RoleRepository] Update: roleID=%d", role.ID)
+
+	dbEntity := r.mapper.ToDBEntity(role)
+
+	query := `
+		UPDATE core.core_role
+		SET rol_name = $2,
+		    rol_description = $3,
+		    rol_permissions = $4
+		WHERE rol_id = $1
+	`
+
+	_, err := r.db.ExecContext(ctx, query, dbEntity.RolID, dbEntity.RolName, dbEntity.RolDescription, dbEntity.RolPermissions)
+
+	duration := time.Since(start)
+
+	if err != nil {
+		log.Printf("[RoleRepository] Update ERROR: roleID=%d, error=%v, duration=%v", role.ID, err, duration)
+		return errors.ErrInternal(err)
+	}
+
+	log.Printf("[RoleRepository] Update: success, roleID=%d, duration=%v", role.ID, duration)
+	return nil
+}
+
+// UpdateStatus sets the record status of a role
+func (r *RoleRepositoryImpl) UpdateStatus(ctx context.Context, id int, status string) error {
+	start := time.Now()
+	log.Printf("[RoleRepository] UpdateStatus: roleID=%d, status=%s", id, status)
+
+	query := `
+		UPDATE core.core_role
+		SET rol_record_status = $2
+		WHERE rol_id = $1
+	`
+
+	_, err := r.db.ExecContext(ctx, query, id, status)
+
+	duration := time.Since(start)
+
+	if err != nil {
+		log.Printf("[RoleRepository] UpdateStatus ERROR: roleID=%d, error=%v, duration=%v", id, err, duration)
+		return errors.ErrInternal(err)
+	}
+
+	log.Printf("[RoleRepository] UpdateStatus: success, roleID=%d, status=%s, duration=%v", id, status, duration)
+	return nil
+}
+
+// scanRole scans a single row selected with roleSelectColumns into a RoleDB entity
+func (r *RoleRepositoryImpl) scanRole(row rowScanner) (*dbEntities.RoleDB, error) {
+	var dbEntity dbEntities.RoleDB
+
+	err := row.Scan(
+		&dbEntity.RolID,
+		&dbEntity.RolName,
+		&dbEntity.RolCode,
+		&dbEntity.RolDescription,
+		&dbEntity.RolPermissions,
+		&dbEntity.RolCreatedDate,
+		&dbEntity.RolRecordStatus,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dbEntity, nil
 }
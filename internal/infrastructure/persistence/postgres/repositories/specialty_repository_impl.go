@@ -0,0 +1,102 @@
+package repositories
+
+import (
+	"citary-backend/internal/domain/entities"
+	"citary-backend/internal/domain/errors"
+	dbEntities "citary-backend/internal/infrastructure/persistence/postgres/entities"
+	"citary-backend/internal/infrastructure/persistence/postgres/mappers"
+	"citary-backend/pkg/constants"
+	"context"
+	"database/sql"
+	"log"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// specialtySelectColumns lists the core.core_specialty columns read by every specialty query, in scan order
+const specialtySelectColumns = `
+		SELECT spe_id, spe_code, spe_name, spe_created_date, spe_record_status
+		FROM core.core_specialty`
+
+// SpecialtyRepositoryImpl implements the SpecialtyRepository interface using PostgreSQL
+type SpecialtyRepositoryImpl struct {
+	db     *sql.DB
+	mapper *mappers.SpecialtyMapper
+}
+
+// NewSpecialtyRepositoryImpl creates a new instance of SpecialtyRepositoryImpl
+func NewSpecialtyRepositoryImpl(db *sql.DB) *SpecialtyRepositoryImpl {
+	return &SpecialtyRepositoryImpl{
+		db:     db,
+		mapper: mappers.NewSpecialtyMapper(),
+	}
+}
+
+// FindActive retrieves every active specialty ordered by name
+func (r *SpecialtyRepositoryImpl) FindActive(ctx context.Context) ([]*entities.Specialty, error) {
+	start := time.Now()
+	log.Printf("[SpecialtyRepository] FindActive")
+
+	query := specialtySelectColumns + `
+		WHERE spe_record_status = $1
+		ORDER BY spe_name`
+
+	specialties, err := r.querySpecialties(ctx, query, constants.RecordStatus.Active)
+	if err != nil {
+		log.Printf("[SpecialtyRepository] FindActive ERROR: error=%v, duration=%v", err, time.Since(start))
+		return nil, errors.ErrInternal(err)
+	}
+
+	log.Printf("[SpecialtyRepository] FindActive: success, count=%d, duration=%v", len(specialties), time.Since(start))
+	return specialties, nil
+}
+
+// FindActiveByCodes retrieves the active specialties matching the given codes
+func (r *SpecialtyRepositoryImpl) FindActiveByCodes(ctx context.Context, codes []string) ([]*entities.Specialty, error) {
+	start := time.Now()
+	log.Printf("[SpecialtyRepository] FindActiveByCodes: codes=%v", codes)
+
+	query := specialtySelectColumns + `
+		WHERE spe_record_status = $1 AND spe_code = ANY($2)
+		ORDER BY spe_name`
+
+	specialties, err := r.querySpecialties(ctx, query, constants.RecordStatus.Active, pq.Array(codes))
+	if err != nil {
+		log.Printf("[SpecialtyRepository] FindActiveByCodes ERROR: codes=%v, error=%v, duration=%v", codes, err, time.Since(start))
+		return nil, errors.ErrInternal(err)
+	}
+
+	log.Printf("[SpecialtyRepository] FindActiveByCodes: success, requested=%d, found=%d, duration=%v", len(codes), len(specialties), time.Since(start))
+	return specialties, nil
+}
+
+// querySpecialties runs a specialty list query and maps every row
+func (r *SpecialtyRepositoryImpl) querySpecialties(ctx context.Context, query string, args ...any) ([]*entities.Specialty, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	specialties := []*entities.Specialty{}
+	for rows.Next() {
+		var dbEntity dbEntities.SpecialtyDB
+		if err := rows.Scan(
+			&dbEntity.SpeID,
+			&dbEntity.SpeCode,
+			&dbEntity.SpeName,
+			&dbEntity.SpeCreatedDate,
+			&dbEntity.SpeRecordStatus,
+		); err != nil {
+			return nil, err
+		}
+		specialties = append(specialties, r.mapper.ToDomainEntity(&dbEntity))
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return specialties, nil
+}
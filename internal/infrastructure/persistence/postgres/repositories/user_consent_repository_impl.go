@@ -0,0 +1,128 @@
+package repositories
+
+import (
+	"citary-backend/internal/domain/entities"
+	"citary-backend/internal/domain/errors"
+	dbEntities "citary-backend/internal/infrastructure/persistence/postgres/entities"
+	"citary-backend/internal/infrastructure/persistence/postgres/mappers"
+	"context"
+	"database/sql"
+	"log"
+	"time"
+)
+
+// UserConsentRepositoryImpl implements the UserConsentRepository interface using PostgreSQL
+type UserConsentRepositoryImpl struct {
+	db     *sql.DB
+	mapper *mappers.UserConsentMapper
+}
+
+// NewUserConsentRepositoryImpl creates a new instance of UserConsentRepositoryImpl
+func NewUserConsentRepositoryImpl(db *sql.DB) *UserConsentRepositoryImpl {
+	return &UserConsentRepositoryImpl{
+		db:     db,
+		mapper: mappers.NewUserConsentMapper(),
+	}
+}
+
+// Create records a user's acceptance of a document version
+// ON CONFLICT keeps the first acceptance; the consent ID is left unset when the version was already accepted
+func (r *UserConsentRepositoryImpl) Create(ctx context.Context, consent *entities.UserConsent) error {
+	start := time.Now()
+	log.Printf("[UserConsentRepository] Create: userID=%d, documentID=%d", consent.UserID, consent.LegalDocumentID)
+
+	err := r.insert(ctx, r.db, consent)
+
+	duration := time.Since(start)
+
+	if err == sql.ErrNoRows {
+		log.Printf("[UserConsentRepository] Create: already accepted, userID=%d, documentID=%d, duration=%v", consent.UserID, consent.LegalDocumentID, duration)
+		return nil
+	}
+
+	if err != nil {
+		log.Printf("[UserConsentRepository] Create ERROR: userID=%d, documentID=%d, error=%v, duration=%v", consent.UserID, consent.LegalDocumentID, err, duration)
+		return errors.ErrInternal(err)
+	}
+
+	log.Printf("[UserConsentRepository] Create: success, userID=%d, documentID=%d, consentID=%d, duration=%v", consent.UserID, consent.LegalDocumentID, consent.ID, duration)
+	return nil
+}
+
+// FindByUser retrieves the consent history of a user, oldest first
+func (r *UserConsentRepositoryImpl) FindByUser(ctx context.Context, userID int) ([]*entities.UserConsent, error) {
+	start := time.Now()
+	log.Printf("[UserConsentRepository] FindByUser: userID=%d", userID)
+
+	query := `
+		SELECT c.uco_id, c.id_user, c.id_legal_document, d.led_type, d.led_version,
+		       c.uco_accepted_at, c.uco_ip_address, c.uco_user_agent,
+		       c.uco_created_date, c.uco_record_status
+		FROM data.data_user_consent c
+		JOIN data.data_legal_document d ON d.led_id = c.id_legal_document
+		WHERE c.id_user = $1
+		ORDER BY c.uco_accepted_at, c.uco_id`
+
+	rows, err := r.db.QueryContext(ctx, query, userID)
+	if err != nil {
+		log.Printf("[UserConsentRepository] FindByUser ERROR: userID=%d, error=%v, duration=%v", userID, err, time.Since(start))
+		return nil, errors.ErrInternal(err)
+	}
+	defer rows.Close()
+
+	consents := []*entities.UserConsent{}
+	for rows.Next() {
+		var dbEntity dbEntities.UserConsentDB
+		if err := rows.Scan(
+			&dbEntity.UcoID,
+			&dbEntity.IdUser,
+			&dbEntity.IdLegalDocument,
+			&dbEntity.LedType,
+			&dbEntity.LedVersion,
+			&dbEntity.UcoAcceptedAt,
+			&dbEntity.UcoIPAddress,
+			&dbEntity.UcoUserAgent,
+			&dbEntity.UcoCreatedDate,
+			&dbEntity.UcoRecordStatus,
+		); err != nil {
+			log.Printf("[UserConsentRepository] FindByUser ERROR: scan failed, userID=%d, error=%v", userID, err)
+			return nil, errors.ErrInternal(err)
+		}
+		consents = append(consents, r.mapper.ToDomainEntity(&dbEntity))
+	}
+
+	if err := rows.Err(); err != nil {
+		log.Printf("[UserConsentRepository] FindByUser ERROR: userID=%d, error=%v, duration=%v", userID, err, time.Since(start))
+		return nil, errors.ErrInternal(err)
+	}
+
+	log.Printf("[UserConsentRepository] FindByUser: success, userID=%d, count=%d, duration=%v", userID, len(consents), time.Since(start))
+	return consents, nil
+}
+
+// insert writes a consent row using the given executor and sets the generated ID
+// Returns sql.ErrNoRows when the user had already accepted that document version
+func (r *UserConsentRepositoryImpl) insert(ctx context.Context, q queryRower, consent *entities.UserConsent) error {
+	dbEntity := r.mapper.ToDBEntity(consent)
+
+	query := `
+		INSERT INTO data.data_user_consent (
+			id_user, id_legal_document, uco_accepted_at, uco_ip_address, uco_user_agent,
+			uco_created_date, uco_record_status
+		) VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (id_user, id_legal_document) DO NOTHING
+		RETURNING uco_id
+	`
+
+	return q.QueryRowContext(
+		ctx,
+		query,
+		dbEntity.IdUser,
+		dbEntity.IdLegalDocument,
+		dbEntity.UcoAcceptedAt,
+		dbEntity.UcoIPAddress,
+		dbEntity.UcoUserAgent,
+		dbEntity.UcoCreatedDate,
+		dbEntity.UcoRecordStatus,
+	).Scan(&consent.ID)
+}
@@ -0,0 +1,107 @@
+package repositories
+
+import (
+	"citary-backend/internal/domain/entities"
+	"citary-backend/internal/domain/errors"
+	dbEntities "citary-backend/internal/infrastructure/persistence/postgres/entities"
+	"citary-backend/internal/infrastructure/persistence/postgres/mappers"
+	"context"
+	"database/sql"
+	"log"
+	"time"
+)
+
+// UserEventRepositoryImpl implements the UserEventRepository interface using PostgreSQL
+type UserEventRepositoryImpl struct {
+	db     *sql.DB
+	mapper *mappers.UserEventMapper
+}
+
+// NewUserEventRepositoryImpl creates a new instance of UserEventRepositoryImpl
+func NewUserEventRepositoryImpl(db *sql.DB) *UserEventRepositoryImpl {
+	return &UserEventRepositoryImpl{
+		db:     db,
+		mapper: mappers.NewUserEventMapper(),
+	}
+}
+
+// Create appends an event to the audit trail
+func (r *UserEventRepositoryImpl) Create(ctx context.Context, event *entities.UserEvent) error {
+	start := time.Now()
+	log.Printf("[UserEventRepository] Create: userID=%d, type=%s", event.UserID, event.Type)
+
+	dbEntity := r.mapper.ToDBEntity(event)
+
+	query := `
+		INSERT INTO data.data_user_event (
+			id_user, uev_type, uev_details, uev_ip_address, uev_created_date, uev_record_status
+		) VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING uev_id
+	`
+
+	err := r.db.QueryRowContext(
+		ctx,
+		query,
+		dbEntity.IdUser,
+		dbEntity.UevType,
+		dbEntity.UevDetails,
+		dbEntity.UevIPAddress,
+		dbEntity.UevCreatedDate,
+		dbEntity.UevRecordStatus,
+	).Scan(&event.ID)
+
+	duration := time.Since(start)
+
+	if err != nil {
+		log.Printf("[UserEventRepository] Create ERROR: userID=%d, type=%s, error=%v, duration=%v", event.UserID, event.Type, err, duration)
+		return errors.ErrInternal(err)
+	}
+
+	log.Printf("[UserEventRepository] Create: success, userID=%d, type=%s, eventID=%d, duration=%v", event.UserID, event.Type, event.ID, duration)
+	return nil
+}
+
+// FindByUser retrieves the audit trail of a user, oldest first
+func (r *UserEventRepositoryImpl) FindByUser(ctx context.Context, userID int) ([]*entities.UserEvent, error) {
+	start := time.Now()
+	log.Printf("[UserEventRepository] FindByUser: userID=%d", userID)
+
+	query := `
+		SELECT uev_id, id_user, uev_type, uev_details, uev_ip_address, uev_created_date, uev_record_status
+		FROM data.data_user_event
+		WHERE id_user = $1
+		ORDER BY uev_created_date, uev_id`
+
+	rows, err := r.db.QueryContext(ctx, query, userID)
+	if err != nil {
+		log.Printf("[UserEventRepository] FindByUser ERROR: userID=%d, error=%v, duration=%v", userID, err, time.Since(start))
+		return nil, errors.ErrInternal(err)
+	}
+	defer rows.Close()
+
+	events := []*entities.UserEvent{}
+	for rows.Next() {
+		var dbEntity dbEntities.UserEventDB
+		if err := rows.Scan(
+			&dbEntity.UevID,
+			&dbEntity.IdUser,
+			&dbEntity.UevType,
+			&dbEntity.UevDetails,
+			&dbEntity.UevIPAddress,
+			&dbEntity.UevCreatedDate,
+			&dbEntity.UevRecordStatus,
+		); err != nil {
+			log.Printf("[UserEventRepository] FindByUser ERROR: scan failed, userID=%d, error=%v", userID, err)
+			return nil, errors.ErrInternal(err)
+		}
+		events = append(events, r.mapper.ToDomainEntity(&dbEntity))
+	}
+
+	if err := rows.Err(); err != nil {
+		log.Printf("[UserEventRepository] FindByUser ERROR: userID=%d, error=%v, duration=%v", userID, err, time.Since(start))
+		return nil, errors.ErrInternal(err)
+	}
+
+	log.Printf("[UserEventRepository] FindByUser: success, userID=%d, count=%d, duration=%v", userID, len(events), time.Since(start))
+	return events, nil
+}
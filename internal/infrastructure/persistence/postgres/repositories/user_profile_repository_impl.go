@@ -0,0 +1,120 @@
+package repositories
+
+import (
+	"citary-backend/internal/domain/entities"
+	"citary-backend/internal/domain/errors"
+	dbEntities "citary-backend/internal/infrastructure/persistence/postgres/entities"
+	"citary-backend/internal/infrastructure/persistence/postgres/mappers"
+	"context"
+	"database/sql"
+	"log"
+	"time"
+)
+
+// UserProfileRepositoryImpl implements the UserProfileRepository interface using PostgreSQL
+type UserProfileRepositoryImpl struct {
+	db     *sql.DB
+	mapper *mappers.UserProfileMapper
+}
+
+// NewUserProfileRepositoryImpl creates a new instance of UserProfileRepositoryImpl
+func NewUserProfileRepositoryImpl(db *sql.DB) *UserProfileRepositoryImpl {
+	return &UserProfileRepositoryImpl{
+		db:     db,
+		mapper: mappers.NewUserProfileMapper(),
+	}
+}
+
+// FindByUserID retrieves the profile of a user
+// Returns (nil, nil) if not found - business layer decides if that's an error
+func (r *UserProfileRepositoryImpl) FindByUserID(ctx context.Context, userID int) (*entities.UserProfile, error) {
+	start := time.Now()
+	log.Printf("[UserProfileRepository] FindByUserID: userID=%d", userID)
+
+	query := `
+		SELECT upr_id, id_user, upr_first_name, upr_last_name, upr_phone,
+		       upr_birth_date, upr_locale, upr_avatar_url,
+		       upr_created_date, upr_updated_date, upr_record_status
+		FROM data.data_user_profile
+		WHERE id_user = $1`
+
+	var dbEntity dbEntities.UserProfileDB
+
+	err := r.db.QueryRowContext(ctx, query, userID).Scan(
+		&dbEntity.UprID,
+		&dbEntity.IdUser,
+		&dbEntity.UprFirstName,
+		&dbEntity.UprLastName,
+		&dbEntity.UprPhone,
+		&dbEntity.UprBirthDate,
+		&dbEntity.UprLocale,
+		&dbEntity.UprAvatarURL,
+		&dbEntity.UprCreatedDate,
+		&dbEntity.UprUpdatedDate,
+		&dbEntity.UprRecordStatus,
+	)
+
+	duration := time.Since(start)
+
+	if err == sql.ErrNoRows {
+		log.Printf("[UserProfileRepository] FindByUserID: profile not found, userID=%d, duration=%v", userID, duration)
+		return nil, nil
+	}
+
+	if err != nil {
+		log.Printf("[UserProfileRepository] FindByUserID ERROR: userID=%d, error=%v, duration=%v", userID, err, duration)
+		return nil, errors.ErrInternal(err)
+	}
+
+	log.Printf("[UserProfileRepository] FindByUserID: success, userID=%d, profileID=%d, duration=%v", userID, dbEntity.UprID, duration)
+	return r.mapper.ToDomainEntity(&dbEntity), nil
+}
+
+// Upsert creates the user's profile or replaces its fields, and sets the profile ID
+func (r *UserProfileRepositoryImpl) Upsert(ctx context.Context, profile *entities.UserProfile) error {
+	start := time.Now()
+	log.Printf("[UserProfileRepository] Upsert: userID=%d", profile.UserID)
+
+	dbEntity := r.mapper.ToDBEntity(profile)
+
+	query := `
+		INSERT INTO data.data_user_profile (
+			id_user, upr_first_name, upr_last_name, upr_phone, upr_birth_date,
+			upr_locale, upr_avatar_url, upr_created_date, upr_updated_date, upr_record_status
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		ON CONFLICT (id_user) DO UPDATE
+		SET upr_first_name = EXCLUDED.upr_first_name,
+		    upr_last_name = EXCLUDED.upr_last_name,
+		    upr_phone = EXCLUDED.upr_phone,
+		    upr_birth_date = EXCLUDED.upr_birth_date,
+		    upr_locale = EXCLUDED.upr_locale,
+		    upr_avatar_url = EXCLUDED.upr_avatar_url,
+		    upr_updated_date = EXCLUDED.upr_updated_date
+		RETURNING upr_id
+	`
+
+	err := r.db.QueryRowContext(
+		ctx,
+		query,
+		dbEntity.IdUser,
+		dbEntity.UprFirstName,
+		dbEntity.UprLastName,
+		dbEntity.UprPhone,
+		dbEntity.UprBirthDate,
+		dbEntity.UprLocale,
+		dbEntity.UprAvatarURL,
+		dbEntity.UprCreatedDate,
+		dbEntity.UprUpdatedDate,
+		dbEntity.UprRecordStatus,
+	).Scan(&profile.ID)
+
+	duration := time.Since(start)
+
+	if err != nil {
+		log.Printf("[UserProfileRepository] Upsert ERROR: userID=%d, error=%v, duration=%v", profile.UserID, err, duration)
+		return errors.ErrInternal(err)
+	}
+
+	log.Printf("[UserProfileRepository] Upsert: success, userID=%d, profileID=%d, duration=%v", profile.UserID, profile.ID, duration)
+	return nil
+}
@@ -5,23 +5,41 @@ import (
 	"citary-backend/internal/domain/errors"
 	dbEntities "citary-backend/internal/infrastructure/persistence/postgres/entities"
 	"citary-backend/internal/infrastructure/persistence/postgres/mappers"
+	"citary-backend/pkg/constants"
 	"context"
 	"database/sql"
 	"log"
 	"time"
+
+	"github.com/lib/pq"
 )
 
+// userSelectColumns lists the data.data_user columns read by every user query, in scan order
+const userSelectColumns = `
+		SELECT use_id, id_role, id_organization, use_email, use_password_hash, use_email_verified,
+		       use_verification_token, use_verification_token_expires_at,
+		       use_verification_sent_at, use_verification_send_count,
+		       use_two_factor_enabled, use_two_factor_secret,
+		       use_last_login, use_login_attempts, use_locked_until,
+		       use_terms_accepted_at, use_privacy_accepted_at, use_deleted_at, use_anonymize_after,
+		       use_created_date, use_record_status
+		FROM data.data_user`
+
 // UserRepositoryImpl implements the UserRepository interface using PostgreSQL
 type UserRepositoryImpl struct {
-	db     *sql.DB
-	mapper *mappers.UserMapper
+	db       *sql.DB
+	mapper   *mappers.UserMapper
+	consents *UserConsentRepositoryImpl
+	tokens   *UserTokenRepositoryImpl
 }
 
 // NewUserRepositoryImpl creates a new instance of UserRepositoryImpl
 func NewUserRepositoryImpl(db *sql.DB) *UserRepositoryImpl {
 	return &UserRepositoryImpl{
-		db:     db,
-		mapper: mappers.NewUserMapper(),
+		db:       db,
+		mapper:   mappers.NewUserMapper(),
+		consents: NewUserConsentRepositoryImpl(db),
+		tokens:   NewUserTokenRepositoryImpl(db),
 	}
 }
 
@@ -32,32 +50,10 @@ func (r *UserRepositoryImpl) FindByEmail(ctx context.Context, email string) (*en
 	start := time.Now()
 	log.Printf("[UserRepository] FindByEmail: email=%s", email)
 
-	query := `
-		SELECT use_id, id_role, use_email, use_password_hash, use_email_verified,
-		       use_verification_token, use_verification_token_expires_at,
-		       use_last_login, use_login_attempts, use_locked_until,
-		       use_terms_accepted_at, use_privacy_accepted_at, use_created_date, use_record_status
-		FROM data.data_user
+	query := userSelectColumns + `
 		WHERE use_email = $1`
 
-	var dbEntity dbEntities.UserDB
-
-	err := r.db.QueryRowContext(ctx, query, email).Scan(
-		&dbEntity.UseID,
-		&dbEntity.IdRole,
-		&dbEntity.UseEmail,
-		&dbEntity.UsePasswordHash,
-		&dbEntity.UseEmailVerified,
-		&dbEntity.UseVerificationToken,
-		&dbEntity.UseVerificationTokenExpiresAt,
-		&dbEntity.UseLastLogin,
-		&dbEntity.UseLoginAttempts,
-		&dbEntity.UseLockedUntil,
-		&dbEntity.UseTermsAcceptedAt,
-		&dbEntity.UsePrivacyAcceptedAt,
-		&dbEntity.UseCreatedDate,
-		&dbEntity.UseRecordStatus,
-	)
+	dbEntity, err := r.scanUser(r.db.QueryRowContext(ctx, query, email))
 
 	duration := time.Since(start)
 
@@ -74,7 +70,62 @@ func (r *UserRepositoryImpl) FindByEmail(ctx context.Context, email string) (*en
 	}
 
 	log.Printf("[UserRepository] FindByEmail: success, email=%s, userID=%d, duration=%v", email, dbEntity.UseID, duration)
-	return r.mapper.ToDomainEntity(&dbEntity), nil
+	return r.mapper.ToDomainEntity(dbEntity), nil
+}
+
+// FindByID retrieves a user by their ID
+// Returns (nil, nil) if not found - business layer decides if that's an error
+func (r *UserRepositoryImpl) FindByID(ctx context.Context, id int) (*entities.User, error) {
+	start := time.Now()
+	log.Printf("[UserRepository] FindByID: id=%d", id)
+
+	query := userSelectColumns + `
+		WHERE use_id = $1`
+
+	dbEntity, err := r.scanUser(r.db.QueryRowContext(ctx, query, id))
+
+	duration := time.Since(start)
+
+	if err == sql.ErrNoRows {
+		log.Printf("[UserRepository] FindByID: user not found, id=%d, duration=%v", id, duration)
+		return nil, nil
+	}
+
+	if err != nil {
+		log.Printf("[UserRepository] FindByID ERROR: id=%d, error=%v, duration=%v", id, err, duration)
+		return nil, errors.ErrInternal(err)
+	}
+
+	log.Printf("[UserRepository] FindByID: success, id=%d, duration=%v", id, duration)
+	return r.mapper.ToDomainEntity(dbEntity), nil
+}
+
+// FindByVerificationToken retrieves a user by their latest email verification token
+// A consumed token is cleared, so it no longer resolves to its user
+// Returns (nil, nil) if no user holds the token - business layer decides if that's an error
+func (r *UserRepositoryImpl) FindByVerificationToken(ctx context.Context, token string) (*entities.User, error) {
+	start := time.Now()
+	log.Printf("[UserRepository] FindByVerificationToken")
+
+	query := userSelectColumns + `
+		WHERE use_verification_token = $1`
+
+	dbEntity, err := r.scanUser(r.db.QueryRowContext(ctx, query, token))
+
+	duration := time.Since(start)
+
+	if err == sql.ErrNoRows {
+		log.Printf("[UserRepository] FindByVerificationToken: user not found, duration=%v", duration)
+		return nil, nil
+	}
+
+	if err != nil {
+		log.Printf("[UserRepository] FindByVerificationToken ERROR: error=%v, duration=%v", err, duration)
+		return nil, errors.ErrInternal(err)
+	}
+
+	log.Printf("[UserRepository] FindByVerificationToken: success, userID=%d, duration=%v", dbEntity.UseID, duration)
+	return r.mapper.ToDomainEntity(dbEntity), nil
 }
 
 // Create persists a new user to the database
@@ -82,6 +133,629 @@ func (r *UserRepositoryImpl) Create(ctx context.Context, user *entities.User) er
 	start := time.Now()
 	log.Printf("[UserRepository] Create: email=%s, roleID=%d, emailVerified=%v", user.Email, user.RoleID, user.EmailVerified)
 
+	err := r.insert(ctx, r.db, user)
+
+	duration := time.Since(start)
+
+	if err != nil {
+		log.Printf("[UserRepository] Create ERROR: email=%s, roleID=%d, error=%v, duration=%v", user.Email, user.RoleID, err, duration)
+		return errors.ErrInternal(err)
+	}
+
+	log.Printf("[UserRepository] Create: success, email=%s, roleID=%d, userID=%d, duration=%v", user.Email, user.RoleID, user.ID, duration)
+	return nil
+}
+
+// CreateWithConsents persists a new user together with their legal consents in one transaction
+// Each consent's UserID is set to the new user's ID; if any insert fails no user is left behind
+func (r *UserRepositoryImpl) CreateWithConsents(ctx context.Context, user *entities.User, consents []*entities.UserConsent) error {
+	start := time.Now()
+	log.Printf("[UserRepository] CreateWithConsents: email=%s, roleID=%d, consents=%d", user.Email, user.RoleID, len(consents))
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		log.Printf("[UserRepository] CreateWithConsents ERROR: failed to begin transaction, error=%v", err)
+		return errors.ErrInternal(err)
+	}
+	defer tx.Rollback()
+
+	if err := r.insert(ctx, tx, user); err != nil {
+		log.Printf("[UserRepository] CreateWithConsents ERROR: failed to insert user, email=%s, error=%v, duration=%v", user.Email, err, time.Since(start))
+		return errors.ErrInternal(err)
+	}
+
+	for _, consent := range consents {
+		consent.UserID = user.ID
+		if err := r.consents.insert(ctx, tx, consent); err != nil && err != sql.ErrNoRows {
+			log.Printf("[UserRepository] CreateWithConsents ERROR: failed to insert consent, userID=%d, documentID=%d, error=%v, duration=%v", user.ID, consent.LegalDocumentID, err, time.Since(start))
+			return errors.ErrInternal(err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		log.Printf("[UserRepository] CreateWithConsents ERROR: failed to commit, email=%s, error=%v, duration=%v", user.Email, err, time.Since(start))
+		return errors.ErrInternal(err)
+	}
+
+	log.Printf("[UserRepository] CreateWithConsents: success, email=%s, userID=%d, consents=%d, duration=%v", user.Email, user.ID, len(consents), time.Since(start))
+	return nil
+}
+
+// MarkEmailVerified flags the user's email as verified and clears the verification token
+// The token must still be the user's current, unexpired one, so a stale link cannot race a resend that rotated it
+// Its hash is recorded as a used token in the same transaction, so a second click on the link can still be recognised
+func (r *UserRepositoryImpl) MarkEmailVerified(ctx context.Context, userID int, token string, consumed *entities.UserToken) (bool, error) {
+	start := time.Now()
+	log.Printf("[UserRepository] MarkEmailVerified: userID=%d", userID)
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		log.Printf("[UserRepository] MarkEmailVerified ERROR: userID=%d, error=%v", userID, err)
+		return false, errors.ErrInternal(err)
+	}
+	defer tx.Rollback()
+
+	query := `
+		UPDATE data.data_user
+		SET use_email_verified = TRUE,
+		    use_verification_token = NULL,
+		    use_verification_token_expires_at = NULL
+		WHERE use_id = $1
+		  AND use_verification_token = $2
+		  AND use_verification_token_expires_at > NOW()
+	`
+
+	result, err := tx.ExecContext(ctx, query, userID, token)
+	if err != nil {
+		log.Printf("[UserRepository] MarkEmailVerified ERROR: userID=%d, error=%v, duration=%v", userID, err, time.Since(start))
+		return false, errors.ErrInternal(err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, errors.ErrInternal(err)
+	}
+
+	if rows == 0 {
+		log.Printf("[UserRepository] MarkEmailVerified: token no longer current, userID=%d, duration=%v", userID, time.Since(start))
+		return false, nil
+	}
+
+	consumed.UserID = userID
+	if err := r.tokens.insert(ctx, tx, consumed); err != nil {
+		log.Printf("[UserRepository] MarkEmailVerified ERROR: recording used token failed, userID=%d, error=%v", userID, err)
+		return false, errors.ErrInternal(err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		log.Printf("[UserRepository] MarkEmailVerified ERROR: commit failed, userID=%d, error=%v", userID, err)
+		return false, errors.ErrInternal(err)
+	}
+
+	log.Printf("[UserRepository] MarkEmailVerified: success, userID=%d, duration=%v", userID, time.Since(start))
+	return true, nil
+}
+
+// RotateVerificationToken stores a new verification token together with its delivery tracking
+// The cooldown and daily cap are checked in the same statement that rotates the token, so concurrent
+// resend requests cannot both pass them; the send count restarts on the first delivery of a UTC day
+func (r *UserRepositoryImpl) RotateVerificationToken(ctx context.Context, user *entities.User, cooldown time.Duration, maxSendsPerDay int) (bool, error) {
+	start := time.Now()
+	log.Printf("[UserRepository] RotateVerificationToken: userID=%d", user.ID)
+
+	dbEntity := r.mapper.ToDBEntity(user)
+
+	query := `
+		UPDATE data.data_user
+		SET use_verification_token = $2,
+		    use_verification_token_expires_at = $3,
+		    use_verification_sent_at = $4,
+		    use_verification_send_count = CASE
+		        WHEN (use_verification_sent_at AT TIME ZONE 'UTC')::date = ($4::timestamptz AT TIME ZONE 'UTC')::date
+		        THEN use_verification_send_count + 1
+		        ELSE 1
+		    END
+		WHERE use_id = $1
+		  AND use_email_verified = FALSE
+		  AND (
+		      use_verification_sent_at IS NULL
+		      OR (
+		          use_verification_sent_at <= $4::timestamptz - make_interval(secs => $5)
+		          AND (
+		              (use_verification_sent_at AT TIME ZONE 'UTC')::date <> ($4::timestamptz AT TIME ZONE 'UTC')::date
+		              OR use_verification_send_count < $6
+		          )
+		      )
+		  )
+		RETURNING use_verification_send_count
+	`
+
+	var sendCount int
+	err := r.db.QueryRowContext(
+		ctx,
+		query,
+		dbEntity.UseID,
+		dbEntity.UseVerificationToken,
+		dbEntity.UseVerificationTokenExpiresAt,
+		dbEntity.UseVerificationSentAt,
+		cooldown.Seconds(),
+		maxSendsPerDay,
+	).Scan(&sendCount)
+
+	duration := time.Since(start)
+
+	if err == sql.ErrNoRows {
+		log.Printf("[UserRepository] RotateVerificationToken: rate limited, userID=%d, duration=%v", user.ID, duration)
+		return false, nil
+	}
+
+	if err != nil {
+		log.Printf("[UserRepository] RotateVerificationToken ERROR: userID=%d, error=%v, duration=%v", user.ID, err, duration)
+		return false, errors.ErrInternal(err)
+	}
+
+	user.VerificationSendCount = sendCount
+
+	log.Printf("[UserRepository] RotateVerificationToken: success, userID=%d, sendCount=%d, duration=%v", user.ID, sendCount, duration)
+	return true, nil
+}
+
+// RecordSuccessfulLogin stores the latest successful login and resets the failed attempt counter and lock
+func (r *UserRepositoryImpl) RecordSuccessfulLogin(ctx context.Context, userID int, loggedInAt time.Time) error {
+	start := time.Now()
+	log.Printf("[UserRepository] RecordSuccessfulLogin: userID=%d", userID)
+
+	query := `
+		UPDATE data.data_user
+		SET use_last_login = $2,
+		    use_login_attempts = 0,
+		    use_locked_until = NULL
+		WHERE use_id = $1
+	`
+
+	_, err := r.db.ExecContext(ctx, query, userID, loggedInAt)
+
+	duration := time.Since(start)
+
+	if err != nil {
+		log.Printf("[UserRepository] RecordSuccessfulLogin ERROR: userID=%d, error=%v, duration=%v", userID, err, duration)
+		return errors.ErrInternal(err)
+	}
+
+	log.Printf("[UserRepository] RecordSuccessfulLogin: success, userID=%d, duration=%v", userID, duration)
+	return nil
+}
+
+// UpdateTwoFactor stores the user's two-factor state and TOTP secret
+// A secret with enabled=false represents an enrollment awaiting confirmation
+func (r *UserRepositoryImpl) UpdateTwoFactor(ctx context.Context, userID int, enabled bool, secret *string) error {
+	start := time.Now()
+	log.Printf("[UserRepository] UpdateTwoFactor: userID=%d, enabled=%v", userID, enabled)
+
+	var dbSecret sql.NullString
+	if secret != nil {
+		dbSecret = sql.NullString{String: *secret, Valid: true}
+	}
+
+	query := `
+		UPDATE data.data_user
+		SET use_two_factor_enabled = $2,
+		    use_two_factor_secret = $3
+		WHERE use_id = $1
+	`
+
+	_, err := r.db.ExecContext(ctx, query, userID, enabled, dbSecret)
+
+	duration := time.Since(start)
+
+	if err != nil {
+		log.Printf("[UserRepository] UpdateTwoFactor ERROR: userID=%d, error=%v, duration=%v", userID, err, duration)
+		return errors.ErrInternal(err)
+	}
+
+	log.Printf("[UserRepository] UpdateTwoFactor: success, userID=%d, enabled=%v, duration=%v", userID, enabled, duration)
+	return nil
+}
+
+// RegisterFailedLogin atomically increments the failed attempt counter and applies the lock schedule
+// Counting and locking happen in a single UPDATE so concurrent failures can neither be lost nor shorten a lock;
+// GREATEST keeps a longer lock set by a concurrent failure with a higher attempt count
+func (r *UserRepositoryImpl) RegisterFailedLogin(ctx context.Context, userID int, lockSchedule []time.Duration, now time.Time) (int, *time.Time, error) {
+	start := time.Now()
+	log.Printf("[UserRepository] RegisterFailedLogin: userID=%d", userID)
+
+	lockSeconds := make([]int64, 0, len(lockSchedule))
+	for _, lock := range lockSchedule {
+		lockSeconds = append(lockSeconds, int64(lock/time.Second))
+	}
+
+	query := `
+		UPDATE data.data_user
+		SET use_login_attempts = use_login_attempts + 1,
+		    use_locked_until = CASE
+		        WHEN ($2::bigint[])[LEAST(use_login_attempts + 1, cardinality($2::bigint[]))] > 0
+		        THEN GREATEST(use_locked_until,
+		                      $3::timestamptz + make_interval(secs => ($2::bigint[])[LEAST(use_login_attempts + 1, cardinality($2::bigint[]))]))
+		        ELSE use_locked_until
+		    END
+		WHERE use_id = $1
+		RETURNING use_login_attempts, use_locked_until
+	`
+
+	var attempts int
+	var lockedUntil sql.NullTime
+	err := r.db.QueryRowContext(ctx, query, userID, pq.Array(lockSeconds), now).Scan(&attempts, &lockedUntil)
+
+	duration := time.Since(start)
+
+	if err != nil {
+		log.Printf("[UserRepository] RegisterFailedLogin ERROR: userID=%d, error=%v, duration=%v", userID, err, duration)
+		return 0, nil, errors.ErrInternal(err)
+	}
+
+	if !lockedUntil.Valid || !lockedUntil.Time.After(now) {
+		log.Printf("[UserRepository] RegisterFailedLogin: success, userID=%d, attempts=%d, duration=%v", userID, attempts, duration)
+		return attempts, nil, nil
+	}
+
+	log.Printf("[UserRepository] RegisterFailedLogin: success, userID=%d, attempts=%d, lockedUntil=%v, duration=%v", userID, attempts, lockedUntil.Time, duration)
+	return attempts, &lockedUntil.Time, nil
+}
+
+// UpdatePassword replaces the user's password hash and clears any failed login lock
+func (r *UserRepositoryImpl) UpdatePassword(ctx context.Context, userID int, passwordHash string) error {
+	start := time.Now()
+	log.Printf("[UserRepository] UpdatePassword: userID=%d", userID)
+
+	query := `
+		UPDATE data.data_user
+		SET use_password_hash = $2,
+		    use_login_attempts = 0,
+		    use_locked_until = NULL
+		WHERE use_id = $1
+	`
+
+	_, err := r.db.ExecContext(ctx, query, userID, passwordHash)
+
+	duration := time.Since(start)
+
+	if err != nil {
+		log.Printf("[UserRepository] UpdatePassword ERROR: userID=%d, error=%v, duration=%v", userID, err, duration)
+		return errors.ErrInternal(err)
+	}
+
+	log.Printf("[UserRepository] UpdatePassword: success, userID=%d, duration=%v", userID, duration)
+	return nil
+}
+
+// Reactivate restores a deleted account awaiting anonymization with a new password and a pending email
+// verification, clearing any failed login lock
+// The WHERE clause re-checks the deletion state, so a reactivation that waited on Anonymize's row lock
+// leaves the anonymized account alone
+func (r *UserRepositoryImpl) Reactivate(ctx context.Context, user *entities.User) (bool, error) {
+	start := time.Now()
+	log.Printf("[UserRepository] Reactivate: userID=%d", user.ID)
+
+	dbEntity := r.mapper.ToDBEntity(user)
+
+	query := `
+		UPDATE data.data_user
+		SET use_password_hash = $2,
+		    use_email_verified = $3,
+		    use_verification_token = $4,
+		    use_verification_token_expires_at = $5,
+		    use_verification_sent_at = $6,
+		    use_verification_send_count = $7,
+		    use_login_attempts = 0,
+		    use_locked_until = NULL,
+		    use_deleted_at = NULL,
+		    use_anonymize_after = NULL,
+		    use_record_status = $8
+		WHERE use_id = $1
+		  AND use_record_status = $9
+		  AND use_anonymize_after IS NOT NULL
+	`
+
+	result, err := r.db.ExecContext(
+		ctx,
+		query,
+		dbEntity.UseID,
+		dbEntity.UsePasswordHash,
+		dbEntity.UseEmailVerified,
+		dbEntity.UseVerificationToken,
+		dbEntity.UseVerificationTokenExpiresAt,
+		dbEntity.UseVerificationSentAt,
+		dbEntity.UseVerificationSendCount,
+		dbEntity.UseRecordStatus,
+		constants.RecordStatus.Inactive,
+	)
+
+	duration := time.Since(start)
+
+	if err != nil {
+		log.Printf("[UserRepository] Reactivate ERROR: userID=%d, error=%v, duration=%v", user.ID, err, duration)
+		return false, errors.ErrInternal(err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, errors.ErrInternal(err)
+	}
+
+	log.Printf("[UserRepository] Reactivate: success, userID=%d, reactivated=%t, duration=%v", user.ID, rows > 0, duration)
+	return rows > 0, nil
+}
+
+// UpdateLegalAcceptance stores when the user last accepted the terms and the privacy policy
+// Nil timestamps keep the stored value
+func (r *UserRepositoryImpl) UpdateLegalAcceptance(ctx context.Context, userID int, termsAcceptedAt, privacyAcceptedAt *time.Time) error {
+	start := time.Now()
+	log.Printf("[UserRepository] UpdateLegalAcceptance: userID=%d", userID)
+
+	query := `
+		UPDATE data.data_user
+		SET use_terms_accepted_at = COALESCE($2, use_terms_accepted_at),
+		    use_privacy_accepted_at = COALESCE($3, use_privacy_accepted_at)
+		WHERE use_id = $1
+	`
+
+	_, err := r.db.ExecContext(ctx, query, userID, termsAcceptedAt, privacyAcceptedAt)
+
+	duration := time.Since(start)
+
+	if err != nil {
+		log.Printf("[UserRepository] UpdateLegalAcceptance ERROR: userID=%d, error=%v, duration=%v", userID, err, duration)
+		return errors.ErrInternal(err)
+	}
+
+	log.Printf("[UserRepository] UpdateLegalAcceptance: success, userID=%d, duration=%v", userID, duration)
+	return nil
+}
+
+// UpdateDefaultOrganization stores the organization new sessions of the user act within
+func (r *UserRepositoryImpl) UpdateDefaultOrganization(ctx context.Context, userID int, organizationID *int) error {
+	start := time.Now()
+	log.Printf("[UserRepository] UpdateDefaultOrganization: userID=%d", userID)
+
+	query := `
+		UPDATE data.data_user
+		SET id_organization = $2
+		WHERE use_id = $1
+	`
+
+	_, err := r.db.ExecContext(ctx, query, userID, organizationID)
+
+	duration := time.Since(start)
+
+	if err != nil {
+		log.Printf("[UserRepository] UpdateDefaultOrganization ERROR: userID=%d, error=%v, duration=%v", userID, err, duration)
+		return errors.ErrInternal(err)
+	}
+
+	log.Printf("[UserRepository] UpdateDefaultOrganization: success, userID=%d, duration=%v", userID, duration)
+	return nil
+}
+
+// SoftDelete deactivates the account and schedules its personal data for anonymization
+func (r *UserRepositoryImpl) SoftDelete(ctx context.Context, userID int, deletedAt, anonymizeAfter time.Time) error {
+	start := time.Now()
+	log.Printf("[UserRepository] SoftDelete: userID=%d, anonymizeAfter=%v", userID, anonymizeAfter)
+
+	query := `
+		UPDATE data.data_user
+		SET use_deleted_at = $2,
+		    use_anonymize_after = $3,
+		    use_record_status = $4
+		WHERE use_id = $1
+	`
+
+	_, err := r.db.ExecContext(ctx, query, userID, deletedAt, anonymizeAfter, constants.RecordStatus.Inactive)
+
+	duration := time.Since(start)
+
+	if err != nil {
+		log.Printf("[UserRepository] SoftDelete ERROR: userID=%d, error=%v, duration=%v", userID, err, duration)
+		return errors.ErrInternal(err)
+	}
+
+	log.Printf("[UserRepository] SoftDelete: success, userID=%d, duration=%v", userID, duration)
+	return nil
+}
+
+// FindDueForAnonymization returns the IDs of deleted accounts whose grace period ended before now
+func (r *UserRepositoryImpl) FindDueForAnonymization(ctx context.Context, now time.Time, limit int) ([]int, error) {
+	start := time.Now()
+	log.Printf("[UserRepository] FindDueForAnonymization: now=%v, limit=%d", now, limit)
+
+	query := `
+		SELECT use_id
+		FROM data.data_user
+		WHERE use_anonymize_after IS NOT NULL
+		  AND use_anonymize_after <= $1
+		  AND use_record_status = $2
+		ORDER BY use_anonymize_after
+		LIMIT $3`
+
+	rows, err := r.db.QueryContext(ctx, query, now, constants.RecordStatus.Inactive, limit)
+	if err != nil {
+		log.Printf("[UserRepository] FindDueForAnonymization ERROR: error=%v, duration=%v", err, time.Since(start))
+		return nil, errors.ErrInternal(err)
+	}
+	defer rows.Close()
+
+	userIDs := []int{}
+	for rows.Next() {
+		var userID int
+		if err := rows.Scan(&userID); err != nil {
+			log.Printf("[UserRepository] FindDueForAnonymization ERROR: scan failed, error=%v", err)
+			return nil, errors.ErrInternal(err)
+		}
+		userIDs = append(userIDs, userID)
+	}
+
+	if err := rows.Err(); err != nil {
+		log.Printf("[UserRepository] FindDueForAnonymization ERROR: error=%v, duration=%v", err, time.Since(start))
+		return nil, errors.ErrInternal(err)
+	}
+
+	log.Printf("[UserRepository] FindDueForAnonymization: success, count=%d, duration=%v", len(userIDs), time.Since(start))
+	return userIDs, nil
+}
+
+// Anonymize erases the personal data of a deleted account whose grace period ended before now
+// The account row is claimed with FOR UPDATE before anything is removed, so an account reactivated in the
+// meantime is left untouched, and a reactivation arriving during the sweep waits for it to finish;
+// everything runs in one transaction, so a failure leaves the account due and the next run retries it
+// The account row itself is kept with a tombstone email so foreign keys and the audit trail stay intact
+func (r *UserRepositoryImpl) Anonymize(ctx context.Context, userID int, tombstoneEmail string, now time.Time) (bool, error) {
+	start := time.Now()
+	log.Printf("[UserRepository] Anonymize: userID=%d", userID)
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		log.Printf("[UserRepository] Anonymize ERROR: failed to begin transaction, error=%v", err)
+		return false, errors.ErrInternal(err)
+	}
+	defer tx.Rollback()
+
+	var claimedID int
+	err = tx.QueryRowContext(ctx, `
+		SELECT use_id
+		FROM data.data_user
+		WHERE use_id = $1
+		  AND use_anonymize_after IS NOT NULL
+		  AND use_anonymize_after <= $2
+		  AND use_record_status = $3
+		FOR UPDATE`,
+		userID, now, constants.RecordStatus.Inactive,
+	).Scan(&claimedID)
+
+	if err == sql.ErrNoRows {
+		log.Printf("[UserRepository] Anonymize: account no longer due, userID=%d, duration=%v", userID, time.Since(start))
+		return false, nil
+	}
+
+	if err != nil {
+		log.Printf("[UserRepository] Anonymize ERROR: failed to claim account, userID=%d, error=%v", userID, err)
+		return false, errors.ErrInternal(err)
+	}
+
+	// Linked data is erased before the account row, in dependency-free order
+	statements := []struct {
+		name  string
+		query string
+		args  []any
+	}{
+		{"profile", `DELETE FROM data.data_user_profile WHERE id_user = $1`, nil},
+		{"refresh tokens", `DELETE FROM data.data_refresh_token WHERE id_user = $1`, nil},
+		{"user tokens", `DELETE FROM data.data_user_token WHERE id_user = $1`, nil},
+		{"recovery codes", `DELETE FROM data.data_recovery_code WHERE id_user = $1`, nil},
+		{"calendar feed", `DELETE FROM data.data_calendar_feed WHERE id_user = $1`, nil},
+		{"consent client details", `
+			UPDATE data.data_user_consent
+			SET uco_ip_address = NULL,
+			    uco_user_agent = NULL
+			WHERE id_user = $1`, nil},
+		{"event IP addresses", `
+			UPDATE data.data_user_event
+			SET uev_ip_address = NULL
+			WHERE id_user = $1 AND uev_ip_address IS NOT NULL`, nil},
+		{"organization memberships", `
+			UPDATE data.data_organization_membership
+			SET orm_record_status = $2,
+			    orm_updated_date = NOW()
+			WHERE id_user = $1 AND orm_record_status <> $2`, []any{constants.RecordStatus.Inactive}},
+		{"doctor profiles", `
+			UPDATE data.data_doctor
+			SET doc_license_number = 'anonymized-' || doc_id,
+			    doc_bio = NULL,
+			    doc_record_status = $2,
+			    doc_updated_date = NOW()
+			WHERE id_user = $1`, []any{constants.RecordStatus.Inactive}},
+		{"invitation emails", `
+			UPDATE data.data_organization_invitation
+			SET oin_email = $2,
+			    oin_revoked_at = CASE WHEN oin_accepted_at IS NULL AND oin_revoked_at IS NULL
+			                          THEN $3::timestamptz ELSE oin_revoked_at END
+			WHERE id_user_accepted = $1
+			   OR oin_email = (SELECT use_email FROM data.data_user WHERE use_id = $1)`, []any{tombstoneEmail, now}},
+		{"appointment history reasons", `
+			UPDATE data.data_appointment_status_history
+			SET ash_reason = NULL
+			WHERE ash_reason IS NOT NULL
+			  AND id_appointment IN (SELECT apt_id FROM data.data_appointment WHERE id_patient = $1)`, nil},
+		{"appointment reasons", `
+			UPDATE data.data_appointment
+			SET apt_reason = NULL
+			WHERE id_patient = $1 AND apt_reason IS NOT NULL`, nil},
+	}
+
+	for _, statement := range statements {
+		if _, err := tx.ExecContext(ctx, statement.query, append([]any{userID}, statement.args...)...); err != nil {
+			log.Printf("[UserRepository] Anonymize ERROR: failed to erase %s, userID=%d, error=%v", statement.name, userID, err)
+			return false, errors.ErrInternal(err)
+		}
+	}
+
+	if _, err := tx.ExecContext(ctx, `
+		UPDATE data.data_user
+		SET use_email = $2,
+		    use_password_hash = '',
+		    use_verification_token = NULL,
+		    use_verification_token_expires_at = NULL,
+		    use_two_factor_enabled = FALSE,
+		    use_two_factor_secret = NULL,
+		    use_last_login = NULL,
+		    id_organization = NULL,
+		    use_anonymize_after = NULL
+		WHERE use_id = $1`,
+		userID, tombstoneEmail,
+	); err != nil {
+		log.Printf("[UserRepository] Anonymize ERROR: failed to anonymize account, userID=%d, error=%v", userID, err)
+		return false, errors.ErrInternal(err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		log.Printf("[UserRepository] Anonymize ERROR: failed to commit, error=%v", err)
+		return false, errors.ErrInternal(err)
+	}
+
+	log.Printf("[UserRepository] Anonymize: success, userID=%d, duration=%v", userID, time.Since(start))
+	return true, nil
+}
+
+// UpdateEmail replaces the user's email address
+// A unique violation means another account took the address in the meantime and is reported as a conflict
+func (r *UserRepositoryImpl) UpdateEmail(ctx context.Context, userID int, email string) error {
+	start := time.Now()
+	log.Printf("[UserRepository] UpdateEmail: userID=%d, email=%s", userID, email)
+
+	query := `
+		UPDATE data.data_user
+		SET use_email = $2
+		WHERE use_id = $1
+	`
+
+	_, err := r.db.ExecContext(ctx, query, userID, email)
+
+	duration := time.Since(start)
+
+	if isUniqueViolation(err) {
+		log.Printf("[UserRepository] UpdateEmail: email already in use, userID=%d, email=%s, duration=%v", userID, email, duration)
+		return errors.ErrConflict(constants.ErrorMessages.UserAlreadyExists)
+	}
+
+	if err != nil {
+		log.Printf("[UserRepository] UpdateEmail ERROR: userID=%d, error=%v, duration=%v", userID, err, duration)
+		return errors.ErrInternal(err)
+	}
+
+	log.Printf("[UserRepository] UpdateEmail: success, userID=%d, duration=%v", userID, duration)
+	return nil
+}
+
+// insert writes a user row using the given executor and sets the generated ID
+func (r *UserRepositoryImpl) insert(ctx context.Context, q queryRower, user *entities.User) error {
 	// Convert domain entity to DB entity to handle nullable fields properly
 	dbEntity := r.mapper.ToDBEntity(user)
 
@@ -89,12 +763,14 @@ func (r *UserRepositoryImpl) Create(ctx context.Context, user *entities.User) er
 		INSERT INTO data.data_user (
 			id_role, use_email, use_password_hash, use_email_verified,
 			use_verification_token, use_verification_token_expires_at,
-			use_login_attempts, use_created_date, use_record_status
-		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+			use_verification_sent_at, use_verification_send_count,
+			use_two_factor_enabled, use_login_attempts,
+			use_terms_accepted_at, use_privacy_accepted_at, use_created_date, use_record_status
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
 		RETURNING use_id
 	`
 
-	err := r.db.QueryRowContext(
+	return q.QueryRowContext(
 		ctx,
 		query,
 		dbEntity.IdRole,
@@ -103,18 +779,47 @@ func (r *UserRepositoryImpl) Create(ctx context.Context, user *entities.User) er
 		dbEntity.UseEmailVerified,
 		dbEntity.UseVerificationToken,
 		dbEntity.UseVerificationTokenExpiresAt,
+		dbEntity.UseVerificationSentAt,
+		dbEntity.UseVerificationSendCount,
+		dbEntity.UseTwoFactorEnabled,
 		dbEntity.UseLoginAttempts,
+		dbEntity.UseTermsAcceptedAt,
+		dbEntity.UsePrivacyAcceptedAt,
 		dbEntity.UseCreatedDate,
 		dbEntity.UseRecordStatus,
 	).Scan(&user.ID)
+}
 
-	duration := time.Since(start)
+// scanUser scans a single row selected with userSelectColumns into a UserDB entity
+func (r *UserRepositoryImpl) scanUser(row *sql.Row) (*dbEntities.UserDB, error) {
+	var dbEntity dbEntities.UserDB
 
+	err := row.Scan(
+		&dbEntity.UseID,
+		&dbEntity.IdRole,
+		&dbEntity.IdOrganization,
+		&dbEntity.UseEmail,
+		&dbEntity.UsePasswordHash,
+		&dbEntity.UseEmailVerified,
+		&dbEntity.UseVerificationToken,
+		&dbEntity.UseVerificationTokenExpiresAt,
+		&dbEntity.UseVerificationSentAt,
+		&dbEntity.UseVerificationSendCount,
+		&dbEntity.UseTwoFactorEnabled,
+		&dbEntity.UseTwoFactorSecret,
+		&dbEntity.UseLastLogin,
+		&dbEntity.UseLoginAttempts,
+		&dbEntity.UseLockedUntil,
+		&dbEntity.UseTermsAcceptedAt,
+		&dbEntity.UsePrivacyAcceptedAt,
+		&dbEntity.UseDeletedAt,
+		&dbEntity.UseAnonymizeAfter,
+		&dbEntity.UseCreatedDate,
+		&dbEntity.UseRecordStatus,
+	)
 	if err != nil {
-		log.Printf("[UserRepository] Create ERROR: email=%s, roleID=%d, error=%v, duration=%v", user.Email, user.RoleID, err, duration)
-		return errors.ErrInternal(err)
+		return nil, err
 	}
 
-	log.Printf("[UserRepository] Create: success, email=%s, roleID=%d, userID=%d, duration=%v", user.Email, user.RoleID, user.ID, duration)
-	return nil
+	return &dbEntity, nil
 }
@@ -0,0 +1,227 @@
+package repositories
+
+import (
+	"citary-backend/internal/domain/entities"
+	"citary-backend/internal/domain/errors"
+	dbEntities "citary-backend/internal/infrastructure/persistence/postgres/entities"
+	"citary-backend/internal/infrastructure/persistence/postgres/mappers"
+	"context"
+	"database/sql"
+	"log"
+	"time"
+)
+
+// UserTokenRepositoryImpl implements the UserTokenRepository interface using PostgreSQL
+type UserTokenRepositoryImpl struct {
+	db     *sql.DB
+	mapper *mappers.UserTokenMapper
+}
+
+// NewUserTokenRepositoryImpl creates a new instance of UserTokenRepositoryImpl
+func NewUserTokenRepositoryImpl(db *sql.DB) *UserTokenRepositoryImpl {
+	return &UserTokenRepositoryImpl{
+		db:     db,
+		mapper: mappers.NewUserTokenMapper(),
+	}
+}
+
+// Create persists a new user token
+func (r *UserTokenRepositoryImpl) Create(ctx context.Context, token *entities.UserToken) error {
+	start := time.Now()
+	log.Printf("[UserTokenRepository] Create: userID=%d, purpose=%s", token.UserID, token.Purpose)
+
+	err := r.insert(ctx, r.db, token)
+
+	duration := time.Since(start)
+
+	if err != nil {
+		log.Printf("[UserTokenRepository] Create ERROR: userID=%d, purpose=%s, error=%v, duration=%v", token.UserID, token.Purpose, err, duration)
+		return errors.ErrInternal(err)
+	}
+
+	log.Printf("[UserTokenRepository] Create: success, userID=%d, purpose=%s, tokenID=%d, duration=%v", token.UserID, token.Purpose, token.ID, duration)
+	return nil
+}
+
+// FindByTokenHash retrieves a token of the given purpose by the hash of its value
+// Returns (nil, nil) if not found - business layer decides if that's an error
+func (r *UserTokenRepositoryImpl) FindByTokenHash(ctx context.Context, purpose, tokenHash string) (*entities.UserToken, error) {
+	start := time.Now()
+	log.Printf("[UserTokenRepository] FindByTokenHash: purpose=%s", purpose)
+
+	query := `
+		SELECT uto_id, id_user, uto_purpose, uto_token_hash, uto_payload,
+		       uto_expires_at, uto_used_at, uto_created_date, uto_record_status
+		FROM data.data_user_token
+		WHERE uto_purpose = $1 AND uto_token_hash = $2`
+
+	var dbEntity dbEntities.UserTokenDB
+
+	err := r.db.QueryRowContext(ctx, query, purpose, tokenHash).Scan(
+		&dbEntity.UtoID,
+		&dbEntity.IdUser,
+		&dbEntity.UtoPurpose,
+		&dbEntity.UtoTokenHash,
+		&dbEntity.UtoPayload,
+		&dbEntity.UtoExpiresAt,
+		&dbEntity.UtoUsedAt,
+		&dbEntity.UtoCreatedDate,
+		&dbEntity.UtoRecordStatus,
+	)
+
+	duration := time.Since(start)
+
+	if err == sql.ErrNoRows {
+		log.Printf("[UserTokenRepository] FindByTokenHash: token not found, purpose=%s, duration=%v", purpose, duration)
+		return nil, nil
+	}
+
+	if err != nil {
+		log.Printf("[UserTokenRepository] FindByTokenHash ERROR: purpose=%s, error=%v, duration=%v", purpose, err, duration)
+		return nil, errors.ErrInternal(err)
+	}
+
+	log.Printf("[UserTokenRepository] FindByTokenHash: success, purpose=%s, tokenID=%d, duration=%v", purpose, dbEntity.UtoID, duration)
+	return r.mapper.ToDomainEntity(&dbEntity), nil
+}
+
+// MarkUsed atomically consumes a token
+// The conditional UPDATE guarantees a token can only be consumed once, even under concurrent requests
+func (r *UserTokenRepositoryImpl) MarkUsed(ctx context.Context, id int) (bool, error) {
+	start := time.Now()
+	log.Printf("[UserTokenRepository] MarkUsed: tokenID=%d", id)
+
+	query := `
+		UPDATE data.data_user_token
+		SET uto_used_at = NOW()
+		WHERE uto_id = $1 AND uto_used_at IS NULL
+	`
+
+	result, err := r.db.ExecContext(ctx, query, id)
+	if err != nil {
+		log.Printf("[UserTokenRepository] MarkUsed ERROR: tokenID=%d, error=%v, duration=%v", id, err, time.Since(start))
+		return false, errors.ErrInternal(err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, errors.ErrInternal(err)
+	}
+
+	log.Printf("[UserTokenRepository] MarkUsed: success, tokenID=%d, consumed=%v, duration=%v", id, rows > 0, time.Since(start))
+	return rows > 0, nil
+}
+
+// InvalidateForUser consumes every outstanding token of the given purpose for a user
+func (r *UserTokenRepositoryImpl) InvalidateForUser(ctx context.Context, userID int, purpose string) error {
+	start := time.Now()
+	log.Printf("[UserTokenRepository] InvalidateForUser: userID=%d, purpose=%s", userID, purpose)
+
+	query := `
+		UPDATE data.data_user_token
+		SET uto_used_at = NOW()
+		WHERE id_user = $1 AND uto_purpose = $2 AND uto_used_at IS NULL
+	`
+
+	_, err := r.db.ExecContext(ctx, query, userID, purpose)
+
+	duration := time.Since(start)
+
+	if err != nil {
+		log.Printf("[UserTokenRepository] InvalidateForUser ERROR: userID=%d, purpose=%s, error=%v, duration=%v", userID, purpose, err, duration)
+		return errors.ErrInternal(err)
+	}
+
+	log.Printf("[UserTokenRepository] InvalidateForUser: success, userID=%d, purpose=%s, duration=%v", userID, purpose, duration)
+	return nil
+}
+
+// Rotate consumes the user's outstanding tokens of the token's purpose and stores the new one
+// The user row is locked first, so concurrent rotations for the same user are checked against the cooldown
+// and daily cap one after the other and cannot both pass them
+func (r *UserTokenRepositoryImpl) Rotate(ctx context.Context, token *entities.UserToken, cooldown time.Duration, maxPerDay int) (bool, error) {
+	start := time.Now()
+	log.Printf("[UserTokenRepository] Rotate: userID=%d, purpose=%s", token.UserID, token.Purpose)
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		log.Printf("[UserTokenRepository] Rotate ERROR: failed to begin transaction, userID=%d, error=%v", token.UserID, err)
+		return false, errors.ErrInternal(err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `SELECT use_id FROM data.data_user WHERE use_id = $1 FOR UPDATE`, token.UserID); err != nil {
+		log.Printf("[UserTokenRepository] Rotate ERROR: failed to lock user, userID=%d, error=%v, duration=%v", token.UserID, err, time.Since(start))
+		return false, errors.ErrInternal(err)
+	}
+
+	limitQuery := `
+		SELECT
+			COALESCE(BOOL_OR(uto_created_date > $3::timestamptz - make_interval(secs => $4)), FALSE),
+			COUNT(*) FILTER (WHERE (uto_created_date AT TIME ZONE 'UTC')::date = ($3::timestamptz AT TIME ZONE 'UTC')::date)
+		FROM data.data_user_token
+		WHERE id_user = $1 AND uto_purpose = $2
+	`
+
+	var coolingDown bool
+	var issuedToday int
+	if err := tx.QueryRowContext(ctx, limitQuery, token.UserID, token.Purpose, token.CreatedDate, cooldown.Seconds()).Scan(&coolingDown, &issuedToday); err != nil {
+		log.Printf("[UserTokenRepository] Rotate ERROR: failed to check limits, userID=%d, error=%v, duration=%v", token.UserID, err, time.Since(start))
+		return false, errors.ErrInternal(err)
+	}
+
+	if coolingDown || issuedToday >= maxPerDay {
+		log.Printf("[UserTokenRepository] Rotate: rate limited, userID=%d, purpose=%s, issuedToday=%d, duration=%v", token.UserID, token.Purpose, issuedToday, time.Since(start))
+		return false, nil
+	}
+
+	invalidateQuery := `
+		UPDATE data.data_user_token
+		SET uto_used_at = NOW()
+		WHERE id_user = $1 AND uto_purpose = $2 AND uto_used_at IS NULL
+	`
+
+	if _, err := tx.ExecContext(ctx, invalidateQuery, token.UserID, token.Purpose); err != nil {
+		log.Printf("[UserTokenRepository] Rotate ERROR: failed to invalidate tokens, userID=%d, error=%v, duration=%v", token.UserID, err, time.Since(start))
+		return false, errors.ErrInternal(err)
+	}
+
+	if err := r.insert(ctx, tx, token); err != nil {
+		log.Printf("[UserTokenRepository] Rotate ERROR: failed to insert token, userID=%d, error=%v, duration=%v", token.UserID, err, time.Since(start))
+		return false, errors.ErrInternal(err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		log.Printf("[UserTokenRepository] Rotate ERROR: failed to commit, userID=%d, error=%v, duration=%v", token.UserID, err, time.Since(start))
+		return false, errors.ErrInternal(err)
+	}
+
+	log.Printf("[UserTokenRepository] Rotate: success, userID=%d, purpose=%s, tokenID=%d, duration=%v", token.UserID, token.Purpose, token.ID, time.Since(start))
+	return true, nil
+}
+
+// insert writes a token row using the given executor and sets the generated ID
+func (r *UserTokenRepositoryImpl) insert(ctx context.Context, q queryRower, token *entities.UserToken) error {
+	dbEntity := r.mapper.ToDBEntity(token)
+
+	query := `
+		INSERT INTO data.data_user_token (
+			id_user, uto_purpose, uto_token_hash, uto_payload,
+			uto_expires_at, uto_used_at, uto_created_date, uto_record_status
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING uto_id
+	`
+
+	return q.QueryRowContext(
+		ctx,
+		query,
+		dbEntity.IdUser,
+		dbEntity.UtoPurpose,
+		dbEntity.UtoTokenHash,
+		dbEntity.UtoPayload,
+		dbEntity.UtoExpiresAt,
+		dbEntity.UtoUsedAt,
+		dbEntity.UtoCreatedDate,
+		dbEntity.UtoRecordStatus,
+	).Scan(&token.ID)
+}
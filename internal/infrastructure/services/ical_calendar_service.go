@@ -0,0 +1,93 @@
+package services
+
+import (
+	"citary-backend/internal/domain/entities"
+	"citary-backend/internal/infrastructure/config"
+	"citary-backend/pkg/constants"
+	"citary-backend/pkg/ical"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ICalCalendarService implements the CalendarService interface with RFC 5545 iCalendar documents
+type ICalCalendarService struct {
+	config *config.Config
+}
+
+// NewICalCalendarService creates a new iCalendar calendar service
+func NewICalCalendarService(cfg *config.Config) *ICalCalendarService {
+	return &ICalCalendarService{config: cfg}
+}
+
+// RenderAppointments renders appointments as an iCalendar document, one VEVENT per appointment
+// Event UIDs are derived from appointment IDs, so an invite imported from an email and the same
+// appointment in a subscribed feed are recognised as one event
+func (s *ICalCalendarService) RenderAppointments(viewerID int, calendarName string, appointments []*entities.Appointment) []byte {
+	now := time.Now()
+
+	calendar := ical.Calendar{
+		ProductID:       constants.CalendarConfig.ProductID,
+		Name:            calendarName,
+		Method:          ical.MethodPublish,
+		RefreshInterval: constants.CalendarConfig.FeedRefreshInterval,
+		Events:          make([]ical.Event, 0, len(appointments)),
+	}
+
+	for _, appointment := range appointments {
+		calendar.Events = append(calendar.Events, s.newEvent(viewerID, appointment, now))
+	}
+
+	return calendar.Bytes()
+}
+
+// newEvent maps an appointment to a VEVENT as seen by the viewer
+// Doctors only see that a patient is booked; the reason for the visit never leaves the application
+func (s *ICalCalendarService) newEvent(viewerID int, appointment *entities.Appointment, now time.Time) ical.Event {
+	event := ical.Event{
+		UID:      fmt.Sprintf("appointment-%d@%s", appointment.ID, constants.CalendarConfig.UIDDomain),
+		Stamp:    now,
+		Start:    appointment.StartTime,
+		End:      appointment.EndTime,
+		Location: appointmentPlace(appointment),
+		Status:   eventStatus(appointment.Status),
+		Created:  appointment.CreatedDate,
+	}
+
+	event.LastModified = appointment.CreatedDate
+	if appointment.UpdatedDate != nil {
+		event.LastModified = *appointment.UpdatedDate
+	}
+
+	if appointment.PatientID == viewerID {
+		event.Summary = fmt.Sprintf("Appointment with %s", doctorDisplayName(appointment))
+		event.URL = fmt.Sprintf("%s/appointments/%d", s.config.FrontendURL, appointment.ID)
+		event.Description = fmt.Sprintf("Your appointment at %s.\nManage it in Citary: %s", appointment.OrganizationName, event.URL)
+	} else {
+		event.Summary = fmt.Sprintf("Patient appointment at %s", appointment.LocationName)
+		event.Description = fmt.Sprintf("Patient appointment at %s.", appointment.OrganizationName)
+	}
+
+	return event
+}
+
+// appointmentPlace describes where an appointment takes place: location, organization and address if known
+func appointmentPlace(appointment *entities.Appointment) string {
+	parts := []string{appointment.LocationName, appointment.OrganizationName}
+	if appointment.LocationAddress != nil && *appointment.LocationAddress != "" {
+		parts = append(parts, *appointment.LocationAddress)
+	}
+	return strings.Join(parts, ", ")
+}
+
+// eventStatus maps an appointment status to a VEVENT status
+func eventStatus(status string) string {
+	switch status {
+	case constants.AppointmentStatus.Requested:
+		return ical.StatusTentative
+	case constants.AppointmentStatus.CancelledByPatient, constants.AppointmentStatus.CancelledByClinic:
+		return ical.StatusCancelled
+	default:
+		return ical.StatusConfirmed
+	}
+}
@@ -0,0 +1,213 @@
+package services
+
+import (
+	"citary-backend/internal/domain/errors"
+	domainServices "citary-backend/internal/domain/services"
+	"citary-backend/internal/infrastructure/config"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// jwtHeader is the fixed, pre-encoded JOSE header for HS256 tokens
+var jwtHeader = base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"HS256","typ":"JWT"}`))
+
+// Token use values keep each token kind from being replayed as another
+const (
+	tokenUseAccess       = "access"
+	tokenUseMFAChallenge = "mfa_challenge"
+)
+
+// jwtClaims represents the registered and private claims encoded in a token payload
+type jwtClaims struct {
+	Subject   string `json:"sub"`
+	Issuer    string `json:"iss"`
+	IssuedAt  int64  `json:"iat"`
+	ExpiresAt int64  `json:"exp"`
+	TokenUse  string `json:"token_use"`
+	Role      string `json:"role,omitempty"`
+	Org       *int   `json:"org,omitempty"`
+}
+
+// JWTTokenService implements the TokenService interface using HMAC-SHA256 signed JWTs
+// and random opaque refresh tokens
+type JWTTokenService struct {
+	secret          []byte
+	issuer          string
+	accessTokenTTL  time.Duration
+	refreshTokenTTL time.Duration
+	mfaChallengeTTL time.Duration
+}
+
+// NewJWTTokenService creates a new JWT token service
+func NewJWTTokenService(cfg *config.Config) *JWTTokenService {
+	return &JWTTokenService{
+		secret:          []byte(cfg.JWTSecret),
+		issuer:          cfg.JWTIssuer,
+		accessTokenTTL:  cfg.JWTAccessTokenTTL,
+		refreshTokenTTL: cfg.JWTRefreshTokenTTL,
+		mfaChallengeTTL: cfg.MFAChallengeTTL,
+	}
+}
+
+// GenerateAccessToken signs an access token for the given claims
+func (s *JWTTokenService) GenerateAccessToken(claims domainServices.AccessTokenClaims) (*domainServices.IssuedToken, error) {
+	now := time.Now()
+	expiresAt := now.Add(s.accessTokenTTL)
+
+	token, err := s.sign(jwtClaims{
+		Subject:   strconv.Itoa(claims.UserID),
+		Issuer:    s.issuer,
+		IssuedAt:  now.Unix(),
+		ExpiresAt: expiresAt.Unix(),
+		TokenUse:  tokenUseAccess,
+		Role:      claims.RoleCode,
+		Org:       claims.OrganizationID,
+	})
+	if err != nil {
+		return nil, errors.ErrInternal(err)
+	}
+
+	return &domainServices.IssuedToken{Token: token, ExpiresAt: expiresAt}, nil
+}
+
+// ParseAccessToken validates an access token and returns its claims
+func (s *JWTTokenService) ParseAccessToken(token string) (*domainServices.AccessTokenClaims, error) {
+	claims, err := s.verify(token, tokenUseAccess)
+	if err != nil {
+		return nil, errors.ErrUnauthorized("Invalid or expired access token")
+	}
+
+	userID, err := strconv.Atoi(claims.Subject)
+	if err != nil {
+		return nil, errors.ErrUnauthorized("Invalid or expired access token")
+	}
+
+	return &domainServices.AccessTokenClaims{
+		UserID:         userID,
+		RoleCode:       claims.Role,
+		OrganizationID: claims.Org,
+		IssuedAt:       time.Unix(claims.IssuedAt, 0),
+		ExpiresAt:      time.Unix(claims.ExpiresAt, 0),
+	}, nil
+}
+
+// GenerateMFAChallengeToken signs a short-lived token proving the password step succeeded for a user
+func (s *JWTTokenService) GenerateMFAChallengeToken(userID int) (*domainServices.IssuedToken, error) {
+	now := time.Now()
+	expiresAt := now.Add(s.mfaChallengeTTL)
+
+	token, err := s.sign(jwtClaims{
+		Subject:   strconv.Itoa(userID),
+		Issuer:    s.issuer,
+		IssuedAt:  now.Unix(),
+		ExpiresAt: expiresAt.Unix(),
+		TokenUse:  tokenUseMFAChallenge,
+	})
+	if err != nil {
+		return nil, errors.ErrInternal(err)
+	}
+
+	return &domainServices.IssuedToken{Token: token, ExpiresAt: expiresAt}, nil
+}
+
+// ParseMFAChallengeToken validates an MFA challenge token and returns the user ID it was issued for
+func (s *JWTTokenService) ParseMFAChallengeToken(token string) (int, error) {
+	claims, err := s.verify(token, tokenUseMFAChallenge)
+	if err != nil {
+		return 0, errors.ErrUnauthorized("Invalid or expired two-factor challenge")
+	}
+
+	userID, err := strconv.Atoi(claims.Subject)
+	if err != nil {
+		return 0, errors.ErrUnauthorized("Invalid or expired two-factor challenge")
+	}
+
+	return userID, nil
+}
+
+// GenerateRefreshToken creates a new random opaque refresh token (32 bytes = 64 hex characters)
+func (s *JWTTokenService) GenerateRefreshToken() (*domainServices.IssuedToken, error) {
+	tokenBytes := make([]byte, 32)
+	if _, err := rand.Read(tokenBytes); err != nil {
+		return nil, errors.ErrInternal(fmt.Errorf("failed to generate refresh token: %w", err))
+	}
+
+	return &domainServices.IssuedToken{
+		Token:     hex.EncodeToString(tokenBytes),
+		ExpiresAt: time.Now().Add(s.refreshTokenTTL),
+	}, nil
+}
+
+// HashToken returns the hex-encoded SHA-256 of an opaque token
+// Opaque tokens carry 256 bits of entropy, so an unsalted fast hash is sufficient for lookups
+func (s *JWTTokenService) HashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// sign encodes the claims and appends the HMAC-SHA256 signature
+func (s *JWTTokenService) sign(claims jwtClaims) (string, error) {
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode token claims: %w", err)
+	}
+
+	signingInput := jwtHeader + "." + base64.RawURLEncoding.EncodeToString(payload)
+	return signingInput + "." + s.signature(signingInput), nil
+}
+
+// verify checks the token signature, header, issuer, token use and expiration
+func (s *JWTTokenService) verify(token, expectedUse string) (*jwtClaims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("malformed token")
+	}
+
+	if parts[0] != jwtHeader {
+		return nil, fmt.Errorf("unsupported token header")
+	}
+
+	expected := s.signature(parts[0] + "." + parts[1])
+	if !hmac.Equal([]byte(expected), []byte(parts[2])) {
+		return nil, fmt.Errorf("invalid token signature")
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, fmt.Errorf("malformed token payload: %w", err)
+	}
+
+	var claims jwtClaims
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return nil, fmt.Errorf("malformed token claims: %w", err)
+	}
+
+	if claims.Issuer != s.issuer {
+		return nil, fmt.Errorf("unexpected token issuer")
+	}
+
+	if claims.TokenUse != expectedUse {
+		return nil, fmt.Errorf("unexpected token use")
+	}
+
+	if time.Now().Unix() >= claims.ExpiresAt {
+		return nil, fmt.Errorf("token expired")
+	}
+
+	return &claims, nil
+}
+
+// signature computes the base64url-encoded HMAC-SHA256 of the signing input
+func (s *JWTTokenService) signature(signingInput string) string {
+	mac := hmac.New(sha256.New, s.secret)
+	mac.Write([]byte(signingInput))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
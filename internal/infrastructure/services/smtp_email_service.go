@@ -2,12 +2,17 @@ package services
 
 import (
 	"bytes"
+	"citary-backend/internal/domain/entities"
 	"citary-backend/internal/infrastructure/config"
 	"context"
+	"encoding/base64"
 	"fmt"
 	"html/template"
 	"log"
+	"mime"
+	"mime/multipart"
 	"net/smtp"
+	"net/textproto"
 	"time"
 )
 
@@ -29,32 +34,175 @@ func NewSMTPEmailService(cfg *config.Config) *SMTPEmailService {
 
 // SendVerificationEmail sends an email verification link to the user
 func (s *SMTPEmailService) SendVerificationEmail(ctx context.Context, email, token string) error {
-	start := time.Now()
-	log.Printf("[SMTPEmailService] SendVerificationEmail: email=%s", email)
-
 	verificationLink := fmt.Sprintf("%s/auth/verify-email?token=%s", s.config.FrontendURL, token)
 
-	subject := "Verify Your Email Address"
-	htmlBody, err := s.renderVerificationEmailTemplate(verificationLink)
+	return s.sendActionEmail("SendVerificationEmail", email, "Verify Your Email Address", actionEmail{
+		Title:   "Verify Your Email",
+		Banner:  "Welcome to Citary!",
+		Heading: "Verify Your Email Address",
+		Paragraphs: []string{
+			"Thank you for signing up! To complete your registration and start using Citary, please verify your email address by clicking the button below.",
+			"This verification link will expire in 24 hours.",
+		},
+		ButtonText: "Verify Email Address",
+		Link:       verificationLink,
+		Footer:     "If you didn't create an account with Citary, you can safely ignore this email.",
+	})
+}
+
+// SendPasswordResetEmail sends a password reset link to the user
+func (s *SMTPEmailService) SendPasswordResetEmail(ctx context.Context, email, token string) error {
+	resetLink := fmt.Sprintf("%s/auth/reset-password?token=%s", s.config.FrontendURL, token)
+
+	return s.sendActionEmail("SendPasswordResetEmail", email, "Reset Your Password", actionEmail{
+		Title:   "Reset Your Password",
+		Banner:  "Citary",
+		Heading: "Reset Your Password",
+		Paragraphs: []string{
+			"We received a request to reset the password for your Citary account. Click the button below to choose a new password.",
+			"This link will expire in 1 hour and can only be used once. Resetting your password signs you out of every device.",
+		},
+		ButtonText: "Reset Password",
+		Link:       resetLink,
+		Footer:     "If you didn't request a password reset, you can safely ignore this email. Your password will not change.",
+	})
+}
+
+// SendReactivationEmail sends a link to reactivate an inactive account
+func (s *SMTPEmailService) SendReactivationEmail(ctx context.Context, email, token string) error {
+	reactivationLink := fmt.Sprintf("%s/auth/reactivate?token=%s", s.config.FrontendURL, token)
+
+	return s.sendActionEmail("SendReactivationEmail", email, "Reactivate Your Account", actionEmail{
+		Title:   "Reactivate Your Account",
+		Banner:  "Welcome back to Citary!",
+		Heading: "Reactivate Your Account",
+		Paragraphs: []string{
+			"Someone tried to sign up with this email address, which belongs to an inactive Citary account. Click the button below to reactivate it and choose a new password.",
+			"This link will expire in 24 hours. After reactivating you will need to verify your email address again.",
+		},
+		ButtonText: "Reactivate Account",
+		Link:       reactivationLink,
+		Footer:     "If you didn't try to sign up, you can safely ignore this email. Your account will stay inactive.",
+	})
+}
+
+// SendEmailChangeConfirmation sends a link confirming the new address to that address
+func (s *SMTPEmailService) SendEmailChangeConfirmation(ctx context.Context, newEmail, token string) error {
+	confirmLink := fmt.Sprintf("%s/auth/confirm-email-change?token=%s", s.config.FrontendURL, token)
+
+	return s.sendActionEmail("SendEmailChangeConfirmation", newEmail, "Confirm Your New Email Address", actionEmail{
+		Title:   "Confirm Your New Email",
+		Banner:  "Citary",
+		Heading: "Confirm Your New Email Address",
+		Paragraphs: []string{
+			"We received a request to use this address for your Citary account. Click the button below to confirm the change.",
+			"This link will expire in 1 hour. Until you confirm, your account keeps using its current email address.",
+		},
+		ButtonText: "Confirm Email Address",
+		Link:       confirmLink,
+		Footer:     "If you didn't request this change, you can safely ignore this email.",
+	})
+}
+
+// SendEmailChangedNotice tells the previous address that the account email was changed
+func (s *SMTPEmailService) SendEmailChangedNotice(ctx context.Context, oldEmail, newEmail string) error {
+	return s.sendActionEmail("SendEmailChangedNotice", oldEmail, "Your Email Address Was Changed", actionEmail{
+		Title:   "Email Address Changed",
+		Banner:  "Citary",
+		Heading: "Your Email Address Was Changed",
+		Paragraphs: []string{
+			fmt.Sprintf("The email address of your Citary account was changed to %s. You will no longer receive account emails at this address.", newEmail),
+			"If you made this change, no further action is needed.",
+		},
+		Footer: "If you didn't make this change, contact our support team immediately.",
+	})
+}
+
+// SendOrganizationInvitation sends a link to join an organization with the given role
+func (s *SMTPEmailService) SendOrganizationInvitation(ctx context.Context, email, organizationName, roleName, token string) error {
+	invitationLink := fmt.Sprintf("%s/invitations/accept?token=%s", s.config.FrontendURL, token)
+
+	return s.sendActionEmail("SendOrganizationInvitation", email, fmt.Sprintf("You're Invited to Join %s", organizationName), actionEmail{
+		Title:   "Organization Invitation",
+		Banner:  "Citary",
+		Heading: fmt.Sprintf("Join %s on Citary", organizationName),
+		Paragraphs: []string{
+			fmt.Sprintf("You have been invited to join %s as %s. Click the button below to accept the invitation.", organizationName, roleName),
+			"If you don't have a Citary account yet, you will create one with this email address. This link will expire in 7 days.",
+		},
+		ButtonText: "Accept Invitation",
+		Link:       invitationLink,
+		Footer:     "If you weren't expecting this invitation, you can safely ignore this email.",
+	})
+}
+
+// SendAppointmentReminder reminds a patient of an upcoming appointment
+func (s *SMTPEmailService) SendAppointmentReminder(ctx context.Context, email string, appointment *entities.Appointment) error {
+	appointmentLink := fmt.Sprintf("%s/appointments/%d", s.config.FrontendURL, appointment.ID)
+
+	return s.sendActionEmail("SendAppointmentReminder", email, fmt.Sprintf("Reminder: Your Appointment at %s", appointment.OrganizationName), actionEmail{
+		Title:   "Appointment Reminder",
+		Banner:  "Citary",
+		Heading: "Your Appointment Is Coming Up",
+		Paragraphs: []string{
+			fmt.Sprintf("This is a reminder of your appointment with %s at %s on %s.", doctorDisplayName(appointment), appointment.OrganizationName, formatAppointmentTime(appointment)),
+			fmt.Sprintf("Location: %s", appointment.LocationName),
+		},
+		ButtonText: "View Appointment",
+		Link:       appointmentLink,
+		Footer:     "If you can no longer attend, please cancel or reschedule so the time can be offered to another patient.",
+	})
+}
+
+// SendAppointmentBooked confirms a new booking to the patient, attaching the appointment as an iCalendar file
+func (s *SMTPEmailService) SendAppointmentBooked(ctx context.Context, email string, appointment *entities.Appointment, invite []byte) error {
+	appointmentLink := fmt.Sprintf("%s/appointments/%d", s.config.FrontendURL, appointment.ID)
+
+	return s.sendActionEmail("SendAppointmentBooked", email, fmt.Sprintf("Appointment Booked at %s", appointment.OrganizationName), actionEmail{
+		Title:   "Appointment Booked",
+		Banner:  "Citary",
+		Heading: "Your Appointment Is Booked",
+		Paragraphs: []string{
+			fmt.Sprintf("Your appointment with %s at %s on %s has been booked. The clinic will confirm it shortly.", doctorDisplayName(appointment), appointment.OrganizationName, formatAppointmentTime(appointment)),
+			fmt.Sprintf("Location: %s", appointment.LocationName),
+			"Open the attached calendar file to add the appointment to your calendar.",
+		},
+		ButtonText: "View Appointment",
+		Link:       appointmentLink,
+		Footer:     "If you can no longer attend, please cancel or reschedule so the time can be offered to another patient.",
+	}, emailAttachment{
+		Filename:    "appointment.ics",
+		ContentType: "text/calendar; charset=UTF-8; method=PUBLISH",
+		Content:     invite,
+	})
+}
+
+// sendActionEmail renders an action email and sends it with any attachments, logging under the given operation name
+func (s *SMTPEmailService) sendActionEmail(operation, email, subject string, content actionEmail, attachments ...emailAttachment) error {
+	start := time.Now()
+	log.Printf("[SMTPEmailService] %s: email=%s", operation, email)
+
+	htmlBody, err := renderActionEmailTemplate(content)
 	if err != nil {
-		log.Printf("[SMTPEmailService] SendVerificationEmail ERROR: failed to render template, email=%s, error=%v", email, err)
+		log.Printf("[SMTPEmailService] %s ERROR: failed to render template, email=%s, error=%v", operation, email, err)
 		return fmt.Errorf("failed to render email template: %w", err)
 	}
 
-	err = s.sendEmail(email, subject, htmlBody)
+	err = s.sendEmail(email, subject, htmlBody, attachments...)
 	duration := time.Since(start)
 
 	if err != nil {
-		log.Printf("[SMTPEmailService] SendVerificationEmail ERROR: email=%s, error=%v, duration=%v", email, err, duration)
+		log.Printf("[SMTPEmailService] %s ERROR: email=%s, error=%v, duration=%v", operation, email, err, duration)
 		return err
 	}
 
-	log.Printf("[SMTPEmailService] SendVerificationEmail: success, email=%s, duration=%v", email, duration)
+	log.Printf("[SMTPEmailService] %s: success, email=%s, duration=%v", operation, email, duration)
 	return nil
 }
 
 // sendEmail sends an email using SMTP
-func (s *SMTPEmailService) sendEmail(to, subject, htmlBody string) error {
+// Emails with attachments are sent as multipart/mixed with the HTML body as the first part
+func (s *SMTPEmailService) sendEmail(to, subject, htmlBody string, attachments ...emailAttachment) error {
 	from := fmt.Sprintf("%s <%s>", s.config.SMTPFromName, s.config.SMTPFromEmail)
 
 	headers := make(map[string]string)
@@ -64,11 +212,21 @@ func (s *SMTPEmailService) sendEmail(to, subject, htmlBody string) error {
 	headers["MIME-Version"] = "1.0"
 	headers["Content-Type"] = "text/html; charset=UTF-8"
 
+	body := htmlBody
+	if len(attachments) > 0 {
+		contentType, multipartBody, err := buildMultipartBody(htmlBody, attachments)
+		if err != nil {
+			return fmt.Errorf("failed to build email body: %w", err)
+		}
+		headers["Content-Type"] = contentType
+		body = multipartBody
+	}
+
 	message := ""
 	for key, value := range headers {
 		message += fmt.Sprintf("%s: %s\r\n", key, value)
 	}
-	message += "\r\n" + htmlBody
+	message += "\r\n" + body
 
 	addr := fmt.Sprintf("%s:%s", s.config.SMTPHost, s.config.SMTPPort)
 	err := smtp.SendMail(addr, s.auth, s.config.SMTPFromEmail, []string{to}, []byte(message))
@@ -79,15 +237,97 @@ func (s *SMTPEmailService) sendEmail(to, subject, htmlBody string) error {
 	return nil
 }
 
-// renderVerificationEmailTemplate renders the HTML template for verification email
-func (s *SMTPEmailService) renderVerificationEmailTemplate(verificationLink string) (string, error) {
-	tmpl := `
+// doctorDisplayName returns how a doctor is named in patient emails
+func doctorDisplayName(appointment *entities.Appointment) string {
+	if name := appointment.DoctorName(); name != "" {
+		return "Dr. " + name
+	}
+	return "your doctor"
+}
+
+// formatAppointmentTime renders an appointment's start in the local time of its location
+// Falls back to UTC if the location's timezone cannot be loaded
+func formatAppointmentTime(appointment *entities.Appointment) string {
+	startTime := appointment.StartTime.UTC()
+	if location, err := time.LoadLocation(appointment.LocationTimezone); err == nil {
+		startTime = startTime.In(location)
+	}
+	return startTime.Format("Monday, January 2, 2006 at 3:04 PM (MST)")
+}
+
+// emailAttachment is a file attached to an email
+type emailAttachment struct {
+	Filename    string
+	ContentType string
+	Content     []byte
+}
+
+// buildMultipartBody renders an HTML body and attachments as a multipart/mixed body
+// Attachments are base64 encoded in lines of 76 characters, as required by RFC 2045
+func buildMultipartBody(htmlBody string, attachments []emailAttachment) (string, string, error) {
+	var buffer bytes.Buffer
+	writer := multipart.NewWriter(&buffer)
+
+	htmlPart, err := writer.CreatePart(textproto.MIMEHeader{
+		"Content-Type": {"text/html; charset=UTF-8"},
+	})
+	if err != nil {
+		return "", "", err
+	}
+	if _, err := htmlPart.Write([]byte(htmlBody)); err != nil {
+		return "", "", err
+	}
+
+	for _, attachment := range attachments {
+		part, err := writer.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {attachment.ContentType},
+			"Content-Transfer-Encoding": {"base64"},
+			"Content-Disposition":       {mime.FormatMediaType("attachment", map[string]string{"filename": attachment.Filename})},
+		})
+		if err != nil {
+			return "", "", err
+		}
+
+		encoded := base64.StdEncoding.EncodeToString(attachment.Content)
+		for len(encoded) > 76 {
+			if _, err := part.Write([]byte(encoded[:76] + "\r\n")); err != nil {
+				return "", "", err
+			}
+			encoded = encoded[76:]
+		}
+		if _, err := part.Write([]byte(encoded + "\r\n")); err != nil {
+			return "", "", err
+		}
+	}
+
+	if err := writer.Close(); err != nil {
+		return "", "", err
+	}
+
+	return "multipart/mixed; boundary=" + writer.Boundary(), buffer.String(), nil
+}
+
+// actionEmail holds the content of a transactional email built around a single call-to-action link
+// ButtonText and Link may be empty for purely informational emails
+type actionEmail struct {
+	Title      string
+	Banner     string
+	Heading    string
+	Paragraphs []string
+	ButtonText string
+	Link       string
+	Footer     string
+	Year       int
+}
+
+// actionEmailTemplate is the shared HTML layout for every transactional email
+var actionEmailTemplate = template.Must(template.New("action").Parse(`
 <!DOCTYPE html>
 <html lang="en">
 <head>
     <meta charset="UTF-8">
     <meta name="viewport" content="width=device-width, initial-scale=1.0">
-    <title>Verify Your Email</title>
+    <title>{{.Title}}</title>
 </head>
 <body style="margin: 0; padding: 0; font-family: Arial, sans-serif; background-color: #f4f4f4;">
     <table width="100%" cellpadding="0" cellspacing="0" style="background-color: #f4f4f4; padding: 20px;">
@@ -97,27 +337,26 @@ func (s *SMTPEmailService) renderVerificationEmailTemplate(verificationLink stri
                     <!-- Header -->
                     <tr>
                         <td style="background: linear-gradient(135deg, #667eea 0%, #764ba2 100%); padding: 40px 20px; text-align: center;">
-                            <h1 style="color: #ffffff; margin: 0; font-size: 28px; font-weight: bold;">Welcome to Citary!</h1>
+                            <h1 style="color: #ffffff; margin: 0; font-size: 28px; font-weight: bold;">{{.Banner}}</h1>
                         </td>
                     </tr>
 
                     <!-- Body -->
                     <tr>
                         <td style="padding: 40px 30px;">
-                            <h2 style="color: #333333; margin: 0 0 20px 0; font-size: 24px;">Verify Your Email Address</h2>
+                            <h2 style="color: #333333; margin: 0 0 20px 0; font-size: 24px;">{{.Heading}}</h2>
+                            {{range .Paragraphs}}
                             <p style="color: #666666; line-height: 1.6; margin: 0 0 20px 0; font-size: 16px;">
-                                Thank you for signing up! To complete your registration and start using Citary,
-                                please verify your email address by clicking the button below.
-                            </p>
-                            <p style="color: #666666; line-height: 1.6; margin: 0 0 30px 0; font-size: 16px;">
-                                This verification link will expire in 24 hours.
+                                {{.}}
                             </p>
+                            {{end}}
 
+                            {{if .Link}}
                             <!-- Button -->
                             <table width="100%" cellpadding="0" cellspacing="0">
                                 <tr>
                                     <td align="center" style="padding: 20px 0;">
-                                        <a href="{{.VerificationLink}}"
+                                        <a href="{{.Link}}"
                                            style="background: linear-gradient(135deg, #667eea 0%, #764ba2 100%);
                                                   color: #ffffff;
                                                   text-decoration: none;
@@ -126,7 +365,7 @@ func (s *SMTPEmailService) renderVerificationEmailTemplate(verificationLink stri
                                                   font-size: 16px;
                                                   font-weight: bold;
                                                   display: inline-block;">
-                                            Verify Email Address
+                                            {{.ButtonText}}
                                         </a>
                                     </td>
                                 </tr>
@@ -136,8 +375,9 @@ func (s *SMTPEmailService) renderVerificationEmailTemplate(verificationLink stri
                                 If the button doesn't work, copy and paste this link into your browser:
                             </p>
                             <p style="color: #667eea; line-height: 1.6; margin: 10px 0 0 0; font-size: 14px; word-break: break-all;">
-                                {{.VerificationLink}}
+                                {{.Link}}
                             </p>
+                            {{end}}
                         </td>
                     </tr>
 
@@ -145,7 +385,7 @@ func (s *SMTPEmailService) renderVerificationEmailTemplate(verificationLink stri
                     <tr>
                         <td style="background-color: #f8f9fa; padding: 30px; text-align: center; border-top: 1px solid #eeeeee;">
                             <p style="color: #999999; margin: 0 0 10px 0; font-size: 14px;">
-                                If you didn't create an account with Citary, you can safely ignore this email.
+                                {{.Footer}}
                             </p>
                             <p style="color: #999999; margin: 0; font-size: 12px;">
                                 &copy; {{.Year}} Citary. All rights reserved.
@@ -158,24 +398,14 @@ func (s *SMTPEmailService) renderVerificationEmailTemplate(verificationLink stri
     </table>
 </body>
 </html>
-`
+`))
 
-	t, err := template.New("verification").Parse(tmpl)
-	if err != nil {
-		return "", err
-	}
-
-	data := struct {
-		VerificationLink string
-		Year             int
-	}{
-		VerificationLink: verificationLink,
-		Year:             time.Now().Year(),
-	}
+// renderActionEmailTemplate renders the shared HTML layout with the given content
+func renderActionEmailTemplate(content actionEmail) (string, error) {
+	content.Year = time.Now().Year()
 
 	var buffer bytes.Buffer
-	err = t.Execute(&buffer, data)
-	if err != nil {
+	if err := actionEmailTemplate.Execute(&buffer, content); err != nil {
 		return "", err
 	}
 
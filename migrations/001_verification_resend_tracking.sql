@@ -0,0 +1,4 @@
+-- Tracks verification email deliveries so resend requests can enforce a cooldown and a daily cap
+ALTER TABLE data.data_user
+    ADD COLUMN IF NOT EXISTS use_verification_sent_at TIMESTAMPTZ NULL,
+    ADD COLUMN IF NOT EXISTS use_verification_send_count INTEGER NOT NULL DEFAULT 0;
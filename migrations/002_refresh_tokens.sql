@@ -0,0 +1,18 @@
+-- Opaque refresh tokens stored as SHA-256 hashes, grouped in families (one family per device login)
+CREATE TABLE IF NOT EXISTS data.data_refresh_token (
+    ref_id            SERIAL PRIMARY KEY,
+    id_user           INTEGER      NOT NULL REFERENCES data.data_user (use_id),
+    ref_family_id     VARCHAR(32)  NOT NULL,
+    ref_token_hash    CHAR(64)     NOT NULL UNIQUE,
+    ref_device_name   VARCHAR(100) NULL,
+    ref_user_agent    VARCHAR(255) NULL,
+    ref_ip_address    VARCHAR(45)  NULL,
+    ref_expires_at    TIMESTAMPTZ  NOT NULL,
+    ref_revoked_at    TIMESTAMPTZ  NULL,
+    ref_replaced_by   INTEGER      NULL REFERENCES data.data_refresh_token (ref_id),
+    ref_created_date  TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    ref_record_status VARCHAR(1)   NOT NULL DEFAULT '0'
+);
+
+CREATE INDEX IF NOT EXISTS idx_refresh_token_user ON data.data_refresh_token (id_user);
+CREATE INDEX IF NOT EXISTS idx_refresh_token_family ON data.data_refresh_token (ref_family_id);
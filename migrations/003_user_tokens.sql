@@ -0,0 +1,14 @@
+-- Single-use, hashed, time-limited tokens emailed to users (password reset and similar flows)
+CREATE TABLE IF NOT EXISTS data.data_user_token (
+    uto_id            SERIAL PRIMARY KEY,
+    id_user           INTEGER      NOT NULL REFERENCES data.data_user (use_id),
+    uto_purpose       VARCHAR(30)  NOT NULL,
+    uto_token_hash    CHAR(64)     NOT NULL UNIQUE,
+    uto_payload       VARCHAR(255) NULL,
+    uto_expires_at    TIMESTAMPTZ  NOT NULL,
+    uto_used_at       TIMESTAMPTZ  NULL,
+    uto_created_date  TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    uto_record_status VARCHAR(1)   NOT NULL DEFAULT '0'
+);
+
+CREATE INDEX IF NOT EXISTS idx_user_token_user_purpose ON data.data_user_token (id_user, uto_purpose);
@@ -0,0 +1,12 @@
+-- One-time two-factor recovery codes, stored as SHA-256 hashes
+-- (use_two_factor_enabled / use_two_factor_secret already exist on data.data_user)
+CREATE TABLE IF NOT EXISTS data.data_recovery_code (
+    rco_id            SERIAL PRIMARY KEY,
+    id_user           INTEGER     NOT NULL REFERENCES data.data_user (use_id),
+    rco_code_hash     CHAR(64)    NOT NULL,
+    rco_used_at       TIMESTAMPTZ NULL,
+    rco_created_date  TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    rco_record_status VARCHAR(1)  NOT NULL DEFAULT '0'
+);
+
+CREATE INDEX IF NOT EXISTS idx_recovery_code_user ON data.data_recovery_code (id_user, rco_code_hash);
@@ -0,0 +1,19 @@
+-- Permission sets interpreted by the authorizer (a JSON array of "resource:action[:scope]" codes)
+-- A trailing "*" segment grants every action below it; "*" alone grants everything
+UPDATE core.core_role SET rol_permissions = '["*"]'::jsonb
+WHERE rol_code = 'super_admin';
+
+UPDATE core.core_role SET rol_permissions = '["users:*", "roles:read", "organizations:*", "doctors:*", "availability:*", "appointments:*"]'::jsonb
+WHERE rol_code = 'admin';
+
+UPDATE core.core_role SET rol_permissions = '["users:read", "organizations:*", "doctors:*", "availability:*", "appointments:*"]'::jsonb
+WHERE rol_code = 'organization_owner';
+
+UPDATE core.core_role SET rol_permissions = '["users:read", "organizations:read", "doctors:read", "availability:manage", "appointments:read", "appointments:read:any", "appointments:manage"]'::jsonb
+WHERE rol_code = 'doctor';
+
+UPDATE core.core_role SET rol_permissions = '["users:read", "organizations:read", "doctors:read", "availability:manage", "appointments:*"]'::jsonb
+WHERE rol_code = 'staff';
+
+UPDATE core.core_role SET rol_permissions = '["users:read", "doctors:read", "appointments:create", "appointments:read"]'::jsonb
+WHERE rol_code = 'patient';
@@ -0,0 +1,14 @@
+-- Personal profile data shown to the logged-in user (one row per user, created on first update)
+CREATE TABLE IF NOT EXISTS data.data_user_profile (
+    upr_id            SERIAL PRIMARY KEY,
+    id_user           INTEGER      NOT NULL UNIQUE REFERENCES data.data_user (use_id),
+    upr_first_name    VARCHAR(100) NULL,
+    upr_last_name     VARCHAR(100) NULL,
+    upr_phone         VARCHAR(20)  NULL,
+    upr_birth_date    DATE         NULL,
+    upr_locale        VARCHAR(10)  NULL,
+    upr_avatar_url    VARCHAR(500) NULL,
+    upr_created_date  TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    upr_updated_date  TIMESTAMPTZ  NULL,
+    upr_record_status VARCHAR(1)   NOT NULL DEFAULT '0'
+);
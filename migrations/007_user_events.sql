@@ -0,0 +1,12 @@
+-- Append-only audit trail of account lifecycle events (reactivation, deletion, ...)
+CREATE TABLE IF NOT EXISTS data.data_user_event (
+    uev_id            SERIAL PRIMARY KEY,
+    id_user           INTEGER      NOT NULL REFERENCES data.data_user (use_id),
+    uev_type          VARCHAR(50)  NOT NULL,
+    uev_details       VARCHAR(255) NULL,
+    uev_ip_address    VARCHAR(45)  NULL,
+    uev_created_date  TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    uev_record_status VARCHAR(1)   NOT NULL DEFAULT '0'
+);
+
+CREATE INDEX IF NOT EXISTS idx_user_event_user ON data.data_user_event (id_user, uev_created_date);
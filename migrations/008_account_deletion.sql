@@ -0,0 +1,7 @@
+-- Self-service account deletion: soft delete timestamp and the moment PII becomes due for anonymization
+ALTER TABLE data.data_user
+    ADD COLUMN IF NOT EXISTS use_deleted_at      TIMESTAMPTZ NULL,
+    ADD COLUMN IF NOT EXISTS use_anonymize_after TIMESTAMPTZ NULL;
+
+CREATE INDEX IF NOT EXISTS idx_user_anonymize_after ON data.data_user (use_anonymize_after)
+    WHERE use_anonymize_after IS NOT NULL;
@@ -0,0 +1,33 @@
+-- Versioned legal documents (terms of service, privacy policy); the latest published version of each type is current
+CREATE TABLE IF NOT EXISTS data.data_legal_document (
+    led_id            SERIAL PRIMARY KEY,
+    led_type          VARCHAR(20)  NOT NULL,
+    led_version       VARCHAR(20)  NOT NULL,
+    led_title         VARCHAR(150) NOT NULL,
+    led_url           VARCHAR(500) NOT NULL,
+    led_published_at  TIMESTAMPTZ  NOT NULL,
+    led_created_date  TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    led_record_status VARCHAR(1)   NOT NULL DEFAULT '0',
+    UNIQUE (led_type, led_version)
+);
+
+CREATE INDEX IF NOT EXISTS idx_legal_document_current ON data.data_legal_document (led_type, led_published_at DESC);
+
+-- Consent history: one row per user and accepted document version, with the client it was given from
+CREATE TABLE IF NOT EXISTS data.data_user_consent (
+    uco_id            SERIAL PRIMARY KEY,
+    id_user           INTEGER      NOT NULL REFERENCES data.data_user (use_id),
+    id_legal_document INTEGER      NOT NULL REFERENCES data.data_legal_document (led_id),
+    uco_accepted_at   TIMESTAMPTZ  NOT NULL,
+    uco_ip_address    VARCHAR(45)  NULL,
+    uco_user_agent    VARCHAR(255) NULL,
+    uco_created_date  TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    uco_record_status VARCHAR(1)   NOT NULL DEFAULT '0',
+    UNIQUE (id_user, id_legal_document)
+);
+
+INSERT INTO data.data_legal_document (led_type, led_version, led_title, led_url, led_published_at)
+VALUES
+    ('terms', '1.0', 'Terms of Service', '/legal/terms/1.0', NOW()),
+    ('privacy', '1.0', 'Privacy Policy', '/legal/privacy/1.0', NOW())
+ON CONFLICT (led_type, led_version) DO NOTHING;
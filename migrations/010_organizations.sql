@@ -0,0 +1,21 @@
+-- Organizations (clinics) onboarded by their owner; organization-bound records reference them through id_organization
+CREATE TABLE IF NOT EXISTS data.data_organization (
+    org_id            SERIAL PRIMARY KEY,
+    id_user_owner     INTEGER      NOT NULL REFERENCES data.data_user (use_id),
+    org_name          VARCHAR(150) NOT NULL,
+    org_slug          VARCHAR(80)  NOT NULL UNIQUE,
+    org_email         VARCHAR(100) NULL,
+    org_phone         VARCHAR(20)  NULL,
+    org_address       VARCHAR(255) NULL,
+    org_timezone      VARCHAR(64)  NOT NULL,
+    org_created_date  TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    org_updated_date  TIMESTAMPTZ  NULL,
+    org_record_status VARCHAR(1)   NOT NULL DEFAULT '0'
+);
+
+-- The organization a user works in; NULL for patients and users who have not onboarded a clinic
+ALTER TABLE data.data_user
+    ADD COLUMN IF NOT EXISTS id_organization INTEGER NULL REFERENCES data.data_organization (org_id);
+
+CREATE INDEX IF NOT EXISTS idx_user_organization ON data.data_user (id_organization)
+    WHERE id_organization IS NOT NULL;
@@ -0,0 +1,21 @@
+-- Staff invitations: an emailed single-use token that brings a doctor or staff member into an organization
+CREATE TABLE IF NOT EXISTS data.data_organization_invitation (
+    oin_id             SERIAL PRIMARY KEY,
+    id_organization    INTEGER      NOT NULL REFERENCES data.data_organization (org_id),
+    id_role            INTEGER      NOT NULL REFERENCES core.core_role (rol_id),
+    id_user_invited_by INTEGER      NOT NULL REFERENCES data.data_user (use_id),
+    id_user_accepted   INTEGER      NULL REFERENCES data.data_user (use_id),
+    oin_email          VARCHAR(100) NOT NULL,
+    oin_token_hash     CHAR(64)     NOT NULL UNIQUE,
+    oin_expires_at     TIMESTAMPTZ  NOT NULL,
+    oin_accepted_at    TIMESTAMPTZ  NULL,
+    oin_revoked_at     TIMESTAMPTZ  NULL,
+    oin_created_date   TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    oin_record_status  VARCHAR(1)   NOT NULL DEFAULT '0'
+);
+
+CREATE INDEX IF NOT EXISTS idx_organization_invitation_org ON data.data_organization_invitation (id_organization, oin_created_date DESC);
+
+-- At most one open invitation per organization and email
+CREATE UNIQUE INDEX IF NOT EXISTS uq_organization_invitation_open ON data.data_organization_invitation (id_organization, oin_email)
+    WHERE oin_accepted_at IS NULL AND oin_revoked_at IS NULL;
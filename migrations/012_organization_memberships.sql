@@ -0,0 +1,35 @@
+-- Organization memberships: a user can work in several organizations, with a role per organization
+CREATE TABLE IF NOT EXISTS data.data_organization_membership (
+    orm_id            SERIAL PRIMARY KEY,
+    id_organization   INTEGER     NOT NULL REFERENCES data.data_organization (org_id),
+    id_user           INTEGER     NOT NULL REFERENCES data.data_user (use_id),
+    id_role           INTEGER     NOT NULL REFERENCES core.core_role (rol_id),
+    orm_created_date  TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    orm_updated_date  TIMESTAMPTZ NULL,
+    orm_record_status VARCHAR(1)  NOT NULL DEFAULT '0',
+    UNIQUE (id_organization, id_user)
+);
+
+CREATE INDEX IF NOT EXISTS idx_organization_membership_user ON data.data_organization_membership (id_user);
+
+-- Every existing organization member keeps their current role as a membership
+INSERT INTO data.data_organization_membership (id_organization, id_user, id_role)
+SELECT id_organization, use_id, id_role
+FROM data.data_user
+WHERE id_organization IS NOT NULL
+ON CONFLICT (id_organization, id_user) DO NOTHING;
+
+-- data_user.id_role is now the role outside of any organization; organization roles live on the membership
+UPDATE data.data_user
+SET id_role = (SELECT rol_id FROM core.core_role WHERE rol_code = 'patient')
+WHERE id_organization IS NOT NULL
+  AND id_role IN (
+      SELECT rol_id FROM core.core_role
+      WHERE rol_code IN ('organization_owner', 'doctor', 'staff', 'admin')
+  );
+
+COMMENT ON COLUMN data.data_user.id_organization IS 'Organization selected by default when the user logs in';
+
+-- The organization a session acts within, carried across refresh token rotations
+ALTER TABLE data.data_refresh_token
+    ADD COLUMN IF NOT EXISTS id_organization INTEGER NULL REFERENCES data.data_organization (org_id);
@@ -0,0 +1,61 @@
+-- Medical specialties doctors are listed under; codes are stable identifiers used in search filters
+CREATE TABLE IF NOT EXISTS core.core_specialty (
+    spe_id            SERIAL PRIMARY KEY,
+    spe_code          VARCHAR(50)  NOT NULL UNIQUE,
+    spe_name          VARCHAR(100) NOT NULL,
+    spe_created_date  TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    spe_record_status VARCHAR(1)   NOT NULL DEFAULT '0'
+);
+
+INSERT INTO core.core_specialty (spe_code, spe_name)
+VALUES
+    ('general_medicine', 'General Medicine'),
+    ('family_medicine', 'Family Medicine'),
+    ('internal_medicine', 'Internal Medicine'),
+    ('pediatrics', 'Pediatrics'),
+    ('gynecology', 'Gynecology and Obstetrics'),
+    ('cardiology', 'Cardiology'),
+    ('dermatology', 'Dermatology'),
+    ('endocrinology', 'Endocrinology'),
+    ('gastroenterology', 'Gastroenterology'),
+    ('neurology', 'Neurology'),
+    ('ophthalmology', 'Ophthalmology'),
+    ('orthopedics', 'Orthopedics and Traumatology'),
+    ('otolaryngology', 'Otolaryngology'),
+    ('psychiatry', 'Psychiatry'),
+    ('psychology', 'Psychology'),
+    ('urology', 'Urology'),
+    ('dentistry', 'Dentistry'),
+    ('nutrition', 'Nutrition'),
+    ('physiotherapy', 'Physiotherapy')
+ON CONFLICT (spe_code) DO NOTHING;
+
+-- Doctor profiles: a member of an organization who sees patients there
+-- A user practicing at several clinics has one profile per organization
+CREATE TABLE IF NOT EXISTS data.data_doctor (
+    doc_id                   SERIAL PRIMARY KEY,
+    id_user                  INTEGER       NOT NULL REFERENCES data.data_user (use_id),
+    id_organization          INTEGER       NOT NULL REFERENCES data.data_organization (org_id),
+    doc_license_number       VARCHAR(50)   NOT NULL,
+    doc_bio                  VARCHAR(2000) NULL,
+    doc_languages            VARCHAR(10)[] NOT NULL DEFAULT '{}',
+    doc_consultation_minutes INTEGER       NOT NULL,
+    doc_fee_cents            INTEGER       NULL CHECK (doc_fee_cents >= 0),
+    doc_fee_currency         CHAR(3)       NOT NULL DEFAULT 'USD',
+    doc_created_date         TIMESTAMPTZ   NOT NULL DEFAULT NOW(),
+    doc_updated_date         TIMESTAMPTZ   NULL,
+    doc_record_status        VARCHAR(1)    NOT NULL DEFAULT '0',
+    CONSTRAINT uq_doctor_organization_user UNIQUE (id_organization, id_user),
+    CONSTRAINT uq_doctor_organization_license UNIQUE (id_organization, doc_license_number)
+);
+
+CREATE INDEX IF NOT EXISTS idx_doctor_languages ON data.data_doctor USING GIN (doc_languages);
+
+-- Specialties of each doctor profile
+CREATE TABLE IF NOT EXISTS data.data_doctor_specialty (
+    id_doctor    INTEGER NOT NULL REFERENCES data.data_doctor (doc_id) ON DELETE CASCADE,
+    id_specialty INTEGER NOT NULL REFERENCES core.core_specialty (spe_id),
+    PRIMARY KEY (id_doctor, id_specialty)
+);
+
+CREATE INDEX IF NOT EXISTS idx_doctor_specialty_specialty ON data.data_doctor_specialty (id_specialty);
@@ -0,0 +1,56 @@
+-- Places an organization sees patients at; availability is expressed in the wall-clock time of the location's timezone
+CREATE TABLE IF NOT EXISTS data.data_location (
+    loc_id            SERIAL PRIMARY KEY,
+    id_organization   INTEGER      NOT NULL REFERENCES data.data_organization (org_id),
+    loc_name          VARCHAR(100) NOT NULL,
+    loc_address       VARCHAR(255) NULL,
+    loc_timezone      VARCHAR(64)  NOT NULL,
+    loc_created_date  TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    loc_updated_date  TIMESTAMPTZ  NULL,
+    loc_record_status VARCHAR(1)   NOT NULL DEFAULT '0'
+);
+
+CREATE INDEX IF NOT EXISTS idx_location_organization ON data.data_location (id_organization);
+
+-- Recurring weekly working hours of a doctor at a location
+-- Weekday follows EXTRACT(DOW): 0 is Sunday; times are local to the location and the end may be 24:00
+CREATE TABLE IF NOT EXISTS data.data_availability_block (
+    avb_id            SERIAL PRIMARY KEY,
+    id_doctor         INTEGER     NOT NULL REFERENCES data.data_doctor (doc_id),
+    id_location       INTEGER     NOT NULL REFERENCES data.data_location (loc_id),
+    avb_weekday       SMALLINT    NOT NULL CHECK (avb_weekday BETWEEN 0 AND 6),
+    avb_start_time    TIME        NOT NULL,
+    avb_end_time      TIME        NOT NULL,
+    avb_valid_from    DATE        NULL,
+    avb_valid_until   DATE        NULL,
+    avb_created_date  TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    avb_updated_date  TIMESTAMPTZ NULL,
+    avb_record_status VARCHAR(1)  NOT NULL DEFAULT '0',
+    CHECK (avb_end_time > avb_start_time),
+    CHECK (avb_valid_until IS NULL OR avb_valid_from IS NULL OR avb_valid_until >= avb_valid_from)
+);
+
+CREATE INDEX IF NOT EXISTS idx_availability_block_doctor ON data.data_availability_block (id_doctor, avb_weekday);
+
+-- Date-specific changes to a doctor's weekly hours
+-- 'unavailable' removes time (vacations, holidays; whole days when no times are set, all locations when no location is set)
+-- 'available' adds an extra shift at a location
+CREATE TABLE IF NOT EXISTS data.data_availability_exception (
+    avx_id            SERIAL PRIMARY KEY,
+    id_doctor         INTEGER      NOT NULL REFERENCES data.data_doctor (doc_id),
+    id_location       INTEGER      NULL REFERENCES data.data_location (loc_id),
+    avx_kind          VARCHAR(20)  NOT NULL CHECK (avx_kind IN ('unavailable', 'available')),
+    avx_start_date    DATE         NOT NULL,
+    avx_end_date      DATE         NOT NULL,
+    avx_start_time    TIME         NULL,
+    avx_end_time      TIME         NULL,
+    avx_reason        VARCHAR(255) NULL,
+    avx_created_date  TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    avx_record_status VARCHAR(1)   NOT NULL DEFAULT '0',
+    CHECK (avx_end_date >= avx_start_date),
+    CHECK ((avx_start_time IS NULL) = (avx_end_time IS NULL)),
+    CHECK (avx_end_time IS NULL OR avx_end_time > avx_start_time),
+    CHECK (avx_kind = 'unavailable' OR (id_location IS NOT NULL AND avx_start_time IS NOT NULL))
+);
+
+CREATE INDEX IF NOT EXISTS idx_availability_exception_doctor ON data.data_availability_exception (id_doctor, avx_start_date, avx_end_date);
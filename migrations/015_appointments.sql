@@ -0,0 +1,28 @@
+-- btree_gist lets the exclusion constraint below combine equality on the doctor with range overlap
+CREATE EXTENSION IF NOT EXISTS btree_gist;
+
+-- Appointments a patient booked with a doctor at one of the organization's locations
+-- Start and end are absolute instants; the location's timezone is only used to display them
+CREATE TABLE IF NOT EXISTS data.data_appointment (
+    apt_id            SERIAL PRIMARY KEY,
+    id_organization   INTEGER      NOT NULL REFERENCES data.data_organization (org_id),
+    id_doctor         INTEGER      NOT NULL REFERENCES data.data_doctor (doc_id),
+    id_location       INTEGER      NOT NULL REFERENCES data.data_location (loc_id),
+    id_patient        INTEGER      NOT NULL REFERENCES data.data_user (use_id),
+    apt_start_time    TIMESTAMPTZ  NOT NULL,
+    apt_end_time      TIMESTAMPTZ  NOT NULL,
+    apt_status        VARCHAR(30)  NOT NULL DEFAULT 'requested',
+    apt_reason        VARCHAR(500) NULL,
+    apt_created_date  TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    apt_updated_date  TIMESTAMPTZ  NULL,
+    apt_record_status VARCHAR(1)   NOT NULL DEFAULT '0',
+    CONSTRAINT chk_appointment_time_range CHECK (apt_end_time > apt_start_time),
+    -- Two appointments holding the same doctor can never overlap, however concurrent the bookings
+    CONSTRAINT ex_appointment_doctor_overlap EXCLUDE USING gist (
+        id_doctor WITH =,
+        tstzrange(apt_start_time, apt_end_time, '[)') WITH &&
+    ) WHERE (apt_record_status = '0' AND apt_status IN ('requested', 'confirmed'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_appointment_patient ON data.data_appointment (id_patient, apt_start_time);
+CREATE INDEX IF NOT EXISTS idx_appointment_organization ON data.data_appointment (id_organization, apt_start_time);
@@ -0,0 +1,42 @@
+-- Appointment lifecycle: the statuses an appointment moves through; allowed transitions are enforced by the domain layer
+ALTER TABLE data.data_appointment DROP CONSTRAINT IF EXISTS chk_appointment_status;
+ALTER TABLE data.data_appointment
+    ADD CONSTRAINT chk_appointment_status CHECK (apt_status IN (
+        'requested', 'confirmed', 'checked_in', 'completed',
+        'cancelled_by_patient', 'cancelled_by_clinic', 'no_show'
+    ));
+
+-- Every appointment that was not cancelled keeps holding its slot, including attended and missed ones
+ALTER TABLE data.data_appointment DROP CONSTRAINT IF EXISTS ex_appointment_doctor_overlap;
+ALTER TABLE data.data_appointment
+    ADD CONSTRAINT ex_appointment_doctor_overlap EXCLUDE USING gist (
+        id_doctor WITH =,
+        tstzrange(apt_start_time, apt_end_time, '[)') WITH &&
+    ) WHERE (apt_record_status = '0' AND apt_status NOT IN ('cancelled_by_patient', 'cancelled_by_clinic'));
+
+-- Audit trail of appointment changes: who moved an appointment to which status or time, when and why
+-- The booking itself is recorded with a NULL from status; reschedules keep the status and record the new times
+CREATE TABLE IF NOT EXISTS data.data_appointment_status_history (
+    ash_id           SERIAL PRIMARY KEY,
+    id_appointment   INTEGER      NOT NULL REFERENCES data.data_appointment (apt_id) ON DELETE CASCADE,
+    ash_from_status  VARCHAR(30)  NULL,
+    ash_to_status    VARCHAR(30)  NOT NULL,
+    ash_start_time   TIMESTAMPTZ  NOT NULL,
+    ash_end_time     TIMESTAMPTZ  NOT NULL,
+    id_changed_by    INTEGER      NOT NULL REFERENCES data.data_user (use_id),
+    ash_reason       VARCHAR(500) NULL,
+    ash_created_date TIMESTAMPTZ  NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_appointment_status_history_appointment
+    ON data.data_appointment_status_history (id_appointment, ash_created_date);
+
+-- Appointments booked before the history existed get their booking entry
+INSERT INTO data.data_appointment_status_history (
+    id_appointment, ash_from_status, ash_to_status, ash_start_time, ash_end_time, id_changed_by, ash_created_date
+)
+SELECT a.apt_id, NULL, a.apt_status, a.apt_start_time, a.apt_end_time, a.id_patient, a.apt_created_date
+FROM data.data_appointment a
+WHERE NOT EXISTS (
+    SELECT 1 FROM data.data_appointment_status_history h WHERE h.id_appointment = a.apt_id
+);
@@ -0,0 +1,3 @@
+-- Minutes kept free between two appointments of a doctor, applied on both sides of every booking
+ALTER TABLE data.data_doctor
+    ADD COLUMN IF NOT EXISTS doc_buffer_minutes INTEGER NOT NULL DEFAULT 0 CHECK (doc_buffer_minutes >= 0);
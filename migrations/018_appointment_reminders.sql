@@ -0,0 +1,15 @@
+-- Reminder emails sent before appointments: one row per appointment, offset and start time
+-- The row is claimed before the email goes out, so neither a restart nor a second replica sends it twice;
+-- keying on the start time lets a rescheduled appointment be reminded again for its new time
+CREATE TABLE IF NOT EXISTS data.data_appointment_reminder (
+    apr_id             SERIAL PRIMARY KEY,
+    id_appointment     INTEGER     NOT NULL REFERENCES data.data_appointment (apt_id),
+    apr_offset_minutes INTEGER     NOT NULL CHECK (apr_offset_minutes > 0),
+    apr_start_time     TIMESTAMPTZ NOT NULL,
+    apr_sent_date      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT uq_appointment_reminder UNIQUE (id_appointment, apr_offset_minutes, apr_start_time)
+);
+
+-- Upcoming appointments still waiting to take place, scanned by the reminder job
+CREATE INDEX IF NOT EXISTS idx_appointment_upcoming ON data.data_appointment (apt_start_time)
+    WHERE apt_record_status = '0' AND apt_status IN ('requested', 'confirmed');
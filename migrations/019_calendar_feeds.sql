@@ -0,0 +1,8 @@
+-- Calendar subscription feeds: each user has at most one secret feed URL, identified by the hash of its token
+-- Regenerating the feed replaces the hash, which revokes the previous URL
+CREATE TABLE IF NOT EXISTS data.data_calendar_feed (
+    cfd_id           SERIAL PRIMARY KEY,
+    id_user          INTEGER     NOT NULL UNIQUE REFERENCES data.data_user (use_id),
+    cfd_token_hash   VARCHAR(64) NOT NULL UNIQUE,
+    cfd_created_date TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
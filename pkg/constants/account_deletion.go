@@ -0,0 +1,16 @@
+package constants
+
+import "time"
+
+// AccountDeletionConfig contains self-service account deletion and anonymization settings
+var AccountDeletionConfig = struct {
+	GracePeriod            time.Duration
+	AnonymizationInterval  time.Duration
+	AnonymizationBatchSize int
+	TombstoneEmailFormat   string
+}{
+	GracePeriod:            30 * 24 * time.Hour,
+	AnonymizationInterval:  time.Hour,
+	AnonymizationBatchSize: 100,
+	TombstoneEmailFormat:   "deleted-%d@deleted.invalid",
+}
@@ -0,0 +1,45 @@
+package constants
+
+import "time"
+
+// AppointmentConfig contains appointment booking, lifecycle and reminder settings
+var AppointmentConfig = struct {
+	MinBookingNotice      time.Duration
+	MaxBookingAdvance     time.Duration
+	MaxReasonLength       int
+	MinCancellationNotice time.Duration
+	CheckInOpensBefore    time.Duration
+	NoShowGracePeriod     time.Duration
+	MaxListRangeDays      int
+	ReminderInterval      time.Duration
+	ReminderBatchSize     int
+}{
+	MinBookingNotice:      1 * time.Hour,
+	MaxBookingAdvance:     180 * 24 * time.Hour,
+	MaxReasonLength:       500,
+	MinCancellationNotice: 24 * time.Hour,
+	CheckInOpensBefore:    1 * time.Hour,
+	NoShowGracePeriod:     15 * time.Minute,
+	MaxListRangeDays:      62,
+	ReminderInterval:      5 * time.Minute,
+	ReminderBatchSize:     100,
+}
+
+// AppointmentStatus contains the states of an appointment
+var AppointmentStatus = struct {
+	Requested          string
+	Confirmed          string
+	CheckedIn          string
+	Completed          string
+	CancelledByPatient string
+	CancelledByClinic  string
+	NoShow             string
+}{
+	Requested:          "requested",
+	Confirmed:          "confirmed",
+	CheckedIn:          "checked_in",
+	Completed:          "completed",
+	CancelledByPatient: "cancelled_by_patient",
+	CancelledByClinic:  "cancelled_by_clinic",
+	NoShow:             "no_show",
+}
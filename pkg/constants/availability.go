@@ -0,0 +1,20 @@
+package constants
+
+// AvailabilityConfig contains availability query limits
+// Slot queries are kept shorter because every window is split into individual slots
+var AvailabilityConfig = struct {
+	MaxRangeDays     int
+	MaxSlotRangeDays int
+}{
+	MaxRangeDays:     62,
+	MaxSlotRangeDays: 31,
+}
+
+// AvailabilityExceptionKind contains the kinds of availability exceptions
+var AvailabilityExceptionKind = struct {
+	Unavailable string
+	Available   string
+}{
+	Unavailable: "unavailable",
+	Available:   "available",
+}
@@ -0,0 +1,19 @@
+package constants
+
+import "time"
+
+// CalendarConfig contains iCalendar export and subscription feed settings
+// Feeds include appointments that ended within FeedLookback so recent visits stay visible
+var CalendarConfig = struct {
+	ProductID           string
+	FeedName            string
+	FeedLookback        time.Duration
+	FeedRefreshInterval time.Duration
+	UIDDomain           string
+}{
+	ProductID:           "-//Citary//Appointments//EN",
+	FeedName:            "Citary appointments",
+	FeedLookback:        30 * 24 * time.Hour,
+	FeedRefreshInterval: time.Hour,
+	UIDDomain:           "citary",
+}
@@ -0,0 +1,20 @@
+package constants
+
+// DoctorConfig contains doctor profile defaults and limits
+var DoctorConfig = struct {
+	DefaultConsultationMinutes int
+	MinConsultationMinutes     int
+	MaxConsultationMinutes     int
+	MaxBufferMinutes           int
+	DefaultFeeCurrency         string
+	MaxSpecialties             int
+	MaxLanguages               int
+}{
+	DefaultConsultationMinutes: 30,
+	MinConsultationMinutes:     5,
+	MaxConsultationMinutes:     480,
+	MaxBufferMinutes:           120,
+	DefaultFeeCurrency:         "USD",
+	MaxSpecialties:             10,
+	MaxLanguages:               10,
+}
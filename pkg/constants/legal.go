@@ -0,0 +1,10 @@
+package constants
+
+// LegalDocumentType contains the kinds of legal documents users must accept
+var LegalDocumentType = struct {
+	Terms   string
+	Privacy string
+}{
+	Terms:   "terms",
+	Privacy: "privacy",
+}
@@ -2,32 +2,300 @@ package constants
 
 // ErrorMessages contains standardized error messages
 var ErrorMessages = struct {
-	NotFound          string
-	BadRequest        string
-	InternalError     string
-	Unauthorized      string
-	AlreadyExists     string
-	InvalidEmail      string
-	InvalidPassword   string
-	UserAlreadyExists string
+	NotFound                        string
+	BadRequest                      string
+	InternalError                   string
+	Unauthorized                    string
+	Forbidden                       string
+	AlreadyExists                   string
+	Gone                            string
+	Locked                          string
+	PreconditionRequired            string
+	InvalidEmail                    string
+	InvalidPassword                 string
+	UserAlreadyExists               string
+	UserNotFound                    string
+	RoleNotFound                    string
+	RoleAlreadyExists               string
+	RoleProtected                   string
+	RolePermissionsProtected        string
+	VerificationTokenInvalid        string
+	VerificationTokenExpired        string
+	VerificationTokenUsed           string
+	InvalidCredentials              string
+	EmailNotVerified                string
+	AccountInactive                 string
+	AccountLocked                   string
+	RefreshTokenInvalid             string
+	RefreshTokenReused              string
+	PasswordResetTokenInvalid       string
+	PasswordResetTokenExpired       string
+	TwoFactorAlreadyEnabled         string
+	TwoFactorNotEnabled             string
+	TwoFactorSetupRequired          string
+	TwoFactorCodeInvalid            string
+	PasswordIncorrect               string
+	EmailChangeTokenInvalid         string
+	EmailChangeTokenExpired         string
+	EmailUnchanged                  string
+	AccountInactiveReactivationSent string
+	ReactivationTokenInvalid        string
+	ReactivationTokenExpired        string
+	LegalAcceptanceRequired         string
+	LegalDocumentUnknown            string
+	LegalDocumentOutdated           string
+	ConsentRequired                 string
+	OrganizationNotFound            string
+	OrganizationSlugTaken           string
+	OrganizationRequired            string
+	InvitationInvalid               string
+	InvitationExpired               string
+	InvitationNotPending            string
+	InvitationNotFound              string
+	InvitationAlreadyPending        string
+	InvitationLoginRequired         string
+	InvitationEmailMismatch         string
+	AlreadyOrganizationMember       string
+	NotOrganizationMember           string
+	DoctorNotFound                  string
+	DoctorUserNotMember             string
+	DoctorAlreadyExists             string
+	DoctorLicenseTaken              string
+	SpecialtyUnknown                string
+	LocationNotFound                string
+	LocationInactive                string
+	AvailabilityBlockOverlap        string
+	AvailabilityBlockNotFound       string
+	AvailabilityExceptionNotFound   string
+	AppointmentNotFound             string
+	AppointmentSlotTaken            string
+	AppointmentOutsideAvailability  string
+	AppointmentTooSoon              string
+	AppointmentTooFar               string
+	DoctorNotBookable               string
+	AppointmentTransitionNotAllowed string
+	AppointmentModified             string
+	AppointmentCancellationTooLate  string
+	AppointmentAlreadyStarted       string
+	AppointmentCheckInNotOpen       string
+	AppointmentNoShowTooEarly       string
+	AppointmentNotReschedulable     string
+	CalendarFeedNotFound            string
 }{
-	NotFound:          "The requested record was not found",
-	BadRequest:        "Invalid request",
-	InternalError:     "Internal server error",
-	Unauthorized:      "Unauthorized",
-	AlreadyExists:     "The resource already exists",
-	InvalidEmail:      "The provided email is not valid",
-	InvalidPassword:   "The password does not meet minimum requirements",
-	UserAlreadyExists: "A user with that email already exists",
+	NotFound:                        "The requested record was not found",
+	BadRequest:                      "Invalid request",
+	InternalError:                   "Internal server error",
+	Unauthorized:                    "Unauthorized",
+	Forbidden:                       "Forbidden",
+	AlreadyExists:                   "The resource already exists",
+	Gone:                            "The requested resource is no longer available",
+	Locked:                          "The resource is locked",
+	PreconditionRequired:            "A precondition must be met before continuing",
+	InvalidEmail:                    "The provided email is not valid",
+	InvalidPassword:                 "The password does not meet minimum requirements",
+	UserAlreadyExists:               "A user with that email already exists",
+	UserNotFound:                    "User not found",
+	RoleNotFound:                    "Role not found",
+	RoleAlreadyExists:               "A role with that code already exists",
+	RoleProtected:                   "This role is required by the platform and cannot be deactivated",
+	RolePermissionsProtected:        "This role is required by the platform and its permissions cannot be changed",
+	VerificationTokenInvalid:        "The verification link is invalid or has been replaced by a newer one",
+	VerificationTokenExpired:        "The verification link has expired",
+	VerificationTokenUsed:           "The verification link has already been used",
+	InvalidCredentials:              "Invalid email or password",
+	EmailNotVerified:                "Email address has not been verified",
+	AccountInactive:                 "User account is inactive",
+	AccountLocked:                   "Account temporarily locked due to too many failed login attempts. Please try again later",
+	RefreshTokenInvalid:             "The refresh token is invalid or has expired",
+	RefreshTokenReused:              "Refresh token reuse detected. All sessions for this device have been revoked",
+	PasswordResetTokenInvalid:       "The password reset link is invalid or has already been used",
+	PasswordResetTokenExpired:       "The password reset link has expired",
+	TwoFactorAlreadyEnabled:         "Two-factor authentication is already enabled",
+	TwoFactorNotEnabled:             "Two-factor authentication is not enabled",
+	TwoFactorSetupRequired:          "Start two-factor setup before confirming it",
+	TwoFactorCodeInvalid:            "The two-factor code is invalid",
+	PasswordIncorrect:               "The current password is incorrect",
+	EmailChangeTokenInvalid:         "The email change link is invalid or has already been used",
+	EmailChangeTokenExpired:         "The email change link has expired",
+	EmailUnchanged:                  "The new email is the same as the current one",
+	AccountInactiveReactivationSent: "An inactive account exists for this email. A reactivation link has been sent to it",
+	ReactivationTokenInvalid:        "The reactivation link is invalid or has already been used",
+	ReactivationTokenExpired:        "The reactivation link has expired",
+	LegalAcceptanceRequired:         "You must accept the current terms of service and privacy policy",
+	LegalDocumentUnknown:            "The accepted legal document does not exist",
+	LegalDocumentOutdated:           "A newer version of the legal document has been published. Please review and accept it",
+	ConsentRequired:                 "Updated legal documents must be accepted before continuing",
+	OrganizationNotFound:            "Organization not found",
+	OrganizationSlugTaken:           "An organization with that slug already exists",
+	OrganizationRequired:            "This action requires acting within an organization",
+	InvitationInvalid:               "Invitation link is invalid",
+	InvitationExpired:               "Invitation link has expired. Ask the organization for a new one",
+	InvitationNotPending:            "Invitation has already been accepted or revoked",
+	InvitationNotFound:              "Invitation not found",
+	InvitationAlreadyPending:        "An open invitation already exists for this email",
+	InvitationLoginRequired:         "An account already exists for the invited email. Log in to accept the invitation",
+	InvitationEmailMismatch:         "This invitation was sent to a different email address",
+	AlreadyOrganizationMember:       "The user already belongs to this organization",
+	NotOrganizationMember:           "You are not a member of this organization",
+	DoctorNotFound:                  "Doctor not found",
+	DoctorUserNotMember:             "The user must be an active member of the organization to have a doctor profile",
+	DoctorAlreadyExists:             "This user already has a doctor profile in the organization",
+	DoctorLicenseTaken:              "Another doctor of the organization already uses that license number",
+	SpecialtyUnknown:                "One or more specialties do not exist",
+	LocationNotFound:                "Location not found",
+	LocationInactive:                "The location is inactive",
+	AvailabilityBlockOverlap:        "The block overlaps another availability block of the doctor",
+	AvailabilityBlockNotFound:       "Availability block not found",
+	AvailabilityExceptionNotFound:   "Availability exception not found",
+	AppointmentNotFound:             "Appointment not found",
+	AppointmentSlotTaken:            "The selected time is no longer available. Please choose another slot",
+	AppointmentOutsideAvailability:  "The doctor is not available at the selected time",
+	AppointmentTooSoon:              "Appointments must be booked further in advance",
+	AppointmentTooFar:               "Appointments cannot be booked that far in advance",
+	DoctorNotBookable:               "This doctor is not accepting appointments",
+	AppointmentTransitionNotAllowed: "The appointment cannot move to that status from its current one",
+	AppointmentModified:             "The appointment was changed by someone else. Reload it and try again",
+	AppointmentCancellationTooLate:  "It is too late to cancel or reschedule this appointment online. Please contact the clinic",
+	AppointmentAlreadyStarted:       "The appointment has already started",
+	AppointmentCheckInNotOpen:       "Check-in is only open from shortly before the appointment until it ends",
+	AppointmentNoShowTooEarly:       "A patient can only be marked as a no-show once the appointment is underway",
+	AppointmentNotReschedulable:     "Only requested or confirmed appointments can be rescheduled",
+	CalendarFeedNotFound:            "Calendar feed not found",
 }
 
 // SuccessMessages contains standardized success messages
 var SuccessMessages = struct {
-	UserCreated string
-	UserUpdated string
-	UserDeleted string
+	UserCreated                     string
+	UserUpdated                     string
+	UserDeleted                     string
+	EmailVerified                   string
+	VerificationEmailSent           string
+	LoginSuccessful                 string
+	TokenRefreshed                  string
+	LoggedOut                       string
+	PasswordResetRequested          string
+	PasswordReset                   string
+	TwoFactorRequired               string
+	TwoFactorSetupStarted           string
+	TwoFactorEnabled                string
+	TwoFactorDisabled               string
+	RolesRetrieved                  string
+	RoleRetrieved                   string
+	RoleCreated                     string
+	RoleUpdated                     string
+	RoleDeactivated                 string
+	ProfileRetrieved                string
+	ProfileUpdated                  string
+	PasswordChanged                 string
+	EmailChangeRequested            string
+	EmailChanged                    string
+	AccountReactivated              string
+	AccountDeleted                  string
+	DataExported                    string
+	LegalDocumentsRetrieved         string
+	LegalDocumentsAccepted          string
+	OrganizationCreated             string
+	OrganizationRetrieved           string
+	InvitationSent                  string
+	InvitationsRetrieved            string
+	InvitationRevoked               string
+	InvitationAccepted              string
+	OrganizationSwitched            string
+	MembershipsRetrieved            string
+	DoctorCreated                   string
+	DoctorRetrieved                 string
+	DoctorsRetrieved                string
+	DoctorUpdated                   string
+	DoctorDeactivated               string
+	SpecialtiesRetrieved            string
+	LocationCreated                 string
+	LocationsRetrieved              string
+	LocationDeactivated             string
+	AvailabilityBlockCreated        string
+	AvailabilityBlocksRetrieved     string
+	AvailabilityBlockDeleted        string
+	AvailabilityExceptionCreated    string
+	AvailabilityExceptionsRetrieved string
+	AvailabilityExceptionDeleted    string
+	AvailabilityRetrieved           string
+	SlotsRetrieved                  string
+	AppointmentBooked               string
+	AppointmentsRetrieved           string
+	AppointmentRetrieved            string
+	AppointmentConfirmed            string
+	AppointmentCheckedIn            string
+	AppointmentCompleted            string
+	AppointmentCancelled            string
+	AppointmentMarkedNoShow         string
+	AppointmentRescheduled          string
+	AppointmentHistoryRetrieved     string
+	CalendarFeedCreated             string
+	CalendarFeedRevoked             string
 }{
-	UserCreated: "User created successfully",
-	UserUpdated: "User updated successfully",
-	UserDeleted: "User deleted successfully",
+	UserCreated:                     "User created successfully",
+	UserUpdated:                     "User updated successfully",
+	UserDeleted:                     "User deleted successfully",
+	EmailVerified:                   "Email verified successfully",
+	VerificationEmailSent:           "If an unverified account exists for that email, a new verification link has been sent",
+	LoginSuccessful:                 "Login successful",
+	TokenRefreshed:                  "Token refreshed successfully",
+	LoggedOut:                       "Logged out successfully",
+	PasswordResetRequested:          "If an account exists for that email, a password reset link has been sent",
+	PasswordReset:                   "Password reset successfully. Please log in with your new password",
+	TwoFactorRequired:               "Two-factor authentication required",
+	TwoFactorSetupStarted:           "Scan the QR code with your authenticator app and confirm with a code",
+	TwoFactorEnabled:                "Two-factor authentication enabled. Store your recovery codes somewhere safe",
+	TwoFactorDisabled:               "Two-factor authentication disabled",
+	RolesRetrieved:                  "Roles retrieved successfully",
+	RoleRetrieved:                   "Role retrieved successfully",
+	RoleCreated:                     "Role created successfully",
+	RoleUpdated:                     "Role updated successfully",
+	RoleDeactivated:                 "Role deactivated successfully",
+	ProfileRetrieved:                "Profile retrieved successfully",
+	ProfileUpdated:                  "Profile updated successfully",
+	PasswordChanged:                 "Password changed successfully. Please log in again",
+	EmailChangeRequested:            "A confirmation link has been sent to the new email address",
+	EmailChanged:                    "Email changed successfully",
+	AccountReactivated:              "Account reactivated. Please check your email to verify your address",
+	AccountDeleted:                  "Account deleted. Your personal data will be erased after the grace period",
+	DataExported:                    "Data exported successfully",
+	LegalDocumentsRetrieved:         "Legal documents retrieved successfully",
+	LegalDocumentsAccepted:          "Legal documents accepted successfully",
+	OrganizationCreated:             "Organization created successfully. Switch your session to it to act as its owner",
+	OrganizationRetrieved:           "Organization retrieved successfully",
+	InvitationSent:                  "Invitation sent successfully",
+	InvitationsRetrieved:            "Invitations retrieved successfully",
+	InvitationRevoked:               "Invitation revoked successfully",
+	InvitationAccepted:              "Invitation accepted. Log in or switch your session to the organization to act within it",
+	OrganizationSwitched:            "Organization switched successfully",
+	MembershipsRetrieved:            "Organizations retrieved successfully",
+	DoctorCreated:                   "Doctor profile created successfully",
+	DoctorRetrieved:                 "Doctor retrieved successfully",
+	DoctorsRetrieved:                "Doctors retrieved successfully",
+	DoctorUpdated:                   "Doctor profile updated successfully",
+	DoctorDeactivated:               "Doctor profile deactivated successfully",
+	SpecialtiesRetrieved:            "Specialties retrieved successfully",
+	LocationCreated:                 "Location created successfully",
+	LocationsRetrieved:              "Locations retrieved successfully",
+	LocationDeactivated:             "Location deactivated successfully",
+	AvailabilityBlockCreated:        "Availability block created successfully",
+	AvailabilityBlocksRetrieved:     "Availability blocks retrieved successfully",
+	AvailabilityBlockDeleted:        "Availability block removed successfully",
+	AvailabilityExceptionCreated:    "Availability exception created successfully",
+	AvailabilityExceptionsRetrieved: "Availability exceptions retrieved successfully",
+	AvailabilityExceptionDeleted:    "Availability exception removed successfully",
+	AvailabilityRetrieved:           "Availability retrieved successfully",
+	SlotsRetrieved:                  "Available slots retrieved successfully",
+	AppointmentBooked:               "Appointment booked successfully",
+	AppointmentsRetrieved:           "Appointments retrieved successfully",
+	AppointmentRetrieved:            "Appointment retrieved successfully",
+	AppointmentConfirmed:            "Appointment confirmed successfully",
+	AppointmentCheckedIn:            "Patient checked in successfully",
+	AppointmentCompleted:            "Appointment completed successfully",
+	AppointmentCancelled:            "Appointment cancelled successfully",
+	AppointmentMarkedNoShow:         "Appointment marked as a no-show",
+	AppointmentRescheduled:          "Appointment rescheduled successfully",
+	AppointmentHistoryRetrieved:     "Appointment history retrieved successfully",
+	CalendarFeedCreated:             "Calendar feed created successfully",
+	CalendarFeedRevoked:             "Calendar feed revoked successfully",
 }
@@ -0,0 +1,37 @@
+package constants
+
+import "time"
+
+// OrganizationConfig contains organization onboarding defaults
+var OrganizationConfig = struct {
+	DefaultTimezone string
+}{
+	DefaultTimezone: "America/Guayaquil",
+}
+
+// InvitationConfig contains organization invitation limits
+var InvitationConfig = struct {
+	TokenTTL time.Duration
+}{
+	TokenTTL: 7 * 24 * time.Hour,
+}
+
+// InvitableRoles lists the role codes an organization can grant through an invitation
+// Only organization-scoped roles are invitable; platform roles such as admin are never granted by an organization
+var InvitableRoles = []string{
+	RoleCodes.Doctor,
+	RoleCodes.Staff,
+}
+
+// InvitationStatus contains the states an invitation is reported in
+var InvitationStatus = struct {
+	Pending  string
+	Accepted string
+	Revoked  string
+	Expired  string
+}{
+	Pending:  "pending",
+	Accepted: "accepted",
+	Revoked:  "revoked",
+	Expired:  "expired",
+}
@@ -0,0 +1,13 @@
+package constants
+
+// PaginationConfig contains the page sizes applied to paginated listings
+// MaxPage bounds the offset a client can ask the database to skip
+var PaginationConfig = struct {
+	DefaultPageSize int
+	MaxPageSize     int
+	MaxPage         int
+}{
+	DefaultPageSize: 20,
+	MaxPageSize:     100,
+	MaxPage:         1000,
+}
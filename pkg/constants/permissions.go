@@ -0,0 +1,53 @@
+package constants
+
+// Permissions defines the permission vocabulary granted to roles through core.core_role.rol_permissions
+// Codes follow the "resource:action[:scope]" convention; a ":any" scope reaches records of other users
+var Permissions = struct {
+	All                 string
+	UsersRead           string
+	RolesRead           string
+	RolesManage         string
+	OrganizationsRead   string
+	OrganizationsManage string
+	OrganizationsInvite string
+	DoctorsRead         string
+	DoctorsManage       string
+	AvailabilityManage  string
+	AppointmentsCreate  string
+	AppointmentsRead    string
+	AppointmentsReadAny string
+	AppointmentsManage  string
+}{
+	All:                 "*",
+	UsersRead:           "users:read",
+	RolesRead:           "roles:read",
+	RolesManage:         "roles:manage",
+	OrganizationsRead:   "organizations:read",
+	OrganizationsManage: "organizations:manage",
+	OrganizationsInvite: "organizations:invite",
+	DoctorsRead:         "doctors:read",
+	DoctorsManage:       "doctors:manage",
+	AvailabilityManage:  "availability:manage",
+	AppointmentsCreate:  "appointments:create",
+	AppointmentsRead:    "appointments:read",
+	AppointmentsReadAny: "appointments:read:any",
+	AppointmentsManage:  "appointments:manage",
+}
+
+// PermissionVocabulary lists every permission code the application checks
+// Role permission patterns are validated against it
+var PermissionVocabulary = []string{
+	Permissions.UsersRead,
+	Permissions.RolesRead,
+	Permissions.RolesManage,
+	Permissions.OrganizationsRead,
+	Permissions.OrganizationsManage,
+	Permissions.OrganizationsInvite,
+	Permissions.DoctorsRead,
+	Permissions.DoctorsManage,
+	Permissions.AvailabilityManage,
+	Permissions.AppointmentsCreate,
+	Permissions.AppointmentsRead,
+	Permissions.AppointmentsReadAny,
+	Permissions.AppointmentsManage,
+}
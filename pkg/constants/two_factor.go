@@ -0,0 +1,12 @@
+package constants
+
+// TwoFactorConfig contains TOTP two-factor authentication settings
+var TwoFactorConfig = struct {
+	Issuer            string
+	ValidationSkew    int
+	RecoveryCodeCount int
+}{
+	Issuer:            "Citary",
+	ValidationSkew:    1,
+	RecoveryCodeCount: 10,
+}
@@ -0,0 +1,14 @@
+package constants
+
+// UserEventType contains the account lifecycle events recorded in the user audit trail
+var UserEventType = struct {
+	ReactivationRequested string
+	Reactivated           string
+	Deleted               string
+	Anonymized            string
+}{
+	ReactivationRequested: "reactivation_requested",
+	Reactivated:           "reactivated",
+	Deleted:               "deleted",
+	Anonymized:            "anonymized",
+}
@@ -0,0 +1,14 @@
+package constants
+
+// UserTokenPurpose contains the purposes of single-use tokens emailed to users
+var UserTokenPurpose = struct {
+	PasswordReset     string
+	EmailChange       string
+	Reactivation      string
+	EmailVerification string
+}{
+	PasswordReset:     "password_reset",
+	EmailChange:       "email_change",
+	Reactivation:      "reactivation",
+	EmailVerification: "email_verification",
+}
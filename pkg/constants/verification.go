@@ -0,0 +1,39 @@
+package constants
+
+import "time"
+
+// VerificationConfig contains email verification token and delivery limits
+var VerificationConfig = struct {
+	TokenTTL       time.Duration
+	ResendCooldown time.Duration
+	MaxSendsPerDay int
+}{
+	TokenTTL:       24 * time.Hour,
+	ResendCooldown: 60 * time.Second,
+	MaxSendsPerDay: 5,
+}
+
+// PasswordResetConfig contains password reset token limits
+var PasswordResetConfig = struct {
+	TokenTTL time.Duration
+}{
+	TokenTTL: time.Hour,
+}
+
+// EmailChangeConfig contains email change confirmation token limits
+var EmailChangeConfig = struct {
+	TokenTTL time.Duration
+}{
+	TokenTTL: time.Hour,
+}
+
+// ReactivationConfig contains account reactivation token and delivery limits
+var ReactivationConfig = struct {
+	TokenTTL       time.Duration
+	ResendCooldown time.Duration
+	MaxSendsPerDay int
+}{
+	TokenTTL:       24 * time.Hour,
+	ResendCooldown: 60 * time.Second,
+	MaxSendsPerDay: 5,
+}
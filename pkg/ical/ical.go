@@ -0,0 +1,170 @@
+// Package ical writes RFC 5545 iCalendar documents with VEVENT components, the format imported by
+// Google Calendar, Outlook and Apple Calendar from email attachments and subscription URLs.
+package ical
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	// MethodPublish marks a calendar as informational: clients import its events without replying
+	MethodPublish = "PUBLISH"
+
+	// StatusTentative, StatusConfirmed and StatusCancelled are the VEVENT statuses
+	StatusTentative = "TENTATIVE"
+	StatusConfirmed = "CONFIRMED"
+	StatusCancelled = "CANCELLED"
+
+	// maxLineOctets is the longest a content line may be before it must be folded (RFC 5545 section 3.1)
+	maxLineOctets = 75
+
+	// utcLayout is the DATE-TIME form with the UTC designator, which needs no VTIMEZONE
+	utcLayout = "20060102T150405Z"
+)
+
+// Calendar is a VCALENDAR object
+// Name and RefreshInterval are hints used by subscribed calendars; Method is set for calendars sent by email
+type Calendar struct {
+	ProductID       string
+	Name            string
+	Method          string
+	RefreshInterval time.Duration
+	Events          []Event
+}
+
+// Event is a VEVENT component
+// UID must stay the same across exports of the same event so clients update it instead of duplicating it
+// Optional text fields are omitted when empty and optional times when zero
+type Event struct {
+	UID          string
+	Stamp        time.Time
+	Start        time.Time
+	End          time.Time
+	Summary      string
+	Description  string
+	Location     string
+	URL          string
+	Status       string
+	Created      time.Time
+	LastModified time.Time
+}
+
+// Bytes renders the calendar with CRLF line endings and folded lines
+func (c *Calendar) Bytes() []byte {
+	var w writer
+
+	w.line("BEGIN", "VCALENDAR")
+	w.line("VERSION", "2.0")
+	w.line("PRODID", c.ProductID)
+	w.line("CALSCALE", "GREGORIAN")
+	if c.Method != "" {
+		w.line("METHOD", c.Method)
+	}
+	if c.Name != "" {
+		w.line("NAME", escapeText(c.Name))
+		w.line("X-WR-CALNAME", escapeText(c.Name))
+	}
+	if c.RefreshInterval > 0 {
+		interval := formatDuration(c.RefreshInterval)
+		w.line("REFRESH-INTERVAL;VALUE=DURATION", interval)
+		w.line("X-PUBLISHED-TTL", interval)
+	}
+
+	for _, event := range c.Events {
+		event.write(&w)
+	}
+
+	w.line("END", "VCALENDAR")
+	return w.buffer.Bytes()
+}
+
+// write renders the event as a VEVENT component
+func (e *Event) write(w *writer) {
+	w.line("BEGIN", "VEVENT")
+	w.line("UID", e.UID)
+	w.line("DTSTAMP", formatTime(e.Stamp))
+	w.line("DTSTART", formatTime(e.Start))
+	w.line("DTEND", formatTime(e.End))
+	if !e.Created.IsZero() {
+		w.line("CREATED", formatTime(e.Created))
+	}
+	if !e.LastModified.IsZero() {
+		w.line("LAST-MODIFIED", formatTime(e.LastModified))
+	}
+	w.line("SUMMARY", escapeText(e.Summary))
+	if e.Description != "" {
+		w.line("DESCRIPTION", escapeText(e.Description))
+	}
+	if e.Location != "" {
+		w.line("LOCATION", escapeText(e.Location))
+	}
+	if e.URL != "" {
+		w.line("URL", e.URL)
+	}
+	if e.Status != "" {
+		w.line("STATUS", e.Status)
+	}
+	w.line("END", "VEVENT")
+}
+
+// writer accumulates content lines
+type writer struct {
+	buffer bytes.Buffer
+}
+
+// line writes one "NAME:value" content line, folding it into chunks of at most maxLineOctets octets
+// Continuation lines start with a single space, and folds never split a UTF-8 sequence
+func (w *writer) line(name, value string) {
+	content := name + ":" + value
+	limit := maxLineOctets
+
+	for len(content) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(content[cut]) {
+			cut--
+		}
+
+		w.buffer.WriteString(content[:cut])
+		w.buffer.WriteString("\r\n ")
+		content = content[cut:]
+
+		// The leading space of a continuation line counts towards its length
+		limit = maxLineOctets - 1
+	}
+
+	w.buffer.WriteString(content)
+	w.buffer.WriteString("\r\n")
+}
+
+// textEscaper escapes the characters with special meaning in TEXT values (RFC 5545 section 3.3.11)
+var textEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	";", `\;`,
+	",", `\,`,
+	"\r\n", `\n`,
+	"\n", `\n`,
+	"\r", `\n`,
+)
+
+// escapeText escapes a TEXT property value
+func escapeText(value string) string {
+	return textEscaper.Replace(value)
+}
+
+// formatTime renders a DATE-TIME in UTC
+func formatTime(t time.Time) string {
+	return t.UTC().Format(utcLayout)
+}
+
+// formatDuration renders a DURATION value in whole minutes, e.g. PT60M
+func formatDuration(d time.Duration) string {
+	minutes := int(d / time.Minute)
+	if minutes < 1 {
+		minutes = 1
+	}
+	return fmt.Sprintf("PT%dM", minutes)
+}
@@ -0,0 +1,145 @@
+package repositories
+
+import (
+	"citary-backend/internal/domain/entities"
+	"citary-backend/internal/domain/repositories"
+	"context"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// MockUserRepository is a testify mock of repositories.UserRepository
+type MockUserRepository struct {
+	mock.Mock
+}
+
+var _ repositories.UserRepository = (*MockUserRepository)(nil)
+
+// FindByEmail mocks UserRepository.FindByEmail
+func (m *MockUserRepository) FindByEmail(ctx context.Context, email string) (*entities.User, error) {
+	args := m.Called(ctx, email)
+	var r0 *entities.User
+	if v := args.Get(0); v != nil {
+		r0 = v.(*entities.User)
+	}
+	return r0, args.Error(1)
+}
+
+// FindByID mocks UserRepository.FindByID
+func (m *MockUserRepository) FindByID(ctx context.Context, id int) (*entities.User, error) {
+	args := m.Called(ctx, id)
+	var r0 *entities.User
+	if v := args.Get(0); v != nil {
+		r0 = v.(*entities.User)
+	}
+	return r0, args.Error(1)
+}
+
+// FindByVerificationToken mocks UserRepository.FindByVerificationToken
+func (m *MockUserRepository) FindByVerificationToken(ctx context.Context, token string) (*entities.User, error) {
+	args := m.Called(ctx, token)
+	var r0 *entities.User
+	if v := args.Get(0); v != nil {
+		r0 = v.(*entities.User)
+	}
+	return r0, args.Error(1)
+}
+
+// Create mocks UserRepository.Create
+func (m *MockUserRepository) Create(ctx context.Context, user *entities.User) error {
+	args := m.Called(ctx, user)
+	return args.Error(0)
+}
+
+// CreateWithConsents mocks UserRepository.CreateWithConsents
+func (m *MockUserRepository) CreateWithConsents(ctx context.Context, user *entities.User, consents []*entities.UserConsent) error {
+	args := m.Called(ctx, user, consents)
+	return args.Error(0)
+}
+
+// MarkEmailVerified mocks UserRepository.MarkEmailVerified
+func (m *MockUserRepository) MarkEmailVerified(ctx context.Context, userID int, token string, consumed *entities.UserToken) (bool, error) {
+	args := m.Called(ctx, userID, token, consumed)
+	return args.Bool(0), args.Error(1)
+}
+
+// RotateVerificationToken mocks UserRepository.RotateVerificationToken
+func (m *MockUserRepository) RotateVerificationToken(ctx context.Context, user *entities.User, cooldown time.Duration, maxSendsPerDay int) (bool, error) {
+	args := m.Called(ctx, user, cooldown, maxSendsPerDay)
+	return args.Bool(0), args.Error(1)
+}
+
+// RecordSuccessfulLogin mocks UserRepository.RecordSuccessfulLogin
+func (m *MockUserRepository) RecordSuccessfulLogin(ctx context.Context, userID int, loggedInAt time.Time) error {
+	args := m.Called(ctx, userID, loggedInAt)
+	return args.Error(0)
+}
+
+// UpdatePassword mocks UserRepository.UpdatePassword
+func (m *MockUserRepository) UpdatePassword(ctx context.Context, userID int, passwordHash string) error {
+	args := m.Called(ctx, userID, passwordHash)
+	return args.Error(0)
+}
+
+// Reactivate mocks UserRepository.Reactivate
+func (m *MockUserRepository) Reactivate(ctx context.Context, user *entities.User) (bool, error) {
+	args := m.Called(ctx, user)
+	return args.Bool(0), args.Error(1)
+}
+
+// UpdateEmail mocks UserRepository.UpdateEmail
+func (m *MockUserRepository) UpdateEmail(ctx context.Context, userID int, email string) error {
+	args := m.Called(ctx, userID, email)
+	return args.Error(0)
+}
+
+// UpdateTwoFactor mocks UserRepository.UpdateTwoFactor
+func (m *MockUserRepository) UpdateTwoFactor(ctx context.Context, userID int, enabled bool, secret *string) error {
+	args := m.Called(ctx, userID, enabled, secret)
+	return args.Error(0)
+}
+
+// UpdateLegalAcceptance mocks UserRepository.UpdateLegalAcceptance
+func (m *MockUserRepository) UpdateLegalAcceptance(ctx context.Context, userID int, termsAcceptedAt *time.Time, privacyAcceptedAt *time.Time) error {
+	args := m.Called(ctx, userID, termsAcceptedAt, privacyAcceptedAt)
+	return args.Error(0)
+}
+
+// UpdateDefaultOrganization mocks UserRepository.UpdateDefaultOrganization
+func (m *MockUserRepository) UpdateDefaultOrganization(ctx context.Context, userID int, organizationID *int) error {
+	args := m.Called(ctx, userID, organizationID)
+	return args.Error(0)
+}
+
+// SoftDelete mocks UserRepository.SoftDelete
+func (m *MockUserRepository) SoftDelete(ctx context.Context, userID int, deletedAt time.Time, anonymizeAfter time.Time) error {
+	args := m.Called(ctx, userID, deletedAt, anonymizeAfter)
+	return args.Error(0)
+}
+
+// FindDueForAnonymization mocks UserRepository.FindDueForAnonymization
+func (m *MockUserRepository) FindDueForAnonymization(ctx context.Context, now time.Time, limit int) ([]int, error) {
+	args := m.Called(ctx, now, limit)
+	var r0 []int
+	if v := args.Get(0); v != nil {
+		r0 = v.([]int)
+	}
+	return r0, args.Error(1)
+}
+
+// Anonymize mocks UserRepository.Anonymize
+func (m *MockUserRepository) Anonymize(ctx context.Context, userID int, tombstoneEmail string, now time.Time) (bool, error) {
+	args := m.Called(ctx, userID, tombstoneEmail, now)
+	return args.Bool(0), args.Error(1)
+}
+
+// RegisterFailedLogin mocks UserRepository.RegisterFailedLogin
+func (m *MockUserRepository) RegisterFailedLogin(ctx context.Context, userID int, lockSchedule []time.Duration, now time.Time) (int, *time.Time, error) {
+	args := m.Called(ctx, userID, lockSchedule, now)
+	var r1 *time.Time
+	if v := args.Get(1); v != nil {
+		r1 = v.(*time.Time)
+	}
+	return args.Int(0), r1, args.Error(2)
+}
@@ -0,0 +1,43 @@
+package doctor_test
+
+import (
+	"citary-backend/internal/domain/dtos/doctor"
+	"citary-backend/pkg/constants"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSearchDoctorsQuery_ValidatePaging(t *testing.T) {
+	tests := []struct {
+		name     string
+		page     int
+		pageSize int
+		expected error
+	}{
+		{name: "defaults", page: 0, pageSize: 0, expected: nil},
+		{name: "first page", page: 1, pageSize: 20, expected: nil},
+		{name: "last allowed page", page: constants.PaginationConfig.MaxPage, pageSize: constants.PaginationConfig.MaxPageSize, expected: nil},
+		{name: "negative page", page: -1, pageSize: 20, expected: doctor.ErrPageOutOfRange},
+		{name: "page beyond the maximum", page: constants.PaginationConfig.MaxPage + 1, pageSize: 20, expected: doctor.ErrPageOutOfRange},
+		{name: "negative page size", page: 1, pageSize: -1, expected: doctor.ErrPageSizeOutOfRange},
+		{name: "page size beyond the maximum", page: 1, pageSize: constants.PaginationConfig.MaxPageSize + 1, expected: doctor.ErrPageSizeOutOfRange},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			query := doctor.SearchDoctorsQuery{Page: tt.page, PageSize: tt.pageSize}
+
+			// Act
+			err := query.Validate()
+
+			// Assert
+			if tt.expected == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.Equal(t, tt.expected, err)
+			}
+		})
+	}
+}
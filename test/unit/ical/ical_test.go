@@ -0,0 +1,175 @@
+package ical_test
+
+import (
+	"citary-backend/pkg/ical"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	stamp = time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	start = time.Date(2026, 3, 9, 14, 0, 0, 0, time.UTC)
+	end   = time.Date(2026, 3, 9, 14, 30, 0, 0, time.UTC)
+)
+
+// event returns a calendar with a single event that has only its required properties and the given summary
+func event(summary string) ical.Calendar {
+	return ical.Calendar{
+		ProductID: "-//Citary//Appointments//EN",
+		Events: []ical.Event{{
+			UID:     "appointment-1@citary",
+			Stamp:   stamp,
+			Start:   start,
+			End:     end,
+			Summary: summary,
+		}},
+	}
+}
+
+// document joins the lines of the calendar returned by event around the given SUMMARY lines
+func document(summary ...string) string {
+	lines := []string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"PRODID:-//Citary//Appointments//EN",
+		"CALSCALE:GREGORIAN",
+		"BEGIN:VEVENT",
+		"UID:appointment-1@citary",
+		"DTSTAMP:20260301T120000Z",
+		"DTSTART:20260309T140000Z",
+		"DTEND:20260309T143000Z",
+	}
+	lines = append(lines, summary...)
+	lines = append(lines, "END:VEVENT", "END:VCALENDAR")
+	return strings.Join(lines, "\r\n") + "\r\n"
+}
+
+func TestCalendar_Bytes(t *testing.T) {
+	tests := []struct {
+		name     string
+		calendar ical.Calendar
+		expected string
+	}{
+		{
+			name: "calendar with every property",
+			calendar: ical.Calendar{
+				ProductID:       "-//Citary//Appointments//EN",
+				Name:            "Citary appointments",
+				Method:          ical.MethodPublish,
+				RefreshInterval: time.Hour,
+				Events: []ical.Event{{
+					UID:          "appointment-1@citary",
+					Stamp:        stamp,
+					Start:        start.In(time.FixedZone("ECT", -5*60*60)),
+					End:          end,
+					Summary:      "Appointment with Dr. Ana Ruiz",
+					Description:  "General checkup",
+					Location:     "Centro Médico",
+					URL:          "https://citary.app/appointments/1",
+					Status:       ical.StatusConfirmed,
+					Created:      stamp,
+					LastModified: stamp,
+				}},
+			},
+			expected: strings.Join([]string{
+				"BEGIN:VCALENDAR",
+				"VERSION:2.0",
+				"PRODID:-//Citary//Appointments//EN",
+				"CALSCALE:GREGORIAN",
+				"METHOD:PUBLISH",
+				"NAME:Citary appointments",
+				"X-WR-CALNAME:Citary appointments",
+				"REFRESH-INTERVAL;VALUE=DURATION:PT60M",
+				"X-PUBLISHED-TTL:PT60M",
+				"BEGIN:VEVENT",
+				"UID:appointment-1@citary",
+				"DTSTAMP:20260301T120000Z",
+				"DTSTART:20260309T140000Z",
+				"DTEND:20260309T143000Z",
+				"CREATED:20260301T120000Z",
+				"LAST-MODIFIED:20260301T120000Z",
+				"SUMMARY:Appointment with Dr. Ana Ruiz",
+				"DESCRIPTION:General checkup",
+				"LOCATION:Centro Médico",
+				"URL:https://citary.app/appointments/1",
+				"STATUS:CONFIRMED",
+				"END:VEVENT",
+				"END:VCALENDAR",
+			}, "\r\n") + "\r\n",
+		},
+		{
+			name:     "text escapes backslashes, semicolons and commas",
+			calendar: event(`Checkup; bring results, fasting C:\lab`),
+			expected: document(`SUMMARY:Checkup\; bring results\, fasting C:\\lab`),
+		},
+		{
+			name:     "text escapes every line break as \\n",
+			calendar: event("one\ntwo\r\nthree\rfour"),
+			expected: document(`SUMMARY:one\ntwo\nthree\nfour`),
+		},
+		{
+			name:     "line of exactly 75 octets is not folded",
+			calendar: event(strings.Repeat("a", 67)),
+			expected: document("SUMMARY:" + strings.Repeat("a", 67)),
+		},
+		{
+			name:     "long line folds at 75 octets, then 74 plus the leading space",
+			calendar: event(strings.Repeat("a", 67+74+5)),
+			expected: document(
+				"SUMMARY:"+strings.Repeat("a", 67),
+				" "+strings.Repeat("a", 74),
+				" "+strings.Repeat("a", 5),
+			),
+		},
+		{
+			name:     "fold before a two-octet rune that would cross the limit",
+			calendar: event(strings.Repeat("a", 66) + "ñandú"),
+			expected: document(
+				"SUMMARY:"+strings.Repeat("a", 66),
+				" ñandú",
+			),
+		},
+		{
+			name:     "fold before a three-octet rune that would cross the limit",
+			calendar: event(strings.Repeat("a", 65) + "€€"),
+			expected: document(
+				"SUMMARY:"+strings.Repeat("a", 65),
+				" €€",
+			),
+		},
+		{
+			name:     "fold before a four-octet rune that would cross the limit",
+			calendar: event(strings.Repeat("a", 64) + "🩺x"),
+			expected: document(
+				"SUMMARY:"+strings.Repeat("a", 64),
+				" 🩺x",
+			),
+		},
+		{
+			name:     "escape sequences count towards the fold",
+			calendar: event(strings.Repeat("a", 66) + ",b"),
+			expected: document(
+				"SUMMARY:"+strings.Repeat("a", 66)+`\`,
+				" ,b",
+			),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			output := string(tt.calendar.Bytes())
+
+			// Assert
+			assert.Equal(t, tt.expected, output)
+			for _, line := range strings.Split(strings.TrimSuffix(output, "\r\n"), "\r\n") {
+				assert.LessOrEqual(t, len(line), 75, "line %q is longer than 75 octets", line)
+				assert.True(t, utf8.ValidString(line), "line %q splits a UTF-8 sequence", line)
+			}
+		})
+	}
+}
@@ -0,0 +1,325 @@
+package scheduling_test
+
+import (
+	"citary-backend/internal/domain/entities"
+	"citary-backend/internal/domain/scheduling"
+	"citary-backend/pkg/constants"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	mainLocation  = 1
+	otherLocation = 2
+)
+
+func loadLocation(t *testing.T, name string) *time.Location {
+	t.Helper()
+	loc, err := time.LoadLocation(name)
+	require.NoError(t, err)
+	return loc
+}
+
+func utc(year int, month time.Month, day, hour, minute int) time.Time {
+	return time.Date(year, month, day, hour, minute, 0, 0, time.UTC)
+}
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func minutes(hour, minute int) *int {
+	value := hour*60 + minute
+	return &value
+}
+
+func intPtr(value int) *int {
+	return &value
+}
+
+func block(locationID int, weekday time.Weekday, start, end *int) *entities.AvailabilityBlock {
+	return &entities.AvailabilityBlock{
+		LocationID:   locationID,
+		Weekday:      weekday,
+		StartMinute:  *start,
+		EndMinute:    *end,
+		RecordStatus: constants.RecordStatus.Active,
+	}
+}
+
+func exception(kind string, locationID *int, day time.Time, start, end *int) *entities.AvailabilityException {
+	return &entities.AvailabilityException{
+		LocationID:   locationID,
+		Kind:         kind,
+		StartDate:    day,
+		EndDate:      day,
+		StartMinute:  start,
+		EndMinute:    end,
+		RecordStatus: constants.RecordStatus.Active,
+	}
+}
+
+func window(locationID int, start, end time.Time) *entities.AvailabilityWindow {
+	return &entities.AvailabilityWindow{LocationID: locationID, Start: start, End: end}
+}
+
+func TestSchedule_Windows(t *testing.T) {
+	guayaquil := loadLocation(t, "America/Guayaquil")
+	newYork := loadLocation(t, "America/New_York")
+
+	unavailable := constants.AvailabilityExceptionKind.Unavailable
+	available := constants.AvailabilityExceptionKind.Available
+
+	// 2026-03-09 is a Monday; New York springs forward on Sunday 2026-03-08 and falls back on Sunday 2026-11-01
+	monday := date(2026, 3, 9)
+	mondayRange := [2]time.Time{utc(2026, 3, 9, 0, 0), utc(2026, 3, 10, 0, 0)}
+	springRange := [2]time.Time{utc(2026, 3, 8, 0, 0), utc(2026, 3, 9, 0, 0)}
+	fallRange := [2]time.Time{utc(2026, 11, 1, 0, 0), utc(2026, 11, 2, 0, 0)}
+
+	tests := []struct {
+		name       string
+		timezone   *time.Location
+		blocks     []*entities.AvailabilityBlock
+		exceptions []*entities.AvailabilityException
+		locations  map[int]*time.Location
+		span       [2]time.Time
+		expected   []*entities.AvailabilityWindow
+	}{
+		{
+			name:     "weekly block in a timezone without DST",
+			timezone: guayaquil,
+			blocks:   []*entities.AvailabilityBlock{block(mainLocation, time.Monday, minutes(9, 0), minutes(12, 0))},
+			span:     mondayRange,
+			expected: []*entities.AvailabilityWindow{window(mainLocation, utc(2026, 3, 9, 14, 0), utc(2026, 3, 9, 17, 0))},
+		},
+		{
+			name:     "block on another weekday opens nothing",
+			timezone: guayaquil,
+			blocks:   []*entities.AvailabilityBlock{block(mainLocation, time.Tuesday, minutes(9, 0), minutes(12, 0))},
+			span:     mondayRange,
+			expected: []*entities.AvailabilityWindow{},
+		},
+		{
+			name:     "block ending at midnight runs to the end of the local day",
+			timezone: guayaquil,
+			blocks:   []*entities.AvailabilityBlock{block(mainLocation, time.Monday, minutes(20, 0), minutes(24, 0))},
+			span:     [2]time.Time{utc(2026, 3, 9, 0, 0), utc(2026, 3, 11, 0, 0)},
+			expected: []*entities.AvailabilityWindow{window(mainLocation, utc(2026, 3, 10, 1, 0), utc(2026, 3, 10, 5, 0))},
+		},
+		{
+			name:     "windows are clipped to the range",
+			timezone: guayaquil,
+			blocks:   []*entities.AvailabilityBlock{block(mainLocation, time.Monday, minutes(9, 0), minutes(12, 0))},
+			span:     [2]time.Time{utc(2026, 3, 9, 15, 0), utc(2026, 3, 9, 16, 30)},
+			expected: []*entities.AvailabilityWindow{window(mainLocation, utc(2026, 3, 9, 15, 0), utc(2026, 3, 9, 16, 30))},
+		},
+		{
+			name:     "block before its validity opens nothing",
+			timezone: guayaquil,
+			blocks: []*entities.AvailabilityBlock{func() *entities.AvailabilityBlock {
+				b := block(mainLocation, time.Monday, minutes(9, 0), minutes(12, 0))
+				validFrom := date(2026, 3, 10)
+				b.ValidFrom = &validFrom
+				return b
+			}()},
+			span:     mondayRange,
+			expected: []*entities.AvailabilityWindow{},
+		},
+		{
+			name:     "block after its validity opens nothing",
+			timezone: guayaquil,
+			blocks: []*entities.AvailabilityBlock{func() *entities.AvailabilityBlock {
+				b := block(mainLocation, time.Monday, minutes(9, 0), minutes(12, 0))
+				validUntil := date(2026, 3, 8)
+				b.ValidUntil = &validUntil
+				return b
+			}()},
+			span:     mondayRange,
+			expected: []*entities.AvailabilityWindow{},
+		},
+		{
+			name:      "block at an inactive location opens nothing",
+			timezone:  guayaquil,
+			blocks:    []*entities.AvailabilityBlock{block(otherLocation, time.Monday, minutes(9, 0), minutes(12, 0))},
+			locations: map[int]*time.Location{mainLocation: guayaquil},
+			span:      mondayRange,
+			expected:  []*entities.AvailabilityWindow{},
+		},
+		{
+			name:     "spring forward: a block across the gap is an hour shorter",
+			timezone: newYork,
+			blocks:   []*entities.AvailabilityBlock{block(mainLocation, time.Sunday, minutes(1, 0), minutes(4, 0))},
+			span:     springRange,
+			expected: []*entities.AvailabilityWindow{window(mainLocation, utc(2026, 3, 8, 6, 0), utc(2026, 3, 8, 8, 0))},
+		},
+		{
+			name:     "spring forward: a start inside the gap moves forward by the gap",
+			timezone: newYork,
+			blocks:   []*entities.AvailabilityBlock{block(mainLocation, time.Sunday, minutes(2, 30), minutes(5, 0))},
+			span:     springRange,
+			expected: []*entities.AvailabilityWindow{window(mainLocation, utc(2026, 3, 8, 7, 30), utc(2026, 3, 8, 9, 0))},
+		},
+		{
+			name:     "spring forward: an end inside the gap moves forward by the gap",
+			timezone: newYork,
+			blocks:   []*entities.AvailabilityBlock{block(mainLocation, time.Sunday, minutes(0, 0), minutes(2, 30))},
+			span:     springRange,
+			expected: []*entities.AvailabilityWindow{window(mainLocation, utc(2026, 3, 8, 5, 0), utc(2026, 3, 8, 7, 30))},
+		},
+		{
+			name:     "spring forward: hours after the change keep their wall-clock times",
+			timezone: newYork,
+			blocks:   []*entities.AvailabilityBlock{block(mainLocation, time.Sunday, minutes(9, 0), minutes(17, 0))},
+			span:     springRange,
+			expected: []*entities.AvailabilityWindow{window(mainLocation, utc(2026, 3, 8, 13, 0), utc(2026, 3, 8, 21, 0))},
+		},
+		{
+			name:     "fall back: a block across the repeated hour is an hour longer",
+			timezone: newYork,
+			blocks:   []*entities.AvailabilityBlock{block(mainLocation, time.Sunday, minutes(0, 0), minutes(3, 0))},
+			span:     fallRange,
+			expected: []*entities.AvailabilityWindow{window(mainLocation, utc(2026, 11, 1, 4, 0), utc(2026, 11, 1, 8, 0))},
+		},
+		{
+			name:     "fall back: a repeated start time resolves to its second occurrence",
+			timezone: newYork,
+			blocks:   []*entities.AvailabilityBlock{block(mainLocation, time.Sunday, minutes(1, 30), minutes(3, 0))},
+			span:     fallRange,
+			expected: []*entities.AvailabilityWindow{window(mainLocation, utc(2026, 11, 1, 6, 30), utc(2026, 11, 1, 8, 0))},
+		},
+		{
+			name:     "fall back: hours after the change keep their wall-clock times",
+			timezone: newYork,
+			blocks:   []*entities.AvailabilityBlock{block(mainLocation, time.Sunday, minutes(9, 0), minutes(17, 0))},
+			span:     fallRange,
+			expected: []*entities.AvailabilityWindow{window(mainLocation, utc(2026, 11, 1, 14, 0), utc(2026, 11, 1, 22, 0))},
+		},
+		{
+			name:       "whole-day unavailable exception without a location closes every location",
+			timezone:   guayaquil,
+			blocks:     []*entities.AvailabilityBlock{block(mainLocation, time.Monday, minutes(9, 0), minutes(12, 0)), block(otherLocation, time.Monday, minutes(14, 0), minutes(16, 0))},
+			exceptions: []*entities.AvailabilityException{exception(unavailable, nil, monday, nil, nil)},
+			span:       mondayRange,
+			expected:   []*entities.AvailabilityWindow{},
+		},
+		{
+			name:       "unavailable time range splits the block",
+			timezone:   guayaquil,
+			blocks:     []*entities.AvailabilityBlock{block(mainLocation, time.Monday, minutes(9, 0), minutes(12, 0))},
+			exceptions: []*entities.AvailabilityException{exception(unavailable, intPtr(mainLocation), monday, minutes(10, 0), minutes(11, 0))},
+			span:       mondayRange,
+			expected: []*entities.AvailabilityWindow{
+				window(mainLocation, utc(2026, 3, 9, 14, 0), utc(2026, 3, 9, 15, 0)),
+				window(mainLocation, utc(2026, 3, 9, 16, 0), utc(2026, 3, 9, 17, 0)),
+			},
+		},
+		{
+			name:       "unavailable exception at another location leaves the block open",
+			timezone:   guayaquil,
+			blocks:     []*entities.AvailabilityBlock{block(mainLocation, time.Monday, minutes(9, 0), minutes(12, 0))},
+			exceptions: []*entities.AvailabilityException{exception(unavailable, intPtr(otherLocation), monday, nil, nil)},
+			span:       mondayRange,
+			expected:   []*entities.AvailabilityWindow{window(mainLocation, utc(2026, 3, 9, 14, 0), utc(2026, 3, 9, 17, 0))},
+		},
+		{
+			name:       "unavailable exception on another date leaves the block open",
+			timezone:   guayaquil,
+			blocks:     []*entities.AvailabilityBlock{block(mainLocation, time.Monday, minutes(9, 0), minutes(12, 0))},
+			exceptions: []*entities.AvailabilityException{exception(unavailable, nil, date(2026, 3, 16), nil, nil)},
+			span:       mondayRange,
+			expected:   []*entities.AvailabilityWindow{window(mainLocation, utc(2026, 3, 9, 14, 0), utc(2026, 3, 9, 17, 0))},
+		},
+		{
+			name:       "available exception adjacent to a block extends its window",
+			timezone:   guayaquil,
+			blocks:     []*entities.AvailabilityBlock{block(mainLocation, time.Monday, minutes(9, 0), minutes(12, 0))},
+			exceptions: []*entities.AvailabilityException{exception(available, intPtr(mainLocation), monday, minutes(12, 0), minutes(14, 0))},
+			span:       mondayRange,
+			expected:   []*entities.AvailabilityWindow{window(mainLocation, utc(2026, 3, 9, 14, 0), utc(2026, 3, 9, 19, 0))},
+		},
+		{
+			name:     "available exception opens time without a block",
+			timezone: guayaquil,
+			exceptions: []*entities.AvailabilityException{
+				exception(available, intPtr(mainLocation), monday, minutes(8, 0), minutes(10, 0)),
+			},
+			span:     mondayRange,
+			expected: []*entities.AvailabilityWindow{window(mainLocation, utc(2026, 3, 9, 13, 0), utc(2026, 3, 9, 15, 0))},
+		},
+		{
+			name:     "unavailable exception wins over an available one",
+			timezone: guayaquil,
+			exceptions: []*entities.AvailabilityException{
+				exception(available, intPtr(mainLocation), monday, minutes(8, 0), minutes(10, 0)),
+				exception(unavailable, nil, monday, minutes(9, 0), minutes(12, 0)),
+			},
+			span:     mondayRange,
+			expected: []*entities.AvailabilityWindow{window(mainLocation, utc(2026, 3, 9, 13, 0), utc(2026, 3, 9, 14, 0))},
+		},
+		{
+			name:     "windows of several locations are ordered by start",
+			timezone: guayaquil,
+			blocks: []*entities.AvailabilityBlock{
+				block(otherLocation, time.Monday, minutes(8, 0), minutes(9, 0)),
+				block(mainLocation, time.Monday, minutes(10, 0), minutes(11, 0)),
+				block(otherLocation, time.Monday, minutes(12, 0), minutes(13, 0)),
+			},
+			span: mondayRange,
+			expected: []*entities.AvailabilityWindow{
+				window(otherLocation, utc(2026, 3, 9, 13, 0), utc(2026, 3, 9, 14, 0)),
+				window(mainLocation, utc(2026, 3, 9, 15, 0), utc(2026, 3, 9, 16, 0)),
+				window(otherLocation, utc(2026, 3, 9, 17, 0), utc(2026, 3, 9, 18, 0)),
+			},
+		},
+		{
+			name:     "each location reads its hours in its own timezone",
+			timezone: guayaquil,
+			blocks: []*entities.AvailabilityBlock{
+				block(mainLocation, time.Monday, minutes(9, 0), minutes(10, 0)),
+				block(otherLocation, time.Monday, minutes(9, 0), minutes(10, 0)),
+			},
+			locations: map[int]*time.Location{mainLocation: guayaquil, otherLocation: newYork},
+			span:      mondayRange,
+			expected: []*entities.AvailabilityWindow{
+				window(otherLocation, utc(2026, 3, 9, 13, 0), utc(2026, 3, 9, 14, 0)),
+				window(mainLocation, utc(2026, 3, 9, 14, 0), utc(2026, 3, 9, 15, 0)),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			locations := tt.locations
+			if locations == nil {
+				locations = map[int]*time.Location{mainLocation: tt.timezone, otherLocation: tt.timezone}
+			}
+			schedule := &scheduling.Schedule{
+				Blocks:     tt.blocks,
+				Exceptions: tt.exceptions,
+				Locations:  locations,
+				Timezone:   tt.timezone,
+			}
+
+			// Act
+			windows := schedule.Windows(tt.span[0], tt.span[1])
+
+			// Assert
+			assertWindows(t, tt.expected, windows)
+		})
+	}
+}
+
+// assertWindows compares windows by location and instant, whatever the time.Location they carry
+func assertWindows(t *testing.T, expected, actual []*entities.AvailabilityWindow) {
+	t.Helper()
+	require.Len(t, actual, len(expected))
+	for i := range expected {
+		assert.Equal(t, expected[i].LocationID, actual[i].LocationID, "window %d location", i)
+		assert.True(t, expected[i].Start.Equal(actual[i].Start), "window %d start: expected %s, got %s", i, expected[i].Start, actual[i].Start)
+		assert.True(t, expected[i].End.Equal(actual[i].End), "window %d end: expected %s, got %s", i, expected[i].End, actual[i].End)
+	}
+}
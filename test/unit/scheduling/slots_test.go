@@ -0,0 +1,206 @@
+package scheduling_test
+
+import (
+	"citary-backend/internal/domain/entities"
+	"citary-backend/internal/domain/scheduling"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFreeWindows(t *testing.T) {
+	morning := window(mainLocation, utc(2026, 3, 9, 14, 0), utc(2026, 3, 9, 17, 0))
+	afternoon := window(otherLocation, utc(2026, 3, 9, 19, 0), utc(2026, 3, 9, 21, 0))
+
+	tests := []struct {
+		name     string
+		windows  []*entities.AvailabilityWindow
+		bookings []scheduling.Span
+		buffer   time.Duration
+		expected []*entities.AvailabilityWindow
+	}{
+		{
+			name:     "no bookings leave the windows free",
+			windows:  []*entities.AvailabilityWindow{morning, afternoon},
+			expected: []*entities.AvailabilityWindow{morning, afternoon},
+		},
+		{
+			name:     "booking in the middle splits the window",
+			windows:  []*entities.AvailabilityWindow{morning},
+			bookings: []scheduling.Span{{Start: utc(2026, 3, 9, 15, 0), End: utc(2026, 3, 9, 15, 30)}},
+			expected: []*entities.AvailabilityWindow{
+				window(mainLocation, utc(2026, 3, 9, 14, 0), utc(2026, 3, 9, 15, 0)),
+				window(mainLocation, utc(2026, 3, 9, 15, 30), utc(2026, 3, 9, 17, 0)),
+			},
+		},
+		{
+			name:     "buffer pads the booking on both sides",
+			windows:  []*entities.AvailabilityWindow{morning},
+			bookings: []scheduling.Span{{Start: utc(2026, 3, 9, 15, 0), End: utc(2026, 3, 9, 15, 30)}},
+			buffer:   10 * time.Minute,
+			expected: []*entities.AvailabilityWindow{
+				window(mainLocation, utc(2026, 3, 9, 14, 0), utc(2026, 3, 9, 14, 50)),
+				window(mainLocation, utc(2026, 3, 9, 15, 40), utc(2026, 3, 9, 17, 0)),
+			},
+		},
+		{
+			name:     "buffer of a booking outside the window still trims it",
+			windows:  []*entities.AvailabilityWindow{morning},
+			bookings: []scheduling.Span{{Start: utc(2026, 3, 9, 13, 30), End: utc(2026, 3, 9, 14, 0)}},
+			buffer:   15 * time.Minute,
+			expected: []*entities.AvailabilityWindow{window(mainLocation, utc(2026, 3, 9, 14, 15), utc(2026, 3, 9, 17, 0))},
+		},
+		{
+			name:    "bookings whose buffers overlap close the time between them",
+			windows: []*entities.AvailabilityWindow{morning},
+			bookings: []scheduling.Span{
+				{Start: utc(2026, 3, 9, 15, 0), End: utc(2026, 3, 9, 15, 30)},
+				{Start: utc(2026, 3, 9, 15, 45), End: utc(2026, 3, 9, 16, 0)},
+			},
+			buffer: 10 * time.Minute,
+			expected: []*entities.AvailabilityWindow{
+				window(mainLocation, utc(2026, 3, 9, 14, 0), utc(2026, 3, 9, 14, 50)),
+				window(mainLocation, utc(2026, 3, 9, 16, 10), utc(2026, 3, 9, 17, 0)),
+			},
+		},
+		{
+			name:     "booking covering the window leaves nothing",
+			windows:  []*entities.AvailabilityWindow{morning},
+			bookings: []scheduling.Span{{Start: utc(2026, 3, 9, 13, 0), End: utc(2026, 3, 9, 18, 0)}},
+			expected: []*entities.AvailabilityWindow{},
+		},
+		{
+			name:     "booking at one location blocks the doctor at every location",
+			windows:  []*entities.AvailabilityWindow{morning, afternoon},
+			bookings: []scheduling.Span{{Start: utc(2026, 3, 9, 16, 0), End: utc(2026, 3, 9, 20, 0)}},
+			expected: []*entities.AvailabilityWindow{
+				window(mainLocation, utc(2026, 3, 9, 14, 0), utc(2026, 3, 9, 16, 0)),
+				window(otherLocation, utc(2026, 3, 9, 20, 0), utc(2026, 3, 9, 21, 0)),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			free := scheduling.FreeWindows(tt.windows, tt.bookings, tt.buffer)
+
+			// Assert
+			assertWindows(t, tt.expected, free)
+		})
+	}
+}
+
+func TestSlots(t *testing.T) {
+	guayaquil := loadLocation(t, "America/Guayaquil")
+	newYork := loadLocation(t, "America/New_York")
+	timezones := map[int]*time.Location{mainLocation: guayaquil, otherLocation: newYork}
+
+	morning := window(mainLocation, utc(2026, 3, 9, 14, 0), utc(2026, 3, 9, 16, 0))
+
+	tests := []struct {
+		name     string
+		free     []*entities.AvailabilityWindow
+		from     time.Time
+		length   time.Duration
+		buffer   time.Duration
+		expected []scheduling.Span
+	}{
+		{
+			name:   "window splits into back-to-back slots",
+			free:   []*entities.AvailabilityWindow{morning},
+			from:   utc(2026, 3, 9, 0, 0),
+			length: 30 * time.Minute,
+			expected: []scheduling.Span{
+				{Start: utc(2026, 3, 9, 14, 0), End: utc(2026, 3, 9, 14, 30)},
+				{Start: utc(2026, 3, 9, 14, 30), End: utc(2026, 3, 9, 15, 0)},
+				{Start: utc(2026, 3, 9, 15, 0), End: utc(2026, 3, 9, 15, 30)},
+				{Start: utc(2026, 3, 9, 15, 30), End: utc(2026, 3, 9, 16, 0)},
+			},
+		},
+		{
+			name:   "buffer separates slots and the last slot must fit",
+			free:   []*entities.AvailabilityWindow{window(mainLocation, utc(2026, 3, 9, 14, 0), utc(2026, 3, 9, 15, 50))},
+			from:   utc(2026, 3, 9, 0, 0),
+			length: 30 * time.Minute,
+			buffer: 15 * time.Minute,
+			expected: []scheduling.Span{
+				{Start: utc(2026, 3, 9, 14, 0), End: utc(2026, 3, 9, 14, 30)},
+				{Start: utc(2026, 3, 9, 14, 45), End: utc(2026, 3, 9, 15, 15)},
+			},
+		},
+		{
+			name:   "slots before from are skipped without shifting the others",
+			free:   []*entities.AvailabilityWindow{morning},
+			from:   utc(2026, 3, 9, 14, 10),
+			length: 30 * time.Minute,
+			buffer: 15 * time.Minute,
+			expected: []scheduling.Span{
+				{Start: utc(2026, 3, 9, 14, 45), End: utc(2026, 3, 9, 15, 15)},
+				{Start: utc(2026, 3, 9, 15, 30), End: utc(2026, 3, 9, 16, 0)},
+			},
+		},
+		{
+			name:     "window shorter than a slot has none",
+			free:     []*entities.AvailabilityWindow{window(mainLocation, utc(2026, 3, 9, 14, 0), utc(2026, 3, 9, 14, 20))},
+			from:     utc(2026, 3, 9, 0, 0),
+			length:   30 * time.Minute,
+			expected: []scheduling.Span{},
+		},
+		{
+			name:     "zero length has no slots",
+			free:     []*entities.AvailabilityWindow{morning},
+			from:     utc(2026, 3, 9, 0, 0),
+			expected: []scheduling.Span{},
+		},
+		{
+			name:   "slot across spring forward lasts exactly its length",
+			free:   []*entities.AvailabilityWindow{window(otherLocation, utc(2026, 3, 8, 6, 0), utc(2026, 3, 8, 8, 0))},
+			from:   utc(2026, 3, 8, 0, 0),
+			length: time.Hour,
+			expected: []scheduling.Span{
+				{Start: utc(2026, 3, 8, 6, 0), End: utc(2026, 3, 8, 7, 0)},
+				{Start: utc(2026, 3, 8, 7, 0), End: utc(2026, 3, 8, 8, 0)},
+			},
+		},
+		{
+			name:   "slot across fall back lasts exactly its length",
+			free:   []*entities.AvailabilityWindow{window(otherLocation, utc(2026, 11, 1, 5, 30), utc(2026, 11, 1, 6, 30))},
+			from:   utc(2026, 11, 1, 0, 0),
+			length: time.Hour,
+			expected: []scheduling.Span{
+				{Start: utc(2026, 11, 1, 5, 30), End: utc(2026, 11, 1, 6, 30)},
+			},
+		},
+		{
+			name: "slots of several windows are ordered by start",
+			free: []*entities.AvailabilityWindow{
+				window(mainLocation, utc(2026, 3, 9, 14, 30), utc(2026, 3, 9, 15, 0)),
+				window(otherLocation, utc(2026, 3, 9, 14, 0), utc(2026, 3, 9, 14, 30)),
+			},
+			from:   utc(2026, 3, 9, 0, 0),
+			length: 30 * time.Minute,
+			expected: []scheduling.Span{
+				{Start: utc(2026, 3, 9, 14, 0), End: utc(2026, 3, 9, 14, 30)},
+				{Start: utc(2026, 3, 9, 14, 30), End: utc(2026, 3, 9, 15, 0)},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			slots := scheduling.Slots(tt.free, timezones, tt.from, tt.length, tt.buffer)
+
+			// Assert
+			require.Len(t, slots, len(tt.expected))
+			for i, expected := range tt.expected {
+				assert.True(t, expected.Start.Equal(slots[i].Start), "slot %d start: expected %s, got %s", i, expected.Start, slots[i].Start)
+				assert.True(t, expected.End.Equal(slots[i].End), "slot %d end: expected %s, got %s", i, expected.End, slots[i].End)
+				assert.Equal(t, timezones[slots[i].LocationID].String(), slots[i].Timezone)
+			}
+		})
+	}
+}
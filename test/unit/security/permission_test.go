@@ -0,0 +1,124 @@
+package security_test
+
+import (
+	"citary-backend/internal/domain/security"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPermissionSet_Allows(t *testing.T) {
+	tests := []struct {
+		name       string
+		granted    []string
+		permission string
+		expected   bool
+	}{
+		{name: "global wildcard grants a two segment permission", granted: []string{"*"}, permission: "users:read", expected: true},
+		{name: "global wildcard grants a scoped permission", granted: []string{"*"}, permission: "appointments:read:any", expected: true},
+		{name: "exact match", granted: []string{"users:read"}, permission: "users:read", expected: true},
+		{name: "different action", granted: []string{"users:read"}, permission: "users:update", expected: false},
+		{name: "different resource", granted: []string{"users:read"}, permission: "roles:read", expected: false},
+		{name: "unscoped grant does not reach the any scope", granted: []string{"users:read"}, permission: "users:read:any", expected: false},
+		{name: "scoped grant does not imply the unscoped permission", granted: []string{"users:read:any"}, permission: "users:read", expected: false},
+		{name: "scoped grant matches its scope", granted: []string{"users:read:any"}, permission: "users:read:any", expected: true},
+		{name: "resource wildcard grants every action", granted: []string{"appointments:*"}, permission: "appointments:manage", expected: true},
+		{name: "resource wildcard grants scoped actions", granted: []string{"appointments:*"}, permission: "appointments:read:any", expected: true},
+		{name: "resource wildcard stays within its resource", granted: []string{"appointments:*"}, permission: "doctors:read", expected: false},
+		{name: "action wildcard in the middle matches one segment", granted: []string{"users:*:any"}, permission: "users:update:any", expected: true},
+		{name: "action wildcard in the middle needs the scope", granted: []string{"users:*:any"}, permission: "users:update", expected: false},
+		{name: "resource position wildcard", granted: []string{"*:read"}, permission: "doctors:read", expected: true},
+		{name: "resource position wildcard does not reach deeper segments", granted: []string{"*:read"}, permission: "users:read:any", expected: false},
+		{name: "any pattern of the set may grant", granted: []string{"roles:read", "doctors:*"}, permission: "doctors:manage", expected: true},
+		{name: "empty set grants nothing", granted: nil, permission: "users:read", expected: false},
+		{name: "blank codes are ignored", granted: []string{"", "  "}, permission: "users:read", expected: false},
+		{name: "codes are trimmed", granted: []string{" users:read "}, permission: "users:read", expected: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			set := security.NewPermissionSet(tt.granted...)
+
+			// Act
+			allowed := set.Allows(tt.permission)
+
+			// Assert
+			assert.Equal(t, tt.expected, allowed)
+		})
+	}
+}
+
+func TestPermissionSet_IsEmpty(t *testing.T) {
+	assert.True(t, security.NewPermissionSet().IsEmpty())
+	assert.True(t, security.NewPermissionSet("", " ").IsEmpty())
+	assert.False(t, security.NewPermissionSet("users:read").IsEmpty())
+}
+
+func TestParsePermissions(t *testing.T) {
+	tests := []struct {
+		name       string
+		raw        *json.RawMessage
+		permission string
+		expected   bool
+		wantErr    bool
+	}{
+		{name: "nil column", raw: nil, permission: "users:read", expected: false},
+		{name: "null column", raw: rawJSON("null"), permission: "users:read", expected: false},
+		{name: "array of codes", raw: rawJSON(`["users:read", "appointments:*"]`), permission: "appointments:create", expected: true},
+		{name: "not an array", raw: rawJSON(`{"users": "read"}`), wantErr: true},
+		{name: "array of numbers", raw: rawJSON(`[1, 2]`), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			set, err := security.ParsePermissions(tt.raw)
+
+			// Assert
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, set.Allows(tt.permission))
+		})
+	}
+}
+
+func TestIsKnownPermission(t *testing.T) {
+	tests := []struct {
+		name     string
+		pattern  string
+		expected bool
+	}{
+		{name: "global wildcard", pattern: "*", expected: true},
+		{name: "vocabulary permission", pattern: "doctors:manage", expected: true},
+		{name: "scoped vocabulary permission", pattern: "appointments:read:any", expected: true},
+		{name: "resource wildcard of a known resource", pattern: "availability:*", expected: true},
+		{name: "resource wildcard of an unknown resource", pattern: "invoices:*", expected: false},
+		{name: "wildcard action with a known scope", pattern: "appointments:*:any", expected: true},
+		{name: "resource position wildcard with a known action", pattern: "*:manage", expected: true},
+		{name: "resource position wildcard with an unknown action", pattern: "*:delete", expected: false},
+		{name: "typo in the action", pattern: "users:raed", expected: false},
+		{name: "unknown scope", pattern: "users:read:own", expected: false},
+		{name: "scope on a permission that has none", pattern: "roles:read:any", expected: false},
+		{name: "resource only", pattern: "users", expected: false},
+		{name: "surrounding spaces are ignored", pattern: " roles:read ", expected: true},
+		{name: "empty pattern", pattern: "", expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, security.IsKnownPermission(tt.pattern))
+		})
+	}
+}
+
+// rawJSON wraps a JSON literal as a permissions column value
+func rawJSON(value string) *json.RawMessage {
+	raw := json.RawMessage(value)
+	return &raw
+}
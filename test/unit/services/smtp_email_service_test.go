@@ -0,0 +1,206 @@
+package services_test
+
+import (
+	"bytes"
+	"citary-backend/internal/domain/entities"
+	"citary-backend/internal/infrastructure/config"
+	"citary-backend/internal/infrastructure/services"
+	"context"
+	"encoding/base64"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net"
+	"net/mail"
+	"net/textproto"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeSMTPServer accepts a single SMTP session on a local port and hands over the message it received
+type fakeSMTPServer struct {
+	listener net.Listener
+	messages chan []byte
+}
+
+// newFakeSMTPServer starts a server that advertises PLAIN authentication and accepts any credentials
+func newFakeSMTPServer(t *testing.T) *fakeSMTPServer {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { listener.Close() })
+
+	server := &fakeSMTPServer{listener: listener, messages: make(chan []byte, 1)}
+	go server.serve()
+	return server
+}
+
+// config returns a configuration that sends email through the server
+func (s *fakeSMTPServer) config() *config.Config {
+	host, port, _ := net.SplitHostPort(s.listener.Addr().String())
+	return &config.Config{
+		SMTPHost:      host,
+		SMTPPort:      port,
+		SMTPUsername:  "citary",
+		SMTPPassword:  "secret",
+		SMTPFromEmail: "no-reply@citary.app",
+		SMTPFromName:  "Citary",
+		FrontendURL:   "https://citary.app",
+	}
+}
+
+// message waits for the message the server received
+func (s *fakeSMTPServer) message(t *testing.T) *mail.Message {
+	select {
+	case raw := <-s.messages:
+		message, err := mail.ReadMessage(bytes.NewReader(raw))
+		require.NoError(t, err)
+		return message
+	case <-time.After(5 * time.Second):
+		t.Fatal("no message received")
+		return nil
+	}
+}
+
+// serve answers the commands net/smtp sends for one authenticated message
+func (s *fakeSMTPServer) serve() {
+	conn, err := s.listener.Accept()
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+
+	text := textproto.NewConn(conn)
+	text.PrintfLine("220 localhost ESMTP")
+	for {
+		line, err := text.ReadLine()
+		if err != nil {
+			return
+		}
+
+		switch command := strings.ToUpper(strings.Fields(line + " ")[0]); command {
+		case "EHLO", "HELO":
+			text.PrintfLine("250-localhost")
+			text.PrintfLine("250 AUTH PLAIN")
+		case "AUTH":
+			text.PrintfLine("235 Authentication successful")
+		case "MAIL", "RCPT", "RSET", "NOOP":
+			text.PrintfLine("250 OK")
+		case "DATA":
+			text.PrintfLine("354 End data with <CR><LF>.<CR><LF>")
+			raw, err := readData(text)
+			if err != nil {
+				return
+			}
+			s.messages <- raw
+			text.PrintfLine("250 OK")
+		case "QUIT":
+			text.PrintfLine("221 Bye")
+			return
+		default:
+			text.PrintfLine("502 Command not implemented")
+		}
+	}
+}
+
+// readData reads a DATA payload up to the terminating dot, undoing dot-stuffing but keeping CRLF line endings
+func readData(text *textproto.Conn) ([]byte, error) {
+	var raw bytes.Buffer
+	for {
+		line, err := text.ReadLine()
+		if err != nil {
+			return nil, err
+		}
+		if line == "." {
+			return raw.Bytes(), nil
+		}
+		raw.WriteString(strings.TrimPrefix(line, ".") + "\r\n")
+	}
+}
+
+func TestSMTPEmailService_SendAppointmentBooked(t *testing.T) {
+	doctorFirstName := "Ana"
+	appointment := &entities.Appointment{
+		ID:               42,
+		OrganizationName: "Centro Médico",
+		LocationName:     "Main office",
+		LocationTimezone: "America/Guayaquil",
+		DoctorFirstName:  &doctorFirstName,
+		StartTime:        time.Date(2026, 3, 9, 14, 0, 0, 0, time.UTC),
+	}
+
+	tests := []struct {
+		name   string
+		invite []byte
+	}{
+		{
+			name:   "calendar invite",
+			invite: []byte("BEGIN:VCALENDAR\r\nEND:VCALENDAR\r\n"),
+		},
+		{
+			name:   "invite longer than one base64 line",
+			invite: bytes.Repeat([]byte("0123456789"), 30),
+		},
+		{
+			name:   "invite exactly one base64 line long",
+			invite: bytes.Repeat([]byte{0xff}, 57),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			server := newFakeSMTPServer(t)
+			service := services.NewSMTPEmailService(server.config())
+
+			// Act
+			err := service.SendAppointmentBooked(context.Background(), "patient@example.com", appointment, tt.invite)
+
+			// Assert
+			require.NoError(t, err)
+			message := server.message(t)
+			assert.Equal(t, "patient@example.com", message.Header.Get("To"))
+
+			mediaType, params, err := mime.ParseMediaType(message.Header.Get("Content-Type"))
+			require.NoError(t, err)
+			assert.Equal(t, "multipart/mixed", mediaType)
+			require.NotEmpty(t, params["boundary"])
+
+			reader := multipart.NewReader(message.Body, params["boundary"])
+
+			htmlPart, err := reader.NextPart()
+			require.NoError(t, err)
+			assert.Equal(t, "text/html; charset=UTF-8", htmlPart.Header.Get("Content-Type"))
+			html, err := io.ReadAll(htmlPart)
+			require.NoError(t, err)
+			assert.Contains(t, string(html), "https://citary.app/appointments/42")
+
+			part, err := reader.NextPart()
+			require.NoError(t, err)
+			assert.Equal(t, "text/calendar; charset=UTF-8; method=PUBLISH", part.Header.Get("Content-Type"))
+			assert.Equal(t, "base64", part.Header.Get("Content-Transfer-Encoding"))
+
+			disposition, dispositionParams, err := mime.ParseMediaType(part.Header.Get("Content-Disposition"))
+			require.NoError(t, err)
+			assert.Equal(t, "attachment", disposition)
+			assert.Equal(t, "appointment.ics", dispositionParams["filename"])
+
+			encoded, err := io.ReadAll(part)
+			require.NoError(t, err)
+			lines := strings.Split(strings.TrimSuffix(string(encoded), "\r\n"), "\r\n")
+			for _, line := range lines {
+				assert.LessOrEqual(t, len(line), 76, "base64 line %q is longer than 76 characters", line)
+			}
+
+			decoded, err := base64.StdEncoding.DecodeString(strings.Join(lines, ""))
+			require.NoError(t, err)
+			assert.Equal(t, tt.invite, decoded)
+
+			_, err = reader.NextPart()
+			assert.ErrorIs(t, err, io.EOF)
+		})
+	}
+}
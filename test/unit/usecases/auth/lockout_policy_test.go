@@ -0,0 +1,100 @@
+package auth_test
+
+import (
+	authUseCase "citary-backend/internal/domain/usecases/auth"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLockoutPolicy_LockFor(t *testing.T) {
+	policy := authUseCase.LockoutPolicy{
+		MaxAttempts:     5,
+		LockDuration:    15 * time.Minute,
+		MaxLockDuration: 24 * time.Hour,
+	}
+
+	tests := []struct {
+		name     string
+		attempts int
+		expected time.Duration
+	}{
+		{name: "first failure", attempts: 1, expected: 0},
+		{name: "one below the threshold", attempts: 4, expected: 0},
+		{name: "threshold reached", attempts: 5, expected: 15 * time.Minute},
+		{name: "one extra failure doubles the lock", attempts: 6, expected: 30 * time.Minute},
+		{name: "two extra failures", attempts: 7, expected: time.Hour},
+		{name: "six extra failures", attempts: 11, expected: 16 * time.Hour},
+		{name: "seven extra failures hit the cap", attempts: 12, expected: 24 * time.Hour},
+		{name: "long streak stays capped", attempts: 1000, expected: 24 * time.Hour},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			lock := policy.LockFor(tt.attempts)
+
+			// Assert
+			assert.Equal(t, tt.expected, lock)
+		})
+	}
+}
+
+func TestLockoutPolicy_LockFor_CapBelowBaseDuration(t *testing.T) {
+	// Arrange
+	policy := authUseCase.LockoutPolicy{
+		MaxAttempts:     3,
+		LockDuration:    time.Hour,
+		MaxLockDuration: 30 * time.Minute,
+	}
+
+	// Act & Assert
+	assert.Equal(t, 30*time.Minute, policy.LockFor(3))
+	assert.Equal(t, 30*time.Minute, policy.LockFor(4))
+}
+
+func TestLockoutPolicy_LockFor_NoLockDuration(t *testing.T) {
+	// Arrange
+	policy := authUseCase.LockoutPolicy{MaxAttempts: 3, MaxLockDuration: time.Hour}
+
+	// Act & Assert
+	assert.Zero(t, policy.LockFor(10))
+}
+
+func TestLockoutPolicy_Schedule(t *testing.T) {
+	tests := []struct {
+		name     string
+		policy   authUseCase.LockoutPolicy
+		expected []time.Duration
+	}{
+		{
+			name:     "doubles up to the cap",
+			policy:   authUseCase.LockoutPolicy{MaxAttempts: 3, LockDuration: 15 * time.Minute, MaxLockDuration: time.Hour},
+			expected: []time.Duration{0, 0, 15 * time.Minute, 30 * time.Minute, time.Hour},
+		},
+		{
+			name:     "cap below the base duration",
+			policy:   authUseCase.LockoutPolicy{MaxAttempts: 2, LockDuration: time.Hour, MaxLockDuration: 30 * time.Minute},
+			expected: []time.Duration{0, 30 * time.Minute},
+		},
+		{
+			name:     "no lock duration never locks",
+			policy:   authUseCase.LockoutPolicy{MaxAttempts: 3, MaxLockDuration: time.Hour},
+			expected: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			schedule := tt.policy.Schedule()
+
+			// Assert
+			assert.Equal(t, tt.expected, schedule)
+			for i, lock := range schedule {
+				assert.Equal(t, tt.policy.LockFor(i+1), lock)
+			}
+		})
+	}
+}
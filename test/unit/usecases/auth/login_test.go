@@ -0,0 +1,124 @@
+package auth_test
+
+import (
+	"citary-backend/internal/domain/dtos/auth"
+	"citary-backend/internal/domain/entities"
+	domainErrors "citary-backend/internal/domain/errors"
+	authUseCase "citary-backend/internal/domain/usecases/auth"
+	"citary-backend/pkg/constants"
+	mockRepo "citary-backend/test/mocks/repositories"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const loginPassword = "ValidPass123!"
+
+var loginPolicy = authUseCase.LockoutPolicy{
+	MaxAttempts:     5,
+	LockDuration:    15 * time.Minute,
+	MaxLockDuration: 24 * time.Hour,
+}
+
+// newLoginUser returns an active, verified user whose password is loginPassword
+func newLoginUser(t *testing.T) *entities.User {
+	hash, err := bcrypt.GenerateFromPassword([]byte(loginPassword), bcrypt.MinCost)
+	require.NoError(t, err)
+
+	return &entities.User{
+		ID:            42,
+		Email:         "patient@example.com",
+		PasswordHash:  string(hash),
+		EmailVerified: true,
+		RecordStatus:  constants.RecordStatus.Active,
+	}
+}
+
+// assertDomainError checks err is a domain error with the given status code and message
+func assertDomainError(t *testing.T, err error, statusCode int, message string) {
+	var domainErr *domainErrors.DomainError
+	require.True(t, errors.As(err, &domainErr), "expected a domain error, got %v", err)
+	assert.Equal(t, statusCode, domainErr.StatusCode)
+	assert.Equal(t, message, domainErr.Message)
+}
+
+func TestLoginUseCase_Execute_UnknownEmail(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	userRepository := new(mockRepo.MockUserRepository)
+	useCase := authUseCase.NewLoginUseCase(userRepository, nil, nil, loginPolicy)
+
+	userRepository.On("FindByEmail", mock.Anything, "nobody@example.com").Return(nil, nil)
+
+	// Act
+	result, err := useCase.Execute(ctx, auth.LoginRequest{Email: "nobody@example.com", Password: loginPassword})
+
+	// Assert
+	assert.Nil(t, result)
+	assertDomainError(t, err, constants.StatusCode.Unauthorized, constants.ErrorMessages.InvalidCredentials)
+	userRepository.AssertExpectations(t)
+}
+
+func TestLoginUseCase_Execute_LockedAccount(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	userRepository := new(mockRepo.MockUserRepository)
+	useCase := authUseCase.NewLoginUseCase(userRepository, nil, nil, loginPolicy)
+
+	user := newLoginUser(t)
+	lockedUntil := time.Now().Add(time.Hour)
+	user.LockedUntil = &lockedUntil
+
+	userRepository.On("FindByEmail", mock.Anything, user.Email).Return(user, nil)
+
+	// Act: even the correct password is not checked while the lock lasts
+	result, err := useCase.Execute(ctx, auth.LoginRequest{Email: user.Email, Password: loginPassword})
+
+	// Assert
+	assert.Nil(t, result)
+	assertDomainError(t, err, constants.StatusCode.Locked, constants.ErrorMessages.AccountLocked)
+	userRepository.AssertNotCalled(t, "RegisterFailedLogin", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	userRepository.AssertExpectations(t)
+}
+
+func TestLoginUseCase_Execute_WrongPassword(t *testing.T) {
+	lockedUntil := time.Now().Add(15 * time.Minute)
+
+	tests := []struct {
+		name        string
+		lockedUntil *time.Time
+		statusCode  int
+		message     string
+	}{
+		{name: "below the threshold", statusCode: constants.StatusCode.Unauthorized, message: constants.ErrorMessages.InvalidCredentials},
+		{name: "failure that locks the account", lockedUntil: &lockedUntil, statusCode: constants.StatusCode.Locked, message: constants.ErrorMessages.AccountLocked},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+			userRepository := new(mockRepo.MockUserRepository)
+			useCase := authUseCase.NewLoginUseCase(userRepository, nil, nil, loginPolicy)
+
+			user := newLoginUser(t)
+			userRepository.On("FindByEmail", mock.Anything, user.Email).Return(user, nil)
+			userRepository.On("RegisterFailedLogin", mock.Anything, user.ID, loginPolicy.Schedule(), mock.AnythingOfType("time.Time")).
+				Return(loginPolicy.MaxAttempts, tt.lockedUntil, nil)
+
+			// Act
+			result, err := useCase.Execute(ctx, auth.LoginRequest{Email: user.Email, Password: "WrongPass123!"})
+
+			// Assert: the policy is applied by the repository in the same update that counts the failure
+			assert.Nil(t, result)
+			assertDomainError(t, err, tt.statusCode, tt.message)
+			userRepository.AssertExpectations(t)
+		})
+	}
+}